@@ -15,14 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/dashboard/overview": {
+        "/api/v1/analytics/food-intake": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "回傳目前登入使用者的寵物數量與健康日誌數量",
+                "description": "加總寵物每日進食量並與前幾日的滾動基準比較，連續多日低於門檻時回傳食慾下降警示",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,14 +30,90 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "analytics"
+                ],
+                "summary": "查詢進食量趨勢",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始日期 (RFC3339 格式)，預設 30 天前",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (RFC3339 格式)，預設現在",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "滾動基準涵蓋的天數",
+                        "name": "baseline_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "低於基準的此百分比視為食慾下降",
+                        "name": "threshold_pct",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "連續多少天食慾下降時發出警示",
+                        "name": "consecutive_days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "僅納入有嘔吐紀錄的日誌",
+                        "name": "vomiting",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態下限 (1-7)",
+                        "name": "stool_consistency_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態上限 (1-7)",
+                        "name": "stool_consistency_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "活動量 (low, normal, high)",
+                        "name": "activity_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "情緒標籤",
+                        "name": "mood_tag",
+                        "in": "query"
+                    }
                 ],
-                "summary": "首頁快速概覽",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetDashboardOverviewResponse"
+                            "$ref": "#/definitions/endpoint.GetFoodIntakeTrendResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "401": {
@@ -47,6 +123,20 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -57,14 +147,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/expenses": {
+        "/api/v1/analytics/weight-trend": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "依條件查詢費用紀錄",
+                "description": "依日、週或月分組計算寵物的平均體重、移動平均與變化百分比，並依寵物門檻標示快速減重或增重",
                 "consumes": [
                     "application/json"
                 ],
@@ -72,69 +162,131 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "analytics"
                 ],
-                "summary": "查詢費用紀錄",
+                "summary": "查詢體重趨勢",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "寵物ID",
                         "name": "pet_id",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "分類",
-                        "name": "category",
+                        "description": "分組單位 (day, week, month)，預設 week",
+                        "name": "interval",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "起始日期 (RFC3339)",
+                        "description": "開始日期 (RFC3339 格式)",
                         "name": "start_date",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "結束日期 (RFC3339)",
+                        "description": "結束日期 (RFC3339 格式)",
                         "name": "end_date",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "移動平均涵蓋的區間數，預設 3",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "變化百分比比較的區間數，預設 1",
+                        "name": "change_window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "僅納入有嘔吐紀錄的日誌",
+                        "name": "vomiting",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態下限 (1-7)",
+                        "name": "stool_consistency_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態上限 (1-7)",
+                        "name": "stool_consistency_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "活動量 (low, normal, high)",
+                        "name": "activity_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "情緒標籤",
+                        "name": "mood_tag",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
+                            "$ref": "#/definitions/endpoint.GetWeightTrendResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/attachments": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "建立一筆新的費用紀錄",
+                "description": "查詢費用或醫療記錄的所有附件，預設依上傳時間由早到晚排列",
                 "consumes": [
                     "application/json"
                 ],
@@ -142,110 +294,196 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "attachments"
                 ],
-                "summary": "新增費用紀錄",
+                "summary": "查詢附件",
                 "parameters": [
                     {
-                        "description": "費用資料",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/endpoint.CreateExpenseRequest"
-                        }
+                        "enum": [
+                            "expense",
+                            "medical_record"
+                        ],
+                        "type": "string",
+                        "description": "所屬資料種類",
+                        "name": "owner_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "費用或醫療記錄ID",
+                        "name": "owner_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (created_at, file_name, size)，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.ListAttachmentsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/expenses/summary": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "查詢本月總支出、分類統計等摘要",
+                "description": "以 multipart/form-data 上傳收據、發票或證明文件並連結至費用或醫療記錄，支援 JPEG、PNG、WebP、GIF 與 PDF",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "attachments"
                 ],
-                "summary": "查詢費用摘要",
+                "summary": "上傳附件",
                 "parameters": [
                     {
+                        "enum": [
+                            "expense",
+                            "medical_record"
+                        ],
                         "type": "string",
-                        "description": "寵物ID",
-                        "name": "pet_id",
-                        "in": "query"
+                        "description": "所屬資料種類",
+                        "name": "owner_type",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "費用或醫療記錄ID",
+                        "name": "owner_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "附件檔案",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/expenses/{id}": {
+        "/api/v1/attachments/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "取得指定 ID 的費用紀錄",
+                "description": "取得附件的檔名、類型與大小等中繼資料",
                 "consumes": [
                     "application/json"
                 ],
@@ -253,13 +491,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "attachments"
                 ],
-                "summary": "取得單筆費用紀錄",
+                "summary": "取得附件資訊",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "費用ID",
+                        "description": "附件ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -269,42 +507,48 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                            "$ref": "#/definitions/endpoint.AttachmentResponse"
                         }
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "編輯指定 ID 的費用紀錄",
+                "description": "刪除附件的中繼資料與檔案",
                 "consumes": [
                     "application/json"
                 ],
@@ -312,67 +556,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "attachments"
                 ],
-                "summary": "編輯費用紀錄",
+                "summary": "刪除附件",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "費用ID",
+                        "description": "附件ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "費用資料",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DeleteAttachmentResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/attachments/{id}/download": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "刪除指定 ID 的費用紀錄",
+                "description": "產生附件的限時簽章下載連結，連結到期後需重新取得",
                 "consumes": [
                     "application/json"
                 ],
@@ -380,60 +623,103 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expenses"
+                    "attachments"
                 ],
-                "summary": "刪除費用紀錄",
+                "summary": "取得附件下載連結",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "費用ID",
+                        "description": "附件ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                            "$ref": "#/definitions/endpoint.DownloadAttachmentResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/health-logs": {
+        "/api/v1/avatars/{path}": {
+            "get": {
+                "description": "公開的頭像網址，轉址至限時下載連結",
+                "tags": [
+                    "pets"
+                ],
+                "summary": "取得寵物頭像",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "頭像路徑（\u003c寵物ID\u003e/\u003c鍵值\u003e/\u003c尺寸\u003e.jpg）",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/budgets": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據寵物 ID 和可選的日期範圍列出健康日誌",
+                "description": "列出目前使用者設定的所有預算",
                 "consumes": [
                     "application/json"
                 ],
@@ -441,27 +727,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health-logs"
+                    "budgets"
                 ],
-                "summary": "列出寵物的健康日誌",
+                "summary": "查詢預算清單",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "寵物 ID",
-                        "name": "pet_id",
-                        "in": "query",
-                        "required": true
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "開始日期 (RFC3339 格式)",
-                        "name": "start_date",
+                        "description": "排序欄位 (created_at, limit)，預設 created_at",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "結束日期 (RFC3339 格式)",
-                        "name": "end_date",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
                         "in": "query"
                     }
                 ],
@@ -469,28 +766,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListHealthLogsByPetResponse"
+                            "$ref": "#/definitions/endpoint.ListBudgetsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListBudgetsResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListBudgetsResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListBudgetsResponse"
                         }
                     }
                 }
@@ -501,7 +801,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "為指定的寵物建立一筆新的健康日誌紀錄",
+                "description": "依寵物（或所有寵物）與分類設定每月或每年的預算，花費達門檻時發出警示",
                 "consumes": [
                     "application/json"
                 ],
@@ -509,17 +809,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health-logs"
+                    "budgets"
                 ],
-                "summary": "建立健康日誌",
+                "summary": "建立預算",
                 "parameters": [
                     {
-                        "description": "健康日誌資訊",
-                        "name": "healthLog",
+                        "description": "預算設定",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateHealthLogRequest"
+                            "$ref": "#/definitions/endpoint.CreateBudgetRequest"
                         }
                     }
                 ],
@@ -527,41 +827,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateHealthLogResponse"
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/health-logs/{id}": {
+        "/api/v1/budgets/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "取得指定 ID 的健康日誌詳細資訊",
+                "description": "取得指定 ID 的預算設定",
                 "consumes": [
                     "application/json"
                 ],
@@ -569,13 +878,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health-logs"
+                    "budgets"
                 ],
-                "summary": "根據 ID 取得健康日誌",
+                "summary": "取得預算",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "健康日誌 ID",
+                        "description": "預算ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -585,35 +894,43 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetHealthLogByIDResponse"
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     }
                 }
@@ -624,7 +941,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "更新指定 ID 的健康日誌資訊",
+                "description": "更新預算設定；範圍或金額變更後，本期警示會重新計算",
                 "consumes": [
                     "application/json"
                 ],
@@ -632,60 +949,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health-logs"
+                    "budgets"
                 ],
-                "summary": "更新健康日誌",
+                "summary": "更新預算",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "健康日誌 ID",
+                        "description": "預算ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "更新的健康日誌資訊",
-                        "name": "healthLog",
+                        "description": "預算設定",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateHealthLogRequest"
+                            "$ref": "#/definitions/endpoint.UpdateBudgetRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateHealthLogResponse"
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.BudgetResponse"
                         }
                     }
                 }
@@ -696,7 +1039,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "刪除指定 ID 的健康日誌",
+                "description": "刪除指定 ID 的預算",
                 "consumes": [
                     "application/json"
                 ],
@@ -704,13 +1047,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "health-logs"
+                    "budgets"
                 ],
-                "summary": "刪除健康日誌",
+                "summary": "刪除預算",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "健康日誌 ID",
+                        "description": "預算ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -720,43 +1063,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteHealthLogResponse"
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteBudgetResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/hospitals": {
+        "/api/v1/budgets/{id}/status": {
             "get": {
-                "description": "根據關鍵字、縣市、狀態等條件搜尋醫院，支援分頁和排序",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "回報目前週期的已花費、預算上限、剩餘額度，以及依本月平均每日花費推估的月底花費",
                 "consumes": [
                     "application/json"
                 ],
@@ -764,198 +1114,166 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "hospitals"
+                    "budgets"
                 ],
-                "summary": "搜尋醫院",
+                "summary": "查詢預算執行狀況",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "搜尋關鍵字（醫院名稱、地址、獸醫師）",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "縣市篩選",
-                        "name": "county",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "狀態篩選（開業、歇業等）",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "執照類型篩選（動物醫院、動物診所）",
-                        "name": "license_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "座標緯度（用於距離排序）",
-                        "name": "latitude",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "座標經度（用於距離排序）",
-                        "name": "longitude",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "搜尋半徑（公里）",
-                        "name": "radius",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "頁碼（預設1）",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "每頁數量（預設20）",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "排序方式（distance, name）",
-                        "name": "sort_by",
-                        "in": "query"
+                        "description": "預算ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.SearchHospitalsResponse"
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetBudgetStatusResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/hospitals/nearby": {
-            "get": {
-                "description": "根據使用者位置座標搜尋指定半徑內的醫院",
-                "consumes": [
+        "/api/v1/calendar-feed/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "為目前使用者產生新的 iCalendar 訂閱 token，舊 token 的訂閱隨即失效；token 只在此回傳一次\n訂閱網址為 /api/v1/calendar-feed/{token}.ics，可加入 Google 或 Apple 行事曆",
+                "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hospitals"
-                ],
-                "summary": "查詢附近醫院",
-                "parameters": [
-                    {
-                        "type": "number",
-                        "description": "使用者位置緯度",
-                        "name": "latitude",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "number",
-                        "description": "使用者位置經度",
-                        "name": "longitude",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "number",
-                        "description": "搜尋半徑（公里，預設10）",
-                        "name": "radius_km",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "結果數量限制（預設50）",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "calendar-feed"
                 ],
+                "summary": "產生行事曆訂閱 token",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListNearbyHospitalsResponse"
+                            "$ref": "#/definitions/endpoint.RotateCalendarFeedTokenResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.RotateCalendarFeedTokenResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.RotateCalendarFeedTokenResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/hospitals/{id}": {
+        "/api/v1/calendar-feed/{token}": {
             "get": {
-                "description": "根據醫院ID取得完整的醫院資訊",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "以訂閱 token 輸出可存取寵物的醫療記錄下次到期日（疫苗、驅蟲、用藥等）為 iCalendar 全天事件並附提醒\n事件 UID 以醫療記錄產生，到期日變更時行事曆會更新原事件而不會重複建立",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "hospitals"
+                    "calendar-feed"
                 ],
-                "summary": "取得醫院詳細資訊",
+                "summary": "讀取行事曆訂閱",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "醫院ID",
-                        "name": "id",
+                        "description": "訂閱 token，可加上 .ics 副檔名",
+                        "name": "token",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "iCalendar 內容",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetHospitalDetailResponse"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetCalendarFeedResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetCalendarFeedResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard/overview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "回傳目前登入使用者的寵物數量與健康日誌數量",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "首頁快速概覽",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetDashboardOverviewResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -971,14 +1289,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/medical-records": {
+        "/api/v1/exchange-rates": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據寵物 ID 和可選的日期範圍列出醫療記錄",
+                "description": "查詢指定幣別的匯率表，匯率為 1 單位參考貨幣可兌換的數量，依日期由早到晚排列",
                 "consumes": [
                     "application/json"
                 ],
@@ -986,67 +1304,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "medical-records"
+                    "exchange-rates"
                 ],
-                "summary": "列出寵物的醫療記錄",
+                "summary": "查詢匯率",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "寵物 ID",
-                        "name": "pet_id",
+                        "description": "ISO 4217 幣別代碼",
+                        "name": "currency",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "開始日期 (RFC3339 格式)",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "結束日期 (RFC3339 格式)",
-                        "name": "end_date",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListMedicalRecordsByPetResponse"
+                            "$ref": "#/definitions/endpoint.ListExchangeRatesResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExchangeRatesResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExchangeRatesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExchangeRatesResponse"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "為指定的寵物建立一筆新的醫療記錄（疫苗、驅蟲、藥物治療、獸醫檢查等）",
+                "description": "管理者匯入匯率，同幣別同日期的匯率會被覆寫",
                 "consumes": [
                     "application/json"
                 ],
@@ -1054,17 +1357,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "medical-records"
+                    "exchange-rates"
                 ],
-                "summary": "建立醫療記錄",
+                "summary": "匯入匯率",
                 "parameters": [
                     {
-                        "description": "醫療記錄資訊",
-                        "name": "medicalRecord",
+                        "description": "匯率資料",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateMedicalRecordRequest"
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesRequest"
                         }
                     }
                 ],
@@ -1072,41 +1375,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreateMedicalRecordResponse"
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ImportExchangeRatesResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/medical-records/{id}": {
+        "/api/v1/expenses": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據醫療記錄 ID 取得詳細資訊",
+                "description": "依條件查詢費用紀錄，未指定寵物時查詢使用者自己的與所屬家庭的所有寵物",
                 "consumes": [
                     "application/json"
                 ],
@@ -1114,55 +1420,111 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "medical-records"
+                    "expenses"
                 ],
-                "summary": "取得醫療記錄詳情",
+                "summary": "查詢費用紀錄",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "醫療記錄 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分類",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "起始日期 (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (date, amount, category, created_at)，預設 date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetMedicalRecordResponse"
+                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExpensesResponse"
                         }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據醫療記錄 ID 更新現有醫療記錄資料",
+                "description": "建立一筆新的費用紀錄",
                 "consumes": [
                     "application/json"
                 ],
@@ -1170,71 +1532,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "medical-records"
+                    "expenses"
                 ],
-                "summary": "更新醫療記錄",
+                "summary": "新增費用紀錄",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "醫療記錄 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "要更新的醫療記錄資訊",
-                        "name": "medicalRecord",
+                        "description": "費用資料",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateMedicalRecordRequest"
+                            "$ref": "#/definitions/endpoint.CreateExpenseRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdateMedicalRecordResponse"
+                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.CreateExpenseResponse"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/expenses/summary": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據醫療記錄 ID 刪除醫療記錄資料",
+                "description": "查詢期間內的總支出、分類統計、各寵物支出、依月／季／年／分類／寵物分組的圖表序列，以及依日期排序的最近紀錄；未指定寵物時彙總使用者所有寵物",
                 "consumes": [
                     "application/json"
                 ],
@@ -1242,57 +1601,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "medical-records"
+                    "expenses"
                 ],
-                "summary": "刪除醫療記錄",
+                "summary": "查詢費用摘要",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "醫療記錄 ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始日期 (RFC3339 格式)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (RFC3339 格式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分組方式 (month, quarter, year, category, pet)，預設 month",
+                        "name": "group_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeleteMedicalRecordResponse"
+                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetExpenseSummaryResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/pets": {
+        "/api/v1/expenses/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "取得目前使用者擁有的所有寵物列表",
+                "description": "取得指定 ID 的費用紀錄",
                 "consumes": [
                     "application/json"
                 ],
@@ -1300,32 +1673,70 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pets"
+                    "expenses"
+                ],
+                "summary": "取得單筆費用紀錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "列出所有寵物",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.ListPetsResponse"
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.GetExpenseResponse"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "為使用者建立一筆新的寵物資料",
+                "description": "編輯指定 ID 的費用紀錄",
                 "consumes": [
                     "application/json"
                 ],
@@ -1333,52 +1744,97 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pets"
+                    "expenses"
                 ],
-                "summary": "建立新寵物",
+                "summary": "編輯費用紀錄",
                 "parameters": [
                     {
-                        "description": "寵物資訊",
-                        "name": "pet",
+                        "type": "string",
+                        "description": "費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "費用資料",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreatePetRequest"
+                            "$ref": "#/definitions/endpoint.UpdateExpenseRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.CreatePetResponse"
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.UpdateExpenseResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/pets/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據寵物ID取得詳細資訊",
+                "description": "刪除指定 ID 的費用紀錄",
                 "consumes": [
                     "application/json"
                 ],
@@ -1386,48 +1842,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pets"
+                    "expenses"
                 ],
-                "summary": "取得寵物資訊",
+                "summary": "刪除費用紀錄",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "寵物ID",
+                        "description": "費用ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.GetPetResponse"
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.DeleteExpenseResponse"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/v1/exports": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據寵物ID更新現有寵物資料",
+                "description": "列出使用者尚未逾期的匯出工作，預設由新到舊排列",
                 "consumes": [
                     "application/json"
                 ],
@@ -1435,64 +1909,81 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pets"
+                    "exports"
                 ],
-                "summary": "更新寵物資訊",
+                "summary": "查詢匯出紀錄",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "寵物ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
                     },
                     {
-                        "description": "要更新的寵物資訊",
-                        "name": "pet",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/endpoint.UpdatePetRequest"
-                        }
+                        "type": "string",
+                        "description": "排序欄位 (created_at)，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.UpdatePetResponse"
+                            "$ref": "#/definitions/endpoint.ListExportsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExportsResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExportsResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ListExportsResponse"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "根據寵物ID刪除寵物資料",
+                "description": "匯出使用者所有寵物、健康日誌、醫療記錄與費用。json 為含格式版本的單一 JSON 檔；csv 為每種資料各一個 CSV 的 ZIP；zip 同時包含 JSON、CSV 與附件檔案。資料量小時直接完成（status=completed），否則於背景產生（status=pending），完成後發送通知，可查詢工作狀態後下載",
                 "consumes": [
                     "application/json"
                 ],
@@ -1500,552 +1991,7614 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pets"
+                    "exports"
                 ],
-                "summary": "刪除寵物",
+                "summary": "匯出資料",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "寵物ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "匯出格式",
+                        "name": "export",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RequestExportRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/endpoint.DeletePetResponse"
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得匯出工作的狀態，status 為 completed 時即可下載",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "查詢匯出工作狀態",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "匯出工作ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ExportResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ExportResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/endpoint.ExportResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "endpoint.Coordinates": {
-            "type": "object",
-            "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "longitude": {
-                    "type": "number"
+        },
+        "/api/v1/exports/{id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "產生已完成匯出檔的限時簽章下載連結，尚未完成時回傳 409",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "取得匯出檔下載連結",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "匯出工作ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DownloadExportResponse"
+                        }
+                    }
                 }
             }
         },
-        "endpoint.CreateExpenseRequest": {
-            "type": "object",
-            "required": [
-                "amount",
-                "category",
-                "date",
-                "pet_id"
-            ],
-            "properties": {
-                "amount": {
-                    "type": "integer",
-                    "minimum": 1
+        "/api/v1/health-logs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據寵物 ID 和可選的日期範圍列出健康日誌，可依結構化觀察條件篩選",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health-logs"
+                ],
+                "summary": "列出寵物的健康日誌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物 ID",
+                        "name": "pet_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始日期 (RFC3339 格式)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (RFC3339 格式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "僅列出有嘔吐紀錄的日誌",
+                        "name": "vomiting",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態下限 (1-7)",
+                        "name": "stool_consistency_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "糞便型態上限 (1-7)",
+                        "name": "stool_consistency_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "活動量 (low, normal, high)",
+                        "name": "activity_level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "情緒標籤",
+                        "name": "mood_tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (date, weight_kg, food_gram, created_at)，預設 date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListHealthLogsByPetResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "為指定的寵物建立一筆新的健康日誌紀錄",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health-logs"
+                ],
+                "summary": "建立健康日誌",
+                "parameters": [
+                    {
+                        "description": "健康日誌資訊",
+                        "name": "healthLog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateHealthLogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateHealthLogResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/health-logs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得指定 ID 的健康日誌詳細資訊",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health-logs"
+                ],
+                "summary": "根據 ID 取得健康日誌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "健康日誌 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetHealthLogByIDResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "更新指定 ID 的健康日誌資訊",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health-logs"
+                ],
+                "summary": "更新健康日誌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "健康日誌 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新的健康日誌資訊",
+                        "name": "healthLog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateHealthLogRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateHealthLogResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "刪除指定 ID 的健康日誌",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health-logs"
+                ],
+                "summary": "刪除健康日誌",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "健康日誌 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteHealthLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hospitals": {
+            "get": {
+                "description": "根據關鍵字、縣市、狀態等條件搜尋醫院，支援分頁和排序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hospitals"
+                ],
+                "summary": "搜尋醫院",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "搜尋關鍵字（醫院名稱、地址、獸醫師）",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "縣市篩選",
+                        "name": "county",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "狀態篩選（開業、歇業等）",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "執照類型篩選（動物醫院、動物診所）",
+                        "name": "license_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "座標緯度（用於距離排序）",
+                        "name": "latitude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "座標經度（用於距離排序）",
+                        "name": "longitude",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "搜尋半徑（公里）",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "頁碼（預設1）",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁數量（預設20）",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方式（distance, name）",
+                        "name": "sort_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.SearchHospitalsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hospitals/nearby": {
+            "get": {
+                "description": "根據使用者位置座標搜尋指定半徑內的醫院",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hospitals"
+                ],
+                "summary": "查詢附近醫院",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "使用者位置緯度",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "使用者位置經度",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "搜尋半徑（公里，預設10）",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結果數量限制（預設50）",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListNearbyHospitalsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/hospitals/{id}": {
+            "get": {
+                "description": "根據醫院ID取得完整的醫院資訊",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hospitals"
+                ],
+                "summary": "取得醫院詳細資訊",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "醫院ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetHospitalDetailResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/households": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出目前使用者仍有效的家庭成員資格",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "查詢所屬家庭",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (name, created_at)，預設 name",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListHouseholdsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListHouseholdsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListHouseholdsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListHouseholdsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "建立共同照顧寵物的家庭，建立者自動成為 owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "建立家庭",
+                "parameters": [
+                    {
+                        "description": "家庭資料",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateHouseholdRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/households/invitations/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "以邀請 token 加入家庭，邀請使用後即失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "接受家庭邀請",
+                "parameters": [
+                    {
+                        "description": "邀請 token",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.AcceptHouseholdInvitationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/households/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得指定 ID 的家庭與成員，僅家庭成員可檢視",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "取得家庭",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "家庭ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/households/{id}/invitations": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "由 owner 產生邀請 token，token 只會回傳一次；sitter 角色須設定 member_expires_at",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "邀請家庭成員",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "家庭ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "邀請設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.InviteHouseholdMemberResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/households/{id}/members/{user_id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "由 owner 調整成員角色與到期日，家庭至少需保留一位 owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "調整家庭成員",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "家庭ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "成員使用者ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "成員設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateHouseholdMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.HouseholdResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "owner 可移除任何成員，一般成員可移除自己以退出家庭",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "households"
+                ],
+                "summary": "移除家庭成員",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "家庭ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "成員使用者ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RemoveHouseholdMemberResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/imports": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "以 multipart/form-data 上傳 CSV 或 XLSX（第一個工作表），第一列為標題列。欄位名稱與資料匯出的 CSV 相同，其他名稱可透過 mapping 對應。每列以建立資料時相同的規則驗證並回傳逐列報告；dry_run=true 時僅驗證不寫入。每列以去重鍵（dedupe_key 欄位，未提供時為該列內容）寫入，重複匯入同一檔案時已存在的資料會略過",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "批次匯入健康日誌或費用",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "匯入檔（.csv 或 .xlsx）",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資料種類：health_logs 或 expenses",
+                        "name": "entity",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "預設寵物，檔案沒有 pet_id 欄位或欄位空白時使用",
+                        "name": "pet_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "欄位對應 JSON，例如 {\\",
+                        "name": "mapping",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "檔案格式：csv 或 xlsx，未指定時依副檔名判斷",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "僅驗證不寫入",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ImportRecordsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medical-records": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據寵物 ID 和可選的日期範圍列出醫療記錄",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medical-records"
+                ],
+                "summary": "列出寵物的醫療記錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物 ID",
+                        "name": "pet_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始日期 (RFC3339 格式)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束日期 (RFC3339 格式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (date, type, next_due_date, created_at)，預設 date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicalRecordsByPetResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "為指定的寵物建立一筆新的醫療記錄（疫苗、驅蟲、藥物治療、獸醫檢查等）",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medical-records"
+                ],
+                "summary": "建立醫療記錄",
+                "parameters": [
+                    {
+                        "description": "醫療記錄資訊",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateMedicalRecordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateMedicalRecordResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medical-records/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據醫療記錄 ID 取得詳細資訊",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medical-records"
+                ],
+                "summary": "取得醫療記錄詳情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "醫療記錄 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicalRecordResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據醫療記錄 ID 更新現有醫療記錄資料",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medical-records"
+                ],
+                "summary": "更新醫療記錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "醫療記錄 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "要更新的醫療記錄資訊",
+                        "name": "medicalRecord",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateMedicalRecordRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateMedicalRecordResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據醫療記錄 ID 刪除醫療記錄資料",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medical-records"
+                ],
+                "summary": "刪除醫療記錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "醫療記錄 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicalRecordResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medication-regimens": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出指定寵物的用藥療程，未指定寵物時列出所有可存取寵物的用藥療程",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "查詢用藥療程清單",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (start_date, drug, created_at)，預設 start_date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicationRegimensResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicationRegimensResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicationRegimensResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicationRegimensResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListMedicationRegimensResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "設定長期用藥的藥品、劑量、給藥排程、起訖日期與開立處方的醫院",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "建立用藥療程",
+                "parameters": [
+                    {
+                        "description": "用藥療程設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateMedicationRegimenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medication-regimens/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得指定 ID 的用藥療程設定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "取得用藥療程",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "編輯藥品、劑量或排程；已勾選的給藥紀錄維持原樣",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "編輯用藥療程",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "用藥療程設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateMedicationRegimenRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.MedicationRegimenResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "刪除用藥療程，療程的給藥紀錄會一併刪除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "刪除用藥療程",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteMedicationRegimenResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medication-regimens/{id}/adherence": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "依日、週或月統計排定、已服、略過、漏服與尚未到期的給藥次數，\n服藥率為已到期給藥中已服的百分比，略過的給藥不計入；未指定期間時為最近 30 天，期間不可超過 1 年",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "查詢服藥率",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始時間 (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束時間 (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分組單位 (day, week, month)，預設 week",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetMedicationAdherenceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/medication-regimens/{id}/doses": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出療程在期間內排定的每次給藥：taken/skipped 為照護者勾選的紀錄，\n未勾選的給藥在寬限期內為 pending，超過寬限期為 missed；未指定期間時為前 7 天至後 1 天，期間不可超過 1 年",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "查詢給藥紀錄",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始時間 (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束時間 (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListDoseEventsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "照護者勾選排定的單次給藥為 taken 或 skipped，重複勾選同一次給藥時覆寫前一次的紀錄",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "medication-regimens"
+                ],
+                "summary": "勾選給藥",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用藥療程ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "給藥紀錄",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecordDoseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DoseEventResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notification-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得目前使用者各通知管道（LINE、Email、Webhook）的設定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "取得通知偏好",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "設定目前使用者各通知管道的目標與啟用狀態",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "更新通知偏好",
+                "parameters": [
+                    {
+                        "description": "通知偏好",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateNotificationPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.NotificationPreferenceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得目前使用者擁有及所屬家庭共享的所有寵物列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "列出所有寵物",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (name, dob, created_at)，預設 name",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListPetsResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "為使用者建立一筆新的寵物資料",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "建立新寵物",
+                "parameters": [
+                    {
+                        "description": "寵物資訊",
+                        "name": "pet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreatePetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreatePetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據寵物ID取得詳細資訊",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "取得寵物資訊",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "根據寵物ID更新現有寵物資料",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "更新寵物資訊",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "要更新的寵物資訊",
+                        "name": "pet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdatePetRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdatePetResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "預設封存寵物及其健康日誌、醫療記錄與費用，可於還原期限內還原；purge=true 時永久清除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "刪除寵物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否永久清除",
+                        "name": "purge",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeletePetResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets/{id}/avatar": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "以 multipart/form-data 上傳 JPEG、PNG、WebP 或 GIF 頭像，移除 EXIF 後產生多種尺寸的縮圖並自動設定 avatar_url，舊頭像會一併清除",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "上傳寵物頭像",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "頭像圖片",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UploadPetAvatarResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出寵物與其健康日誌、醫療記錄、費用、提醒的欄位異動紀錄，依時間由新到舊排序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "查詢寵物異動歷程",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "資料種類 (pet, health_log, medical_record, expense, reminder)",
+                        "name": "entity_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始時間 (RFC3339 格式)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束時間 (RFC3339 格式)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (created_at)，預設 created_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListPetHistoryResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets/{id}/report.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "產生可於就診時提供給獸醫的 PDF 報告，包含寵物資料、體重趨勢圖、疫苗與用藥紀錄，以及期間內值得注意的健康日誌觀察；未指定期間時為過去一年",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "下載寵物健康報告",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始時間 (RFC3339 格式)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束時間 (RFC3339 格式)",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/pets/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "在還原期限內還原已封存的寵物及與其一同封存的紀錄",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pets"
+                ],
+                "summary": "還原寵物",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RestorePetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-expenses": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出指定寵物的週期性費用，未指定寵物時列出所有可存取寵物的週期性費用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "查詢週期性費用清單",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (start_date, amount, created_at)，預設 start_date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRecurringExpensesResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRecurringExpensesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRecurringExpensesResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRecurringExpensesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRecurringExpensesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "設定每月固定日期或每隔數週的固定費用，排程器會依期別自動產生費用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "建立週期性費用",
+                "parameters": [
+                    {
+                        "description": "週期性費用設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateRecurringExpenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-expenses/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得指定 ID 的週期性費用設定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "取得週期性費用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "編輯金額、分類或排程；只影響尚未產生的期別，已產生的費用維持原樣",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "編輯週期性費用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "週期性費用設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateRecurringExpenseRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "刪除週期性費用設定，已產生的費用不會一併刪除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "刪除週期性費用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteRecurringExpenseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-expenses/{id}/pause": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "暫停後排程不再產生費用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "暫停週期性費用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-expenses/{id}/resume": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "恢復排程，自恢復當下起產生費用，不補產生暫停期間的費用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "恢復週期性費用",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recurring-expenses/{id}/skip": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "略過尚未產生費用的單一期別，該期別不會產生費用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring-expenses"
+                ],
+                "summary": "略過單次期別",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "週期性費用ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "要略過的期別日期",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.SkipRecurringExpenseRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "最後取得的 ETag，版本不符時回傳 412",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "資源版本"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.RecurringExpenseResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reminders": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出使用者寵物的提醒，可依寵物與狀態篩選",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "查詢提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "狀態，以逗號分隔 (pending,sent,snoozed,done)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (due_date, remind_at, title, created_at)，預設 due_date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRemindersResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRemindersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRemindersResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRemindersResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListRemindersResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "為寵物建立一筆手動提醒",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "新增提醒",
+                "parameters": [
+                    {
+                        "description": "提醒資料",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.CreateReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reminders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得指定 ID 的提醒",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "取得單筆提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "提醒ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "編輯指定 ID 的提醒內容與提醒時間",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "編輯提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "提醒ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "提醒資料",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "刪除指定 ID 的提醒",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "刪除提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "提醒ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.DeleteReminderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reminders/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "將指定 ID 的提醒標記為已完成",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "完成提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "提醒ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/reminders/{id}/snooze": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "將提醒延後至指定時間，或延後指定分鐘數",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reminders"
+                ],
+                "summary": "延後提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "提醒ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "延後設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.SnoozeReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ReminderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "合併健康日誌、醫療記錄、費用與醫療記錄的下次到期日，依時間排序後分頁，供日曆檢視使用\n未指定寵物時列出所有可存取寵物的事件，未指定期間時為前後一個月，期間不可超過 1 年",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timeline"
+                ],
+                "summary": "查詢寵物時間軸",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "開始時間 (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "結束時間 (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "種類，以逗號分隔 (health_log,medical_record,expense,due)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (date, type)，預設 date",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 asc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.GetPetTimelineResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "列出可檢視的封存寵物與已刪除的健康日誌、醫療記錄與費用，預設依刪除時間由新到舊排序",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "查詢垃圾桶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "項目種類（pet、health_log、medical_record、expense）",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "寵物ID",
+                        "name": "pet_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "略過的筆數，不可與 cursor 同時使用",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序欄位 (deleted_at, type)，預設 deleted_at",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序方向 (asc, desc)，預設 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListTrashResponse"
+                        },
+                        "headers": {
+                            "X-Total-Count": {
+                                "type": "integer",
+                                "description": "符合條件的總筆數"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListTrashResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListTrashResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.ListTrashResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trash/{type}/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "永久刪除垃圾桶中的項目，清除寵物會一併刪除其所有關聯紀錄，無法復原",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "永久清除垃圾桶項目",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "項目種類（pet、health_log、medical_record、expense）",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "項目ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/trash/{type}/{id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "在保留期限內還原項目；還原寵物會一併還原與其一同封存的紀錄，還原紀錄前所屬寵物須未被刪除",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trash"
+                ],
+                "summary": "還原垃圾桶項目",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "項目種類（pet、health_log、medical_record、expense）",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "項目ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.TrashItemResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user-settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "取得目前使用者的基準貨幣等設定，尚未設定時回傳系統預設值",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-settings"
+                ],
+                "summary": "取得使用者設定",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "設定目前使用者的基準貨幣，費用摘要與預算將換算為此貨幣",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-settings"
+                ],
+                "summary": "更新使用者設定",
+                "parameters": [
+                    {
+                        "description": "使用者設定",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UpdateUserSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/endpoint.UserSettingsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "endpoint.AcceptHouseholdInvitationRequest": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "attachment": {
+                    "$ref": "#/definitions/model.Attachment"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.BudgetResponse": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "$ref": "#/definitions/model.Budget"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.Coordinates": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                }
+            }
+        },
+        "endpoint.CreateBudgetRequest": {
+            "type": "object",
+            "required": [
+                "limit",
+                "period"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "period": {
+                    "$ref": "#/definitions/model.BudgetPeriod"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "endpoint.CreateExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "date",
+                "pet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "category": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency ISO 4217 幣別代碼，未填時使用者的基準貨幣",
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.CreateExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "expense": {
+                    "$ref": "#/definitions/model.Expense"
+                }
+            }
+        },
+        "endpoint.CreateHealthLogRequest": {
+            "type": "object",
+            "properties": {
+                "activity_level": {
+                    "$ref": "#/definitions/model.ActivityLevel"
+                },
+                "behaviour_notes": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "food_gram": {
+                    "type": "integer"
+                },
+                "litter_notes": {
+                    "type": "string"
+                },
+                "mood_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MoodTag"
+                    }
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "stool_consistency": {
+                    "type": "integer"
+                },
+                "urine_clumps": {
+                    "type": "integer"
+                },
+                "vomiting_count": {
+                    "type": "integer"
+                },
+                "weight_kg": {
+                    "type": "number"
+                }
+            }
+        },
+        "endpoint.CreateHealthLogResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "health_log": {
+                    "$ref": "#/definitions/model.HealthLog"
+                }
+            }
+        },
+        "endpoint.CreateHouseholdRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.CreateMedicalRecordRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "description",
+                "pet_id",
+                "type"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dosage": {
+                    "type": "string"
+                },
+                "next_due_date": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                }
+            }
+        },
+        "endpoint.CreateMedicalRecordResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medical_record": {
+                    "$ref": "#/definitions/model.MedicalRecord"
+                }
+            }
+        },
+        "endpoint.CreateMedicationRegimenRequest": {
+            "type": "object",
+            "required": [
+                "dose",
+                "drug",
+                "pet_id",
+                "schedule",
+                "start_date",
+                "unit"
+            ],
+            "properties": {
+                "dose": {
+                    "type": "number"
+                },
+                "drug": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "hospital_id": {
+                    "type": "string"
+                },
+                "medical_record_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/model.DoseSchedule"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.CreatePetRequest": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "breed": {
+                    "type": "string"
+                },
+                "dob": {
+                    "type": "string"
+                },
+                "household_id": {
+                    "type": "string"
+                },
+                "microchip_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "weight_alert": {
+                    "description": "WeightAlert overrides the default weight change thresholds; omit to use the defaults.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.WeightAlertThreshold"
+                        }
+                    ]
+                }
+            }
+        },
+        "endpoint.CreatePetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "pet": {
+                    "$ref": "#/definitions/model.Pet"
+                }
+            }
+        },
+        "endpoint.CreateRecurringExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "pet_id",
+                "schedule",
+                "start_date"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "category": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency ISO 4217 幣別代碼，未填時使用者的基準貨幣",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/model.ExpenseSchedule"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.CreateReminderRequest": {
+            "type": "object",
+            "required": [
+                "due_date",
+                "pet_id",
+                "title"
+            ],
+            "properties": {
+                "due_date": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "remind_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                }
+            }
+        },
+        "endpoint.DeleteAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteBudgetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteHealthLogResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "endpoint.DeleteMedicalRecordResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteMedicationRegimenResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeletePetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteRecurringExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DeleteReminderResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.DoseEventResponse": {
+            "type": "object",
+            "properties": {
+                "dose_event": {
+                    "$ref": "#/definitions/model.DoseEvent"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.DownloadAttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "download": {
+                    "$ref": "#/definitions/model.AttachmentDownload"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.DownloadExportResponse": {
+            "type": "object",
+            "properties": {
+                "download": {
+                    "$ref": "#/definitions/model.ExportDownload"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.ExportResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "export": {
+                    "$ref": "#/definitions/model.ExportJob"
+                }
+            }
+        },
+        "endpoint.GetBudgetStatusResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "status": {
+                    "$ref": "#/definitions/model.BudgetStatus"
+                }
+            }
+        },
+        "endpoint.GetCalendarFeedResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.GetDashboardOverviewResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "health_record_count": {
+                    "description": "健康日誌數量\nexample: 10",
+                    "type": "integer"
+                },
+                "pet_count": {
+                    "description": "寵物數量\nexample: 2",
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.GetExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "expense": {
+                    "$ref": "#/definitions/model.Expense"
+                }
+            }
+        },
+        "endpoint.GetExpenseSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "category_stats": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "error": {},
+                "group_by": {
+                    "$ref": "#/definitions/model.ExpenseGroupBy"
+                },
+                "pet_stats": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "recent": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Expense"
+                    }
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExpenseBucket"
+                    }
+                },
+                "total_amount": {
+                    "type": "integer"
+                },
+                "unconverted": {
+                    "description": "Unconverted 缺少匯率而未計入統計的費用，依幣別合計",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UnconvertedExpense"
+                    }
+                }
+            }
+        },
+        "endpoint.GetFoodIntakeTrendResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "trend": {
+                    "$ref": "#/definitions/model.FoodIntakeTrend"
+                }
+            }
+        },
+        "endpoint.GetHealthLogByIDResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "health_log": {
+                    "$ref": "#/definitions/model.HealthLog"
+                }
+            }
+        },
+        "endpoint.GetHospitalDetailResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "hospital": {
+                    "$ref": "#/definitions/endpoint.HospitalDTO"
+                }
+            }
+        },
+        "endpoint.GetMedicalRecordResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medical_record": {
+                    "$ref": "#/definitions/model.MedicalRecord"
+                }
+            }
+        },
+        "endpoint.GetMedicationAdherenceResponse": {
+            "type": "object",
+            "properties": {
+                "adherence": {
+                    "$ref": "#/definitions/model.MedicationAdherence"
+                },
+                "error": {}
+            }
+        },
+        "endpoint.GetPetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "pet": {
+                    "$ref": "#/definitions/model.Pet"
+                }
+            }
+        },
+        "endpoint.GetPetTimelineResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TimelineItem"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.GetWeightTrendResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "trend": {
+                    "$ref": "#/definitions/model.WeightTrend"
+                }
+            }
+        },
+        "endpoint.HospitalDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "coordinates": {
+                    "$ref": "#/definitions/endpoint.Coordinates"
+                },
+                "county": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issued_date": {
+                    "type": "string"
+                },
+                "license_no": {
+                    "type": "string"
+                },
+                "license_type": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "veterinarian": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.HouseholdResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "household": {
+                    "$ref": "#/definitions/model.Household"
+                }
+            }
+        },
+        "endpoint.ImportExchangeRatesRequest": {
+            "type": "object",
+            "required": [
+                "rates"
+            ],
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExchangeRate"
+                    }
+                }
+            }
+        },
+        "endpoint.ImportExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ImportRecordsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "report": {
+                    "$ref": "#/definitions/model.ImportReport"
+                }
+            }
+        },
+        "endpoint.InviteHouseholdMemberRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "household_id": {
+                    "type": "string"
+                },
+                "member_expires_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/model.HouseholdRole"
+                }
+            }
+        },
+        "endpoint.InviteHouseholdMemberResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "invitation": {
+                    "$ref": "#/definitions/model.HouseholdInvitation"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.ListAttachmentsResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Attachment"
+                    }
+                },
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListBudgetsResponse": {
+            "type": "object",
+            "properties": {
+                "budgets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Budget"
+                    }
+                },
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListDoseEventsResponse": {
+            "type": "object",
+            "properties": {
+                "dose_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DoseEvent"
+                    }
+                },
+                "error": {}
+            }
+        },
+        "endpoint.ListExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "result": {
+                    "$ref": "#/definitions/query.ListExchangeRatesResult"
+                }
+            }
+        },
+        "endpoint.ListExpensesResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Expense"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListExportsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "exports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExportJob"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListHealthLogsByPetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "health_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.HealthLog"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListHouseholdsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "households": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Household"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListMedicalRecordsByPetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medical_records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MedicalRecord"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListMedicationRegimensResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medication_regimens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MedicationRegimen"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListNearbyHospitalsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "hospitals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoint.HospitalDTO"
+                    }
+                }
+            }
+        },
+        "endpoint.ListPetHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AuditEntry"
+                    }
+                },
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListPetsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "pets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Pet"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListRecurringExpensesResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "recurring_expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RecurringExpense"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListRemindersResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "next_cursor": {
+                    "type": "string"
+                },
+                "reminders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Reminder"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.ListTrashResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.TrashItem"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.MedicationRegimenResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medication_regimen": {
+                    "$ref": "#/definitions/model.MedicationRegimen"
+                }
+            }
+        },
+        "endpoint.NotificationPreferenceResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "preference": {
+                    "$ref": "#/definitions/model.NotificationPreference"
+                }
+            }
+        },
+        "endpoint.RecordDoseRequest": {
+            "type": "object",
+            "required": [
+                "scheduled_at",
+                "status"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.DoseStatus"
+                },
+                "taken_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.RecurringExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "recurring_expense": {
+                    "$ref": "#/definitions/model.RecurringExpense"
+                }
+            }
+        },
+        "endpoint.ReminderResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "reminder": {
+                    "$ref": "#/definitions/model.Reminder"
+                }
+            }
+        },
+        "endpoint.RemoveHouseholdMemberResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.RequestExportRequest": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "description": "Format 匯出格式：json、csv 或 zip",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.ExportFormat"
+                        }
+                    ]
+                }
+            }
+        },
+        "endpoint.RestorePetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "pet": {
+                    "$ref": "#/definitions/model.Pet"
+                }
+            }
+        },
+        "endpoint.RotateCalendarFeedTokenResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "feed": {
+                    "$ref": "#/definitions/model.CalendarFeed"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.SearchHospitalsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "hospitals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/endpoint.HospitalDTO"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "stats": {
+                    "$ref": "#/definitions/query.SearchStats"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "endpoint.SkipRecurringExpenseRequest": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.SnoozeReminderRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "minutes": {
+                    "type": "integer"
+                },
+                "until": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.TrashItemResponse": {
+            "type": "object",
+            "properties": {
+                "error": {}
+            }
+        },
+        "endpoint.UpdateBudgetRequest": {
+            "type": "object",
+            "required": [
+                "limit",
+                "period"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "period": {
+                    "$ref": "#/definitions/model.BudgetPeriod"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "endpoint.UpdateExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "date",
+                "pet_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "category": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency ISO 4217 幣別代碼，未填時維持原幣別",
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.UpdateExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "expense": {
+                    "$ref": "#/definitions/model.Expense"
+                }
+            }
+        },
+        "endpoint.UpdateHealthLogRequest": {
+            "type": "object",
+            "properties": {
+                "activity_level": {
+                    "$ref": "#/definitions/model.ActivityLevel"
+                },
+                "behaviour_notes": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "food_gram": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "litter_notes": {
+                    "type": "string"
+                },
+                "mood_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MoodTag"
+                    }
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "stool_consistency": {
+                    "type": "integer"
+                },
+                "urine_clumps": {
+                    "type": "integer"
+                },
+                "vomiting_count": {
+                    "type": "integer"
+                },
+                "weight_kg": {
+                    "type": "number"
+                }
+            }
+        },
+        "endpoint.UpdateHealthLogResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "health_log": {
+                    "$ref": "#/definitions/model.HealthLog"
+                }
+            }
+        },
+        "endpoint.UpdateHouseholdMemberRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "household_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/model.HouseholdRole"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.UpdateMedicalRecordRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "description",
+                "pet_id",
+                "type"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dosage": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "next_due_date": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                }
+            }
+        },
+        "endpoint.UpdateMedicalRecordResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "medical_record": {
+                    "$ref": "#/definitions/model.MedicalRecord"
+                }
+            }
+        },
+        "endpoint.UpdateMedicationRegimenRequest": {
+            "type": "object",
+            "required": [
+                "dose",
+                "drug",
+                "schedule",
+                "start_date",
+                "unit"
+            ],
+            "properties": {
+                "dose": {
+                    "type": "number"
+                },
+                "drug": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "hospital_id": {
+                    "type": "string"
+                },
+                "medical_record_id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/model.DoseSchedule"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.UpdateNotificationPreferenceRequest": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NotificationChannelSetting"
+                    }
+                }
+            }
+        },
+        "endpoint.UpdatePetRequest": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "breed": {
+                    "type": "string"
+                },
+                "dob": {
+                    "type": "string"
+                },
+                "household_id": {
+                    "description": "HouseholdID moves the pet to another household; omit to keep the current one, \"\" to remove it.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "microchip_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "weight_alert": {
+                    "description": "WeightAlert overrides the default weight change thresholds; omit to keep the stored thresholds,\nsend an empty object to clear them and use the defaults.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.WeightAlertThreshold"
+                        }
+                    ]
+                }
+            }
+        },
+        "endpoint.UpdatePetResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "pet": {
+                    "$ref": "#/definitions/model.Pet"
+                }
+            }
+        },
+        "endpoint.UpdateRecurringExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "schedule",
+                "start_date"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "category": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency ISO 4217 幣別代碼，未填時維持原幣別",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/model.ExpenseSchedule"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.UpdateReminderRequest": {
+            "type": "object",
+            "required": [
+                "due_date",
+                "remind_at",
+                "title"
+            ],
+            "properties": {
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "remind_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                }
+            }
+        },
+        "endpoint.UpdateUserSettingsRequest": {
+            "type": "object",
+            "required": [
+                "base_currency"
+            ],
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "endpoint.UploadPetAvatarResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "pet": {
+                    "$ref": "#/definitions/model.Pet"
+                }
+            }
+        },
+        "endpoint.UserSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "error": {},
+                "settings": {
+                    "$ref": "#/definitions/model.UserSettings"
+                }
+            }
+        },
+        "model.ActivityLevel": {
+            "type": "string",
+            "enum": [
+                "low",
+                "normal",
+                "high"
+            ],
+            "x-enum-varnames": [
+                "ActivityLevelLow",
+                "ActivityLevelNormal",
+                "ActivityLevelHigh"
+            ]
+        },
+        "model.AppetiteAlert": {
+            "type": "object",
+            "properties": {
+                "average_ratio_pct": {
+                    "type": "number"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Attachment": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "owner_type": {
+                    "$ref": "#/definitions/model.AttachmentOwnerType"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AttachmentDownload": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AttachmentOwnerType": {
+            "type": "string",
+            "enum": [
+                "expense",
+                "medical_record"
+            ],
+            "x-enum-varnames": [
+                "AttachmentOwnerExpense",
+                "AttachmentOwnerMedicalRecord"
+            ]
+        },
+        "model.AuditAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete",
+                "restore",
+                "purge"
+            ],
+            "x-enum-varnames": [
+                "AuditActionCreate",
+                "AuditActionUpdate",
+                "AuditActionDelete",
+                "AuditActionRestore",
+                "AuditActionPurge"
+            ]
+        },
+        "model.AuditEntityType": {
+            "type": "string",
+            "enum": [
+                "pet",
+                "health_log",
+                "medical_record",
+                "expense",
+                "reminder",
+                "recurring_expense",
+                "attachment",
+                "medication_regimen",
+                "dose_event",
+                "household",
+                "budget",
+                "user_settings"
+            ],
+            "x-enum-varnames": [
+                "AuditEntityPet",
+                "AuditEntityHealthLog",
+                "AuditEntityMedicalRecord",
+                "AuditEntityExpense",
+                "AuditEntityReminder",
+                "AuditEntityRecurringExpense",
+                "AuditEntityAttachment",
+                "AuditEntityMedicationRegimen",
+                "AuditEntityDoseEvent",
+                "AuditEntityHousehold",
+                "AuditEntityBudget",
+                "AuditEntityUserSettings"
+            ]
+        },
+        "model.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/model.AuditAction"
+                },
+                "actor_id": {
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FieldChange"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "$ref": "#/definitions/model.AuditEntityType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AvatarThumbnail": {
+            "type": "object",
+            "properties": {
+                "size": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Budget": {
+            "type": "object",
+            "properties": {
+                "alerted_pct": {
+                    "type": "integer"
+                },
+                "alerted_period_start": {
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "period": {
+                    "$ref": "#/definitions/model.BudgetPeriod"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BudgetPeriod": {
+            "type": "string",
+            "enum": [
+                "monthly",
+                "yearly"
+            ],
+            "x-enum-varnames": [
+                "BudgetPeriodMonthly",
+                "BudgetPeriodYearly"
+            ]
+        },
+        "model.BudgetStatus": {
+            "type": "object",
+            "properties": {
+                "budget": {
+                    "$ref": "#/definitions/model.Budget"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "projected_month_end": {
+                    "type": "integer"
+                },
+                "projected_overrun": {
+                    "type": "boolean"
+                },
+                "remaining": {
+                    "type": "integer"
+                },
+                "spent": {
+                    "type": "integer"
+                },
+                "unconverted": {
+                    "description": "Unconverted 缺少匯率而未計入花費的費用，依幣別合計",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UnconvertedExpense"
+                    }
+                },
+                "used_pct": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.CalendarFeed": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rotated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DoseAdherence": {
+            "type": "object",
+            "properties": {
+                "adherence_pct": {
+                    "type": "number"
+                },
+                "missed": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "scheduled": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "taken": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.DoseEvent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
                 },
-                "category": {
+                "pet_id": {
                     "type": "string"
                 },
-                "date": {
+                "recorded_by": {
                     "type": "string"
                 },
-                "description": {
+                "regimen_id": {
                     "type": "string"
                 },
-                "pet_id": {
+                "scheduled_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.DoseStatus"
+                },
+                "taken_at": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "endpoint.CreateExpenseResponse": {
+        "model.DoseFrequency": {
+            "type": "string",
+            "enum": [
+                "daily",
+                "weekly"
+            ],
+            "x-enum-varnames": [
+                "DoseDaily",
+                "DoseWeekly"
+            ]
+        },
+        "model.DoseSchedule": {
             "type": "object",
             "properties": {
-                "error": {},
-                "expense": {
-                    "$ref": "#/definitions/model.Expense"
+                "frequency": {
+                    "$ref": "#/definitions/model.DoseFrequency"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "times": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "endpoint.CreateHealthLogRequest": {
+        "model.DoseStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "taken",
+                "skipped",
+                "missed"
+            ],
+            "x-enum-varnames": [
+                "DoseStatusPending",
+                "DoseStatusTaken",
+                "DoseStatusSkipped",
+                "DoseStatusMissed"
+            ]
+        },
+        "model.ExchangeRate": {
             "type": "object",
             "properties": {
-                "behaviour_notes": {
+                "currency": {
                     "type": "string"
                 },
                 "date": {
                     "type": "string"
                 },
-                "food_gram": {
-                    "type": "integer"
-                },
-                "litter_notes": {
-                    "type": "string"
+                "rate": {
+                    "type": "number"
                 },
-                "pet_id": {
+                "updated_at": {
                     "type": "string"
-                },
-                "weight_kg": {
-                    "type": "number"
-                }
-            }
-        },
-        "endpoint.CreateHealthLogResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "health_log": {
-                    "$ref": "#/definitions/model.HealthLog"
                 }
             }
         },
-        "endpoint.CreateMedicalRecordRequest": {
+        "model.Expense": {
             "type": "object",
-            "required": [
-                "date",
-                "description",
-                "pet_id",
-                "type"
-            ],
             "properties": {
-                "date": {
+                "amount": {
+                    "type": "integer"
+                },
+                "category": {
                     "type": "string"
                 },
-                "description": {
+                "created_at": {
                     "type": "string"
                 },
-                "dosage": {
+                "currency": {
                     "type": "string"
                 },
-                "next_due_date": {
+                "date": {
                     "type": "string"
                 },
-                "pet_id": {
+                "description": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/model.MedicalRecordType"
-                }
-            }
-        },
-        "endpoint.CreateMedicalRecordResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "medical_record": {
-                    "$ref": "#/definitions/model.MedicalRecord"
-                }
-            }
-        },
-        "endpoint.CreatePetRequest": {
-            "type": "object",
-            "properties": {
-                "avatar_url": {
+                "id": {
                     "type": "string"
                 },
-                "breed": {
+                "import_key": {
                     "type": "string"
                 },
-                "dob": {
+                "occurrence_date": {
                     "type": "string"
                 },
-                "microchip_id": {
+                "pet_id": {
                     "type": "string"
                 },
-                "name": {
+                "recurring_expense_id": {
                     "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "endpoint.CreatePetResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "pet": {
-                    "$ref": "#/definitions/model.Pet"
-                }
-            }
-        },
-        "endpoint.DeleteExpenseResponse": {
-            "type": "object",
-            "properties": {
-                "error": {}
-            }
-        },
-        "endpoint.DeleteHealthLogResponse": {
+        "model.ExpenseBucket": {
             "type": "object",
             "properties": {
-                "error": {},
-                "success": {
-                    "type": "boolean"
+                "count": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "endpoint.DeleteMedicalRecordResponse": {
-            "type": "object",
-            "properties": {
-                "error": {}
-            }
-        },
-        "endpoint.DeletePetResponse": {
-            "type": "object",
-            "properties": {
-                "error": {}
-            }
+        "model.ExpenseGroupBy": {
+            "type": "string",
+            "enum": [
+                "month",
+                "quarter",
+                "year",
+                "category",
+                "pet"
+            ],
+            "x-enum-varnames": [
+                "ExpenseGroupByMonth",
+                "ExpenseGroupByQuarter",
+                "ExpenseGroupByYear",
+                "ExpenseGroupByCategory",
+                "ExpenseGroupByPet"
+            ]
         },
-        "endpoint.GetDashboardOverviewResponse": {
+        "model.ExpenseSchedule": {
             "type": "object",
             "properties": {
-                "error": {},
-                "health_record_count": {
-                    "description": "健康日誌數量\nexample: 10",
+                "day_of_month": {
                     "type": "integer"
                 },
-                "pet_count": {
-                    "description": "寵物數量\nexample: 2",
+                "frequency": {
+                    "$ref": "#/definitions/model.RecurrenceFrequency"
+                },
+                "interval": {
                     "type": "integer"
                 }
             }
         },
-        "endpoint.GetExpenseResponse": {
+        "model.ExportDownload": {
             "type": "object",
             "properties": {
-                "error": {},
-                "expense": {
-                    "$ref": "#/definitions/model.Expense"
+                "expires_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.GetExpenseSummaryResponse": {
+        "model.ExportFormat": {
+            "type": "string",
+            "enum": [
+                "json",
+                "csv",
+                "zip"
+            ],
+            "x-enum-varnames": [
+                "ExportFormatJSON",
+                "ExportFormatCSV",
+                "ExportFormatZIP"
+            ]
+        },
+        "model.ExportJob": {
             "type": "object",
             "properties": {
-                "category_stats": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "completed_at": {
+                    "type": "string"
                 },
-                "error": {},
-                "recent": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Expense"
-                    }
+                "created_at": {
+                    "type": "string"
                 },
-                "total_amount": {
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "format": {
+                    "$ref": "#/definitions/model.ExportFormat"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "size": {
                     "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.ExportStatus"
                 }
             }
         },
-        "endpoint.GetHealthLogByIDResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "health_log": {
-                    "$ref": "#/definitions/model.HealthLog"
-                }
-            }
+        "model.ExportStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "completed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "ExportStatusPending",
+                "ExportStatusRunning",
+                "ExportStatusCompleted",
+                "ExportStatusFailed"
+            ]
         },
-        "endpoint.GetHospitalDetailResponse": {
+        "model.FieldChange": {
             "type": "object",
             "properties": {
-                "error": {},
-                "hospital": {
-                    "$ref": "#/definitions/endpoint.HospitalDTO"
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "field": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.GetMedicalRecordResponse": {
+        "model.FoodIntakePoint": {
             "type": "object",
             "properties": {
-                "error": {},
-                "medical_record": {
-                    "$ref": "#/definitions/model.MedicalRecord"
+                "baseline_gram": {
+                    "type": "number"
+                },
+                "below_threshold": {
+                    "type": "boolean"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "food_gram": {
+                    "type": "integer"
+                },
+                "ratio_pct": {
+                    "type": "number"
                 }
             }
         },
-        "endpoint.GetPetResponse": {
+        "model.FoodIntakeTrend": {
             "type": "object",
             "properties": {
-                "error": {},
-                "pet": {
-                    "$ref": "#/definitions/model.Pet"
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AppetiteAlert"
+                    }
+                },
+                "baseline_days": {
+                    "type": "integer"
+                },
+                "consecutive_days": {
+                    "type": "integer"
+                },
+                "pet_id": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FoodIntakePoint"
+                    }
+                },
+                "threshold_pct": {
+                    "type": "number"
                 }
             }
         },
-        "endpoint.HospitalDTO": {
+        "model.HealthLog": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "coordinates": {
-                    "$ref": "#/definitions/endpoint.Coordinates"
+                "activity_level": {
+                    "$ref": "#/definitions/model.ActivityLevel"
                 },
-                "county": {
+                "behaviour_notes": {
                     "type": "string"
                 },
-                "created_at": {
+                "date": {
                     "type": "string"
                 },
+                "food_gram": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
-                "issued_date": {
+                "import_key": {
+                    "description": "ImportKey is the dedupe key of a log created by bulk import; empty for logs entered manually.",
                     "type": "string"
                 },
-                "license_no": {
+                "litter_notes": {
                     "type": "string"
                 },
-                "license_type": {
-                    "type": "string"
+                "mood_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MoodTag"
+                    }
                 },
-                "name": {
+                "pet_id": {
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string"
+                "stool_consistency": {
+                    "type": "integer"
                 },
-                "status": {
-                    "type": "string"
+                "urine_clumps": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string"
+                "version": {
+                    "type": "integer"
                 },
-                "veterinarian": {
-                    "type": "string"
+                "vomiting_count": {
+                    "type": "integer"
+                },
+                "weight_kg": {
+                    "type": "number"
                 }
             }
         },
-        "endpoint.ListExpensesResponse": {
+        "model.Household": {
             "type": "object",
             "properties": {
-                "error": {},
-                "expenses": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invitations": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Expense"
+                        "$ref": "#/definitions/model.HouseholdInvitation"
                     }
-                }
-            }
-        },
-        "endpoint.ListHealthLogsByPetResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "health_logs": {
+                },
+                "members": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.HealthLog"
+                        "$ref": "#/definitions/model.HouseholdMember"
                     }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.ListMedicalRecordsByPetResponse": {
+        "model.HouseholdInvitation": {
             "type": "object",
             "properties": {
-                "error": {},
-                "medical_records": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.MedicalRecord"
-                    }
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "invited_by": {
+                    "type": "string"
+                },
+                "member_expires_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/model.HouseholdRole"
                 }
             }
         },
-        "endpoint.ListNearbyHospitalsResponse": {
+        "model.HouseholdMember": {
             "type": "object",
             "properties": {
-                "error": {},
-                "hospitals": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/endpoint.HospitalDTO"
-                    }
+                "expires_at": {
+                    "type": "string"
+                },
+                "joined_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/model.HouseholdRole"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.ListPetsResponse": {
+        "model.HouseholdRole": {
+            "type": "string",
+            "enum": [
+                "owner",
+                "editor",
+                "viewer",
+                "sitter"
+            ],
+            "x-enum-varnames": [
+                "HouseholdRoleOwner",
+                "HouseholdRoleEditor",
+                "HouseholdRoleViewer",
+                "HouseholdRoleSitter"
+            ]
+        },
+        "model.ImportEntity": {
+            "type": "string",
+            "enum": [
+                "health_logs",
+                "expenses"
+            ],
+            "x-enum-varnames": [
+                "ImportEntityHealthLogs",
+                "ImportEntityExpenses"
+            ]
+        },
+        "model.ImportFormat": {
+            "type": "string",
+            "enum": [
+                "csv",
+                "xlsx"
+            ],
+            "x-enum-varnames": [
+                "ImportFormatCSV",
+                "ImportFormatXLSX"
+            ]
+        },
+        "model.ImportReport": {
             "type": "object",
             "properties": {
-                "error": {},
-                "pets": {
+                "created": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "duplicates": {
+                    "type": "integer"
+                },
+                "entity": {
+                    "$ref": "#/definitions/model.ImportEntity"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "format": {
+                    "$ref": "#/definitions/model.ImportFormat"
+                },
+                "invalid": {
+                    "type": "integer"
+                },
+                "rows": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Pet"
+                        "$ref": "#/definitions/model.ImportRowResult"
                     }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
                 }
             }
         },
-        "endpoint.SearchHospitalsResponse": {
+        "model.ImportRowResult": {
             "type": "object",
             "properties": {
-                "error": {},
-                "hospitals": {
+                "dedupe_key": {
+                    "type": "string"
+                },
+                "errors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/endpoint.HospitalDTO"
+                        "type": "string"
                     }
                 },
-                "limit": {
-                    "type": "integer"
-                },
-                "page": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "stats": {
-                    "$ref": "#/definitions/query.SearchStats"
+                "pet_id": {
+                    "type": "string"
                 },
-                "total": {
+                "row": {
                     "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.ImportRowStatus"
                 }
             }
         },
-        "endpoint.UpdateExpenseRequest": {
-            "type": "object",
-            "required": [
-                "amount",
-                "category",
-                "date",
-                "pet_id"
+        "model.ImportRowStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "valid",
+                "duplicate",
+                "invalid",
+                "failed"
             ],
+            "x-enum-varnames": [
+                "ImportRowCreated",
+                "ImportRowValid",
+                "ImportRowDuplicate",
+                "ImportRowInvalid",
+                "ImportRowFailed"
+            ]
+        },
+        "model.MedicalRecord": {
+            "type": "object",
             "properties": {
-                "amount": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "category": {
-                    "type": "string"
-                },
                 "date": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "dosage": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "next_due_date": {
+                    "type": "string"
+                },
                 "pet_id": {
                     "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "endpoint.UpdateExpenseResponse": {
+        "model.MedicalRecordType": {
+            "type": "string",
+            "enum": [
+                "vaccination",
+                "deworming",
+                "medication",
+                "vet_visit",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "RecordTypeVaccination",
+                "RecordTypeDeworming",
+                "RecordTypeMedication",
+                "RecordTypeVetVisit",
+                "RecordTypeOther"
+            ]
+        },
+        "model.MedicationAdherence": {
             "type": "object",
             "properties": {
-                "error": {},
-                "expense": {
-                    "$ref": "#/definitions/model.Expense"
+                "end_date": {
+                    "type": "string"
+                },
+                "interval": {
+                    "$ref": "#/definitions/model.TrendInterval"
+                },
+                "overall": {
+                    "$ref": "#/definitions/model.DoseAdherence"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DoseAdherence"
+                    }
+                },
+                "regimen_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.UpdateHealthLogRequest": {
+        "model.MedicationRegimen": {
             "type": "object",
             "properties": {
-                "behaviour_notes": {
+                "created_at": {
                     "type": "string"
                 },
-                "date": {
+                "dose": {
+                    "type": "number"
+                },
+                "drug": {
                     "type": "string"
                 },
-                "food_gram": {
-                    "type": "integer"
+                "end_date": {
+                    "type": "string"
                 },
-                "id": {
+                "hospital_id": {
                     "type": "string"
                 },
-                "litter_notes": {
+                "id": {
                     "type": "string"
                 },
-                "pet_id": {
+                "medical_record_id": {
                     "type": "string"
                 },
-                "weight_kg": {
-                    "type": "number"
-                }
-            }
-        },
-        "endpoint.UpdateHealthLogResponse": {
-            "type": "object",
-            "properties": {
-                "error": {},
-                "health_log": {
-                    "$ref": "#/definitions/model.HealthLog"
-                }
-            }
-        },
-        "endpoint.UpdateMedicalRecordRequest": {
-            "type": "object",
-            "required": [
-                "date",
-                "description",
-                "pet_id",
-                "type"
-            ],
-            "properties": {
-                "date": {
+                "missed_checked_through": {
+                    "type": "string"
+                },
+                "notes": {
                     "type": "string"
                 },
-                "description": {
+                "pet_id": {
                     "type": "string"
                 },
-                "dosage": {
+                "schedule": {
+                    "$ref": "#/definitions/model.DoseSchedule"
+                },
+                "start_date": {
                     "type": "string"
                 },
-                "id": {
+                "timezone": {
                     "type": "string"
                 },
-                "next_due_date": {
+                "unit": {
                     "type": "string"
                 },
-                "pet_id": {
+                "updated_at": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/model.MedicalRecordType"
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "endpoint.UpdateMedicalRecordResponse": {
+        "model.MoodTag": {
+            "type": "string",
+            "enum": [
+                "calm",
+                "playful",
+                "affectionate",
+                "anxious",
+                "aggressive",
+                "lethargic",
+                "hiding"
+            ],
+            "x-enum-varnames": [
+                "MoodCalm",
+                "MoodPlayful",
+                "MoodAffectionate",
+                "MoodAnxious",
+                "MoodAggressive",
+                "MoodLethargic",
+                "MoodHiding"
+            ]
+        },
+        "model.NotificationChannel": {
+            "type": "string",
+            "enum": [
+                "line",
+                "email",
+                "webhook"
+            ],
+            "x-enum-varnames": [
+                "NotificationChannelLine",
+                "NotificationChannelEmail",
+                "NotificationChannelWebhook"
+            ]
+        },
+        "model.NotificationChannelSetting": {
             "type": "object",
             "properties": {
-                "error": {},
-                "medical_record": {
-                    "$ref": "#/definitions/model.MedicalRecord"
+                "channel": {
+                    "$ref": "#/definitions/model.NotificationChannel"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "target": {
+                    "type": "string"
                 }
             }
         },
-        "endpoint.UpdatePetRequest": {
+        "model.NotificationPreference": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NotificationChannelSetting"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Pet": {
             "type": "object",
             "properties": {
+                "avatar": {
+                    "$ref": "#/definitions/model.PetAvatar"
+                },
                 "avatar_url": {
                     "type": "string"
                 },
                 "breed": {
                     "type": "string"
                 },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
                 "dob": {
                     "type": "string"
                 },
+                "household_id": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -2054,16 +9607,47 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "weight_alert": {
+                    "$ref": "#/definitions/model.WeightAlertThreshold"
                 }
             }
         },
-        "endpoint.UpdatePetResponse": {
+        "model.PetAvatar": {
             "type": "object",
             "properties": {
-                "error": {}
+                "thumbnails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AvatarThumbnail"
+                    }
+                },
+                "uploaded_at": {
+                    "type": "string"
+                }
             }
         },
-        "model.Expense": {
+        "model.RecurrenceFrequency": {
+            "type": "string",
+            "enum": [
+                "monthly",
+                "weekly"
+            ],
+            "x-enum-varnames": [
+                "RecurrenceMonthly",
+                "RecurrenceWeekly"
+            ]
+        },
+        "model.RecurringExpense": {
             "type": "object",
             "properties": {
                 "amount": {
@@ -2075,120 +9659,316 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
-                "date": {
+                "currency": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "end_date": {
+                    "type": "string"
+                },
+                "generated_through": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "paused": {
+                    "type": "boolean"
+                },
                 "pet_id": {
                     "type": "string"
                 },
+                "schedule": {
+                    "$ref": "#/definitions/model.ExpenseSchedule"
+                },
+                "skipped_dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "start_date": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
+                },
+                "version": {
+                    "type": "integer"
                 }
             }
         },
-        "model.HealthLog": {
+        "model.Reminder": {
             "type": "object",
             "properties": {
-                "behaviour_notes": {
+                "completed_at": {
                     "type": "string"
                 },
-                "date": {
+                "created_at": {
                     "type": "string"
                 },
-                "food_gram": {
-                    "type": "integer"
+                "due_date": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "litter_notes": {
+                "medical_record_id": {
+                    "type": "string"
+                },
+                "medication_regimen_id": {
                     "type": "string"
                 },
                 "pet_id": {
                     "type": "string"
                 },
-                "weight_kg": {
-                    "type": "number"
+                "remind_at": {
+                    "type": "string"
+                },
+                "sent_at": {
+                    "type": "string"
+                },
+                "snoozed_until": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/model.ReminderStatus"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/model.MedicalRecordType"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "model.MedicalRecord": {
+        "model.ReminderStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "sent",
+                "snoozed",
+                "done"
+            ],
+            "x-enum-varnames": [
+                "ReminderStatusPending",
+                "ReminderStatusSent",
+                "ReminderStatusSnoozed",
+                "ReminderStatusDone"
+            ]
+        },
+        "model.TimelineItem": {
             "type": "object",
             "properties": {
                 "date": {
                     "type": "string"
                 },
-                "description": {
+                "expense": {
+                    "$ref": "#/definitions/model.Expense"
+                },
+                "health_log": {
+                    "$ref": "#/definitions/model.HealthLog"
+                },
+                "id": {
                     "type": "string"
                 },
-                "dosage": {
+                "medical_record": {
+                    "$ref": "#/definitions/model.MedicalRecord"
+                },
+                "pet_id": {
                     "type": "string"
                 },
-                "id": {
+                "summary": {
                     "type": "string"
                 },
-                "next_due_date": {
+                "type": {
+                    "$ref": "#/definitions/model.TimelineItemType"
+                }
+            }
+        },
+        "model.TimelineItemType": {
+            "type": "string",
+            "enum": [
+                "health_log",
+                "medical_record",
+                "expense",
+                "due"
+            ],
+            "x-enum-varnames": [
+                "TimelineItemHealthLog",
+                "TimelineItemMedicalRecord",
+                "TimelineItemExpense",
+                "TimelineItemDue"
+            ]
+        },
+        "model.TrashItem": {
+            "type": "object",
+            "properties": {
+                "deleted_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 },
                 "pet_id": {
                     "type": "string"
                 },
+                "summary": {
+                    "type": "string"
+                },
                 "type": {
-                    "$ref": "#/definitions/model.MedicalRecordType"
+                    "$ref": "#/definitions/model.TrashItemType"
                 }
             }
         },
-        "model.MedicalRecordType": {
+        "model.TrashItemType": {
             "type": "string",
             "enum": [
-                "vaccination",
-                "deworming",
-                "medication",
-                "vet_visit",
-                "other"
+                "pet",
+                "health_log",
+                "medical_record",
+                "expense"
             ],
             "x-enum-varnames": [
-                "RecordTypeVaccination",
-                "RecordTypeDeworming",
-                "RecordTypeMedication",
-                "RecordTypeVetVisit",
-                "RecordTypeOther"
+                "TrashItemPet",
+                "TrashItemHealthLog",
+                "TrashItemMedicalRecord",
+                "TrashItemExpense"
             ]
         },
-        "model.Pet": {
+        "model.TrendInterval": {
+            "type": "string",
+            "enum": [
+                "day",
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "TrendIntervalDay",
+                "TrendIntervalWeek",
+                "TrendIntervalMonth"
+            ]
+        },
+        "model.UnconvertedExpense": {
             "type": "object",
             "properties": {
-                "avatar_url": {
+                "count": {
+                    "type": "integer"
+                },
+                "currency": {
                     "type": "string"
                 },
-                "breed": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.UserSettings": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "string"
                 },
-                "dob": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "updated_at": {
                     "type": "string"
                 },
-                "microchip_id": {
+                "user_id": {
                     "type": "string"
+                }
+            }
+        },
+        "model.WeightAlert": {
+            "type": "string",
+            "enum": [
+                "rapid_loss",
+                "rapid_gain"
+            ],
+            "x-enum-varnames": [
+                "WeightAlertRapidLoss",
+                "WeightAlertRapidGain"
+            ]
+        },
+        "model.WeightAlertThreshold": {
+            "type": "object",
+            "properties": {
+                "gain_pct": {
+                    "type": "number"
                 },
-                "name": {
+                "loss_pct": {
+                    "type": "number"
+                }
+            }
+        },
+        "model.WeightTrend": {
+            "type": "object",
+            "properties": {
+                "change_window": {
+                    "type": "integer"
+                },
+                "interval": {
+                    "$ref": "#/definitions/model.TrendInterval"
+                },
+                "pet_id": {
                     "type": "string"
                 },
-                "owner_id": {
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WeightTrendPoint"
+                    }
+                },
+                "threshold": {
+                    "$ref": "#/definitions/model.WeightAlertThreshold"
+                },
+                "window": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WeightTrendPoint": {
+            "type": "object",
+            "properties": {
+                "alert": {
+                    "$ref": "#/definitions/model.WeightAlert"
+                },
+                "change_pct": {
+                    "type": "number"
+                },
+                "moving_average_kg": {
+                    "type": "number"
+                },
+                "period_start": {
                     "type": "string"
                 },
-                "updated_at": {
+                "sample_count": {
+                    "type": "integer"
+                },
+                "weight_kg": {
+                    "type": "number"
+                }
+            }
+        },
+        "query.ListExchangeRatesResult": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ExchangeRate"
+                    }
+                },
+                "reference": {
                     "type": "string"
                 }
             }
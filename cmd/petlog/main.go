@@ -9,9 +9,18 @@ import (
 	"github.com/akrylysov/algnhsa"
 	_ "github.com/blackhorseya/petlog/api" // docs is generated by Swag CLI
 	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/transport/scheduler"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
+// application 聚合 HTTP handler、背景排程器與啟動時的匯率載入
+type application struct {
+	handler       http.Handler
+	scheduler     *scheduler.Scheduler
+	exchangeRates *command.ImportExchangeRatesHandler
+}
+
 // @title PetLog API
 // @version 0.1
 // @description 寵物健康記錄管理系統 API
@@ -32,24 +41,36 @@ func main() {
 
 	// 初始化 API
 	ctx := contextx.WithContext(context.Background())
-	handler, cleanup, err := initPetAPI(ctx, *cfg)
+	app, cleanup, err := initApplication(ctx, *cfg)
 	if err != nil {
 		log.Fatalf("Pet API 初始化失敗: %v", err)
 	}
 	defer cleanup()
 
+	// 自本機檔案載入匯率
+	if path := cfg.Currency.RatesFile; path != "" {
+		if count, err := app.exchangeRates.LoadFile(ctx, path); err != nil {
+			log.Printf("匯率檔案載入失敗: %v", err)
+		} else {
+			log.Printf("已自 %s 載入 %d 筆匯率", path, count)
+		}
+	}
+
 	// 檢查是否在 Lambda 環境中運行
 	if _, ok := os.LookupEnv("AWS_LAMBDA_FUNCTION_NAME"); ok {
 		log.Println("在 Lambda 環境中運行")
-		algnhsa.ListenAndServe(handler, nil)
+		// Lambda 環境中由獨立的 cmd/scheduler 函式負責排程
+		algnhsa.ListenAndServe(app.handler, nil)
 	} else {
-		// 本地運行
+		// 本地運行，於背景啟動提醒排程器
+		go app.scheduler.Start(ctx)
+
 		port := cfg.HTTP.Port
 		if port == "" {
 			port = "8080" // 預設端口
 		}
 		log.Printf("伺服器運行在端口 %s", port)
-		if err := http.ListenAndServe(":"+port, handler); err != nil {
+		if err := http.ListenAndServe(":"+port, app.handler); err != nil {
 			log.Fatalf("伺服器啟動失敗: %s\n", err)
 		}
 	}
@@ -5,28 +5,73 @@ package main
 
 import (
 	"context"
-	"net/http"
 
 	"github.com/blackhorseya/petlog/internal/config"
 	"github.com/blackhorseya/petlog/internal/endpoint"
 	"github.com/blackhorseya/petlog/internal/infra/mongodb"
+	"github.com/blackhorseya/petlog/internal/infra/notification"
+	"github.com/blackhorseya/petlog/internal/infra/report"
+	"github.com/blackhorseya/petlog/internal/infra/storage"
 	"github.com/blackhorseya/petlog/internal/transport/gin"
+	"github.com/blackhorseya/petlog/internal/transport/scheduler"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/event"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/google/wire"
 )
 
-// initPetAPI initializes the pet API.
-func initPetAPI(c context.Context, cfg config.Config) (http.Handler, func(), error) {
+// initApplication initializes the HTTP handler and the background scheduler.
+func initApplication(c context.Context, cfg config.Config) (*application, func(), error) {
 	wire.Build(
 		// 資料庫層
 		mongodb.ProviderSet,
 		mongodb.NewPetMongoRepo,
+		mongodb.NewPetArchiveRepository,
+		mongodb.NewTrashRepository,
 		mongodb.NewHealthLogRepository,
 		mongodb.NewMedicalRecordRepository,
 		mongodb.NewExpenseRepository,
 		mongodb.NewHospitalRepository,
+		mongodb.NewReminderRepository,
+		mongodb.NewNotificationPreferenceRepository,
+		mongodb.NewNotificationDeliveryRepository,
+		mongodb.NewHouseholdRepository,
+		mongodb.NewAuditRepository,
+		mongodb.NewBudgetRepository,
+		mongodb.NewRecurringExpenseRepository,
+		mongodb.NewExchangeRateRepository,
+		mongodb.NewUserSettingsRepository,
+		mongodb.NewAttachmentRepository,
+		mongodb.NewExportJobRepository,
+		mongodb.NewCalendarFeedRepository,
+		mongodb.NewMedicationRegimenRepository,
+		mongodb.NewDoseEventRepository,
+
+		// 附件檔案儲存
+		storage.ProvideBlobStore,
+
+		// PDF 報告輸出
+		report.ProvideReportRenderer,
+
+		// 通知管道
+		notification.ProvideSenders,
+
+		// 寵物存取權檢查（飼主與家庭成員）
+		behavior.NewPetAccessGuard,
+
+		// 寵物相關資料的異動稽核
+		behavior.NewAuditTrail,
+
+		// 幣別換算
+		behavior.NewCurrencyConverter,
+
+		// 領域事件匯流排與預算門檻檢查
+		event.ProviderSet,
+		command.NewNotifyBudgetAlertHandler,
+		command.NewNotifyExportReadyHandler,
+		behavior.NewBudgetTracker,
 
 		// Pet 用例處理器
 		command.NewCreatePetHandler,
@@ -34,6 +79,12 @@ func initPetAPI(c context.Context, cfg config.Config) (http.Handler, func(), err
 		command.NewUpdatePetHandler,
 		query.NewGetPetByIDHandler,
 		query.NewListPetsByOwnerHandler,
+		command.NewRestorePetHandler,
+		query.NewListPetHistoryHandler,
+		behavior.NewPetAvatarStore,
+		command.NewUploadPetAvatarHandler,
+		query.NewGetPetAvatarHandler,
+		query.NewGetPetReportHandler,
 
 		// HealthLog 用例處理器
 		command.NewCreateHealthLogHandler,
@@ -62,9 +113,107 @@ func initPetAPI(c context.Context, cfg config.Config) (http.Handler, func(), err
 		query.NewGetHospitalDetailHandler,
 		query.NewListNearbyHospitalsHandler,
 
+		// Reminder 用例處理器
+		command.NewCreateReminderHandler,
+		command.NewUpdateReminderHandler,
+		command.NewDeleteReminderHandler,
+		command.NewSnoozeReminderHandler,
+		command.NewCompleteReminderHandler,
+		command.NewGenerateRemindersHandler,
+		command.NewDispatchRemindersHandler,
+		command.NewRetryNotificationsHandler,
+		command.NewPurgeExpiredTrashHandler,
+		query.NewGetReminderByIDHandler,
+		query.NewListRemindersHandler,
+
+		// Notification 用例處理器
+		query.NewGetNotificationPreferenceHandler,
+		command.NewUpdateNotificationPreferenceHandler,
+
+		// Household 用例處理器
+		command.NewCreateHouseholdHandler,
+		command.NewInviteHouseholdMemberHandler,
+		command.NewAcceptHouseholdInvitationHandler,
+		command.NewUpdateHouseholdMemberHandler,
+		command.NewRemoveHouseholdMemberHandler,
+		query.NewGetHouseholdByIDHandler,
+		query.NewListHouseholdsHandler,
+
+		// Trash 用例處理器
+		query.NewListTrashHandler,
+		command.NewRestoreTrashItemHandler,
+		command.NewPurgeTrashItemHandler,
+
 		// Dashboard 用例處理器
 		query.NewGetDashboardOverviewHandler,
 
+		// Analytics 用例處理器
+		query.NewGetWeightTrendHandler,
+		query.NewGetFoodIntakeTrendHandler,
+
+		// Budget 用例處理器
+		command.NewCreateBudgetHandler,
+		command.NewUpdateBudgetHandler,
+		command.NewDeleteBudgetHandler,
+		query.NewGetBudgetHandler,
+		query.NewListBudgetsHandler,
+		query.NewGetBudgetStatusHandler,
+
+		// RecurringExpense 用例處理器
+		command.NewCreateRecurringExpenseHandler,
+		command.NewUpdateRecurringExpenseHandler,
+		command.NewDeleteRecurringExpenseHandler,
+		command.NewPauseRecurringExpenseHandler,
+		command.NewSkipRecurringExpenseHandler,
+		command.NewGenerateRecurringExpensesHandler,
+		query.NewGetRecurringExpenseHandler,
+		query.NewListRecurringExpensesHandler,
+
+		// ExchangeRate 用例處理器
+		query.NewListExchangeRatesHandler,
+		command.NewImportExchangeRatesHandler,
+
+		// UserSettings 用例處理器
+		query.NewGetUserSettingsHandler,
+		command.NewUpdateUserSettingsHandler,
+
+		// Attachment 用例處理器
+		behavior.NewAttachmentOwnerResolver,
+		command.NewUploadAttachmentHandler,
+		command.NewDeleteAttachmentHandler,
+		query.NewListAttachmentsHandler,
+		query.NewGetAttachmentHandler,
+		query.NewGetAttachmentDownloadHandler,
+
+		// Export 用例處理器
+		behavior.NewDataExporter,
+		command.NewRequestExportHandler,
+		command.NewProcessExportJobsHandler,
+		query.NewListExportsHandler,
+		query.NewGetExportHandler,
+		query.NewGetExportDownloadHandler,
+
+		// Import 用例處理器
+		command.NewImportRecordsHandler,
+
+		// Timeline 用例處理器
+		query.NewGetPetTimelineHandler,
+
+		// CalendarFeed 用例處理器
+		command.NewRotateCalendarFeedTokenHandler,
+		query.NewGetCalendarFeedHandler,
+
+		// Medication 用例處理器
+		command.NewCreateMedicationRegimenHandler,
+		command.NewUpdateMedicationRegimenHandler,
+		command.NewDeleteMedicationRegimenHandler,
+		command.NewRecordDoseHandler,
+		command.NewDetectMissedDosesHandler,
+		query.NewGetMedicationRegimenHandler,
+		query.NewListMedicationRegimensHandler,
+		query.NewListDoseEventsHandler,
+		query.NewGetMedicationAdherenceHandler,
+
 		// Pet 端點層
 		endpoint.MakePetEndpoints,
 
@@ -83,10 +232,61 @@ func initPetAPI(c context.Context, cfg config.Config) (http.Handler, func(), err
 		// Hospital 端點層
 		endpoint.MakeHospitalEndpoints,
 
+		// Reminder 端點層
+		endpoint.MakeReminderEndpoints,
+
+		// Notification 端點層
+		endpoint.MakeNotificationEndpoints,
+
+		// Household 端點層
+		endpoint.MakeHouseholdEndpoints,
+
+		// Trash 端點層
+		endpoint.MakeTrashEndpoints,
+
+		// Analytics 端點層
+		endpoint.MakeAnalyticsEndpoints,
+
+		// Budget 端點層
+		endpoint.MakeBudgetEndpoints,
+
+		// RecurringExpense 端點層
+		endpoint.MakeRecurringExpenseEndpoints,
+
+		// ExchangeRate 端點層
+		endpoint.MakeExchangeRateEndpoints,
+
+		// UserSettings 端點層
+		endpoint.MakeUserSettingsEndpoints,
+
+		// Attachment 端點層
+		endpoint.MakeAttachmentEndpoints,
+
+		// Export 端點層
+		endpoint.MakeExportEndpoints,
+
+		// Import 端點層
+		endpoint.MakeImportEndpoints,
+
+		// Timeline 端點層
+		endpoint.MakeTimelineEndpoints,
+
+		// CalendarFeed 端點層
+		endpoint.MakeCalendarFeedEndpoints,
+
+		// Medication 端點層
+		endpoint.MakeMedicationEndpoints,
+
 		// Transport層
 		gin.NewGinEngine,
 		gin.NewHTTPHandler,
 
+		// 排程器
+		scheduler.ProvideJobs,
+		scheduler.NewScheduler,
+
+		wire.Struct(new(application), "*"),
+
 		// Provide an empty slice of server options.
 		wire.Value([]httptransport.ServerOption{}),
 	)
@@ -11,11 +11,16 @@ import (
 	"github.com/blackhorseya/petlog/internal/config"
 	"github.com/blackhorseya/petlog/internal/endpoint"
 	"github.com/blackhorseya/petlog/internal/infra/mongodb"
+	"github.com/blackhorseya/petlog/internal/infra/notification"
+	"github.com/blackhorseya/petlog/internal/infra/report"
+	"github.com/blackhorseya/petlog/internal/infra/storage"
 	"github.com/blackhorseya/petlog/internal/transport/gin"
+	"github.com/blackhorseya/petlog/internal/transport/scheduler"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/event"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
-	http2 "github.com/go-kit/kit/transport/http"
-	"net/http"
+	"github.com/go-kit/kit/transport/http"
 )
 
 import (
@@ -24,64 +29,194 @@ import (
 
 // Injectors from wire.go:
 
-// initPetAPI initializes the pet API.
-func initPetAPI(c context.Context, cfg config.Config) (http.Handler, func(), error) {
+// initApplication initializes the HTTP handler and the background scheduler.
+func initApplication(c context.Context, cfg config.Config) (*application, func(), error) {
 	engine := gin.NewGinEngine()
 	database, cleanup, err := mongodb.NewDatabase(cfg)
 	if err != nil {
 		return nil, nil, err
 	}
 	petRepository := mongodb.NewPetMongoRepo(database)
-	createPetHandler := command.NewCreatePetHandler(petRepository)
-	updatePetHandler := command.NewUpdatePetHandler(petRepository)
-	deletePetHandler := command.NewDeletePetHandler(petRepository)
-	getPetByIDHandler := query.NewGetPetByIDHandler(petRepository)
-	listPetsByOwnerHandler := query.NewListPetsByOwnerHandler(petRepository)
-	petEndpoints := endpoint.MakePetEndpoints(createPetHandler, updatePetHandler, deletePetHandler, getPetByIDHandler, listPetsByOwnerHandler)
+	householdRepository := mongodb.NewHouseholdRepository(database)
+	petAccessGuard := behavior.NewPetAccessGuard(petRepository, householdRepository)
+	auditRepository := mongodb.NewAuditRepository(database)
+	auditTrail := behavior.NewAuditTrail(auditRepository)
+	createPetHandler := command.NewCreatePetHandler(petRepository, petAccessGuard, auditTrail)
+	blobStore, err := storage.ProvideBlobStore(cfg)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	petAvatarStore := behavior.NewPetAvatarStore(cfg, blobStore)
+	updatePetHandler := command.NewUpdatePetHandler(petRepository, petAccessGuard, petAvatarStore, auditTrail)
+	petArchiveRepository := mongodb.NewPetArchiveRepository(database)
+	deletePetHandler := command.NewDeletePetHandler(petArchiveRepository, blobStore, petAvatarStore, petAccessGuard, auditTrail)
+	getPetByIDHandler := query.NewGetPetByIDHandler(petAccessGuard)
+	listPetsByOwnerHandler := query.NewListPetsByOwnerHandler(petAccessGuard)
+	restorePetHandler := command.NewRestorePetHandler(cfg, petArchiveRepository, petAccessGuard, auditTrail)
+	listPetHistoryHandler := query.NewListPetHistoryHandler(auditRepository, petAccessGuard)
+	uploadPetAvatarHandler := command.NewUploadPetAvatarHandler(cfg, petRepository, petAccessGuard, petAvatarStore, auditTrail)
+	getPetAvatarHandler := query.NewGetPetAvatarHandler(petAvatarStore)
 	healthLogRepository, err := mongodb.NewHealthLogRepository(database)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	createHealthLogHandler := command.NewCreateHealthLogHandler(healthLogRepository)
-	updateHealthLogHandler := command.NewUpdateHealthLogHandler(healthLogRepository)
-	deleteHealthLogHandler := command.NewDeleteHealthLogHandler(healthLogRepository)
-	getHealthLogByIDHandler := query.NewGetHealthLogByIDHandler(healthLogRepository)
-	listHealthLogsByPetHandler := query.NewListHealthLogsByPetHandler(healthLogRepository)
-	healthLogEndpoints := endpoint.ProvideHealthLogEndpoints(createHealthLogHandler, updateHealthLogHandler, deleteHealthLogHandler, getHealthLogByIDHandler, listHealthLogsByPetHandler)
-	getDashboardOverviewHandler := query.NewGetDashboardOverviewHandler(petRepository, healthLogRepository)
-	dashboardEndpoints := endpoint.NewDashboardEndpoints(getDashboardOverviewHandler)
 	medicalRecordRepository, err := mongodb.NewMedicalRecordRepository(database)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	createMedicalRecordHandler := command.NewCreateMedicalRecordHandler(medicalRecordRepository)
-	updateMedicalRecordHandler := command.NewUpdateMedicalRecordHandler(medicalRecordRepository)
-	deleteMedicalRecordHandler := command.NewDeleteMedicalRecordHandler(medicalRecordRepository)
-	getMedicalRecordByIDHandler := query.NewGetMedicalRecordByIDHandler(medicalRecordRepository)
-	listMedicalRecordsByPetHandler := query.NewListMedicalRecordsByPetHandler(medicalRecordRepository)
+	reportRenderer, err := report.ProvideReportRenderer(cfg)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	getPetReportHandler := query.NewGetPetReportHandler(cfg, healthLogRepository, medicalRecordRepository, petAccessGuard, reportRenderer)
+	petEndpoints := endpoint.MakePetEndpoints(createPetHandler, updatePetHandler, deletePetHandler, getPetByIDHandler, listPetsByOwnerHandler, restorePetHandler, listPetHistoryHandler, uploadPetAvatarHandler, getPetAvatarHandler, getPetReportHandler)
+	createHealthLogHandler := command.NewCreateHealthLogHandler(healthLogRepository, petAccessGuard, auditTrail)
+	updateHealthLogHandler := command.NewUpdateHealthLogHandler(healthLogRepository, petAccessGuard, auditTrail)
+	deleteHealthLogHandler := command.NewDeleteHealthLogHandler(healthLogRepository, petAccessGuard, auditTrail)
+	getHealthLogByIDHandler := query.NewGetHealthLogByIDHandler(healthLogRepository, petAccessGuard)
+	listHealthLogsByPetHandler := query.NewListHealthLogsByPetHandler(healthLogRepository, petAccessGuard)
+	healthLogEndpoints := endpoint.ProvideHealthLogEndpoints(createHealthLogHandler, updateHealthLogHandler, deleteHealthLogHandler, getHealthLogByIDHandler, listHealthLogsByPetHandler)
+	getDashboardOverviewHandler := query.NewGetDashboardOverviewHandler(petAccessGuard, healthLogRepository)
+	dashboardEndpoints := endpoint.NewDashboardEndpoints(getDashboardOverviewHandler)
+	createMedicalRecordHandler := command.NewCreateMedicalRecordHandler(medicalRecordRepository, petAccessGuard, auditTrail)
+	updateMedicalRecordHandler := command.NewUpdateMedicalRecordHandler(medicalRecordRepository, petAccessGuard, auditTrail)
+	deleteMedicalRecordHandler := command.NewDeleteMedicalRecordHandler(medicalRecordRepository, petAccessGuard, auditTrail)
+	getMedicalRecordByIDHandler := query.NewGetMedicalRecordByIDHandler(medicalRecordRepository, petAccessGuard)
+	listMedicalRecordsByPetHandler := query.NewListMedicalRecordsByPetHandler(medicalRecordRepository, petAccessGuard)
 	medicalRecordEndpoints := endpoint.MakeMedicalRecordEndpoints(createMedicalRecordHandler, updateMedicalRecordHandler, deleteMedicalRecordHandler, getMedicalRecordByIDHandler, listMedicalRecordsByPetHandler)
 	expenseRepository := mongodb.NewExpenseRepository(database)
-	createExpenseHandler := command.NewCreateExpenseHandler(expenseRepository, petRepository)
-	updateExpenseHandler := command.NewUpdateExpenseHandler(expenseRepository)
-	deleteExpenseHandler := command.NewDeleteExpenseHandler(expenseRepository)
-	getExpenseByIDHandler := query.NewGetExpenseByIDHandler(expenseRepository)
-	listExpensesByPetHandler := query.NewListExpensesByPetHandler(expenseRepository)
-	getExpenseSummaryHandler := query.NewGetExpenseSummaryHandler(expenseRepository)
+	budgetRepository := mongodb.NewBudgetRepository(database)
+	notificationPreferenceRepository := mongodb.NewNotificationPreferenceRepository(database)
+	notificationDeliveryRepository := mongodb.NewNotificationDeliveryRepository(database)
+	v := notification.ProvideSenders(cfg)
+	notifyBudgetAlertHandler := command.NewNotifyBudgetAlertHandler(cfg, petRepository, notificationPreferenceRepository, notificationDeliveryRepository, v)
+	notifyExportReadyHandler := command.NewNotifyExportReadyHandler(cfg, notificationPreferenceRepository, notificationDeliveryRepository, v)
+	bus := event.ProvideBus(notifyBudgetAlertHandler, notifyExportReadyHandler)
+	exchangeRateRepository := mongodb.NewExchangeRateRepository(database)
+	userSettingsRepository := mongodb.NewUserSettingsRepository(database)
+	currencyConverter := behavior.NewCurrencyConverter(cfg, exchangeRateRepository, userSettingsRepository)
+	budgetTracker := behavior.NewBudgetTracker(budgetRepository, expenseRepository, petRepository, bus, currencyConverter)
+	createExpenseHandler := command.NewCreateExpenseHandler(expenseRepository, petAccessGuard, auditTrail, budgetTracker, currencyConverter)
+	updateExpenseHandler := command.NewUpdateExpenseHandler(expenseRepository, petAccessGuard, auditTrail, budgetTracker, currencyConverter)
+	deleteExpenseHandler := command.NewDeleteExpenseHandler(expenseRepository, petAccessGuard, auditTrail)
+	getExpenseByIDHandler := query.NewGetExpenseByIDHandler(expenseRepository, petAccessGuard)
+	listExpensesByPetHandler := query.NewListExpensesByPetHandler(expenseRepository, petAccessGuard)
+	getExpenseSummaryHandler := query.NewGetExpenseSummaryHandler(expenseRepository, petAccessGuard, currencyConverter)
 	expenseEndpoints := endpoint.MakeExpenseEndpoints(createExpenseHandler, updateExpenseHandler, deleteExpenseHandler, getExpenseByIDHandler, listExpensesByPetHandler, getExpenseSummaryHandler)
 	hospitalRepository := mongodb.NewHospitalRepository(database)
 	searchHospitalsHandler := query.NewSearchHospitalsHandler(hospitalRepository)
 	getHospitalDetailHandler := query.NewGetHospitalDetailHandler(hospitalRepository)
 	listNearbyHospitalsHandler := query.NewListNearbyHospitalsHandler(hospitalRepository)
 	hospitalEndpoints := endpoint.MakeHospitalEndpoints(searchHospitalsHandler, getHospitalDetailHandler, listNearbyHospitalsHandler)
-	v := _wireValue
-	handler := gin.NewHTTPHandler(engine, cfg, petEndpoints, healthLogEndpoints, dashboardEndpoints, medicalRecordEndpoints, expenseEndpoints, hospitalEndpoints, v)
-	return handler, func() {
+	reminderRepository := mongodb.NewReminderRepository(database)
+	createReminderHandler := command.NewCreateReminderHandler(cfg, reminderRepository, petAccessGuard, auditTrail)
+	updateReminderHandler := command.NewUpdateReminderHandler(reminderRepository, petAccessGuard, auditTrail)
+	deleteReminderHandler := command.NewDeleteReminderHandler(reminderRepository, petAccessGuard, auditTrail)
+	snoozeReminderHandler := command.NewSnoozeReminderHandler(reminderRepository, petAccessGuard, auditTrail)
+	completeReminderHandler := command.NewCompleteReminderHandler(reminderRepository, petAccessGuard, auditTrail)
+	getReminderByIDHandler := query.NewGetReminderByIDHandler(reminderRepository, petAccessGuard)
+	listRemindersHandler := query.NewListRemindersHandler(reminderRepository, petAccessGuard)
+	reminderEndpoints := endpoint.MakeReminderEndpoints(createReminderHandler, updateReminderHandler, deleteReminderHandler, snoozeReminderHandler, completeReminderHandler, getReminderByIDHandler, listRemindersHandler)
+	getNotificationPreferenceHandler := query.NewGetNotificationPreferenceHandler(notificationPreferenceRepository)
+	updateNotificationPreferenceHandler := command.NewUpdateNotificationPreferenceHandler(notificationPreferenceRepository)
+	notificationEndpoints := endpoint.MakeNotificationEndpoints(getNotificationPreferenceHandler, updateNotificationPreferenceHandler)
+	createHouseholdHandler := command.NewCreateHouseholdHandler(householdRepository, auditTrail)
+	inviteHouseholdMemberHandler := command.NewInviteHouseholdMemberHandler(householdRepository, petAccessGuard, auditTrail)
+	acceptHouseholdInvitationHandler := command.NewAcceptHouseholdInvitationHandler(householdRepository, auditTrail)
+	updateHouseholdMemberHandler := command.NewUpdateHouseholdMemberHandler(householdRepository, petAccessGuard, auditTrail)
+	removeHouseholdMemberHandler := command.NewRemoveHouseholdMemberHandler(householdRepository, petAccessGuard, auditTrail)
+	getHouseholdByIDHandler := query.NewGetHouseholdByIDHandler(petAccessGuard)
+	listHouseholdsHandler := query.NewListHouseholdsHandler(householdRepository)
+	householdEndpoints := endpoint.MakeHouseholdEndpoints(createHouseholdHandler, inviteHouseholdMemberHandler, acceptHouseholdInvitationHandler, updateHouseholdMemberHandler, removeHouseholdMemberHandler, getHouseholdByIDHandler, listHouseholdsHandler)
+	trashRepository := mongodb.NewTrashRepository(database)
+	listTrashHandler := query.NewListTrashHandler(petArchiveRepository, trashRepository, petAccessGuard)
+	restoreTrashItemHandler := command.NewRestoreTrashItemHandler(cfg, petArchiveRepository, trashRepository, petAccessGuard, auditTrail)
+	purgeTrashItemHandler := command.NewPurgeTrashItemHandler(petArchiveRepository, trashRepository, blobStore, petAvatarStore, petAccessGuard, auditTrail)
+	trashEndpoints := endpoint.MakeTrashEndpoints(listTrashHandler, restoreTrashItemHandler, purgeTrashItemHandler)
+	getWeightTrendHandler := query.NewGetWeightTrendHandler(cfg, healthLogRepository, petAccessGuard)
+	getFoodIntakeTrendHandler := query.NewGetFoodIntakeTrendHandler(cfg, healthLogRepository, petAccessGuard)
+	analyticsEndpoints := endpoint.MakeAnalyticsEndpoints(getWeightTrendHandler, getFoodIntakeTrendHandler)
+	createBudgetHandler := command.NewCreateBudgetHandler(budgetRepository, petAccessGuard, auditTrail)
+	updateBudgetHandler := command.NewUpdateBudgetHandler(budgetRepository, petAccessGuard, auditTrail)
+	deleteBudgetHandler := command.NewDeleteBudgetHandler(budgetRepository, auditTrail)
+	getBudgetHandler := query.NewGetBudgetHandler(budgetRepository)
+	listBudgetsHandler := query.NewListBudgetsHandler(budgetRepository)
+	getBudgetStatusHandler := query.NewGetBudgetStatusHandler(budgetRepository, budgetTracker)
+	budgetEndpoints := endpoint.MakeBudgetEndpoints(createBudgetHandler, updateBudgetHandler, deleteBudgetHandler, getBudgetHandler, listBudgetsHandler, getBudgetStatusHandler)
+	recurringExpenseRepository := mongodb.NewRecurringExpenseRepository(database)
+	createRecurringExpenseHandler := command.NewCreateRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard, auditTrail, currencyConverter)
+	updateRecurringExpenseHandler := command.NewUpdateRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard, auditTrail, currencyConverter)
+	deleteRecurringExpenseHandler := command.NewDeleteRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard, auditTrail)
+	pauseRecurringExpenseHandler := command.NewPauseRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard, auditTrail)
+	skipRecurringExpenseHandler := command.NewSkipRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard, auditTrail)
+	getRecurringExpenseHandler := query.NewGetRecurringExpenseHandler(recurringExpenseRepository, petAccessGuard)
+	listRecurringExpensesHandler := query.NewListRecurringExpensesHandler(recurringExpenseRepository, petAccessGuard)
+	recurringExpenseEndpoints := endpoint.MakeRecurringExpenseEndpoints(createRecurringExpenseHandler, updateRecurringExpenseHandler, deleteRecurringExpenseHandler, pauseRecurringExpenseHandler, skipRecurringExpenseHandler, getRecurringExpenseHandler, listRecurringExpensesHandler)
+	listExchangeRatesHandler := query.NewListExchangeRatesHandler(exchangeRateRepository, currencyConverter)
+	importExchangeRatesHandler := command.NewImportExchangeRatesHandler(cfg, exchangeRateRepository, currencyConverter)
+	exchangeRateEndpoints := endpoint.MakeExchangeRateEndpoints(listExchangeRatesHandler, importExchangeRatesHandler)
+	getUserSettingsHandler := query.NewGetUserSettingsHandler(userSettingsRepository, currencyConverter)
+	updateUserSettingsHandler := command.NewUpdateUserSettingsHandler(userSettingsRepository, auditTrail)
+	userSettingsEndpoints := endpoint.MakeUserSettingsEndpoints(getUserSettingsHandler, updateUserSettingsHandler)
+	attachmentRepository := mongodb.NewAttachmentRepository(database)
+	attachmentOwnerResolver := behavior.NewAttachmentOwnerResolver(expenseRepository, medicalRecordRepository, petAccessGuard)
+	uploadAttachmentHandler := command.NewUploadAttachmentHandler(cfg, attachmentRepository, blobStore, attachmentOwnerResolver, auditTrail)
+	listAttachmentsHandler := query.NewListAttachmentsHandler(attachmentRepository, attachmentOwnerResolver)
+	getAttachmentHandler := query.NewGetAttachmentHandler(attachmentRepository, petAccessGuard)
+	getAttachmentDownloadHandler := query.NewGetAttachmentDownloadHandler(cfg, getAttachmentHandler, blobStore)
+	deleteAttachmentHandler := command.NewDeleteAttachmentHandler(attachmentRepository, blobStore, petAccessGuard, auditTrail)
+	attachmentEndpoints := endpoint.MakeAttachmentEndpoints(uploadAttachmentHandler, listAttachmentsHandler, getAttachmentHandler, getAttachmentDownloadHandler, deleteAttachmentHandler)
+	exportJobRepository := mongodb.NewExportJobRepository(database)
+	dataExporter := behavior.NewDataExporter(cfg, exportJobRepository, petRepository, healthLogRepository, medicalRecordRepository, expenseRepository, attachmentRepository, blobStore)
+	requestExportHandler := command.NewRequestExportHandler(cfg, exportJobRepository, dataExporter)
+	listExportsHandler := query.NewListExportsHandler(exportJobRepository)
+	getExportHandler := query.NewGetExportHandler(exportJobRepository)
+	getExportDownloadHandler := query.NewGetExportDownloadHandler(cfg, getExportHandler, blobStore)
+	exportEndpoints := endpoint.MakeExportEndpoints(requestExportHandler, listExportsHandler, getExportHandler, getExportDownloadHandler)
+	importRecordsHandler := command.NewImportRecordsHandler(cfg, healthLogRepository, expenseRepository, petAccessGuard, auditTrail, currencyConverter)
+	importEndpoints := endpoint.MakeImportEndpoints(importRecordsHandler)
+	getPetTimelineHandler := query.NewGetPetTimelineHandler(healthLogRepository, medicalRecordRepository, expenseRepository, petAccessGuard)
+	timelineEndpoints := endpoint.MakeTimelineEndpoints(getPetTimelineHandler)
+	calendarFeedRepository := mongodb.NewCalendarFeedRepository(database)
+	rotateCalendarFeedTokenHandler := command.NewRotateCalendarFeedTokenHandler(calendarFeedRepository)
+	getCalendarFeedHandler := query.NewGetCalendarFeedHandler(cfg, calendarFeedRepository, medicalRecordRepository, petAccessGuard)
+	calendarFeedEndpoints := endpoint.MakeCalendarFeedEndpoints(rotateCalendarFeedTokenHandler, getCalendarFeedHandler)
+	medicationRegimenRepository := mongodb.NewMedicationRegimenRepository(database)
+	createMedicationRegimenHandler := command.NewCreateMedicationRegimenHandler(medicationRegimenRepository, hospitalRepository, medicalRecordRepository, petAccessGuard, auditTrail)
+	updateMedicationRegimenHandler := command.NewUpdateMedicationRegimenHandler(medicationRegimenRepository, hospitalRepository, medicalRecordRepository, petAccessGuard, auditTrail)
+	doseEventRepository := mongodb.NewDoseEventRepository(database)
+	deleteMedicationRegimenHandler := command.NewDeleteMedicationRegimenHandler(medicationRegimenRepository, doseEventRepository, petAccessGuard, auditTrail)
+	recordDoseHandler := command.NewRecordDoseHandler(medicationRegimenRepository, doseEventRepository, petAccessGuard, auditTrail)
+	getMedicationRegimenHandler := query.NewGetMedicationRegimenHandler(medicationRegimenRepository, petAccessGuard)
+	listMedicationRegimensHandler := query.NewListMedicationRegimensHandler(medicationRegimenRepository, petAccessGuard)
+	listDoseEventsHandler := query.NewListDoseEventsHandler(cfg, medicationRegimenRepository, doseEventRepository, petAccessGuard)
+	getMedicationAdherenceHandler := query.NewGetMedicationAdherenceHandler(cfg, medicationRegimenRepository, doseEventRepository, petAccessGuard)
+	medicationEndpoints := endpoint.MakeMedicationEndpoints(createMedicationRegimenHandler, updateMedicationRegimenHandler, deleteMedicationRegimenHandler, recordDoseHandler, getMedicationRegimenHandler, listMedicationRegimensHandler, listDoseEventsHandler, getMedicationAdherenceHandler)
+	v2 := _wireValue
+	handler := gin.NewHTTPHandler(engine, cfg, petEndpoints, healthLogEndpoints, dashboardEndpoints, medicalRecordEndpoints, expenseEndpoints, hospitalEndpoints, reminderEndpoints, notificationEndpoints, householdEndpoints, trashEndpoints, analyticsEndpoints, budgetEndpoints, recurringExpenseEndpoints, exchangeRateEndpoints, userSettingsEndpoints, attachmentEndpoints, blobStore, exportEndpoints, importEndpoints, timelineEndpoints, calendarFeedEndpoints, medicationEndpoints, v2)
+	generateRemindersHandler := command.NewGenerateRemindersHandler(cfg, medicalRecordRepository, reminderRepository, auditTrail)
+	dispatchRemindersHandler := command.NewDispatchRemindersHandler(cfg, reminderRepository, petRepository, notificationPreferenceRepository, notificationDeliveryRepository, v)
+	retryNotificationsHandler := command.NewRetryNotificationsHandler(cfg, notificationDeliveryRepository, v)
+	purgeExpiredTrashHandler := command.NewPurgeExpiredTrashHandler(cfg, petArchiveRepository, trashRepository, blobStore, petAvatarStore)
+	generateRecurringExpensesHandler := command.NewGenerateRecurringExpensesHandler(recurringExpenseRepository, expenseRepository, petRepository, auditTrail, budgetTracker)
+	processExportJobsHandler := command.NewProcessExportJobsHandler(cfg, exportJobRepository, dataExporter, blobStore, bus)
+	detectMissedDosesHandler := command.NewDetectMissedDosesHandler(cfg, medicationRegimenRepository, doseEventRepository, reminderRepository, auditTrail)
+	v3 := scheduler.ProvideJobs(generateRemindersHandler, dispatchRemindersHandler, retryNotificationsHandler, purgeExpiredTrashHandler, generateRecurringExpensesHandler, processExportJobsHandler, detectMissedDosesHandler)
+	schedulerScheduler := scheduler.NewScheduler(cfg, v3)
+	mainApplication := &application{
+		handler:       handler,
+		scheduler:     schedulerScheduler,
+		exchangeRates: importExchangeRatesHandler,
+	}
+	return mainApplication, func() {
 		cleanup()
 	}, nil
 }
 
 var (
-	_wireValue = []http2.ServerOption{}
+	_wireValue = []http.ServerOption{}
 )
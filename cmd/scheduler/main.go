@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// main 為排程器的獨立進入點，由 Lambda 排程事件觸發，
+// 於本地執行時則只跑一次 tick 後結束。
+func main() {
+	// 載入配置
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("配置載入失敗: %v", err)
+	}
+
+	ctx := contextx.WithContext(context.Background())
+	s, cleanup, err := initScheduler(ctx, *cfg)
+	if err != nil {
+		log.Fatalf("排程器初始化失敗: %v", err)
+	}
+	defer cleanup()
+
+	// 檢查是否在 Lambda 環境中運行
+	if _, ok := os.LookupEnv("AWS_LAMBDA_FUNCTION_NAME"); ok {
+		log.Println("在 Lambda 環境中運行")
+		lambda.Start(func(c context.Context) error {
+			return s.Tick(c)
+		})
+		return
+	}
+
+	if err := s.Tick(ctx); err != nil {
+		log.Fatalf("排程執行失敗: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+//go:build wireinject
+// +build wireinject
+
+package main
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/infra/mongodb"
+	"github.com/blackhorseya/petlog/internal/infra/notification"
+	"github.com/blackhorseya/petlog/internal/infra/storage"
+	"github.com/blackhorseya/petlog/internal/transport/scheduler"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/event"
+	"github.com/google/wire"
+)
+
+// initScheduler initializes the background scheduler.
+func initScheduler(c context.Context, cfg config.Config) (*scheduler.Scheduler, func(), error) {
+	wire.Build(
+		// 資料庫層
+		mongodb.ProviderSet,
+		mongodb.NewPetMongoRepo,
+		mongodb.NewPetArchiveRepository,
+		mongodb.NewTrashRepository,
+		mongodb.NewMedicalRecordRepository,
+		mongodb.NewReminderRepository,
+		mongodb.NewAuditRepository,
+		mongodb.NewNotificationPreferenceRepository,
+		mongodb.NewNotificationDeliveryRepository,
+		mongodb.NewExpenseRepository,
+		mongodb.NewBudgetRepository,
+		mongodb.NewRecurringExpenseRepository,
+		mongodb.NewExchangeRateRepository,
+		mongodb.NewUserSettingsRepository,
+		mongodb.NewHealthLogRepository,
+		mongodb.NewAttachmentRepository,
+		mongodb.NewExportJobRepository,
+		mongodb.NewMedicationRegimenRepository,
+		mongodb.NewDoseEventRepository,
+
+		// 檔案儲存
+		storage.ProvideBlobStore,
+		behavior.NewPetAvatarStore,
+
+		// 通知管道
+		notification.ProvideSenders,
+
+		// 寵物相關資料的異動稽核
+		behavior.NewAuditTrail,
+
+		// 幣別換算
+		behavior.NewCurrencyConverter,
+
+		// 領域事件匯流排與預算門檻檢查
+		event.ProviderSet,
+		command.NewNotifyBudgetAlertHandler,
+		command.NewNotifyExportReadyHandler,
+		behavior.NewBudgetTracker,
+
+		// Reminder 用例處理器
+		command.NewGenerateRemindersHandler,
+		command.NewDispatchRemindersHandler,
+		command.NewRetryNotificationsHandler,
+		command.NewPurgeExpiredTrashHandler,
+
+		// RecurringExpense 用例處理器
+		command.NewGenerateRecurringExpensesHandler,
+
+		// Export 用例處理器
+		behavior.NewDataExporter,
+		command.NewProcessExportJobsHandler,
+
+		// Medication 用例處理器
+		command.NewDetectMissedDosesHandler,
+
+		// 排程器
+		scheduler.ProvideJobs,
+		scheduler.NewScheduler,
+	)
+	return nil, nil, nil
+}
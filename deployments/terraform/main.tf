@@ -46,6 +46,28 @@ resource "aws_ssm_parameter" "google_maps_api_key" {
   overwrite = true
 }
 
+# api 與 scheduler 共用的附件與匯出檔儲存
+resource "aws_ssm_parameter" "s3_bucket" {
+  name      = "/petlog/${var.stage}/S3_BUCKET"
+  type      = "String"
+  value     = var.s3_bucket
+  overwrite = true
+}
+
+resource "aws_ssm_parameter" "s3_access_key_id" {
+  name      = "/petlog/${var.stage}/S3_ACCESS_KEY_ID"
+  type      = "SecureString"
+  value     = var.s3_access_key_id
+  overwrite = true
+}
+
+resource "aws_ssm_parameter" "s3_secret_access_key" {
+  name      = "/petlog/${var.stage}/S3_SECRET_ACCESS_KEY"
+  type      = "SecureString"
+  value     = var.s3_secret_access_key
+  overwrite = true
+}
+
 # 取得 AWS Account ID
 data "aws_caller_identity" "current" {}
 
@@ -34,3 +34,18 @@ variable "google_maps_api_key" {
   description = "Google Maps API 金鑰"
   type        = string
 }
+
+variable "s3_bucket" {
+  description = "附件與匯出檔的 S3 bucket 名稱"
+  type        = string
+}
+
+variable "s3_access_key_id" {
+  description = "存取 S3 bucket 的 Access Key ID"
+  type        = string
+}
+
+variable "s3_secret_access_key" {
+  description = "存取 S3 bucket 的 Secret Access Key"
+  type        = string
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
@@ -10,10 +11,20 @@ import (
 
 // Config 應用程式配置結構
 type Config struct {
-	Auth0            Auth0Config `mapstructure:"auth0"`
-	Mongo            MongoConfig `mapstructure:"mongo"`
-	HTTP             HTTPConfig  `mapstructure:"http"`
-	GoogleMapsAPIKey string      `mapstructure:"google_maps_api_key"`
+	Auth0            Auth0Config        `mapstructure:"auth0"`
+	Mongo            MongoConfig        `mapstructure:"mongo"`
+	HTTP             HTTPConfig         `mapstructure:"http"`
+	Reminder         ReminderConfig     `mapstructure:"reminder"`
+	Notification     NotificationConfig `mapstructure:"notification"`
+	Trash            TrashConfig        `mapstructure:"trash"`
+	Analytics        AnalyticsConfig    `mapstructure:"analytics"`
+	GoogleMapsAPIKey string             `mapstructure:"google_maps_api_key"`
+	Currency         CurrencyConfig     `mapstructure:"currency"`
+	Admin            AdminConfig        `mapstructure:"admin"`
+	Storage          StorageConfig      `mapstructure:"storage"`
+	Export           ExportConfig       `mapstructure:"export"`
+	Import           ImportConfig       `mapstructure:"import"`
+	Report           ReportConfig       `mapstructure:"report"`
 }
 
 // Auth0Config Auth0 認證配置
@@ -33,6 +44,141 @@ type HTTPConfig struct {
 	Port string `mapstructure:"port"`
 }
 
+// ReminderConfig 提醒排程器配置
+type ReminderConfig struct {
+	// LeadDays 在到期日前幾天建立並發送提醒
+	LeadDays int `mapstructure:"lead_days"`
+	// ScanInterval 伺服器模式下排程器的掃描間隔
+	ScanInterval time.Duration `mapstructure:"scan_interval"`
+	// MissedDoseGrace 用藥排定時間過後多久仍未勾選才視為漏服
+	MissedDoseGrace time.Duration `mapstructure:"missed_dose_grace"`
+}
+
+// NotificationConfig 通知發送配置
+type NotificationConfig struct {
+	Line    LineConfig    `mapstructure:"line"`
+	SMTP    SMTPConfig    `mapstructure:"smtp"`
+	Webhook WebhookConfig `mapstructure:"webhook"`
+	// MaxAttempts 單筆通知最多嘗試次數（含第一次）
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// RetryBaseDelay 第一次重試的等待時間，之後以指數倍增
+	RetryBaseDelay time.Duration `mapstructure:"retry_base_delay"`
+}
+
+// LineConfig LINE Messaging API 配置
+type LineConfig struct {
+	ChannelAccessToken string `mapstructure:"channel_access_token"`
+	APIBaseURL         string `mapstructure:"api_base_url"`
+}
+
+// SMTPConfig SMTP 郵件配置
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// WebhookConfig 通用 Webhook 配置
+type WebhookConfig struct {
+	// Secret 用於 HMAC-SHA256 簽章，未設定則停用 webhook 管道
+	Secret  string        `mapstructure:"secret"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// TrashConfig 垃圾桶配置
+type TrashConfig struct {
+	// Retention 刪除後保留於垃圾桶的期限，期間內可還原，逾期由排程器永久清除
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// AnalyticsConfig 健康趨勢分析配置
+type AnalyticsConfig struct {
+	// WeightLossAlertPct 寵物未自訂門檻時，體重下降超過此百分比即標示為快速減重
+	WeightLossAlertPct float64 `mapstructure:"weight_loss_alert_pct"`
+	// WeightGainAlertPct 寵物未自訂門檻時，體重上升超過此百分比即標示為快速增重
+	WeightGainAlertPct float64 `mapstructure:"weight_gain_alert_pct"`
+	// FoodBaselineDays 進食量滾動基準涵蓋的天數
+	FoodBaselineDays int `mapstructure:"food_baseline_days"`
+	// FoodThresholdPct 當日進食量低於基準的此百分比時視為食慾下降
+	FoodThresholdPct float64 `mapstructure:"food_threshold_pct"`
+	// FoodAlertDays 連續多少天食慾下降時發出警示
+	FoodAlertDays int `mapstructure:"food_alert_days"`
+}
+
+// CurrencyConfig 多幣別配置
+type CurrencyConfig struct {
+	// DefaultBase 使用者未設定基準貨幣時的預設貨幣，未標示幣別的舊費用也視為此貨幣
+	DefaultBase string `mapstructure:"default_base"`
+	// Reference 匯率表的參考貨幣，匯率為 1 單位參考貨幣可兌換的各幣別數量
+	Reference string `mapstructure:"reference"`
+	// RatesFile 啟動時載入的匯率 CSV 檔（date,currency,rate），未設定則不載入
+	RatesFile string `mapstructure:"rates_file"`
+}
+
+// AdminConfig 管理者配置
+type AdminConfig struct {
+	// UserIDs 可使用管理功能（例如維護匯率表）的使用者 ID，以逗號分隔
+	UserIDs []string `mapstructure:"user_ids"`
+}
+
+// StorageConfig 附件檔案儲存配置
+type StorageConfig struct {
+	// Driver 儲存方式：local 為本機檔案系統，s3 為 S3 相容的物件儲存（例如 MinIO）；
+	// API 與排程器分開部署時不共用檔案系統，必須使用 s3
+	Driver string `mapstructure:"driver"`
+	// LocalDir 本機儲存的根目錄
+	LocalDir string `mapstructure:"local_dir"`
+	// PublicBaseURL API 對外網址，用於本機儲存的下載連結與寵物頭像的公開網址
+	PublicBaseURL string `mapstructure:"public_base_url"`
+	// SigningSecret 本機儲存下載連結的 HMAC 簽章金鑰，使用 local 時必須設定，且所有實例須使用相同金鑰
+	SigningSecret string `mapstructure:"signing_secret"`
+	// MaxUploadSize 單一附件的大小上限（位元組）
+	MaxUploadSize int64 `mapstructure:"max_upload_size"`
+	// URLExpiry 下載連結的有效期限
+	URLExpiry time.Duration `mapstructure:"url_expiry"`
+	S3        S3Config      `mapstructure:"s3"`
+}
+
+// ExportConfig 資料匯出配置
+type ExportConfig struct {
+	// InlineLimit 資料筆數不超過此值時於請求中直接產生匯出檔，超過則交由排程器於背景處理
+	InlineLimit int `mapstructure:"inline_limit"`
+	// Retention 匯出檔的保留期限，逾期後自動刪除
+	Retention time.Duration `mapstructure:"retention"`
+	// StaleAfter 執行中的工作超過此時間未完成時視為中斷，由下一次排程重新執行
+	StaleAfter time.Duration `mapstructure:"stale_after"`
+}
+
+// ImportConfig 健康日誌與費用批次匯入配置
+type ImportConfig struct {
+	// MaxRows 單一檔案可匯入的資料列上限（不含標題列）
+	MaxRows int `mapstructure:"max_rows"`
+	// MaxFileSize 上傳匯入檔的大小上限（位元組）
+	MaxFileSize int64 `mapstructure:"max_file_size"`
+}
+
+// ReportConfig PDF 報告配置
+type ReportConfig struct {
+	// FontPath 報告使用的 TrueType 字型，未設定時使用內建的 Go 字型（不含中文字）
+	FontPath string `mapstructure:"font_path"`
+	// BoldFontPath 報告標題使用的粗體字型，未設定時與 FontPath 相同
+	BoldFontPath string `mapstructure:"bold_font_path"`
+}
+
+// S3Config S3 相容物件儲存配置
+type S3Config struct {
+	// Endpoint 服務網址，例如 https://s3.ap-northeast-1.amazonaws.com 或 http://localhost:9000
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	// UsePathStyle 以 endpoint/bucket/key 定址，MinIO 需開啟
+	UsePathStyle bool `mapstructure:"use_path_style"`
+}
+
 // Load 載入配置
 func Load() (*Config, error) {
 	// 嘗試載入 .env 檔案（按照慣例順序）
@@ -52,9 +198,80 @@ func Load() (*Config, error) {
 	viper.BindEnv("mongo.database", "MONGO_DATABASE")
 	viper.BindEnv("http.port", "SERVER_PORT")
 	viper.BindEnv("google_maps_api_key", "GOOGLE_MAPS_API_KEY")
+	viper.BindEnv("reminder.lead_days", "REMINDER_LEAD_DAYS")
+	viper.BindEnv("reminder.scan_interval", "REMINDER_SCAN_INTERVAL")
+	viper.BindEnv("reminder.missed_dose_grace", "REMINDER_MISSED_DOSE_GRACE")
+	viper.BindEnv("notification.line.channel_access_token", "LINE_CHANNEL_ACCESS_TOKEN")
+	viper.BindEnv("notification.line.api_base_url", "LINE_API_BASE_URL")
+	viper.BindEnv("notification.smtp.host", "SMTP_HOST")
+	viper.BindEnv("notification.smtp.port", "SMTP_PORT")
+	viper.BindEnv("notification.smtp.username", "SMTP_USERNAME")
+	viper.BindEnv("notification.smtp.password", "SMTP_PASSWORD")
+	viper.BindEnv("notification.smtp.from", "SMTP_FROM")
+	viper.BindEnv("notification.webhook.secret", "WEBHOOK_SECRET")
+	viper.BindEnv("notification.webhook.timeout", "WEBHOOK_TIMEOUT")
+	viper.BindEnv("notification.max_attempts", "NOTIFICATION_MAX_ATTEMPTS")
+	viper.BindEnv("notification.retry_base_delay", "NOTIFICATION_RETRY_BASE_DELAY")
+	viper.BindEnv("trash.retention", "TRASH_RETENTION")
+	viper.BindEnv("analytics.weight_loss_alert_pct", "ANALYTICS_WEIGHT_LOSS_ALERT_PCT")
+	viper.BindEnv("analytics.weight_gain_alert_pct", "ANALYTICS_WEIGHT_GAIN_ALERT_PCT")
+	viper.BindEnv("analytics.food_baseline_days", "ANALYTICS_FOOD_BASELINE_DAYS")
+	viper.BindEnv("analytics.food_threshold_pct", "ANALYTICS_FOOD_THRESHOLD_PCT")
+	viper.BindEnv("analytics.food_alert_days", "ANALYTICS_FOOD_ALERT_DAYS")
+	viper.BindEnv("currency.default_base", "CURRENCY_DEFAULT_BASE")
+	viper.BindEnv("currency.reference", "CURRENCY_REFERENCE")
+	viper.BindEnv("currency.rates_file", "EXCHANGE_RATES_FILE")
+	viper.BindEnv("admin.user_ids", "ADMIN_USER_IDS")
+	viper.BindEnv("storage.driver", "STORAGE_DRIVER")
+	viper.BindEnv("storage.local_dir", "STORAGE_LOCAL_DIR")
+	viper.BindEnv("storage.public_base_url", "STORAGE_PUBLIC_BASE_URL")
+	viper.BindEnv("storage.signing_secret", "STORAGE_SIGNING_SECRET")
+	viper.BindEnv("storage.max_upload_size", "STORAGE_MAX_UPLOAD_SIZE")
+	viper.BindEnv("storage.url_expiry", "STORAGE_URL_EXPIRY")
+	viper.BindEnv("storage.s3.endpoint", "S3_ENDPOINT")
+	viper.BindEnv("storage.s3.region", "S3_REGION")
+	viper.BindEnv("storage.s3.bucket", "S3_BUCKET")
+	viper.BindEnv("storage.s3.access_key_id", "S3_ACCESS_KEY_ID")
+	viper.BindEnv("storage.s3.secret_access_key", "S3_SECRET_ACCESS_KEY")
+	viper.BindEnv("storage.s3.use_path_style", "S3_USE_PATH_STYLE")
+	viper.BindEnv("export.inline_limit", "EXPORT_INLINE_LIMIT")
+	viper.BindEnv("export.retention", "EXPORT_RETENTION")
+	viper.BindEnv("export.stale_after", "EXPORT_STALE_AFTER")
+	viper.BindEnv("import.max_rows", "IMPORT_MAX_ROWS")
+	viper.BindEnv("import.max_file_size", "IMPORT_MAX_FILE_SIZE")
+	viper.BindEnv("report.font_path", "REPORT_FONT_PATH")
+	viper.BindEnv("report.bold_font_path", "REPORT_BOLD_FONT_PATH")
 
 	// 設定預設值
 	viper.SetDefault("http.port", "8080")
+	viper.SetDefault("reminder.lead_days", 3)
+	viper.SetDefault("reminder.scan_interval", "15m")
+	viper.SetDefault("reminder.missed_dose_grace", "2h")
+	viper.SetDefault("notification.line.api_base_url", "https://api.line.me")
+	viper.SetDefault("notification.smtp.port", 587)
+	viper.SetDefault("notification.webhook.timeout", "10s")
+	viper.SetDefault("notification.max_attempts", 5)
+	viper.SetDefault("notification.retry_base_delay", "1m")
+	viper.SetDefault("trash.retention", "720h")
+	viper.SetDefault("analytics.weight_loss_alert_pct", 5)
+	viper.SetDefault("analytics.weight_gain_alert_pct", 5)
+	viper.SetDefault("analytics.food_baseline_days", 7)
+	viper.SetDefault("analytics.food_threshold_pct", 70)
+	viper.SetDefault("analytics.food_alert_days", 2)
+	viper.SetDefault("currency.default_base", "TWD")
+	viper.SetDefault("currency.reference", "USD")
+	viper.SetDefault("storage.driver", "local")
+	viper.SetDefault("storage.local_dir", "./data/blobs")
+	viper.SetDefault("storage.public_base_url", "http://localhost:8080")
+	viper.SetDefault("storage.max_upload_size", 10<<20)
+	viper.SetDefault("storage.url_expiry", "15m")
+	viper.SetDefault("storage.s3.region", "us-east-1")
+	viper.SetDefault("storage.s3.use_path_style", true)
+	viper.SetDefault("export.inline_limit", 1000)
+	viper.SetDefault("export.retention", "168h")
+	viper.SetDefault("export.stale_after", "1h")
+	viper.SetDefault("import.max_rows", 5000)
+	viper.SetDefault("import.max_file_size", 5<<20)
 
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
@@ -18,9 +18,15 @@ var (
 	// ErrUpdateConflict 表示在更新操作期間發生衝突，可能是由於版本不匹配。
 	ErrUpdateConflict = errors.New("update conflict")
 
+	// ErrPreconditionFailed 表示請求指定的版本（If-Match）與目前資源版本不符。
+	ErrPreconditionFailed = errors.New("precondition failed")
+
 	// ErrInvalidParameter is returned when a parameter is invalid.
 	ErrInvalidParameter = errors.New("invalid parameter provided")
 
+	// ErrForbidden 表示使用者無權存取該資源（例如非寵物飼主）。
+	ErrForbidden = errors.New("access to the requested resource is forbidden")
+
 	// ErrInvalidCoordinates 表示座標格式無效
 	ErrInvalidCoordinates = errors.New("invalid coordinates")
 )
@@ -47,7 +53,17 @@ func IsUpdateConflict(err error) bool {
 	return errors.Is(err, ErrUpdateConflict)
 }
 
+// IsPreconditionFailed 檢查是否為版本前置條件不符錯誤
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
 // IsInvalidParameter 檢查是否為參數驗證錯誤
 func IsInvalidParameter(err error) bool {
 	return errors.Is(err, ErrInvalidParameter)
 }
+
+// IsForbidden 檢查是否為無權存取錯誤
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
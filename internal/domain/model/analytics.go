@@ -0,0 +1,97 @@
+package model
+
+import "time"
+
+// TrendInterval 表示趨勢分析的時間分組單位
+type TrendInterval string
+
+const (
+	TrendIntervalDay   TrendInterval = "day"
+	TrendIntervalWeek  TrendInterval = "week"
+	TrendIntervalMonth TrendInterval = "month"
+)
+
+// IsValid 檢查分組單位是否為已定義的值
+func (i TrendInterval) IsValid() bool {
+	switch i {
+	case TrendIntervalDay, TrendIntervalWeek, TrendIntervalMonth:
+		return true
+	}
+	return false
+}
+
+// WeightAlertThreshold 體重快速變化的警示門檻，以變化百分比表示
+// - LossPct: 體重下降超過此百分比時標示為快速減重
+// - GainPct: 體重上升超過此百分比時標示為快速增重
+type WeightAlertThreshold struct {
+	LossPct float64 `json:"loss_pct"`
+	GainPct float64 `json:"gain_pct"`
+}
+
+// WeightAlert 表示體重趨勢中的異常標示
+type WeightAlert string
+
+const (
+	WeightAlertRapidLoss WeightAlert = "rapid_loss"
+	WeightAlertRapidGain WeightAlert = "rapid_gain"
+)
+
+// WeightTrendPoint 代表一個時間區間的體重統計
+// - WeightKg: 區間內體重紀錄的平均值
+// - MovingAverageKg: 含本區間在內往前 Window 個區間的移動平均
+// - ChangePct: 相較於往前 ChangeWindow 個區間的變化百分比，資料不足時為空
+type WeightTrendPoint struct {
+	PeriodStart     time.Time   `json:"period_start"`
+	WeightKg        float64     `json:"weight_kg"`
+	SampleCount     int         `json:"sample_count"`
+	MovingAverageKg float64     `json:"moving_average_kg"`
+	ChangePct       *float64    `json:"change_pct,omitempty"`
+	Alert           WeightAlert `json:"alert,omitempty"`
+}
+
+// WeightTrend 代表寵物在一段期間內的體重趨勢
+type WeightTrend struct {
+	PetID        string               `json:"pet_id"`
+	Interval     TrendInterval        `json:"interval"`
+	Window       int                  `json:"window"`
+	ChangeWindow int                  `json:"change_window"`
+	Threshold    WeightAlertThreshold `json:"threshold"`
+	Points       []WeightTrendPoint   `json:"points"`
+}
+
+// DailyFoodIntake 代表寵物單日（UTC）的進食量加總
+type DailyFoodIntake struct {
+	Date     time.Time `json:"date"`
+	FoodGram int       `json:"food_gram"`
+	LogCount int       `json:"log_count"`
+}
+
+// FoodIntakePoint 代表單日進食量與滾動基準的比較
+// - BaselineGram: 前幾日正常進食量的平均，歷史資料不足時為空
+// - RatioPct: 當日進食量佔基準的百分比
+// - BelowThreshold: 當日進食量低於基準的門檻百分比
+type FoodIntakePoint struct {
+	Date           time.Time `json:"date"`
+	FoodGram       int       `json:"food_gram"`
+	BaselineGram   *float64  `json:"baseline_gram,omitempty"`
+	RatioPct       *float64  `json:"ratio_pct,omitempty"`
+	BelowThreshold bool      `json:"below_threshold"`
+}
+
+// AppetiteAlert 代表連續多日進食量低於基準的警示
+type AppetiteAlert struct {
+	StartDate       time.Time `json:"start_date"`
+	EndDate         time.Time `json:"end_date"`
+	Days            int       `json:"days"`
+	AverageRatioPct float64   `json:"average_ratio_pct"`
+}
+
+// FoodIntakeTrend 代表寵物在一段期間內的進食量趨勢與食慾警示
+type FoodIntakeTrend struct {
+	PetID           string            `json:"pet_id"`
+	BaselineDays    int               `json:"baseline_days"`
+	ThresholdPct    float64           `json:"threshold_pct"`
+	ConsecutiveDays int               `json:"consecutive_days"`
+	Points          []FoodIntakePoint `json:"points"`
+	Alerts          []AppetiteAlert   `json:"alerts"`
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// AttachmentOwnerType 表示附件所屬的資料種類
+type AttachmentOwnerType string
+
+const (
+	AttachmentOwnerExpense       AttachmentOwnerType = "expense"
+	AttachmentOwnerMedicalRecord AttachmentOwnerType = "medical_record"
+)
+
+// IsValid 檢查附件所屬種類是否為已定義的值
+func (t AttachmentOwnerType) IsValid() bool {
+	switch t {
+	case AttachmentOwnerExpense, AttachmentOwnerMedicalRecord:
+		return true
+	}
+	return false
+}
+
+// Attachment 代表附加於費用或醫療記錄的檔案（收據、發票、疫苗證明等），純領域實體
+// - OwnerType/OwnerID: 附件所屬的費用或醫療記錄
+// - PetID: 所屬資料的寵物，用於權限檢查
+// - ContentType: 依檔案內容判斷的 MIME 類型，而非用戶端宣告的類型
+// - StorageKey: 檔案於 BlobStore 中的鍵值，不對外公開
+type Attachment struct {
+	ID          string              `json:"id"`
+	PetID       string              `json:"pet_id"`
+	OwnerType   AttachmentOwnerType `json:"owner_type"`
+	OwnerID     string              `json:"owner_id"`
+	FileName    string              `json:"file_name"`
+	ContentType string              `json:"content_type"`
+	Size        int64               `json:"size"`
+	StorageKey  string              `json:"-"`
+	UploadedBy  string              `json:"uploaded_by"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+// AttachmentDownload 附件的限時下載連結
+type AttachmentDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditAction 表示稽核紀錄的操作種類
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionUpdate  AuditAction = "update"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRestore AuditAction = "restore"
+	AuditActionPurge   AuditAction = "purge"
+)
+
+// AuditEntityType 表示被異動的資料種類
+type AuditEntityType string
+
+const (
+	AuditEntityPet           AuditEntityType = "pet"
+	AuditEntityHealthLog     AuditEntityType = "health_log"
+	AuditEntityMedicalRecord AuditEntityType = "medical_record"
+	AuditEntityExpense       AuditEntityType = "expense"
+	AuditEntityReminder      AuditEntityType = "reminder"
+	// AuditEntityRecurringExpense 週期性費用的定義
+	AuditEntityRecurringExpense AuditEntityType = "recurring_expense"
+	// AuditEntityAttachment 費用或醫療記錄的附件
+	AuditEntityAttachment AuditEntityType = "attachment"
+	// AuditEntityMedicationRegimen 長期用藥的療程
+	AuditEntityMedicationRegimen AuditEntityType = "medication_regimen"
+	// AuditEntityDoseEvent 照護者勾選的單次給藥
+	AuditEntityDoseEvent AuditEntityType = "dose_event"
+	// AuditEntityHousehold 家庭及其成員與邀請，不屬於單一寵物
+	AuditEntityHousehold AuditEntityType = "household"
+	// AuditEntityBudget 費用預算，涵蓋所有寵物的預算不屬於單一寵物
+	AuditEntityBudget AuditEntityType = "budget"
+	// AuditEntityUserSettings 使用者設定，不屬於單一寵物
+	AuditEntityUserSettings AuditEntityType = "user_settings"
+)
+
+// IsValid 檢查資料種類是否為已定義的值
+func (t AuditEntityType) IsValid() bool {
+	switch t {
+	case AuditEntityPet, AuditEntityHealthLog, AuditEntityMedicalRecord, AuditEntityExpense, AuditEntityReminder,
+		AuditEntityRecurringExpense, AuditEntityAttachment, AuditEntityMedicationRegimen, AuditEntityDoseEvent,
+		AuditEntityHousehold, AuditEntityBudget, AuditEntityUserSettings:
+		return true
+	}
+	return false
+}
+
+// AuditSystemActor 排程等非使用者觸發的異動所記錄的操作者
+const AuditSystemActor = "system"
+
+// FieldChange 記錄單一欄位異動前後的值，以 JSON 表示；建立時 Before 為空，刪除時 After 為空
+type FieldChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+// AuditEntry 代表一筆寵物相關資料的異動紀錄，純領域實體
+// - PetID: 異動資料所屬的寵物，用於查詢寵物的變更歷程；家庭、使用者設定等不屬於單一寵物的資料為空
+// - ActorID: 執行異動的使用者
+// - Changes: 欄位層級的異動內容
+type AuditEntry struct {
+	ID         string          `json:"id"`
+	PetID      string          `json:"pet_id"`
+	ActorID    string          `json:"actor_id"`
+	EntityType AuditEntityType `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Action     AuditAction     `json:"action"`
+	Changes    []FieldChange   `json:"changes,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
@@ -0,0 +1,109 @@
+package model
+
+import "time"
+
+// BudgetPeriod 表示預算的計算週期
+type BudgetPeriod string
+
+const (
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+	BudgetPeriodYearly  BudgetPeriod = "yearly"
+)
+
+// IsValid 檢查預算週期是否為已定義的值
+func (p BudgetPeriod) IsValid() bool {
+	switch p {
+	case BudgetPeriodMonthly, BudgetPeriodYearly:
+		return true
+	}
+	return false
+}
+
+// DefaultBudgetThresholds 未指定門檻時使用的警示百分比
+var DefaultBudgetThresholds = []int{80, 100}
+
+// Budget 代表飼主設定的費用預算，純領域實體
+// - OwnerID: 設定預算的使用者，警示通知的對象
+// - PetID: 限定單一寵物；空字串表示涵蓋飼主名下所有寵物
+// - Category: 限定費用分類；空字串表示涵蓋所有分類
+// - Limit: 每個週期的預算上限，以預算擁有者的基準貨幣表示
+// - Thresholds: 已花費達預算的百分比門檻，由小到大排列
+// - AlertedPeriodStart/AlertedPct: 目前週期已發出警示的最高門檻，避免重複通知
+type Budget struct {
+	ID                 string       `json:"id"`
+	OwnerID            string       `json:"owner_id"`
+	PetID              string       `json:"pet_id,omitempty"`
+	Category           string       `json:"category,omitempty"`
+	Period             BudgetPeriod `json:"period"`
+	Limit              int          `json:"limit"`
+	Thresholds         []int        `json:"thresholds"`
+	AlertedPeriodStart *time.Time   `json:"alerted_period_start,omitempty"`
+	AlertedPct         int          `json:"alerted_pct,omitempty"`
+	Version            int          `json:"version"`
+	CreatedAt          time.Time    `json:"created_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+}
+
+// Covers 判斷費用是否計入此預算；petOwnerID 為該費用所屬寵物的飼主
+func (b *Budget) Covers(expense *Expense, petOwnerID string) bool {
+	if b.PetID != "" {
+		if expense.PetID != b.PetID {
+			return false
+		}
+	} else if petOwnerID != b.OwnerID {
+		return false
+	}
+	return b.Category == "" || b.Category == expense.Category
+}
+
+// AlertedIn 回傳指定週期內已發出警示的最高門檻，尚未警示時回傳 0
+func (b *Budget) AlertedIn(periodStart time.Time) int {
+	if b.AlertedPeriodStart == nil || !b.AlertedPeriodStart.Equal(periodStart) {
+		return 0
+	}
+	return b.AlertedPct
+}
+
+// BudgetStatus 預算在目前週期的執行狀況
+// - Spent: 週期內已花費金額，依消費日期的匯率換算為 Currency
+// - Currency: 預算擁有者的基準貨幣
+// - UsedPct: 已花費佔預算的百分比
+// - ProjectedMonthEnd: 依本月目前的平均每日花費推估至月底時的週期累計花費
+type BudgetStatus struct {
+	Budget            *Budget   `json:"budget"`
+	PeriodStart       time.Time `json:"period_start"`
+	PeriodEnd         time.Time `json:"period_end"`
+	Spent             int       `json:"spent"`
+	Remaining         int       `json:"remaining"`
+	UsedPct           float64   `json:"used_pct"`
+	ProjectedMonthEnd int       `json:"projected_month_end"`
+	ProjectedOverrun  bool      `json:"projected_overrun"`
+	Currency          string    `json:"currency"`
+
+	// Unconverted 缺少匯率而未計入花費的費用，依幣別合計
+	Unconverted []*UnconvertedExpense `json:"unconverted,omitempty"`
+}
+
+// BudgetThresholdCrossedEventName 預算門檻突破事件的名稱
+const BudgetThresholdCrossedEventName = "budget.threshold_crossed"
+
+// BudgetThresholdCrossed 當預算週期內的花費首次達到某個門檻時發出的領域事件
+type BudgetThresholdCrossed struct {
+	BudgetID     string       `json:"budget_id"`
+	OwnerID      string       `json:"owner_id"`
+	PetID        string       `json:"pet_id,omitempty"`
+	Category     string       `json:"category,omitempty"`
+	Period       BudgetPeriod `json:"period"`
+	PeriodStart  time.Time    `json:"period_start"`
+	ThresholdPct int          `json:"threshold_pct"`
+	Spent        int          `json:"spent"`
+	Limit        int          `json:"limit"`
+	At           time.Time    `json:"occurred_at"`
+	Currency     string       `json:"currency"`
+}
+
+// EventName 實作 DomainEvent
+func (e BudgetThresholdCrossed) EventName() string { return BudgetThresholdCrossedEventName }
+
+// OccurredAt 實作 DomainEvent
+func (e BudgetThresholdCrossed) OccurredAt() time.Time { return e.At }
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// CalendarFeed 使用者的 iCalendar 訂閱設定，以秘密 token 識別，不需登入即可讀取
+// - TokenHash: 訂閱 token 的雜湊值，明文 token 只在產生時回傳一次
+// - RotatedAt: 最近一次產生 token 的時間，重新產生後舊 token 立即失效
+type CalendarFeed struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	TokenHash string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	RotatedAt time.Time `json:"rotated_at"`
+}
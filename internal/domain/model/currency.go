@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// ExchangeRate 代表某幣別在指定日期相對於參考貨幣的匯率，純領域實體
+// - Currency: ISO 4217 幣別代碼
+// - Date: 匯率生效日期（UTC 當日零時）
+// - Rate: 1 單位參考貨幣可兌換的 Currency 數量，參考貨幣本身恆為 1
+// 任兩種貨幣間的換算皆透過參考貨幣進行：amount × Rate(to) ÷ Rate(from)
+type ExchangeRate struct {
+	Currency  string    `json:"currency"`
+	Date      time.Time `json:"date"`
+	Rate      float64   `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// UserSettings 使用者的個人設定
+// - BaseCurrency: 費用摘要與預算換算的基準貨幣，未設定時使用系統預設貨幣
+type UserSettings struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	BaseCurrency string    `json:"base_currency"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
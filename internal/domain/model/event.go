@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// DomainEvent 代表領域中已發生、可供其他模組訂閱處理的事件
+type DomainEvent interface {
+	// EventName 回傳事件名稱，訂閱者依名稱註冊
+	EventName() string
+
+	// OccurredAt 回傳事件發生的時間
+	OccurredAt() time.Time
+}
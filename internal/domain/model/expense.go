@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Expense 代表一筆費用紀錄，純領域實體
 // 僅包含 json 標籤，不含任何 DB/ORM 標籤
@@ -8,9 +11,13 @@ import "time"
 // - ID: 主鍵
 // - PetID: 關聯寵物
 // - Category: 分類（預設/自訂）
-// - Amount: 金額（正整數）
+// - Amount: 金額（正整數），以 Currency 表示的原始金額
 // - Description: 描述（可空）
 // - Date: 消費日期
+// - RecurringExpenseID/OccurrenceDate: 由週期性費用自動產生時的來源與期別，手動建立時為空
+// - Currency: ISO 4217 幣別代碼，舊資料為空時視為系統預設貨幣；報表依消費日期的匯率換算為基準貨幣
+// - ImportKey: 由批次匯入建立時的去重鍵，手動建立時為空
+// - Version: 每次更新遞增，用於樂觀並行控制
 // - CreatedAt/UpdatedAt: 系統管理
 // 不可有 Pet *Pet 欄位，聚合間僅以 ID 關聯
 type Expense struct {
@@ -20,10 +27,88 @@ type Expense struct {
 	Amount      int       `json:"amount"`
 	Description string    `json:"description"`
 	Date        time.Time `json:"date"`
+	Version     int       `json:"version"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	RecurringExpenseID string     `json:"recurring_expense_id,omitempty"`
+	OccurrenceDate     *time.Time `json:"occurrence_date,omitempty"`
+
+	Currency string `json:"currency,omitempty"`
+
+	ImportKey string `json:"import_key,omitempty"`
 }
 
 var DefaultExpenseCategories = []string{
 	"醫療", "飼料", "保健品", "日用品", "其他",
 }
+
+// ExpenseGroupBy 表示費用報表的分組方式
+type ExpenseGroupBy string
+
+const (
+	ExpenseGroupByMonth    ExpenseGroupBy = "month"
+	ExpenseGroupByQuarter  ExpenseGroupBy = "quarter"
+	ExpenseGroupByYear     ExpenseGroupBy = "year"
+	ExpenseGroupByCategory ExpenseGroupBy = "category"
+	ExpenseGroupByPet      ExpenseGroupBy = "pet"
+)
+
+// IsValid 檢查分組方式是否為已定義的值
+func (g ExpenseGroupBy) IsValid() bool {
+	switch g {
+	case ExpenseGroupByMonth, ExpenseGroupByQuarter, ExpenseGroupByYear, ExpenseGroupByCategory, ExpenseGroupByPet:
+		return true
+	}
+	return false
+}
+
+// IsTimeBased 是否依時間區間分組
+func (g ExpenseGroupBy) IsTimeBased() bool {
+	return g == ExpenseGroupByMonth || g == ExpenseGroupByQuarter || g == ExpenseGroupByYear
+}
+
+// PeriodKey 回傳時間分組區間的顯示鍵，例如 2024-07、2024-Q3、2024
+func (g ExpenseGroupBy) PeriodKey(start time.Time) string {
+	start = start.UTC()
+	switch g {
+	case ExpenseGroupByQuarter:
+		return fmt.Sprintf("%d-Q%d", start.Year(), (int(start.Month())-1)/3+1)
+	case ExpenseGroupByYear:
+		return start.Format("2006")
+	default:
+		return start.Format("2006-01")
+	}
+}
+
+// ExpenseBucket 費用報表中的一個分組統計
+// - Key: 分組鍵，時間分組為 2024-07、2024-Q3、2024，分類為分類名稱，寵物為寵物 ID
+// - PeriodStart: 時間分組的區間起點（UTC），其他分組為 nil
+type ExpenseBucket struct {
+	Key         string     `json:"key"`
+	PeriodStart *time.Time `json:"period_start,omitempty"`
+	Total       int        `json:"total"`
+	Count       int        `json:"count"`
+}
+
+// UnconvertedExpense 缺少匯率而無法換算為基準貨幣、未計入統計的費用，依幣別合計
+// - Total: 以原始幣別表示的金額合計
+type UnconvertedExpense struct {
+	Currency string `json:"currency"`
+	Total    int    `json:"total"`
+	Count    int    `json:"count"`
+}
+
+// ExpenseBucketPart 資料庫彙總的部分結果，同一分組再依幣別與消費日期拆分
+// 依各自日期的匯率換算為基準貨幣後，再合併為 ExpenseBucket
+// - Currency: 原始幣別，舊資料可能為空
+// - Date: 消費日期（UTC 當日零時）
+// - Total: 以原始幣別表示的金額合計
+type ExpenseBucketPart struct {
+	Key         string
+	PeriodStart *time.Time
+	Currency    string
+	Date        time.Time
+	Total       int
+	Count       int
+}
@@ -0,0 +1,117 @@
+package model
+
+import "time"
+
+// ExportSchemaVersion 匯出資料的格式版本，欄位有不相容的變更時遞增
+const ExportSchemaVersion = 1
+
+// ExportFormat 表示資料匯出的格式
+type ExportFormat string
+
+const (
+	// ExportFormatJSON 單一 JSON 檔，包含格式版本與所有資料
+	ExportFormatJSON ExportFormat = "json"
+	// ExportFormatCSV 每種資料各一個 CSV 檔，打包為 ZIP
+	ExportFormatCSV ExportFormat = "csv"
+	// ExportFormatZIP 同時包含 JSON、CSV 與所有附件檔案的 ZIP
+	ExportFormatZIP ExportFormat = "zip"
+)
+
+// IsValid 檢查匯出格式是否為已定義的值
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatJSON, ExportFormatCSV, ExportFormatZIP:
+		return true
+	}
+	return false
+}
+
+// ExportStatus 表示匯出工作的狀態
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "pending"
+	ExportStatusRunning   ExportStatus = "running"
+	ExportStatusCompleted ExportStatus = "completed"
+	ExportStatusFailed    ExportStatus = "failed"
+)
+
+// ExportJob 代表一次使用者資料匯出，資料量大時由排程器於背景產生
+// - StorageKey: 匯出檔於 BlobStore 中的鍵值，不對外公開
+// - ExpiresAt: 匯出檔的保留期限，逾期後檔案與工作一併刪除
+type ExportJob struct {
+	ID          string       `json:"id"`
+	OwnerID     string       `json:"owner_id"`
+	Format      ExportFormat `json:"format"`
+	Status      ExportStatus `json:"status"`
+	FileName    string       `json:"file_name,omitempty"`
+	Size        int64        `json:"size,omitempty"`
+	StorageKey  string       `json:"-"`
+	Error       string       `json:"error,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	StartedAt   *time.Time   `json:"started_at,omitempty"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+	ExpiresAt   *time.Time   `json:"expires_at,omitempty"`
+}
+
+// MarkCompleted 記錄匯出檔並標記為完成
+func (j *ExportJob) MarkCompleted(key, fileName string, size int64, now time.Time, retention time.Duration) {
+	expiresAt := now.Add(retention)
+	j.Status = ExportStatusCompleted
+	j.StorageKey = key
+	j.FileName = fileName
+	j.Size = size
+	j.Error = ""
+	j.CompletedAt = &now
+	j.ExpiresAt = &expiresAt
+}
+
+// MarkFailed 記錄失敗原因並標記為失敗，失敗的工作同樣於保留期限後刪除
+func (j *ExportJob) MarkFailed(err error, now time.Time, retention time.Duration) {
+	expiresAt := now.Add(retention)
+	j.Status = ExportStatusFailed
+	j.Error = err.Error()
+	j.CompletedAt = &now
+	j.ExpiresAt = &expiresAt
+}
+
+// ExportDocument 為 JSON 匯出檔的內容，Version 對應 ExportSchemaVersion
+type ExportDocument struct {
+	Version        int              `json:"version"`
+	ExportedAt     time.Time        `json:"exported_at"`
+	OwnerID        string           `json:"owner_id"`
+	Pets           []*Pet           `json:"pets"`
+	HealthLogs     []*HealthLog     `json:"health_logs"`
+	MedicalRecords []*MedicalRecord `json:"medical_records"`
+	Expenses       []*Expense       `json:"expenses"`
+	Attachments    []*Attachment    `json:"attachments"`
+}
+
+// RecordCount 回傳文件中的資料總筆數
+func (d *ExportDocument) RecordCount() int {
+	return len(d.Pets) + len(d.HealthLogs) + len(d.MedicalRecords) + len(d.Expenses) + len(d.Attachments)
+}
+
+// ExportDownload 匯出檔的限時下載連結
+type ExportDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ExportCompletedEventName 背景匯出工作結束事件的名稱
+const ExportCompletedEventName = "export.completed"
+
+// ExportCompleted 背景匯出工作完成或失敗時發出的領域事件
+type ExportCompleted struct {
+	ExportID string       `json:"export_id"`
+	OwnerID  string       `json:"owner_id"`
+	Format   ExportFormat `json:"format"`
+	Status   ExportStatus `json:"status"`
+	At       time.Time    `json:"occurred_at"`
+}
+
+// EventName 實作 DomainEvent
+func (e ExportCompleted) EventName() string { return ExportCompletedEventName }
+
+// OccurredAt 實作 DomainEvent
+func (e ExportCompleted) OccurredAt() time.Time { return e.At }
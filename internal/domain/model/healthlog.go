@@ -3,12 +3,89 @@ package model
 import "time"
 
 // HealthLog represents a daily health log for a pet. It is a pure domain entity.
+// FoodGram is nil when intake was not recorded; a recorded 0 means the pet did not eat.
+// LitterNotes and BehaviourNotes are kept as free text alongside the structured observations.
+// Version is incremented on every update and used for optimistic concurrency control.
 type HealthLog struct {
 	ID             string    `json:"id"`
 	PetID          string    `json:"pet_id"`
 	Date           time.Time `json:"date"`
 	WeightKg       float64   `json:"weight_kg"`
-	FoodGram       int       `json:"food_gram"`
+	FoodGram       *int      `json:"food_gram,omitempty"`
 	LitterNotes    string    `json:"litter_notes"`
 	BehaviourNotes string    `json:"behaviour_notes"`
+	HealthObservations
+	Version int `json:"version"`
+
+	// ImportKey is the dedupe key of a log created by bulk import; empty for logs entered manually.
+	ImportKey string `json:"import_key,omitempty"`
+}
+
+// HealthObservations holds the structured litter and behaviour observations of a health log.
+// Nil or empty fields mean the observation was not recorded.
+// - UrineClumps: number of urine clumps found in the litter box
+// - StoolConsistency: stool consistency on the Bristol scale, 1 (hard) to 7 (watery)
+// - VomitingCount: number of vomiting events
+type HealthObservations struct {
+	UrineClumps      *int          `json:"urine_clumps,omitempty"`
+	StoolConsistency *int          `json:"stool_consistency,omitempty"`
+	VomitingCount    int           `json:"vomiting_count,omitempty"`
+	ActivityLevel    ActivityLevel `json:"activity_level,omitempty"`
+	MoodTags         []MoodTag     `json:"mood_tags,omitempty"`
+}
+
+const (
+	StoolConsistencyMin = 1
+	StoolConsistencyMax = 7
+)
+
+// ActivityLevel describes how active a pet was during the day.
+type ActivityLevel string
+
+const (
+	ActivityLevelLow    ActivityLevel = "low"
+	ActivityLevelNormal ActivityLevel = "normal"
+	ActivityLevelHigh   ActivityLevel = "high"
+)
+
+// IsValid reports whether the activity level is a known value.
+func (a ActivityLevel) IsValid() bool {
+	switch a {
+	case ActivityLevelLow, ActivityLevelNormal, ActivityLevelHigh:
+		return true
+	}
+	return false
+}
+
+// MoodTag describes an observed mood or behaviour.
+type MoodTag string
+
+const (
+	MoodCalm         MoodTag = "calm"
+	MoodPlayful      MoodTag = "playful"
+	MoodAffectionate MoodTag = "affectionate"
+	MoodAnxious      MoodTag = "anxious"
+	MoodAggressive   MoodTag = "aggressive"
+	MoodLethargic    MoodTag = "lethargic"
+	MoodHiding       MoodTag = "hiding"
+)
+
+// IsValid reports whether the mood tag is a known value.
+func (m MoodTag) IsValid() bool {
+	switch m {
+	case MoodCalm, MoodPlayful, MoodAffectionate, MoodAnxious, MoodAggressive, MoodLethargic, MoodHiding:
+		return true
+	}
+	return false
+}
+
+// HealthLogFilter narrows health log queries by structured observations. Zero values mean no filtering.
+// - HasVomiting: only logs with at least one vomiting event
+// - StoolConsistencyMin/Max: inclusive range on the stool consistency scale
+type HealthLogFilter struct {
+	HasVomiting         bool
+	StoolConsistencyMin int
+	StoolConsistencyMax int
+	ActivityLevel       ActivityLevel
+	MoodTag             MoodTag
 }
@@ -0,0 +1,171 @@
+package model
+
+import "time"
+
+// HouseholdRole 表示家庭成員的角色
+type HouseholdRole string
+
+const (
+	// HouseholdRoleOwner 可管理成員、邀請與刪除寵物
+	HouseholdRoleOwner HouseholdRole = "owner"
+	// HouseholdRoleEditor 可讀寫寵物與相關紀錄
+	HouseholdRoleEditor HouseholdRole = "editor"
+	// HouseholdRoleViewer 僅可檢視
+	HouseholdRoleViewer HouseholdRole = "viewer"
+	// HouseholdRoleSitter 臨時照顧者，可讀寫紀錄，須設定到期日
+	HouseholdRoleSitter HouseholdRole = "sitter"
+)
+
+// IsValid 檢查角色是否為已定義的值
+func (r HouseholdRole) IsValid() bool {
+	switch r {
+	case HouseholdRoleOwner, HouseholdRoleEditor, HouseholdRoleViewer, HouseholdRoleSitter:
+		return true
+	}
+	return false
+}
+
+// Permission 表示對寵物資料的存取層級
+type Permission int
+
+const (
+	// PermissionRead 檢視寵物與相關紀錄
+	PermissionRead Permission = iota + 1
+	// PermissionWrite 新增、編輯與刪除紀錄
+	PermissionWrite
+	// PermissionManage 刪除寵物、管理家庭成員
+	PermissionManage
+)
+
+// Allows 檢查角色是否具備指定的存取層級
+func (r HouseholdRole) Allows(p Permission) bool {
+	switch r {
+	case HouseholdRoleOwner:
+		return true
+	case HouseholdRoleEditor, HouseholdRoleSitter:
+		return p <= PermissionWrite
+	case HouseholdRoleViewer:
+		return p == PermissionRead
+	}
+	return false
+}
+
+// HouseholdMember 代表家庭中的一位成員
+// - ExpiresAt: 成員資格到期時間，sitter 必填，其餘角色可為空
+type HouseholdMember struct {
+	UserID    string        `json:"user_id"`
+	Role      HouseholdRole `json:"role"`
+	ExpiresAt *time.Time    `json:"expires_at,omitempty"`
+	JoinedAt  time.Time     `json:"joined_at"`
+}
+
+// IsActive 檢查成員資格在指定時間點是否仍有效
+func (m *HouseholdMember) IsActive(now time.Time) bool {
+	return m.ExpiresAt == nil || now.Before(*m.ExpiresAt)
+}
+
+// HouseholdInvitation 代表一筆尚未使用的邀請
+// - TokenHash: 邀請 token 的雜湊值，明文 token 只在建立時回傳一次
+// - ExpiresAt: 邀請本身的有效期限
+// - MemberExpiresAt: 接受邀請後成員資格的到期時間（sitter 使用）
+type HouseholdInvitation struct {
+	TokenHash       string        `json:"-"`
+	Role            HouseholdRole `json:"role"`
+	InvitedBy       string        `json:"invited_by"`
+	ExpiresAt       time.Time     `json:"expires_at"`
+	MemberExpiresAt *time.Time    `json:"member_expires_at,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
+// Household 代表共同照顧寵物的家庭，是成員與邀請的聚合根
+type Household struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Members     []HouseholdMember     `json:"members"`
+	Invitations []HouseholdInvitation `json:"invitations,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// Clone 複製家庭，成員與邀請使用獨立的 slice，供記錄異動前的狀態
+func (h *Household) Clone() *Household {
+	clone := *h
+	clone.Members = append([]HouseholdMember(nil), h.Members...)
+	clone.Invitations = append([]HouseholdInvitation(nil), h.Invitations...)
+	return &clone
+}
+
+// Member 依使用者 ID 取得成員，找不到時回傳 nil
+func (h *Household) Member(userID string) *HouseholdMember {
+	for i := range h.Members {
+		if h.Members[i].UserID == userID {
+			return &h.Members[i]
+		}
+	}
+	return nil
+}
+
+// Allows 檢查使用者在指定時間點是否具備存取層級
+func (h *Household) Allows(userID string, p Permission, now time.Time) bool {
+	m := h.Member(userID)
+	return m != nil && m.IsActive(now) && m.Role.Allows(p)
+}
+
+// UpsertMember 新增成員，若已存在則更新角色與到期時間
+func (h *Household) UpsertMember(member HouseholdMember) {
+	if m := h.Member(member.UserID); m != nil {
+		m.Role = member.Role
+		m.ExpiresAt = member.ExpiresAt
+		return
+	}
+	h.Members = append(h.Members, member)
+}
+
+// RemoveMember 移除成員，回傳是否有成員被移除
+func (h *Household) RemoveMember(userID string) bool {
+	for i := range h.Members {
+		if h.Members[i].UserID == userID {
+			h.Members = append(h.Members[:i], h.Members[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// OwnerCount 回傳仍有效的 owner 數量，用於避免家庭失去管理者
+func (h *Household) OwnerCount(now time.Time) int {
+	count := 0
+	for i := range h.Members {
+		if h.Members[i].Role == HouseholdRoleOwner && h.Members[i].IsActive(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// TakeInvitation 取出並移除符合雜湊值且未過期的邀請
+func (h *Household) TakeInvitation(tokenHash string, now time.Time) *HouseholdInvitation {
+	for i := range h.Invitations {
+		inv := h.Invitations[i]
+		if inv.TokenHash != tokenHash {
+			continue
+		}
+		h.Invitations = append(h.Invitations[:i], h.Invitations[i+1:]...)
+		if !now.Before(inv.ExpiresAt) {
+			return nil
+		}
+		return &inv
+	}
+	return nil
+}
+
+// PruneExpiredInvitations 移除已過期的邀請
+func (h *Household) PruneExpiredInvitations(now time.Time) {
+	valid := h.Invitations[:0]
+	for _, inv := range h.Invitations {
+		if now.Before(inv.ExpiresAt) {
+			valid = append(valid, inv)
+		}
+	}
+	h.Invitations = valid
+}
@@ -0,0 +1,97 @@
+package model
+
+// ImportEntity 表示批次匯入的資料種類
+type ImportEntity string
+
+const (
+	ImportEntityHealthLogs ImportEntity = "health_logs"
+	ImportEntityExpenses   ImportEntity = "expenses"
+)
+
+// IsValid 檢查匯入資料種類是否為已定義的值
+func (e ImportEntity) IsValid() bool {
+	switch e {
+	case ImportEntityHealthLogs, ImportEntityExpenses:
+		return true
+	}
+	return false
+}
+
+// ImportFormat 表示匯入檔的格式
+type ImportFormat string
+
+const (
+	ImportFormatCSV  ImportFormat = "csv"
+	ImportFormatXLSX ImportFormat = "xlsx"
+)
+
+// IsValid 檢查匯入檔格式是否為已定義的值
+func (f ImportFormat) IsValid() bool {
+	switch f {
+	case ImportFormatCSV, ImportFormatXLSX:
+		return true
+	}
+	return false
+}
+
+// ImportRowStatus 表示單一資料列的匯入結果
+type ImportRowStatus string
+
+const (
+	// ImportRowCreated 已建立資料
+	ImportRowCreated ImportRowStatus = "created"
+	// ImportRowValid 驗證通過，僅於試算（dry run）時出現，實際匯入時會建立
+	ImportRowValid ImportRowStatus = "valid"
+	// ImportRowDuplicate 相同去重鍵的資料已匯入過（或在同一檔案中重複），略過不寫入
+	ImportRowDuplicate ImportRowStatus = "duplicate"
+	// ImportRowInvalid 欄位格式或驗證規則不符，未寫入
+	ImportRowInvalid ImportRowStatus = "invalid"
+	// ImportRowFailed 驗證通過但寫入失敗
+	ImportRowFailed ImportRowStatus = "failed"
+)
+
+// ImportRowResult 單一資料列的匯入結果
+// - Row: 於原始檔案中的列號（標題列為第 1 列）
+// - DedupeKey: 去重鍵，相同鍵值的資料只會匯入一次
+// - ID: 建立或已存在時對應的資料 ID，試算時為空
+// - Errors: 該列所有欄位的錯誤訊息
+type ImportRowResult struct {
+	Row       int             `json:"row"`
+	Status    ImportRowStatus `json:"status"`
+	PetID     string          `json:"pet_id,omitempty"`
+	DedupeKey string          `json:"dedupe_key,omitempty"`
+	ID        string          `json:"id,omitempty"`
+	Errors    []string        `json:"errors,omitempty"`
+}
+
+// ImportReport 一次批次匯入的結果報告，DryRun 為 true 時不會寫入任何資料
+type ImportReport struct {
+	Entity     ImportEntity       `json:"entity"`
+	Format     ImportFormat       `json:"format"`
+	DryRun     bool               `json:"dry_run"`
+	Total      int                `json:"total"`
+	Created    int                `json:"created"`
+	Valid      int                `json:"valid"`
+	Duplicates int                `json:"duplicates"`
+	Invalid    int                `json:"invalid"`
+	Failed     int                `json:"failed"`
+	Rows       []*ImportRowResult `json:"rows"`
+}
+
+// Add 加入一筆資料列結果並更新統計
+func (r *ImportReport) Add(row *ImportRowResult) {
+	r.Rows = append(r.Rows, row)
+	r.Total++
+	switch row.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowValid:
+		r.Valid++
+	case ImportRowDuplicate:
+		r.Duplicates++
+	case ImportRowInvalid:
+		r.Invalid++
+	case ImportRowFailed:
+		r.Failed++
+	}
+}
@@ -14,6 +14,7 @@ const (
 )
 
 // MedicalRecord represents a medical record for a pet. It is a pure domain entity.
+// Version is incremented on every update and used for optimistic concurrency control.
 type MedicalRecord struct {
 	ID          string            `json:"id"`
 	PetID       string            `json:"pet_id"`
@@ -22,4 +23,5 @@ type MedicalRecord struct {
 	Date        time.Time         `json:"date"`
 	NextDueDate *time.Time        `json:"next_due_date,omitempty"`
 	Dosage      string            `json:"dosage,omitempty"`
+	Version     int               `json:"version"`
 }
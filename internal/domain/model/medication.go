@@ -0,0 +1,117 @@
+package model
+
+import "time"
+
+// DoseFrequency 表示用藥療程的給藥頻率
+type DoseFrequency string
+
+const (
+	// DoseDaily 自開始日期起每 Interval 天於 Times 的各時間給藥
+	DoseDaily DoseFrequency = "daily"
+	// DoseWeekly 自開始日期起每 Interval 週，於開始日期的星期幾的 Times 各時間給藥
+	DoseWeekly DoseFrequency = "weekly"
+)
+
+// IsValid 檢查給藥頻率是否為已定義的值
+func (f DoseFrequency) IsValid() bool {
+	switch f {
+	case DoseDaily, DoseWeekly:
+		return true
+	}
+	return false
+}
+
+// DoseSchedule 用藥療程的給藥排程
+// - Interval: 每幾天／幾週給藥一次，最小為 1
+// - Times: 給藥當天的時間（HH:MM，24 小時制），以療程的時區解讀
+type DoseSchedule struct {
+	Frequency DoseFrequency `json:"frequency"`
+	Interval  int           `json:"interval"`
+	Times     []string      `json:"times"`
+}
+
+// MedicationRegimen 代表長期用藥的療程（例如甲狀腺、腎臟用藥），純領域實體
+// - Dose/Unit: 每次給藥的劑量與單位，例如 0.5 tablet、2.5 mg
+// - Timezone: 解讀給藥時間的 IANA 時區，StartDate/EndDate 為該時區當日零時
+// - EndDate: 療程最後一天（含），nil 表示長期用藥
+// - HospitalID: 開立處方的醫院
+// - MedicalRecordID: 開立處方時的醫療記錄，可不填
+// - MissedCheckedThrough: 已檢查漏服並建立提醒的最後時間點，漏服偵測只處理之後的給藥
+type MedicationRegimen struct {
+	ID                   string       `json:"id"`
+	PetID                string       `json:"pet_id"`
+	Drug                 string       `json:"drug"`
+	Dose                 float64      `json:"dose"`
+	Unit                 string       `json:"unit"`
+	Schedule             DoseSchedule `json:"schedule"`
+	Timezone             string       `json:"timezone"`
+	StartDate            time.Time    `json:"start_date"`
+	EndDate              *time.Time   `json:"end_date,omitempty"`
+	HospitalID           string       `json:"hospital_id,omitempty"`
+	MedicalRecordID      string       `json:"medical_record_id,omitempty"`
+	Notes                string       `json:"notes,omitempty"`
+	MissedCheckedThrough *time.Time   `json:"missed_checked_through,omitempty"`
+	Version              int          `json:"version"`
+	CreatedAt            time.Time    `json:"created_at"`
+	UpdatedAt            time.Time    `json:"updated_at"`
+}
+
+// DoseStatus 表示單次給藥的狀態
+type DoseStatus string
+
+const (
+	// DoseStatusPending 尚未到期或仍在寬限期內，尚未勾選
+	DoseStatusPending DoseStatus = "pending"
+	// DoseStatusTaken 照護者已勾選給藥
+	DoseStatusTaken DoseStatus = "taken"
+	// DoseStatusSkipped 照護者刻意略過（例如獸醫指示暫停），不算服藥但也不算漏服
+	DoseStatusSkipped DoseStatus = "skipped"
+	// DoseStatusMissed 超過寬限期仍未勾選
+	DoseStatusMissed DoseStatus = "missed"
+)
+
+// IsRecordable 檢查狀態是否可由照護者勾選紀錄，pending 與 missed 由排程推算
+func (s DoseStatus) IsRecordable() bool {
+	return s == DoseStatusTaken || s == DoseStatusSkipped
+}
+
+// DoseEvent 代表療程中的單次給藥，純領域實體
+// 排程推算出的給藥時間只有在照護者勾選後才會儲存，未儲存的給藥依時間推算為 pending 或 missed
+// - ScheduledAt: 排定的給藥時間，同一療程的同一時間只會有一筆紀錄
+// - TakenAt: 實際給藥時間，僅 taken 使用
+// - RecordedBy: 勾選的使用者
+type DoseEvent struct {
+	ID          string     `json:"id,omitempty"`
+	RegimenID   string     `json:"regimen_id"`
+	PetID       string     `json:"pet_id"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	Status      DoseStatus `json:"status"`
+	TakenAt     *time.Time `json:"taken_at,omitempty"`
+	RecordedBy  string     `json:"recorded_by,omitempty"`
+	Note        string     `json:"note,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// DoseAdherence 某一期間的給藥統計
+// - Scheduled: 期間內排定的給藥次數，含尚未到期的 pending
+// - AdherencePct: 已到期的給藥中 taken 所佔的百分比，略過的給藥不計入分母；沒有已到期的給藥時為 nil
+type DoseAdherence struct {
+	PeriodStart  time.Time `json:"period_start"`
+	Scheduled    int       `json:"scheduled"`
+	Taken        int       `json:"taken"`
+	Skipped      int       `json:"skipped"`
+	Missed       int       `json:"missed"`
+	Pending      int       `json:"pending"`
+	AdherencePct *float64  `json:"adherence_pct"`
+}
+
+// MedicationAdherence 用藥療程在查詢期間內的服藥率
+type MedicationAdherence struct {
+	RegimenID string           `json:"regimen_id"`
+	Interval  TrendInterval    `json:"interval"`
+	StartDate time.Time        `json:"start_date"`
+	EndDate   time.Time        `json:"end_date"`
+	Overall   DoseAdherence    `json:"overall"`
+	Periods   []*DoseAdherence `json:"periods"`
+}
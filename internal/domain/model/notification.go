@@ -0,0 +1,111 @@
+package model
+
+import "time"
+
+// NotificationChannel 表示通知的發送管道
+type NotificationChannel string
+
+const (
+	NotificationChannelLine    NotificationChannel = "line"
+	NotificationChannelEmail   NotificationChannel = "email"
+	NotificationChannelWebhook NotificationChannel = "webhook"
+)
+
+// IsValid 檢查通知管道是否為已定義的值
+func (c NotificationChannel) IsValid() bool {
+	switch c {
+	case NotificationChannelLine, NotificationChannelEmail, NotificationChannelWebhook:
+		return true
+	}
+	return false
+}
+
+// NotificationMessage 代表一則要發送給使用者的通知內容
+type NotificationMessage struct {
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	PetID      string    `json:"pet_id,omitempty"`
+	ReminderID string    `json:"reminder_id,omitempty"`
+	BudgetID   string    `json:"budget_id,omitempty"`
+	ExportID   string    `json:"export_id,omitempty"`
+	DueDate    time.Time `json:"due_date,omitempty"`
+}
+
+// NotificationChannelSetting 單一管道的通知設定
+// - Target: LINE user ID、Email 地址或 Webhook URL，依管道而定
+type NotificationChannelSetting struct {
+	Channel NotificationChannel `json:"channel"`
+	Target  string              `json:"target"`
+	Enabled bool                `json:"enabled"`
+}
+
+// NotificationPreference 使用者的通知偏好設定
+type NotificationPreference struct {
+	ID        string                       `json:"id"`
+	UserID    string                       `json:"user_id"`
+	Channels  []NotificationChannelSetting `json:"channels"`
+	CreatedAt time.Time                    `json:"created_at"`
+	UpdatedAt time.Time                    `json:"updated_at"`
+}
+
+// EnabledChannels 回傳已啟用的通知管道設定
+func (p *NotificationPreference) EnabledChannels() []NotificationChannelSetting {
+	enabled := make([]NotificationChannelSetting, 0, len(p.Channels))
+	for _, ch := range p.Channels {
+		if ch.Enabled {
+			enabled = append(enabled, ch)
+		}
+	}
+	return enabled
+}
+
+// DeliveryStatus 表示通知投遞的狀態
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending DeliveryStatus = "pending"
+	DeliveryStatusSent    DeliveryStatus = "sent"
+	DeliveryStatusFailed  DeliveryStatus = "failed"
+	// DeliveryStatusExhausted 表示已達重試上限，不再重送
+	DeliveryStatusExhausted DeliveryStatus = "exhausted"
+)
+
+// NotificationDelivery 代表一次通知投遞的紀錄，包含每次嘗試的結果
+// - Attempts: 已嘗試次數
+// - NextAttemptAt: 失敗後下次重試的時間
+type NotificationDelivery struct {
+	ID            string              `json:"id"`
+	UserID        string              `json:"user_id"`
+	Channel       NotificationChannel `json:"channel"`
+	Target        string              `json:"target"`
+	Message       NotificationMessage `json:"message"`
+	Status        DeliveryStatus      `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	LastError     string              `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time          `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time          `json:"sent_at,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+}
+
+// MarkSent 記錄一次成功的投遞
+func (d *NotificationDelivery) MarkSent(now time.Time) {
+	d.Attempts++
+	d.Status = DeliveryStatusSent
+	d.SentAt = &now
+	d.NextAttemptAt = nil
+	d.LastError = ""
+	d.UpdatedAt = now
+}
+
+// MarkFailed 記錄一次失敗的投遞；nextAttemptAt 為 nil 表示不再重試
+func (d *NotificationDelivery) MarkFailed(err error, now time.Time, nextAttemptAt *time.Time) {
+	d.Attempts++
+	d.LastError = err.Error()
+	d.NextAttemptAt = nextAttemptAt
+	d.Status = DeliveryStatusFailed
+	if nextAttemptAt == nil {
+		d.Status = DeliveryStatusExhausted
+	}
+	d.UpdatedAt = now
+}
@@ -3,14 +3,39 @@ package model
 import "time"
 
 // Pet represents a pet profile. It is a pure domain entity.
+// HouseholdID links the pet to a shared household; members access it according to their role.
+// Version is incremented on every update and used for optimistic concurrency control.
+// WeightAlert overrides the default thresholds used to flag rapid weight loss or gain.
+// DeletedAt is set when the pet and its records are moved to the trash and can still be restored.
+// Avatar is set when the avatar was uploaded through the API; AvatarURL then points to its largest thumbnail.
 type Pet struct {
-	ID          string    `json:"id"`
-	OwnerID     string    `json:"owner_id"`
-	Name        string    `json:"name"`
-	AvatarURL   string    `json:"avatar_url"`
-	DOB         time.Time `json:"dob"`
-	Breed       string    `json:"breed"`
-	MicrochipID string    `json:"microchip_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string                `json:"id"`
+	OwnerID     string                `json:"owner_id"`
+	HouseholdID string                `json:"household_id,omitempty"`
+	Name        string                `json:"name"`
+	AvatarURL   string                `json:"avatar_url"`
+	DOB         time.Time             `json:"dob"`
+	Breed       string                `json:"breed"`
+	MicrochipID string                `json:"microchip_id"`
+	WeightAlert *WeightAlertThreshold `json:"weight_alert,omitempty"`
+	Version     int                   `json:"version"`
+	DeletedAt   *time.Time            `json:"deleted_at,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+
+	Avatar *PetAvatar `json:"avatar,omitempty"`
+}
+
+// PetAvatar describes an uploaded avatar whose thumbnails are kept in the blob store.
+type PetAvatar struct {
+	// Key is the blob store prefix; each thumbnail is stored as <Key>/<size>.jpg.
+	Key        string            `json:"-"`
+	Thumbnails []AvatarThumbnail `json:"thumbnails"`
+	UploadedAt time.Time         `json:"uploaded_at"`
+}
+
+// AvatarThumbnail is a square JPEG rendition of an avatar.
+type AvatarThumbnail struct {
+	Size int    `json:"size"`
+	URL  string `json:"url"`
 }
@@ -0,0 +1,80 @@
+package model
+
+import "time"
+
+// RecurrenceFrequency 表示週期性費用的重複頻率
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceMonthly 每 Interval 個月於 DayOfMonth 日
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+	// RecurrenceWeekly 自 StartDate 起每 Interval 週
+	RecurrenceWeekly RecurrenceFrequency = "weekly"
+)
+
+// IsValid 檢查重複頻率是否為已定義的值
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceMonthly, RecurrenceWeekly:
+		return true
+	}
+	return false
+}
+
+// ExpenseSchedule 類似 RRULE 的排程設定
+// - Interval: 每幾個月／幾週重複一次，最小為 1
+// - DayOfMonth: 每月的第幾天（1-31），當月沒有該日時取月底；僅 monthly 使用
+type ExpenseSchedule struct {
+	Frequency  RecurrenceFrequency `json:"frequency"`
+	Interval   int                 `json:"interval"`
+	DayOfMonth int                 `json:"day_of_month,omitempty"`
+}
+
+// RecurringExpense 代表依固定排程自動產生費用的定義，純領域實體
+// - StartDate/EndDate: 排程的起訖日期，EndDate 為 nil 表示不限
+// - Paused: 暫停時排程不會產生費用，恢復後也不會補產生暫停期間的費用
+// - SkippedDates: 略過的單次期別（UTC 當日零時）
+// - GeneratedThrough: 已產生費用的最後時間點，產生器只處理之後的期別
+type RecurringExpense struct {
+	ID               string          `json:"id"`
+	PetID            string          `json:"pet_id"`
+	Category         string          `json:"category"`
+	Amount           int             `json:"amount"`
+	Description      string          `json:"description"`
+	Schedule         ExpenseSchedule `json:"schedule"`
+	StartDate        time.Time       `json:"start_date"`
+	EndDate          *time.Time      `json:"end_date,omitempty"`
+	Paused           bool            `json:"paused"`
+	SkippedDates     []time.Time     `json:"skipped_dates,omitempty"`
+	GeneratedThrough *time.Time      `json:"generated_through,omitempty"`
+	Version          int             `json:"version"`
+	CreatedAt        time.Time       `json:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at"`
+
+	Currency string `json:"currency,omitempty"`
+}
+
+// IsSkipped 檢查指定期別是否已被略過
+func (r *RecurringExpense) IsSkipped(occurrence time.Time) bool {
+	for _, skipped := range r.SkippedDates {
+		if skipped.Equal(occurrence) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewExpense 依期別建立一筆具體的費用
+func (r *RecurringExpense) NewExpense(occurrence time.Time) *Expense {
+	date := occurrence
+	return &Expense{
+		PetID:              r.PetID,
+		Category:           r.Category,
+		Amount:             r.Amount,
+		Description:        r.Description,
+		Date:               occurrence,
+		RecurringExpenseID: r.ID,
+		OccurrenceDate:     &date,
+		Currency:           r.Currency,
+	}
+}
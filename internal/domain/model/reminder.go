@@ -0,0 +1,91 @@
+package model
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+// ReminderStatus 表示提醒目前的狀態
+type ReminderStatus string
+
+const (
+	ReminderStatusPending ReminderStatus = "pending"
+	ReminderStatusSent    ReminderStatus = "sent"
+	ReminderStatusSnoozed ReminderStatus = "snoozed"
+	ReminderStatusDone    ReminderStatus = "done"
+)
+
+// IsValid 檢查提醒狀態是否為已定義的值
+func (s ReminderStatus) IsValid() bool {
+	switch s {
+	case ReminderStatusPending, ReminderStatusSent, ReminderStatusSnoozed, ReminderStatusDone:
+		return true
+	}
+	return false
+}
+
+// Reminder 代表一筆寵物照護提醒，純領域實體
+// - MedicalRecordID: 由排程器依醫療記錄 NextDueDate 產生時的來源記錄，手動建立時為空
+// - MedicationRegimenID: 由漏服偵測產生時的用藥療程，DueDate 為漏服的給藥時間
+// - DueDate: 照護事項的到期日
+// - RemindAt: 預計發送提醒的時間
+// - SnoozedUntil: 延後提醒時，下次發送的時間
+type Reminder struct {
+	ID                  string            `json:"id"`
+	PetID               string            `json:"pet_id"`
+	MedicalRecordID     string            `json:"medical_record_id,omitempty"`
+	MedicationRegimenID string            `json:"medication_regimen_id,omitempty"`
+	Type                MedicalRecordType `json:"type"`
+	Title               string            `json:"title"`
+	DueDate             time.Time         `json:"due_date"`
+	RemindAt            time.Time         `json:"remind_at"`
+	Status              ReminderStatus    `json:"status"`
+	SnoozedUntil        *time.Time        `json:"snoozed_until,omitempty"`
+	SentAt              *time.Time        `json:"sent_at,omitempty"`
+	CompletedAt         *time.Time        `json:"completed_at,omitempty"`
+	CreatedAt           time.Time         `json:"created_at"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+}
+
+// IsDue 檢查提醒在指定時間點是否應被發送
+func (r *Reminder) IsDue(now time.Time) bool {
+	switch r.Status {
+	case ReminderStatusPending:
+		return !r.RemindAt.After(now)
+	case ReminderStatusSnoozed:
+		return r.SnoozedUntil != nil && !r.SnoozedUntil.After(now)
+	}
+	return false
+}
+
+// MarkSent 將提醒標記為已發送
+func (r *Reminder) MarkSent(now time.Time) error {
+	if r.Status == ReminderStatusDone {
+		return domain.ErrInvalidParameter
+	}
+	r.Status = ReminderStatusSent
+	r.SentAt = &now
+	r.SnoozedUntil = nil
+	r.UpdatedAt = now
+	return nil
+}
+
+// Snooze 將提醒延後至指定時間
+func (r *Reminder) Snooze(until, now time.Time) error {
+	if r.Status == ReminderStatusDone || !until.After(now) {
+		return domain.ErrInvalidParameter
+	}
+	r.Status = ReminderStatusSnoozed
+	r.SnoozedUntil = &until
+	r.UpdatedAt = now
+	return nil
+}
+
+// Complete 將提醒標記為已完成
+func (r *Reminder) Complete(now time.Time) {
+	r.Status = ReminderStatusDone
+	r.CompletedAt = &now
+	r.SnoozedUntil = nil
+	r.UpdatedAt = now
+}
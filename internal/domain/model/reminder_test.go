@@ -0,0 +1,139 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+func TestReminder_IsDue(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		reminder Reminder
+		want     bool
+	}{
+		{"待發送且已到提醒時間", Reminder{Status: ReminderStatusPending, RemindAt: past}, true},
+		{"待發送且恰好到提醒時間", Reminder{Status: ReminderStatusPending, RemindAt: now}, true},
+		{"待發送但尚未到提醒時間", Reminder{Status: ReminderStatusPending, RemindAt: future}, false},
+		{"延後且已到延後時間", Reminder{Status: ReminderStatusSnoozed, RemindAt: past, SnoozedUntil: &past}, true},
+		{"延後但尚未到延後時間", Reminder{Status: ReminderStatusSnoozed, RemindAt: past, SnoozedUntil: &future}, false},
+		{"延後但缺少延後時間", Reminder{Status: ReminderStatusSnoozed, RemindAt: past}, false},
+		{"已發送不再發送", Reminder{Status: ReminderStatusSent, RemindAt: past}, false},
+		{"已完成不再發送", Reminder{Status: ReminderStatusDone, RemindAt: past}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.reminder.IsDue(now); got != tt.want {
+				t.Errorf("預期 %v，實際為 %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReminder_Snooze(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		status  ReminderStatus
+		until   time.Time
+		wantErr bool
+	}{
+		{"待發送可延後", ReminderStatusPending, now.Add(24 * time.Hour), false},
+		{"已發送可再延後", ReminderStatusSent, now.Add(time.Hour), false},
+		{"已延後可改期", ReminderStatusSnoozed, now.Add(2 * time.Hour), false},
+		{"延後時間不可早於現在", ReminderStatusPending, now.Add(-time.Hour), true},
+		{"延後時間不可等於現在", ReminderStatusPending, now, true},
+		{"已完成不可延後", ReminderStatusDone, now.Add(time.Hour), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reminder{Status: tt.status}
+			err := r.Snooze(tt.until, now)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Errorf("預期 ErrInvalidParameter，實際為 %v", err)
+				}
+				if r.Status != tt.status || r.SnoozedUntil != nil {
+					t.Errorf("預期狀態不變，實際為 %s、%v", r.Status, r.SnoozedUntil)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("預期無錯誤，實際為 %v", err)
+			}
+			if r.Status != ReminderStatusSnoozed || r.SnoozedUntil == nil || !r.SnoozedUntil.Equal(tt.until) {
+				t.Errorf("預期延後至 %v，實際為 %s、%v", tt.until, r.Status, r.SnoozedUntil)
+			}
+			if !r.UpdatedAt.Equal(now) {
+				t.Errorf("預期更新時間為 %v，實際為 %v", now, r.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestReminder_MarkSent(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	snoozed := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		status  ReminderStatus
+		wantErr bool
+	}{
+		{"待發送標記為已發送", ReminderStatusPending, false},
+		{"延後到期後標記為已發送", ReminderStatusSnoozed, false},
+		{"已完成不可標記為已發送", ReminderStatusDone, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reminder{Status: tt.status, SnoozedUntil: &snoozed}
+			err := r.MarkSent(now)
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Errorf("預期 ErrInvalidParameter，實際為 %v", err)
+				}
+				if r.Status != tt.status || r.SentAt != nil {
+					t.Errorf("預期狀態不變，實際為 %s、%v", r.Status, r.SentAt)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("預期無錯誤，實際為 %v", err)
+			}
+			if r.Status != ReminderStatusSent || r.SentAt == nil || !r.SentAt.Equal(now) {
+				t.Errorf("預期於 %v 標記為已發送，實際為 %s、%v", now, r.Status, r.SentAt)
+			}
+			if r.SnoozedUntil != nil {
+				t.Errorf("預期清除延後時間，實際為 %v", r.SnoozedUntil)
+			}
+		})
+	}
+}
+
+func TestReminder_Complete(t *testing.T) {
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	snoozed := now.Add(time.Hour)
+
+	for _, status := range []ReminderStatus{ReminderStatusPending, ReminderStatusSent, ReminderStatusSnoozed, ReminderStatusDone} {
+		t.Run(string(status)+" 標記為已完成", func(t *testing.T) {
+			r := &Reminder{Status: status, SnoozedUntil: &snoozed}
+			r.Complete(now)
+			if r.Status != ReminderStatusDone || r.CompletedAt == nil || !r.CompletedAt.Equal(now) {
+				t.Errorf("預期於 %v 完成，實際為 %s、%v", now, r.Status, r.CompletedAt)
+			}
+			if r.SnoozedUntil != nil {
+				t.Errorf("預期清除延後時間，實際為 %v", r.SnoozedUntil)
+			}
+			if r.IsDue(now.Add(24 * time.Hour)) {
+				t.Error("預期已完成的提醒不再到期")
+			}
+		})
+	}
+}
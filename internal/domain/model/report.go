@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// ObservationFlag 表示健康日誌中值得就診時告知獸醫的觀察
+type ObservationFlag string
+
+const (
+	// ObservationVomiting 有嘔吐紀錄
+	ObservationVomiting ObservationFlag = "vomiting"
+	// ObservationAbnormalStool 糞便型態偏硬（1-2）或偏稀（6-7）
+	ObservationAbnormalStool ObservationFlag = "abnormal_stool"
+	// ObservationNoUrine 記錄了尿塊數且為 0
+	ObservationNoUrine ObservationFlag = "no_urine"
+	// ObservationLowActivity 活動量低
+	ObservationLowActivity ObservationFlag = "low_activity"
+	// ObservationConcerningMood 出現焦慮、攻擊、倦怠或躲藏等情緒
+	ObservationConcerningMood ObservationFlag = "concerning_mood"
+)
+
+// NotableObservation 一筆有值得注意觀察的健康日誌
+type NotableObservation struct {
+	Log   *HealthLog
+	Flags []ObservationFlag
+}
+
+// PetHealthReport 寵物就診用健康報告的內容，由 service.ReportRenderer 輸出為 PDF
+// - Weights: 期間內的體重趨勢，依期間長短以日、週或月分組，並依寵物門檻標示快速變化
+// - LatestWeightKg/WeightChangePct: 期間內最後一筆體重與相較第一筆的變化百分比，沒有體重紀錄時為空
+// - Vaccinations: 截至報告結束日的所有疫苗紀錄，獸醫需要完整的接種史
+// - Medications: 期間內的用藥紀錄
+type PetHealthReport struct {
+	Pet             *Pet
+	StartDate       time.Time
+	EndDate         time.Time
+	GeneratedAt     time.Time
+	WeightInterval  TrendInterval
+	Weights         []WeightTrendPoint
+	LatestWeightKg  *float64
+	WeightChangePct *float64
+	Vaccinations    []*MedicalRecord
+	Medications     []*MedicalRecord
+	Observations    []NotableObservation
+	HealthLogCount  int
+}
+
+// RenderedReport 已輸出的報告檔案
+type RenderedReport struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
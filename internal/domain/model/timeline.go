@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// TimelineItemType 表示時間軸項目的種類
+type TimelineItemType string
+
+const (
+	TimelineItemHealthLog     TimelineItemType = "health_log"
+	TimelineItemMedicalRecord TimelineItemType = "medical_record"
+	TimelineItemExpense       TimelineItemType = "expense"
+	// TimelineItemDue 醫療記錄 NextDueDate 產生的預定事件
+	TimelineItemDue TimelineItemType = "due"
+)
+
+// IsValid 檢查種類是否為已定義的值
+func (t TimelineItemType) IsValid() bool {
+	switch t {
+	case TimelineItemHealthLog, TimelineItemMedicalRecord, TimelineItemExpense, TimelineItemDue:
+		return true
+	}
+	return false
+}
+
+// TimelineItem 代表寵物時間軸上的一個事件
+// - ID: 來源紀錄的 ID，到期事件為產生它的醫療記錄 ID
+// - Date: 事件日期，到期事件為醫療記錄的 NextDueDate
+// - Summary: 供日曆顯示的簡述
+// - HealthLog/MedicalRecord/Expense: 依種類帶有來源紀錄，到期事件帶有醫療記錄
+type TimelineItem struct {
+	Type          TimelineItemType `json:"type"`
+	ID            string           `json:"id"`
+	PetID         string           `json:"pet_id"`
+	Date          time.Time        `json:"date"`
+	Summary       string           `json:"summary"`
+	HealthLog     *HealthLog       `json:"health_log,omitempty"`
+	MedicalRecord *MedicalRecord   `json:"medical_record,omitempty"`
+	Expense       *Expense         `json:"expense,omitempty"`
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+// TrashItemType 表示垃圾桶中項目的種類
+type TrashItemType string
+
+const (
+	TrashItemPet           TrashItemType = "pet"
+	TrashItemHealthLog     TrashItemType = "health_log"
+	TrashItemMedicalRecord TrashItemType = "medical_record"
+	TrashItemExpense       TrashItemType = "expense"
+)
+
+// IsValid 檢查種類是否為已定義的值
+func (t TrashItemType) IsValid() bool {
+	switch t {
+	case TrashItemPet, TrashItemHealthLog, TrashItemMedicalRecord, TrashItemExpense:
+		return true
+	}
+	return false
+}
+
+// TrashItem 代表一筆已軟刪除、可還原或永久清除的資料
+// - PetID: 所屬寵物，種類為 pet 時即為自身 ID
+// - Summary: 供列表顯示的簡述（寵物名稱、紀錄描述等）
+type TrashItem struct {
+	Type      TrashItemType `json:"type"`
+	ID        string        `json:"id"`
+	PetID     string        `json:"pet_id"`
+	Summary   string        `json:"summary"`
+	DeletedAt time.Time     `json:"deleted_at"`
+}
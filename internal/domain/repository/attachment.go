@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// AttachmentRepository 定義附件中繼資料的存取介面，檔案內容由 service.BlobStore 保存
+type AttachmentRepository interface {
+	Create(c context.Context, attachment *model.Attachment) error
+	FindByID(c context.Context, id string) (*model.Attachment, error)
+	// FindByOwner 查詢費用或醫療記錄的所有附件，依上傳時間由早到晚排列
+	FindByOwner(c context.Context, ownerType model.AttachmentOwnerType, ownerID string) ([]*model.Attachment, error)
+	// FindByPetID 查詢寵物所有費用與醫療記錄的附件，依上傳時間由早到晚排列
+	FindByPetID(c context.Context, petID string) ([]*model.Attachment, error)
+	Delete(c context.Context, id string) error
+}
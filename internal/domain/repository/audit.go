@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// AuditRepository 定義稽核紀錄的存取介面，紀錄只會新增不會修改
+type AuditRepository interface {
+	// Create 新增一筆稽核紀錄
+	Create(c context.Context, entry *model.AuditEntry) error
+
+	// FindByPetID 依寵物查詢稽核紀錄，依時間由新到舊排序
+	// entityType 為空時不限種類；startDate、endDate 為零值時不限範圍
+	FindByPetID(c context.Context, petID string, entityType model.AuditEntityType, startDate, endDate time.Time) ([]*model.AuditEntry, error)
+}
@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// BudgetRepository 定義費用預算的資料存取介面
+type BudgetRepository interface {
+	Create(c context.Context, budget *model.Budget) error
+	FindByID(c context.Context, id string) (*model.Budget, error)
+	FindByOwnerID(c context.Context, ownerID string) ([]*model.Budget, error)
+	// FindApplicable 查詢可能涵蓋指定寵物費用的預算：限定該寵物的預算，以及飼主涵蓋所有寵物的預算
+	FindApplicable(c context.Context, ownerID, petID string) ([]*model.Budget, error)
+	Update(c context.Context, budget *model.Budget) error
+	Delete(c context.Context, id string) error
+}
@@ -0,0 +1,18 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// CalendarFeedRepository 定義行事曆訂閱設定的資料存取介面
+type CalendarFeedRepository interface {
+	// FindByTokenHash 根據訂閱 token 的雜湊值查詢設定
+	FindByTokenHash(c context.Context, tokenHash string) (*model.CalendarFeed, error)
+
+	// Upsert 建立或更新使用者的訂閱設定，更新時以新的 token 取代舊的
+	Upsert(c context.Context, feed *model.CalendarFeed) error
+}
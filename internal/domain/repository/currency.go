@@ -0,0 +1,27 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// ExchangeRateRepository 定義匯率表的資料存取介面
+type ExchangeRateRepository interface {
+	// Upsert 依幣別與日期建立或覆寫匯率
+	Upsert(c context.Context, rates []*model.ExchangeRate) error
+
+	// FindByCurrency 查詢指定幣別的所有匯率，依日期由早到晚排列
+	FindByCurrency(c context.Context, currency string) ([]*model.ExchangeRate, error)
+}
+
+// UserSettingsRepository 定義使用者設定的資料存取介面
+type UserSettingsRepository interface {
+	// FindByUserID 根據使用者 ID 查詢設定
+	FindByUserID(c context.Context, userID string) (*model.UserSettings, error)
+
+	// Upsert 建立或更新使用者設定
+	Upsert(c context.Context, settings *model.UserSettings) error
+}
@@ -12,9 +12,14 @@ import (
 // 可依需求擴充更多欄位
 type ExpenseQueryOptions struct {
 	PetID     *string
+	PetIDs    []string
 	Category  *string
 	StartDate *time.Time
 	EndDate   *time.Time
+	// Recent 大於 0 時依日期由新到舊排序並只取前幾筆
+	Recent int
+	// Page 不為空時依分頁條件排序並只取單頁，回傳的總數為符合條件的總筆數
+	Page *PageRequest
 	// ...可擴充其他查詢條件
 }
 
@@ -27,6 +32,16 @@ func WithPetID(id string) ExpenseQueryOption {
 	}
 }
 
+// WithPetIDs 限定查詢指定寵物群組的費用，空集合表示查無資料
+func WithPetIDs(ids []string) ExpenseQueryOption {
+	return func(o *ExpenseQueryOptions) {
+		if ids == nil {
+			ids = []string{}
+		}
+		o.PetIDs = ids
+	}
+}
+
 func WithCategory(category string) ExpenseQueryOption {
 	return func(o *ExpenseQueryOptions) {
 		o.Category = &category
@@ -40,6 +55,23 @@ func WithDateRange(start, end time.Time) ExpenseQueryOption {
 	}
 }
 
+// WithRecent 依日期由新到舊排序，只取最近的 n 筆
+func WithRecent(n int) ExpenseQueryOption {
+	return func(o *ExpenseQueryOptions) {
+		o.Recent = n
+	}
+}
+
+// WithPage 依分頁條件排序並只取單頁，p 須先以 ExpenseSort 經過 Resolve
+func WithPage(p PageRequest) ExpenseQueryOption {
+	return func(o *ExpenseQueryOptions) {
+		o.Page = &p
+	}
+}
+
+// ExpenseSort 費用列表可排序的欄位，預設依日期由新到舊
+var ExpenseSort = SortSpec{Fields: []string{"date", "amount", "category", "created_at"}, Field: "date", Order: SortDesc}
+
 // ExpenseRepository 定義費用紀錄的資料存取介面
 // id 型別統一為 string
 type ExpenseRepository interface {
@@ -48,4 +80,8 @@ type ExpenseRepository interface {
 	Update(c context.Context, expense *model.Expense) error
 	Delete(c context.Context, id string) error
 	FindAll(c context.Context, opts ...ExpenseQueryOption) (expenses []*model.Expense, total int, err error)
+	// Aggregate 依分組方式彙總符合條件的費用，同一分組再依幣別與消費日期拆分，供換算匯率後合併
+	Aggregate(c context.Context, groupBy model.ExpenseGroupBy, opts ...ExpenseQueryOption) ([]*model.ExpenseBucketPart, error)
+	// FindImportKeys 查詢指定寵物已使用的匯入去重鍵（含垃圾桶中的費用），回傳去重鍵對應的費用 ID
+	FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error)
 }
@@ -0,0 +1,25 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// ExportJobRepository 定義資料匯出工作的存取介面，匯出檔內容由 service.BlobStore 保存
+type ExportJobRepository interface {
+	Create(c context.Context, job *model.ExportJob) error
+	FindByID(c context.Context, id string) (*model.ExportJob, error)
+	// FindByOwnerID 查詢使用者的所有匯出工作，依建立時間由新到舊排列
+	FindByOwnerID(c context.Context, ownerID string) ([]*model.ExportJob, error)
+	// ClaimNext 將最早建立的待處理工作標記為執行中並回傳；開始時間早於 staleBefore 的執行中工作視為中斷而重新領取
+	// 沒有可領取的工作時回傳 domain.ErrNotFound
+	ClaimNext(c context.Context, now, staleBefore time.Time) (*model.ExportJob, error)
+	Update(c context.Context, job *model.ExportJob) error
+	// FindExpired 查詢保留期限已過的工作
+	FindExpired(c context.Context, now time.Time) ([]*model.ExportJob, error)
+	Delete(c context.Context, id string) error
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/blackhorseya/petlog/internal/domain/model"
 )
 
+// HealthLogSort 健康日誌列表可排序的欄位，預設依日期由新到舊
+var HealthLogSort = SortSpec{Fields: []string{"date", "weight_kg", "food_gram", "created_at"}, Field: "date", Order: SortDesc}
+
 // HealthLogRepository 定義了健康日誌資料持久化的介面
 type HealthLogRepository interface {
 	// Create 建立新的健康日誌記錄
@@ -17,15 +20,25 @@ type HealthLogRepository interface {
 	// FindByID 根據 ID 查詢健康日誌
 	FindByID(c context.Context, id string) (*model.HealthLog, error)
 
-	// FindByPetID 查詢指定寵物在指定時間範圍內的健康日誌
-	FindByPetID(c context.Context, petID string, startDate, endDate time.Time) ([]*model.HealthLog, error)
+	// FindByPetID 查詢指定寵物在指定時間範圍內且符合結構化觀察篩選條件的健康日誌
+	FindByPetID(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter) ([]*model.HealthLog, error)
+
+	// ListByPetID 分頁查詢指定寵物在時間範圍內且符合篩選條件的健康日誌，回傳單頁資料與總筆數
+	ListByPetID(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter, page PageRequest) ([]*model.HealthLog, int, error)
+
+	// AggregateDailyFoodIntake 以聚合查詢加總指定寵物在時間範圍內每日（UTC）的進食量，依日期排序
+	// 未記錄進食量或不符合篩選條件的日誌不列入計算
+	AggregateDailyFoodIntake(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter) ([]*model.DailyFoodIntake, error)
 
 	// CountByPetIDs 統計指定寵物 ID 群組的健康日誌總數（用於聚合查詢）
 	CountByPetIDs(c context.Context, petIDs []string) (int, error)
 
-	// Update 更新健康日誌資訊
+	// Update 以版本比對更新健康日誌資訊，版本不符時回傳 domain.ErrUpdateConflict，成功後遞增 Version
 	Update(c context.Context, log *model.HealthLog) error
 
-	// Delete 刪除健康日誌記錄
+	// FindImportKeys 查詢指定寵物已使用的匯入去重鍵（含垃圾桶中的日誌），回傳去重鍵對應的日誌 ID
+	FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error)
+
+	// Delete 軟刪除健康日誌記錄，移至垃圾桶
 	Delete(c context.Context, id string) error
 }
@@ -0,0 +1,27 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// HouseholdRepository 定義家庭資料持久化的介面
+type HouseholdRepository interface {
+	// Create 建立新的家庭
+	Create(c context.Context, household *model.Household) error
+
+	// FindByID 根據 ID 查詢家庭
+	FindByID(c context.Context, id string) (*model.Household, error)
+
+	// FindByMemberUserID 查詢使用者所屬的所有家庭
+	FindByMemberUserID(c context.Context, userID string) ([]*model.Household, error)
+
+	// FindByInvitationTokenHash 根據邀請 token 雜湊值查詢家庭
+	FindByInvitationTokenHash(c context.Context, tokenHash string) (*model.Household, error)
+
+	// Update 更新家庭（包含成員與邀請）
+	Update(c context.Context, household *model.Household) error
+}
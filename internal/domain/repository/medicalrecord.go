@@ -9,11 +9,20 @@ import (
 	"github.com/blackhorseya/petlog/internal/domain/model"
 )
 
+// MedicalRecordSort lists the sortable fields of medical record lists; newest first by default.
+var MedicalRecordSort = SortSpec{Fields: []string{"date", "type", "next_due_date", "created_at"}, Field: "date", Order: SortDesc}
+
 // MedicalRecordRepository defines the interface for medical record data persistence.
 type MedicalRecordRepository interface {
 	Create(c context.Context, record *model.MedicalRecord) error
 	FindByID(c context.Context, id string) (*model.MedicalRecord, error)
 	FindByPetID(c context.Context, petID string, startDate, endDate time.Time) ([]*model.MedicalRecord, error)
+	// ListByPetID returns one page of a pet's medical records within the date range, along with the total count.
+	ListByPetID(c context.Context, petID string, startDate, endDate time.Time, page PageRequest) ([]*model.MedicalRecord, int, error)
+	// FindUpcomingDue 查詢下次到期日落在指定區間內的醫療記錄，供提醒排程器使用
+	FindUpcomingDue(c context.Context, from, to time.Time) ([]*model.MedicalRecord, error)
+	// FindDueByPetID 查詢特定寵物下次到期日落在指定區間內的醫療記錄，供時間軸產生到期事件
+	FindDueByPetID(c context.Context, petID string, from, to time.Time) ([]*model.MedicalRecord, error)
 	Update(c context.Context, record *model.MedicalRecord) error
 	Delete(c context.Context, id string) error
 }
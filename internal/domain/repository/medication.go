@@ -0,0 +1,31 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// MedicationRegimenRepository 定義用藥療程的資料存取介面
+type MedicationRegimenRepository interface {
+	Create(c context.Context, regimen *model.MedicationRegimen) error
+	FindByID(c context.Context, id string) (*model.MedicationRegimen, error)
+	FindByPetIDs(c context.Context, petIDs []string) ([]*model.MedicationRegimen, error)
+	// FindActive 查詢在指定時間點尚未結束且寵物未封存的療程，供漏服偵測使用
+	FindActive(c context.Context, now time.Time) ([]*model.MedicationRegimen, error)
+	Update(c context.Context, regimen *model.MedicationRegimen) error
+	Delete(c context.Context, id string) error
+}
+
+// DoseEventRepository 定義照護者勾選給藥紀錄的資料存取介面
+type DoseEventRepository interface {
+	// Upsert 依 (regimen_id, scheduled_at) 新增或覆寫給藥紀錄
+	Upsert(c context.Context, event *model.DoseEvent) error
+	// FindByRegimen 查詢療程在 [from, to) 期間排定的給藥紀錄，依排定時間排序
+	FindByRegimen(c context.Context, regimenID string, from, to time.Time) ([]*model.DoseEvent, error)
+	// DeleteByRegimen 刪除療程的所有給藥紀錄
+	DeleteByRegimen(c context.Context, regimenID string) error
+}
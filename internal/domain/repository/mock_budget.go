@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: budget.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_budget.go -package=repository -source=budget.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBudgetRepository is a mock of BudgetRepository interface.
+type MockBudgetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBudgetRepositoryMockRecorder is the mock recorder for MockBudgetRepository.
+type MockBudgetRepositoryMockRecorder struct {
+	mock *MockBudgetRepository
+}
+
+// NewMockBudgetRepository creates a new mock instance.
+func NewMockBudgetRepository(ctrl *gomock.Controller) *MockBudgetRepository {
+	mock := &MockBudgetRepository{ctrl: ctrl}
+	mock.recorder = &MockBudgetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetRepository) EXPECT() *MockBudgetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBudgetRepository) Create(c context.Context, budget *model.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBudgetRepositoryMockRecorder) Create(c, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBudgetRepository)(nil).Create), c, budget)
+}
+
+// Delete mocks base method.
+func (m *MockBudgetRepository) Delete(c context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBudgetRepositoryMockRecorder) Delete(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBudgetRepository)(nil).Delete), c, id)
+}
+
+// FindApplicable mocks base method.
+func (m *MockBudgetRepository) FindApplicable(c context.Context, ownerID, petID string) ([]*model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApplicable", c, ownerID, petID)
+	ret0, _ := ret[0].([]*model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApplicable indicates an expected call of FindApplicable.
+func (mr *MockBudgetRepositoryMockRecorder) FindApplicable(c, ownerID, petID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApplicable", reflect.TypeOf((*MockBudgetRepository)(nil).FindApplicable), c, ownerID, petID)
+}
+
+// FindByID mocks base method.
+func (m *MockBudgetRepository) FindByID(c context.Context, id string) (*model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, id)
+	ret0, _ := ret[0].(*model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockBudgetRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockBudgetRepository)(nil).FindByID), c, id)
+}
+
+// FindByOwnerID mocks base method.
+func (m *MockBudgetRepository) FindByOwnerID(c context.Context, ownerID string) ([]*model.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOwnerID", c, ownerID)
+	ret0, _ := ret[0].([]*model.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOwnerID indicates an expected call of FindByOwnerID.
+func (mr *MockBudgetRepositoryMockRecorder) FindByOwnerID(c, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOwnerID", reflect.TypeOf((*MockBudgetRepository)(nil).FindByOwnerID), c, ownerID)
+}
+
+// Update mocks base method.
+func (m *MockBudgetRepository) Update(c context.Context, budget *model.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBudgetRepositoryMockRecorder) Update(c, budget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBudgetRepository)(nil).Update), c, budget)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: calendar.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_calendar.go -package=repository -source=calendar.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarFeedRepository is a mock of CalendarFeedRepository interface.
+type MockCalendarFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarFeedRepositoryMockRecorder is the mock recorder for MockCalendarFeedRepository.
+type MockCalendarFeedRepositoryMockRecorder struct {
+	mock *MockCalendarFeedRepository
+}
+
+// NewMockCalendarFeedRepository creates a new mock instance.
+func NewMockCalendarFeedRepository(ctrl *gomock.Controller) *MockCalendarFeedRepository {
+	mock := &MockCalendarFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeedRepository) EXPECT() *MockCalendarFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByTokenHash mocks base method.
+func (m *MockCalendarFeedRepository) FindByTokenHash(c context.Context, tokenHash string) (*model.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTokenHash", c, tokenHash)
+	ret0, _ := ret[0].(*model.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTokenHash indicates an expected call of FindByTokenHash.
+func (mr *MockCalendarFeedRepositoryMockRecorder) FindByTokenHash(c, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTokenHash", reflect.TypeOf((*MockCalendarFeedRepository)(nil).FindByTokenHash), c, tokenHash)
+}
+
+// Upsert mocks base method.
+func (m *MockCalendarFeedRepository) Upsert(c context.Context, feed *model.CalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c, feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockCalendarFeedRepositoryMockRecorder) Upsert(c, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockCalendarFeedRepository)(nil).Upsert), c, feed)
+}
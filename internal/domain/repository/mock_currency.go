@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_currency.go -package=repository -source=currency.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByCurrency mocks base method.
+func (m *MockExchangeRateRepository) FindByCurrency(c context.Context, currency string) ([]*model.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCurrency", c, currency)
+	ret0, _ := ret[0].([]*model.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCurrency indicates an expected call of FindByCurrency.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindByCurrency(c, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCurrency", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindByCurrency), c, currency)
+}
+
+// Upsert mocks base method.
+func (m *MockExchangeRateRepository) Upsert(c context.Context, rates []*model.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockExchangeRateRepositoryMockRecorder) Upsert(c, rates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockExchangeRateRepository)(nil).Upsert), c, rates)
+}
+
+// MockUserSettingsRepository is a mock of UserSettingsRepository interface.
+type MockUserSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserSettingsRepositoryMockRecorder is the mock recorder for MockUserSettingsRepository.
+type MockUserSettingsRepositoryMockRecorder struct {
+	mock *MockUserSettingsRepository
+}
+
+// NewMockUserSettingsRepository creates a new mock instance.
+func NewMockUserSettingsRepository(ctrl *gomock.Controller) *MockUserSettingsRepository {
+	mock := &MockUserSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockUserSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSettingsRepository) EXPECT() *MockUserSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockUserSettingsRepository) FindByUserID(c context.Context, userID string) (*model.UserSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", c, userID)
+	ret0, _ := ret[0].(*model.UserSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockUserSettingsRepositoryMockRecorder) FindByUserID(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockUserSettingsRepository)(nil).FindByUserID), c, userID)
+}
+
+// Upsert mocks base method.
+func (m *MockUserSettingsRepository) Upsert(c context.Context, settings *model.UserSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockUserSettingsRepositoryMockRecorder) Upsert(c, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockUserSettingsRepository)(nil).Upsert), c, settings)
+}
@@ -41,6 +41,26 @@ func (m *MockExpenseRepository) EXPECT() *MockExpenseRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Aggregate mocks base method.
+func (m *MockExpenseRepository) Aggregate(c context.Context, groupBy model.ExpenseGroupBy, opts ...ExpenseQueryOption) ([]*model.ExpenseBucketPart, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{c, groupBy}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Aggregate", varargs...)
+	ret0, _ := ret[0].([]*model.ExpenseBucketPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Aggregate indicates an expected call of Aggregate.
+func (mr *MockExpenseRepositoryMockRecorder) Aggregate(c, groupBy any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{c, groupBy}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aggregate", reflect.TypeOf((*MockExpenseRepository)(nil).Aggregate), varargs...)
+}
+
 // Create mocks base method.
 func (m *MockExpenseRepository) Create(c context.Context, expense *model.Expense) error {
 	m.ctrl.T.Helper()
@@ -105,6 +125,21 @@ func (mr *MockExpenseRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockExpenseRepository)(nil).FindByID), c, id)
 }
 
+// FindImportKeys mocks base method.
+func (m *MockExpenseRepository) FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImportKeys", c, petID, keys)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImportKeys indicates an expected call of FindImportKeys.
+func (mr *MockExpenseRepositoryMockRecorder) FindImportKeys(c, petID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImportKeys", reflect.TypeOf((*MockExpenseRepository)(nil).FindImportKeys), c, petID, keys)
+}
+
 // Update mocks base method.
 func (m *MockExpenseRepository) Update(c context.Context, expense *model.Expense) error {
 	m.ctrl.T.Helper()
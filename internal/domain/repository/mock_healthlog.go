@@ -42,6 +42,21 @@ func (m *MockHealthLogRepository) EXPECT() *MockHealthLogRepositoryMockRecorder
 	return m.recorder
 }
 
+// AggregateDailyFoodIntake mocks base method.
+func (m *MockHealthLogRepository) AggregateDailyFoodIntake(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter) ([]*model.DailyFoodIntake, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateDailyFoodIntake", c, petID, startDate, endDate, filter)
+	ret0, _ := ret[0].([]*model.DailyFoodIntake)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateDailyFoodIntake indicates an expected call of AggregateDailyFoodIntake.
+func (mr *MockHealthLogRepositoryMockRecorder) AggregateDailyFoodIntake(c, petID, startDate, endDate, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateDailyFoodIntake", reflect.TypeOf((*MockHealthLogRepository)(nil).AggregateDailyFoodIntake), c, petID, startDate, endDate, filter)
+}
+
 // CountByPetIDs mocks base method.
 func (m *MockHealthLogRepository) CountByPetIDs(c context.Context, petIDs []string) (int, error) {
 	m.ctrl.T.Helper()
@@ -101,18 +116,49 @@ func (mr *MockHealthLogRepositoryMockRecorder) FindByID(c, id any) *gomock.Call
 }
 
 // FindByPetID mocks base method.
-func (m *MockHealthLogRepository) FindByPetID(c context.Context, petID string, startDate, endDate time.Time) ([]*model.HealthLog, error) {
+func (m *MockHealthLogRepository) FindByPetID(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter) ([]*model.HealthLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByPetID", c, petID, startDate, endDate)
+	ret := m.ctrl.Call(m, "FindByPetID", c, petID, startDate, endDate, filter)
 	ret0, _ := ret[0].([]*model.HealthLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByPetID indicates an expected call of FindByPetID.
-func (mr *MockHealthLogRepositoryMockRecorder) FindByPetID(c, petID, startDate, endDate any) *gomock.Call {
+func (mr *MockHealthLogRepositoryMockRecorder) FindByPetID(c, petID, startDate, endDate, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetID", reflect.TypeOf((*MockHealthLogRepository)(nil).FindByPetID), c, petID, startDate, endDate, filter)
+}
+
+// FindImportKeys mocks base method.
+func (m *MockHealthLogRepository) FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImportKeys", c, petID, keys)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImportKeys indicates an expected call of FindImportKeys.
+func (mr *MockHealthLogRepositoryMockRecorder) FindImportKeys(c, petID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImportKeys", reflect.TypeOf((*MockHealthLogRepository)(nil).FindImportKeys), c, petID, keys)
+}
+
+// ListByPetID mocks base method.
+func (m *MockHealthLogRepository) ListByPetID(c context.Context, petID string, startDate, endDate time.Time, filter model.HealthLogFilter, page PageRequest) ([]*model.HealthLog, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPetID", c, petID, startDate, endDate, filter, page)
+	ret0, _ := ret[0].([]*model.HealthLog)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByPetID indicates an expected call of ListByPetID.
+func (mr *MockHealthLogRepositoryMockRecorder) ListByPetID(c, petID, startDate, endDate, filter, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetID", reflect.TypeOf((*MockHealthLogRepository)(nil).FindByPetID), c, petID, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPetID", reflect.TypeOf((*MockHealthLogRepository)(nil).ListByPetID), c, petID, startDate, endDate, filter, page)
 }
 
 // Update mocks base method.
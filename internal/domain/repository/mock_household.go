@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: household.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_household.go -package=repository -source=household.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHouseholdRepository is a mock of HouseholdRepository interface.
+type MockHouseholdRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHouseholdRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHouseholdRepositoryMockRecorder is the mock recorder for MockHouseholdRepository.
+type MockHouseholdRepositoryMockRecorder struct {
+	mock *MockHouseholdRepository
+}
+
+// NewMockHouseholdRepository creates a new mock instance.
+func NewMockHouseholdRepository(ctrl *gomock.Controller) *MockHouseholdRepository {
+	mock := &MockHouseholdRepository{ctrl: ctrl}
+	mock.recorder = &MockHouseholdRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHouseholdRepository) EXPECT() *MockHouseholdRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockHouseholdRepository) Create(c context.Context, household *model.Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, household)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockHouseholdRepositoryMockRecorder) Create(c, household any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockHouseholdRepository)(nil).Create), c, household)
+}
+
+// FindByID mocks base method.
+func (m *MockHouseholdRepository) FindByID(c context.Context, id string) (*model.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, id)
+	ret0, _ := ret[0].(*model.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockHouseholdRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockHouseholdRepository)(nil).FindByID), c, id)
+}
+
+// FindByInvitationTokenHash mocks base method.
+func (m *MockHouseholdRepository) FindByInvitationTokenHash(c context.Context, tokenHash string) (*model.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByInvitationTokenHash", c, tokenHash)
+	ret0, _ := ret[0].(*model.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByInvitationTokenHash indicates an expected call of FindByInvitationTokenHash.
+func (mr *MockHouseholdRepositoryMockRecorder) FindByInvitationTokenHash(c, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByInvitationTokenHash", reflect.TypeOf((*MockHouseholdRepository)(nil).FindByInvitationTokenHash), c, tokenHash)
+}
+
+// FindByMemberUserID mocks base method.
+func (m *MockHouseholdRepository) FindByMemberUserID(c context.Context, userID string) ([]*model.Household, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMemberUserID", c, userID)
+	ret0, _ := ret[0].([]*model.Household)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMemberUserID indicates an expected call of FindByMemberUserID.
+func (mr *MockHouseholdRepositoryMockRecorder) FindByMemberUserID(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMemberUserID", reflect.TypeOf((*MockHouseholdRepository)(nil).FindByMemberUserID), c, userID)
+}
+
+// Update mocks base method.
+func (m *MockHouseholdRepository) Update(c context.Context, household *model.Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, household)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockHouseholdRepositoryMockRecorder) Update(c, household any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockHouseholdRepository)(nil).Update), c, household)
+}
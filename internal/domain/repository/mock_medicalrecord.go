@@ -100,6 +100,52 @@ func (mr *MockMedicalRecordRepositoryMockRecorder) FindByPetID(c, petID, startDa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetID", reflect.TypeOf((*MockMedicalRecordRepository)(nil).FindByPetID), c, petID, startDate, endDate)
 }
 
+// FindDueByPetID mocks base method.
+func (m *MockMedicalRecordRepository) FindDueByPetID(c context.Context, petID string, from, to time.Time) ([]*model.MedicalRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueByPetID", c, petID, from, to)
+	ret0, _ := ret[0].([]*model.MedicalRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueByPetID indicates an expected call of FindDueByPetID.
+func (mr *MockMedicalRecordRepositoryMockRecorder) FindDueByPetID(c, petID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueByPetID", reflect.TypeOf((*MockMedicalRecordRepository)(nil).FindDueByPetID), c, petID, from, to)
+}
+
+// FindUpcomingDue mocks base method.
+func (m *MockMedicalRecordRepository) FindUpcomingDue(c context.Context, from, to time.Time) ([]*model.MedicalRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUpcomingDue", c, from, to)
+	ret0, _ := ret[0].([]*model.MedicalRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUpcomingDue indicates an expected call of FindUpcomingDue.
+func (mr *MockMedicalRecordRepositoryMockRecorder) FindUpcomingDue(c, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUpcomingDue", reflect.TypeOf((*MockMedicalRecordRepository)(nil).FindUpcomingDue), c, from, to)
+}
+
+// ListByPetID mocks base method.
+func (m *MockMedicalRecordRepository) ListByPetID(c context.Context, petID string, startDate, endDate time.Time, page PageRequest) ([]*model.MedicalRecord, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPetID", c, petID, startDate, endDate, page)
+	ret0, _ := ret[0].([]*model.MedicalRecord)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByPetID indicates an expected call of ListByPetID.
+func (mr *MockMedicalRecordRepositoryMockRecorder) ListByPetID(c, petID, startDate, endDate, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPetID", reflect.TypeOf((*MockMedicalRecordRepository)(nil).ListByPetID), c, petID, startDate, endDate, page)
+}
+
 // Update mocks base method.
 func (m *MockMedicalRecordRepository) Update(c context.Context, record *model.MedicalRecord) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: medication.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_medication.go -package=repository -source=medication.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMedicationRegimenRepository is a mock of MedicationRegimenRepository interface.
+type MockMedicationRegimenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMedicationRegimenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMedicationRegimenRepositoryMockRecorder is the mock recorder for MockMedicationRegimenRepository.
+type MockMedicationRegimenRepositoryMockRecorder struct {
+	mock *MockMedicationRegimenRepository
+}
+
+// NewMockMedicationRegimenRepository creates a new mock instance.
+func NewMockMedicationRegimenRepository(ctrl *gomock.Controller) *MockMedicationRegimenRepository {
+	mock := &MockMedicationRegimenRepository{ctrl: ctrl}
+	mock.recorder = &MockMedicationRegimenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMedicationRegimenRepository) EXPECT() *MockMedicationRegimenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMedicationRegimenRepository) Create(c context.Context, regimen *model.MedicationRegimen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, regimen)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) Create(c, regimen any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).Create), c, regimen)
+}
+
+// Delete mocks base method.
+func (m *MockMedicationRegimenRepository) Delete(c context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) Delete(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).Delete), c, id)
+}
+
+// FindActive mocks base method.
+func (m *MockMedicationRegimenRepository) FindActive(c context.Context, now time.Time) ([]*model.MedicationRegimen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", c, now)
+	ret0, _ := ret[0].([]*model.MedicationRegimen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) FindActive(c, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).FindActive), c, now)
+}
+
+// FindByID mocks base method.
+func (m *MockMedicationRegimenRepository) FindByID(c context.Context, id string) (*model.MedicationRegimen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, id)
+	ret0, _ := ret[0].(*model.MedicationRegimen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).FindByID), c, id)
+}
+
+// FindByPetIDs mocks base method.
+func (m *MockMedicationRegimenRepository) FindByPetIDs(c context.Context, petIDs []string) ([]*model.MedicationRegimen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPetIDs", c, petIDs)
+	ret0, _ := ret[0].([]*model.MedicationRegimen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPetIDs indicates an expected call of FindByPetIDs.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) FindByPetIDs(c, petIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetIDs", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).FindByPetIDs), c, petIDs)
+}
+
+// Update mocks base method.
+func (m *MockMedicationRegimenRepository) Update(c context.Context, regimen *model.MedicationRegimen) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, regimen)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMedicationRegimenRepositoryMockRecorder) Update(c, regimen any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMedicationRegimenRepository)(nil).Update), c, regimen)
+}
+
+// MockDoseEventRepository is a mock of DoseEventRepository interface.
+type MockDoseEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDoseEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDoseEventRepositoryMockRecorder is the mock recorder for MockDoseEventRepository.
+type MockDoseEventRepositoryMockRecorder struct {
+	mock *MockDoseEventRepository
+}
+
+// NewMockDoseEventRepository creates a new mock instance.
+func NewMockDoseEventRepository(ctrl *gomock.Controller) *MockDoseEventRepository {
+	mock := &MockDoseEventRepository{ctrl: ctrl}
+	mock.recorder = &MockDoseEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDoseEventRepository) EXPECT() *MockDoseEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByRegimen mocks base method.
+func (m *MockDoseEventRepository) DeleteByRegimen(c context.Context, regimenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByRegimen", c, regimenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByRegimen indicates an expected call of DeleteByRegimen.
+func (mr *MockDoseEventRepositoryMockRecorder) DeleteByRegimen(c, regimenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByRegimen", reflect.TypeOf((*MockDoseEventRepository)(nil).DeleteByRegimen), c, regimenID)
+}
+
+// FindByRegimen mocks base method.
+func (m *MockDoseEventRepository) FindByRegimen(c context.Context, regimenID string, from, to time.Time) ([]*model.DoseEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRegimen", c, regimenID, from, to)
+	ret0, _ := ret[0].([]*model.DoseEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRegimen indicates an expected call of FindByRegimen.
+func (mr *MockDoseEventRepositoryMockRecorder) FindByRegimen(c, regimenID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRegimen", reflect.TypeOf((*MockDoseEventRepository)(nil).FindByRegimen), c, regimenID, from, to)
+}
+
+// Upsert mocks base method.
+func (m *MockDoseEventRepository) Upsert(c context.Context, event *model.DoseEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockDoseEventRepositoryMockRecorder) Upsert(c, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockDoseEventRepository)(nil).Upsert), c, event)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_notification.go -package=repository -source=notification.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceRepository is a mock of NotificationPreferenceRepository interface.
+type MockNotificationPreferenceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceRepositoryMockRecorder is the mock recorder for MockNotificationPreferenceRepository.
+type MockNotificationPreferenceRepositoryMockRecorder struct {
+	mock *MockNotificationPreferenceRepository
+}
+
+// NewMockNotificationPreferenceRepository creates a new mock instance.
+func NewMockNotificationPreferenceRepository(ctrl *gomock.Controller) *MockNotificationPreferenceRepository {
+	mock := &MockNotificationPreferenceRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceRepository) EXPECT() *MockNotificationPreferenceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByUserID mocks base method.
+func (m *MockNotificationPreferenceRepository) FindByUserID(c context.Context, userID string) (*model.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", c, userID)
+	ret0, _ := ret[0].(*model.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) FindByUserID(c, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).FindByUserID), c, userID)
+}
+
+// Upsert mocks base method.
+func (m *MockNotificationPreferenceRepository) Upsert(c context.Context, pref *model.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", c, pref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockNotificationPreferenceRepositoryMockRecorder) Upsert(c, pref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockNotificationPreferenceRepository)(nil).Upsert), c, pref)
+}
+
+// MockNotificationDeliveryRepository is a mock of NotificationDeliveryRepository interface.
+type MockNotificationDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationDeliveryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationDeliveryRepositoryMockRecorder is the mock recorder for MockNotificationDeliveryRepository.
+type MockNotificationDeliveryRepositoryMockRecorder struct {
+	mock *MockNotificationDeliveryRepository
+}
+
+// NewMockNotificationDeliveryRepository creates a new mock instance.
+func NewMockNotificationDeliveryRepository(ctrl *gomock.Controller) *MockNotificationDeliveryRepository {
+	mock := &MockNotificationDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationDeliveryRepository) EXPECT() *MockNotificationDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationDeliveryRepository) Create(c context.Context, delivery *model.NotificationDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationDeliveryRepositoryMockRecorder) Create(c, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationDeliveryRepository)(nil).Create), c, delivery)
+}
+
+// FindRetryable mocks base method.
+func (m *MockNotificationDeliveryRepository) FindRetryable(c context.Context, now time.Time) ([]*model.NotificationDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRetryable", c, now)
+	ret0, _ := ret[0].([]*model.NotificationDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRetryable indicates an expected call of FindRetryable.
+func (mr *MockNotificationDeliveryRepositoryMockRecorder) FindRetryable(c, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRetryable", reflect.TypeOf((*MockNotificationDeliveryRepository)(nil).FindRetryable), c, now)
+}
+
+// Update mocks base method.
+func (m *MockNotificationDeliveryRepository) Update(c context.Context, delivery *model.NotificationDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationDeliveryRepositoryMockRecorder) Update(c, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationDeliveryRepository)(nil).Update), c, delivery)
+}
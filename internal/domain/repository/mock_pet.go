@@ -69,6 +69,21 @@ func (mr *MockPetRepositoryMockRecorder) Delete(c, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPetRepository)(nil).Delete), c, id)
 }
 
+// FindByHouseholdIDs mocks base method.
+func (m *MockPetRepository) FindByHouseholdIDs(c context.Context, householdIDs []string) ([]*model.Pet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHouseholdIDs", c, householdIDs)
+	ret0, _ := ret[0].([]*model.Pet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHouseholdIDs indicates an expected call of FindByHouseholdIDs.
+func (mr *MockPetRepositoryMockRecorder) FindByHouseholdIDs(c, householdIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHouseholdIDs", reflect.TypeOf((*MockPetRepository)(nil).FindByHouseholdIDs), c, householdIDs)
+}
+
 // FindByID mocks base method.
 func (m *MockPetRepository) FindByID(c context.Context, id string) (*model.Pet, error) {
 	m.ctrl.T.Helper()
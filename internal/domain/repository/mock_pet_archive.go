@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pet_archive.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_pet_archive.go -package=repository -source=pet_archive.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPetArchiveRepository is a mock of PetArchiveRepository interface.
+type MockPetArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPetArchiveRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPetArchiveRepositoryMockRecorder is the mock recorder for MockPetArchiveRepository.
+type MockPetArchiveRepositoryMockRecorder struct {
+	mock *MockPetArchiveRepository
+}
+
+// NewMockPetArchiveRepository creates a new mock instance.
+func NewMockPetArchiveRepository(ctrl *gomock.Controller) *MockPetArchiveRepository {
+	mock := &MockPetArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockPetArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPetArchiveRepository) EXPECT() *MockPetArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Archive mocks base method.
+func (m *MockPetArchiveRepository) Archive(c context.Context, petID string, archivedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", c, petID, archivedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockPetArchiveRepositoryMockRecorder) Archive(c, petID, archivedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockPetArchiveRepository)(nil).Archive), c, petID, archivedAt)
+}
+
+// FindArchivedBefore mocks base method.
+func (m *MockPetArchiveRepository) FindArchivedBefore(c context.Context, cutoff time.Time) ([]*model.Pet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindArchivedBefore", c, cutoff)
+	ret0, _ := ret[0].([]*model.Pet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindArchivedBefore indicates an expected call of FindArchivedBefore.
+func (mr *MockPetArchiveRepositoryMockRecorder) FindArchivedBefore(c, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArchivedBefore", reflect.TypeOf((*MockPetArchiveRepository)(nil).FindArchivedBefore), c, cutoff)
+}
+
+// FindArchivedByID mocks base method.
+func (m *MockPetArchiveRepository) FindArchivedByID(c context.Context, petID string) (*model.Pet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindArchivedByID", c, petID)
+	ret0, _ := ret[0].(*model.Pet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindArchivedByID indicates an expected call of FindArchivedByID.
+func (mr *MockPetArchiveRepositoryMockRecorder) FindArchivedByID(c, petID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArchivedByID", reflect.TypeOf((*MockPetArchiveRepository)(nil).FindArchivedByID), c, petID)
+}
+
+// FindArchivedByScope mocks base method.
+func (m *MockPetArchiveRepository) FindArchivedByScope(c context.Context, ownerID string, householdIDs []string) ([]*model.Pet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindArchivedByScope", c, ownerID, householdIDs)
+	ret0, _ := ret[0].([]*model.Pet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindArchivedByScope indicates an expected call of FindArchivedByScope.
+func (mr *MockPetArchiveRepositoryMockRecorder) FindArchivedByScope(c, ownerID, householdIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindArchivedByScope", reflect.TypeOf((*MockPetArchiveRepository)(nil).FindArchivedByScope), c, ownerID, householdIDs)
+}
+
+// Purge mocks base method.
+func (m *MockPetArchiveRepository) Purge(c context.Context, petID string) ([]*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", c, petID)
+	ret0, _ := ret[0].([]*model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockPetArchiveRepositoryMockRecorder) Purge(c, petID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockPetArchiveRepository)(nil).Purge), c, petID)
+}
+
+// Restore mocks base method.
+func (m *MockPetArchiveRepository) Restore(c context.Context, pet *model.Pet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", c, pet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockPetArchiveRepositoryMockRecorder) Restore(c, pet any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockPetArchiveRepository)(nil).Restore), c, pet)
+}
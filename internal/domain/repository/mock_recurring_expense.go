@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recurring_expense.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_recurring_expense.go -package=repository -source=recurring_expense.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecurringExpenseRepository is a mock of RecurringExpenseRepository interface.
+type MockRecurringExpenseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecurringExpenseRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRecurringExpenseRepositoryMockRecorder is the mock recorder for MockRecurringExpenseRepository.
+type MockRecurringExpenseRepositoryMockRecorder struct {
+	mock *MockRecurringExpenseRepository
+}
+
+// NewMockRecurringExpenseRepository creates a new mock instance.
+func NewMockRecurringExpenseRepository(ctrl *gomock.Controller) *MockRecurringExpenseRepository {
+	mock := &MockRecurringExpenseRepository{ctrl: ctrl}
+	mock.recorder = &MockRecurringExpenseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecurringExpenseRepository) EXPECT() *MockRecurringExpenseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecurringExpenseRepository) Create(c context.Context, recurring *model.RecurringExpense) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) Create(c, recurring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).Create), c, recurring)
+}
+
+// Delete mocks base method.
+func (m *MockRecurringExpenseRepository) Delete(c context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) Delete(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).Delete), c, id)
+}
+
+// FindActive mocks base method.
+func (m *MockRecurringExpenseRepository) FindActive(c context.Context) ([]*model.RecurringExpense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", c)
+	ret0, _ := ret[0].([]*model.RecurringExpense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) FindActive(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).FindActive), c)
+}
+
+// FindByID mocks base method.
+func (m *MockRecurringExpenseRepository) FindByID(c context.Context, id string) (*model.RecurringExpense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, id)
+	ret0, _ := ret[0].(*model.RecurringExpense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).FindByID), c, id)
+}
+
+// FindByPetIDs mocks base method.
+func (m *MockRecurringExpenseRepository) FindByPetIDs(c context.Context, petIDs []string) ([]*model.RecurringExpense, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPetIDs", c, petIDs)
+	ret0, _ := ret[0].([]*model.RecurringExpense)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPetIDs indicates an expected call of FindByPetIDs.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) FindByPetIDs(c, petIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetIDs", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).FindByPetIDs), c, petIDs)
+}
+
+// Update mocks base method.
+func (m *MockRecurringExpenseRepository) Update(c context.Context, recurring *model.RecurringExpense) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, recurring)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRecurringExpenseRepositoryMockRecorder) Update(c, recurring any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRecurringExpenseRepository)(nil).Update), c, recurring)
+}
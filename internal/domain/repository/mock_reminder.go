@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reminder.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_reminder.go -package=repository -source=reminder.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReminderRepository is a mock of ReminderRepository interface.
+type MockReminderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderRepositoryMockRecorder is the mock recorder for MockReminderRepository.
+type MockReminderRepositoryMockRecorder struct {
+	mock *MockReminderRepository
+}
+
+// NewMockReminderRepository creates a new mock instance.
+func NewMockReminderRepository(ctrl *gomock.Controller) *MockReminderRepository {
+	mock := &MockReminderRepository{ctrl: ctrl}
+	mock.recorder = &MockReminderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepository) EXPECT() *MockReminderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockReminderRepository) Create(c context.Context, reminder *model.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReminderRepositoryMockRecorder) Create(c, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReminderRepository)(nil).Create), c, reminder)
+}
+
+// Delete mocks base method.
+func (m *MockReminderRepository) Delete(c context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReminderRepositoryMockRecorder) Delete(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReminderRepository)(nil).Delete), c, id)
+}
+
+// FindByID mocks base method.
+func (m *MockReminderRepository) FindByID(c context.Context, id string) (*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, id)
+	ret0, _ := ret[0].(*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockReminderRepositoryMockRecorder) FindByID(c, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReminderRepository)(nil).FindByID), c, id)
+}
+
+// FindByMedicalRecord mocks base method.
+func (m *MockReminderRepository) FindByMedicalRecord(c context.Context, medicalRecordID string, dueDate time.Time) (*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMedicalRecord", c, medicalRecordID, dueDate)
+	ret0, _ := ret[0].(*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMedicalRecord indicates an expected call of FindByMedicalRecord.
+func (mr *MockReminderRepositoryMockRecorder) FindByMedicalRecord(c, medicalRecordID, dueDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMedicalRecord", reflect.TypeOf((*MockReminderRepository)(nil).FindByMedicalRecord), c, medicalRecordID, dueDate)
+}
+
+// FindByPetIDs mocks base method.
+func (m *MockReminderRepository) FindByPetIDs(c context.Context, petIDs []string, statuses ...model.ReminderStatus) ([]*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{c, petIDs}
+	for _, a := range statuses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByPetIDs", varargs...)
+	ret0, _ := ret[0].([]*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPetIDs indicates an expected call of FindByPetIDs.
+func (mr *MockReminderRepositoryMockRecorder) FindByPetIDs(c, petIDs any, statuses ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{c, petIDs}, statuses...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetIDs", reflect.TypeOf((*MockReminderRepository)(nil).FindByPetIDs), varargs...)
+}
+
+// FindDue mocks base method.
+func (m *MockReminderRepository) FindDue(c context.Context, now time.Time) ([]*model.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", c, now)
+	ret0, _ := ret[0].([]*model.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockReminderRepositoryMockRecorder) FindDue(c, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockReminderRepository)(nil).FindDue), c, now)
+}
+
+// Update mocks base method.
+func (m *MockReminderRepository) Update(c context.Context, reminder *model.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockReminderRepositoryMockRecorder) Update(c, reminder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReminderRepository)(nil).Update), c, reminder)
+}
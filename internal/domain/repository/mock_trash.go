@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_trash.go -package=repository -source=trash.go
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrashRepository is a mock of TrashRepository interface.
+type MockTrashRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTrashRepositoryMockRecorder is the mock recorder for MockTrashRepository.
+type MockTrashRepositoryMockRecorder struct {
+	mock *MockTrashRepository
+}
+
+// NewMockTrashRepository creates a new mock instance.
+func NewMockTrashRepository(ctrl *gomock.Controller) *MockTrashRepository {
+	mock := &MockTrashRepository{ctrl: ctrl}
+	mock.recorder = &MockTrashRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrashRepository) EXPECT() *MockTrashRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockTrashRepository) FindByID(c context.Context, itemType model.TrashItemType, id string) (*model.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", c, itemType, id)
+	ret0, _ := ret[0].(*model.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTrashRepositoryMockRecorder) FindByID(c, itemType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTrashRepository)(nil).FindByID), c, itemType, id)
+}
+
+// FindByPetIDs mocks base method.
+func (m *MockTrashRepository) FindByPetIDs(c context.Context, petIDs []string, itemType model.TrashItemType) ([]*model.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPetIDs", c, petIDs, itemType)
+	ret0, _ := ret[0].([]*model.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPetIDs indicates an expected call of FindByPetIDs.
+func (mr *MockTrashRepositoryMockRecorder) FindByPetIDs(c, petIDs, itemType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPetIDs", reflect.TypeOf((*MockTrashRepository)(nil).FindByPetIDs), c, petIDs, itemType)
+}
+
+// Purge mocks base method.
+func (m *MockTrashRepository) Purge(c context.Context, itemType model.TrashItemType, id string) ([]*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", c, itemType, id)
+	ret0, _ := ret[0].([]*model.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashRepositoryMockRecorder) Purge(c, itemType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrashRepository)(nil).Purge), c, itemType, id)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockTrashRepository) PurgeDeletedBefore(c context.Context, cutoff time.Time) (int, []*model.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", c, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]*model.Attachment)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockTrashRepositoryMockRecorder) PurgeDeletedBefore(c, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockTrashRepository)(nil).PurgeDeletedBefore), c, cutoff)
+}
+
+// Restore mocks base method.
+func (m *MockTrashRepository) Restore(c context.Context, itemType model.TrashItemType, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", c, itemType, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTrashRepositoryMockRecorder) Restore(c, itemType, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTrashRepository)(nil).Restore), c, itemType, id)
+}
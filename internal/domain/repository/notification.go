@@ -0,0 +1,31 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// NotificationPreferenceRepository 定義通知偏好設定持久化的介面
+type NotificationPreferenceRepository interface {
+	// FindByUserID 根據使用者 ID 查詢通知偏好設定
+	FindByUserID(c context.Context, userID string) (*model.NotificationPreference, error)
+
+	// Upsert 建立或更新使用者的通知偏好設定
+	Upsert(c context.Context, pref *model.NotificationPreference) error
+}
+
+// NotificationDeliveryRepository 定義通知投遞紀錄持久化的介面
+type NotificationDeliveryRepository interface {
+	// Create 建立新的投遞紀錄
+	Create(c context.Context, delivery *model.NotificationDelivery) error
+
+	// Update 更新投遞紀錄
+	Update(c context.Context, delivery *model.NotificationDelivery) error
+
+	// FindRetryable 查詢在指定時間點前應重試的失敗投遞
+	FindRetryable(c context.Context, now time.Time) ([]*model.NotificationDelivery, error)
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+const (
+	// DefaultPageLimit 帶分頁標記但未指定筆數時每頁回傳的筆數
+	DefaultPageLimit = 50
+	// MaxPageLimit 每頁筆數上限
+	MaxPageLimit = 200
+)
+
+var (
+	ErrInvalidPageLimit  = fmt.Errorf("每頁筆數必須介於 1 到 %d", MaxPageLimit)
+	ErrInvalidPageOffset = errors.New("位移不可為負數")
+	ErrInvalidSort       = errors.New("不支援的排序欄位或方向")
+	ErrInvalidPageToken  = errors.New("無效的分頁標記")
+)
+
+// SortOrder 排序方向
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// IsValid 檢查排序方向是否為已定義的值
+func (o SortOrder) IsValid() bool {
+	return o == SortAsc || o == SortDesc
+}
+
+// SortSpec 列表允許的排序欄位與未指定時的預設排序
+type SortSpec struct {
+	Fields []string
+	Field  string
+	Order  SortOrder
+}
+
+// PageRequest 列表查詢的分頁與排序條件
+// - Limit: 每頁筆數，0 且未帶分頁標記時回傳全部，讓未分頁的用戶端維持原本的行為；帶分頁標記時預設 DefaultPageLimit
+// - Offset: 略過的筆數
+// - Cursor: 上一頁回傳的分頁標記（對應 cursor 查詢參數），不可與 Offset 同時使用
+//
+// 分頁標記只是編碼後的位移與排序，並非依排序鍵定位的游標：翻頁期間有資料新增或刪除時，
+// 下一頁可能重複或遺漏資料
+// - Sort/Order: 排序欄位與方向，空值時使用列表的預設排序
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+	Sort   string
+	Order  SortOrder
+}
+
+// pageToken 分頁標記的內容，以 base64 編碼後交給用戶端
+type pageToken struct {
+	Offset int       `json:"o"`
+	Sort   string    `json:"s"`
+	Order  SortOrder `json:"d"`
+}
+
+// Resolve 驗證分頁條件並補上預設值，帶有分頁標記時以其還原位移與排序
+func (p PageRequest) Resolve(spec SortSpec) (PageRequest, error) {
+	if p.Limit == 0 && p.Cursor != "" {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxPageLimit {
+		return p, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrInvalidPageLimit)
+	}
+	if p.Offset < 0 {
+		return p, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrInvalidPageOffset)
+	}
+
+	if p.Cursor != "" {
+		token, err := decodePageToken(p.Cursor)
+		if err != nil || p.Offset != 0 ||
+			(p.Sort != "" && p.Sort != token.Sort) || (p.Order != "" && p.Order != token.Order) {
+			return p, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrInvalidPageToken)
+		}
+		p.Offset, p.Sort, p.Order = token.Offset, token.Sort, token.Order
+	}
+
+	if p.Sort == "" {
+		p.Sort = spec.Field
+		if p.Order == "" {
+			p.Order = spec.Order
+		}
+	}
+	if p.Order == "" {
+		p.Order = SortAsc
+	}
+	if !slices.Contains(spec.Fields, p.Sort) || !p.Order.IsValid() {
+		return p, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrInvalidSort)
+	}
+	return p, nil
+}
+
+// NextCursor 回傳下一頁的分頁標記，已是最後一頁時回傳空字串
+func (p PageRequest) NextCursor(total int) string {
+	next := p.Offset + p.Limit
+	if p.Limit <= 0 || next >= total {
+		return ""
+	}
+	data, _ := json.Marshal(pageToken{Offset: next, Sort: p.Sort, Order: p.Order})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(encoded string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return token, err
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, err
+	}
+	if token.Offset < 0 || token.Sort == "" || !token.Order.IsValid() {
+		return token, ErrInvalidPageToken
+	}
+	return token, nil
+}
+
+// Page 分頁查詢結果，Total 為符合條件的總筆數，NextCursor 為下一頁的分頁標記
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	NextCursor string
+}
+
+// NewPage 以單頁資料與總筆數建立分頁結果
+func NewPage[T any](items []T, total int, p PageRequest) *Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return &Page[T]{Items: items, Total: total, NextCursor: p.NextCursor(total)}
+}
+
+// Paginate 在記憶體中排序並切出一頁，供資料量小、未在資料庫分頁的列表使用
+// compare 依排序欄位比較兩筆資料，p 須先經過 Resolve
+func Paginate[T any](items []T, p PageRequest, compare map[string]func(a, b T) int) *Page[T] {
+	sorted := slices.Clone(items)
+	if cmp, ok := compare[p.Sort]; ok {
+		slices.SortStableFunc(sorted, func(a, b T) int {
+			if p.Order == SortDesc {
+				return cmp(b, a)
+			}
+			return cmp(a, b)
+		})
+	}
+
+	total := len(sorted)
+	start := min(p.Offset, total)
+	end := total
+	if p.Limit > 0 {
+		end = min(start+p.Limit, total)
+	}
+	return NewPage(sorted[start:end], total, p)
+}
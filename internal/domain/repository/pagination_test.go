@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+var testSort = SortSpec{Fields: []string{"value", "name"}, Field: "value", Order: SortDesc}
+
+func TestPageRequest_Resolve(t *testing.T) {
+	t.Run("未指定時套用預設值", func(t *testing.T) {
+		p, err := PageRequest{}.Resolve(testSort)
+		if err != nil {
+			t.Fatalf("不應有錯誤，實際為 %v", err)
+		}
+		if p.Limit != 0 || p.Offset != 0 || p.Sort != "value" || p.Order != SortDesc {
+			t.Errorf("預設值不正確: %+v", p)
+		}
+		if p.NextCursor(500) != "" {
+			t.Error("未指定筆數時回傳全部，不應有下一頁分頁標記")
+		}
+	})
+
+	t.Run("只指定排序欄位時預設遞增", func(t *testing.T) {
+		p, err := PageRequest{Sort: "name"}.Resolve(testSort)
+		if err != nil || p.Order != SortAsc {
+			t.Errorf("預期 asc，實際為 %+v, %v", p, err)
+		}
+	})
+
+	cases := map[string]struct {
+		page PageRequest
+		want error
+	}{
+		"筆數超過上限":    {PageRequest{Limit: MaxPageLimit + 1}, ErrInvalidPageLimit},
+		"筆數為負數":     {PageRequest{Limit: -1}, ErrInvalidPageLimit},
+		"位移為負數":     {PageRequest{Offset: -1}, ErrInvalidPageOffset},
+		"不支援的排序欄位":  {PageRequest{Sort: "secret"}, ErrInvalidSort},
+		"不支援的排序方向":  {PageRequest{Order: "sideways"}, ErrInvalidSort},
+		"無法解碼的分頁標記": {PageRequest{Cursor: "not a cursor"}, ErrInvalidPageToken},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.page.Resolve(testSort)
+			if !errors.Is(err, tc.want) || !domain.IsInvalidParameter(err) {
+				t.Errorf("預期 %v，實際為 %v", tc.want, err)
+			}
+		})
+	}
+
+	t.Run("分頁標記還原位移與排序", func(t *testing.T) {
+		first, _ := PageRequest{Limit: 10, Sort: "name", Order: SortAsc}.Resolve(testSort)
+		cursor := first.NextCursor(25)
+		if cursor == "" {
+			t.Fatal("預期有下一頁分頁標記")
+		}
+
+		next, err := PageRequest{Limit: 10, Cursor: cursor}.Resolve(testSort)
+		if err != nil {
+			t.Fatalf("不應有錯誤，實際為 %v", err)
+		}
+		if next.Offset != 10 || next.Sort != "name" || next.Order != SortAsc {
+			t.Errorf("分頁標記內容不正確: %+v", next)
+		}
+		if next.NextCursor(20) != "" {
+			t.Error("最後一頁不應有分頁標記")
+		}
+
+		defaulted, err := PageRequest{Cursor: cursor}.Resolve(testSort)
+		if err != nil || defaulted.Limit != DefaultPageLimit {
+			t.Errorf("帶分頁標記未指定筆數時應使用預設筆數: %+v, %v", defaulted, err)
+		}
+	})
+
+	t.Run("分頁標記不可與位移或不同排序同時使用", func(t *testing.T) {
+		first, _ := PageRequest{Limit: 10}.Resolve(testSort)
+		cursor := first.NextCursor(25)
+
+		for _, p := range []PageRequest{
+			{Cursor: cursor, Offset: 5},
+			{Cursor: cursor, Sort: "name"},
+			{Cursor: cursor, Order: SortAsc},
+		} {
+			if _, err := p.Resolve(testSort); !errors.Is(err, ErrInvalidPageToken) {
+				t.Errorf("%+v: 預期 ErrInvalidPageToken，實際為 %v", p, err)
+			}
+		}
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	type item struct {
+		name  string
+		value int
+	}
+	items := []*item{{"c", 2}, {"a", 3}, {"b", 1}, {"d", 2}}
+	compare := map[string]func(a, b *item) int{
+		"value": func(a, b *item) int { return cmp.Compare(a.value, b.value) },
+		"name":  func(a, b *item) int { return cmp.Compare(a.name, b.name) },
+	}
+	names := func(page *Page[*item]) []string {
+		var out []string
+		for _, it := range page.Items {
+			out = append(out, it.name)
+		}
+		return out
+	}
+
+	t.Run("依預設排序切出第一頁", func(t *testing.T) {
+		p, _ := PageRequest{Limit: 3}.Resolve(testSort)
+		page := Paginate(items, p, compare)
+		if got := names(page); !slices.Equal(got, []string{"a", "c", "d"}) {
+			t.Errorf("預期 [a c d]，實際為 %v", got)
+		}
+		if page.Total != 4 || page.NextCursor == "" {
+			t.Errorf("預期總數 4 且有下一頁，實際為 %d, %q", page.Total, page.NextCursor)
+		}
+		if items[0].name != "c" {
+			t.Error("不應修改原始切片的順序")
+		}
+	})
+
+	t.Run("以分頁標記取得最後一頁", func(t *testing.T) {
+		p, _ := PageRequest{Limit: 3}.Resolve(testSort)
+		next, _ := PageRequest{Limit: 3, Cursor: Paginate(items, p, compare).NextCursor}.Resolve(testSort)
+		page := Paginate(items, next, compare)
+		if got := names(page); !slices.Equal(got, []string{"b"}) || page.NextCursor != "" {
+			t.Errorf("預期 [b] 且沒有下一頁，實際為 %v, %q", got, page.NextCursor)
+		}
+	})
+
+	t.Run("位移超過總數回傳空頁", func(t *testing.T) {
+		p, _ := PageRequest{Offset: 10, Sort: "name"}.Resolve(testSort)
+		page := Paginate(items, p, compare)
+		if page.Items == nil || len(page.Items) != 0 || page.Total != 4 {
+			t.Errorf("預期空頁與總數 4，實際為 %+v", page)
+		}
+	})
+}
@@ -14,6 +14,7 @@ type PetRepository interface {
 	FindByID(c context.Context, id string) (*model.Pet, error)
 	FindByOwnerID(c context.Context, ownerID string) ([]*model.Pet, error)
 	FindIDsByOwnerID(c context.Context, ownerID string) ([]string, error)
+	FindByHouseholdIDs(c context.Context, householdIDs []string) ([]*model.Pet, error)
 	Update(c context.Context, pet *model.Pet) error
 	Delete(c context.Context, id string) error
 }
@@ -0,0 +1,31 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// PetArchiveRepository 定義寵物連同其健康日誌、醫療記錄、費用、提醒、用藥、週期性費用、附件與限定該寵物的預算的封存、還原與永久清除
+type PetArchiveRepository interface {
+	// Archive 封存寵物及其所有關聯紀錄，封存後一般查詢不再回傳這些資料
+	Archive(c context.Context, petID string, archivedAt time.Time) error
+
+	// FindArchivedByID 查詢已封存的寵物
+	FindArchivedByID(c context.Context, petID string) (*model.Pet, error)
+
+	// FindArchivedByScope 查詢使用者建立或屬於指定家庭的已封存寵物，用於垃圾桶列表
+	FindArchivedByScope(c context.Context, ownerID string, householdIDs []string) ([]*model.Pet, error)
+
+	// FindArchivedBefore 查詢在指定時間點前封存的寵物，用於清除逾期的封存資料
+	FindArchivedBefore(c context.Context, cutoff time.Time) ([]*model.Pet, error)
+
+	// Restore 還原寵物及與其一同封存的關聯紀錄
+	Restore(c context.Context, pet *model.Pet) error
+
+	// Purge 在交易中永久刪除寵物及所有關聯紀錄，回傳被刪除的附件供呼叫端在提交後刪除檔案
+	Purge(c context.Context, petID string) ([]*model.Attachment, error)
+}
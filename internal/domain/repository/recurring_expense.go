@@ -0,0 +1,20 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// RecurringExpenseRepository 定義週期性費用的資料存取介面
+type RecurringExpenseRepository interface {
+	Create(c context.Context, recurring *model.RecurringExpense) error
+	FindByID(c context.Context, id string) (*model.RecurringExpense, error)
+	FindByPetIDs(c context.Context, petIDs []string) ([]*model.RecurringExpense, error)
+	// FindActive 查詢未暫停且寵物未封存的週期性費用，供產生器使用
+	FindActive(c context.Context) ([]*model.RecurringExpense, error)
+	Update(c context.Context, recurring *model.RecurringExpense) error
+	Delete(c context.Context, id string) error
+}
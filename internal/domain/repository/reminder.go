@@ -0,0 +1,34 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// ReminderRepository 定義提醒資料持久化的介面
+type ReminderRepository interface {
+	// Create 建立新的提醒
+	Create(c context.Context, reminder *model.Reminder) error
+
+	// FindByID 根據 ID 查詢提醒
+	FindByID(c context.Context, id string) (*model.Reminder, error)
+
+	// FindByPetIDs 查詢指定寵物群組的提醒，可依狀態篩選（未指定則回傳全部）
+	FindByPetIDs(c context.Context, petIDs []string, statuses ...model.ReminderStatus) ([]*model.Reminder, error)
+
+	// FindByMedicalRecord 查詢由指定醫療記錄與到期日產生的提醒，用於避免重複建立
+	FindByMedicalRecord(c context.Context, medicalRecordID string, dueDate time.Time) (*model.Reminder, error)
+
+	// FindDue 查詢在指定時間點前應發送的提醒（待發送或延後到期）
+	FindDue(c context.Context, now time.Time) ([]*model.Reminder, error)
+
+	// Update 更新提醒
+	Update(c context.Context, reminder *model.Reminder) error
+
+	// Delete 刪除提醒
+	Delete(c context.Context, id string) error
+}
@@ -0,0 +1,29 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// TrashRepository 定義垃圾桶中健康日誌、醫療記錄與費用的查詢、還原與永久清除
+// 寵物本身連同其紀錄的還原與清除由 PetArchiveRepository 負責
+type TrashRepository interface {
+	// FindByPetIDs 列出指定寵物群組已刪除的紀錄，itemType 為空時列出所有種類
+	FindByPetIDs(c context.Context, petIDs []string, itemType model.TrashItemType) ([]*model.TrashItem, error)
+
+	// FindByID 查詢垃圾桶中的單筆紀錄
+	FindByID(c context.Context, itemType model.TrashItemType, id string) (*model.TrashItem, error)
+
+	// Restore 將紀錄從垃圾桶還原
+	Restore(c context.Context, itemType model.TrashItemType, id string) error
+
+	// Purge 永久刪除垃圾桶中的紀錄及其附件中繼資料，回傳被刪除的附件供呼叫端刪除檔案
+	Purge(c context.Context, itemType model.TrashItemType, id string) ([]*model.Attachment, error)
+
+	// PurgeDeletedBefore 永久刪除在指定時間點前刪除的紀錄及其附件中繼資料，回傳刪除筆數與被刪除的附件
+	PurgeDeletedBefore(c context.Context, cutoff time.Time) (int, []*model.Attachment, error)
+}
@@ -0,0 +1,29 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// BlobObject 描述寫入或下載的檔案
+type BlobObject struct {
+	Key         string
+	ContentType string
+	// FileName 下載時建議的檔名
+	FileName string
+}
+
+// BlobStore 定義附件檔案的儲存 port，實作可為本機檔案系統或 S3 相容的物件儲存
+type BlobStore interface {
+	// Put 寫入檔案內容，相同鍵值會被覆寫
+	Put(c context.Context, obj BlobObject, body io.Reader, size int64) error
+	// Open 讀取檔案內容，檔案不存在時回傳 domain.ErrNotFound；呼叫端負責關閉
+	Open(c context.Context, key string) (io.ReadCloser, error)
+	// Delete 刪除檔案，檔案不存在時不視為錯誤
+	Delete(c context.Context, key string) error
+	// SignedURL 產生在 expiresAt 前有效的下載連結
+	SignedURL(c context.Context, obj BlobObject, expiresAt time.Time) (string, error)
+}
@@ -0,0 +1,14 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package service
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// EventPublisher 定義發布領域事件的 port，讓產生事件的用例不需知道有哪些訂閱者
+type EventPublisher interface {
+	Publish(c context.Context, event model.DomainEvent) error
+}
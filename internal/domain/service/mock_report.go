@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock_report.go -package=service -source=report.go
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	model "github.com/blackhorseya/petlog/internal/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRenderer is a mock of ReportRenderer interface.
+type MockReportRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRendererMockRecorder
+	isgomock struct{}
+}
+
+// MockReportRendererMockRecorder is the mock recorder for MockReportRenderer.
+type MockReportRendererMockRecorder struct {
+	mock *MockReportRenderer
+}
+
+// NewMockReportRenderer creates a new mock instance.
+func NewMockReportRenderer(ctrl *gomock.Controller) *MockReportRenderer {
+	mock := &MockReportRenderer{ctrl: ctrl}
+	mock.recorder = &MockReportRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRenderer) EXPECT() *MockReportRendererMockRecorder {
+	return m.recorder
+}
+
+// RenderPetHealthReport mocks base method.
+func (m *MockReportRenderer) RenderPetHealthReport(c context.Context, w io.Writer, report *model.PetHealthReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderPetHealthReport", c, w, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenderPetHealthReport indicates an expected call of RenderPetHealthReport.
+func (mr *MockReportRendererMockRecorder) RenderPetHealthReport(c, w, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderPetHealthReport", reflect.TypeOf((*MockReportRenderer)(nil).RenderPetHealthReport), c, w, report)
+}
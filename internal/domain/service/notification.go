@@ -0,0 +1,18 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package service
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// NotificationSender 定義對外發送通知的 port，每個管道各有一個實作
+type NotificationSender interface {
+	// Channel 回傳此 sender 負責的通知管道
+	Channel() model.NotificationChannel
+
+	// Send 將通知發送至指定目標（LINE user ID、Email 或 Webhook URL）
+	Send(c context.Context, target string, msg *model.NotificationMessage) error
+}
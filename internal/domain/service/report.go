@@ -0,0 +1,16 @@
+//go:generate go tool mockgen -destination=./mock_${GOFILE} -package=${GOPACKAGE} -source=${GOFILE}
+
+package service
+
+import (
+	"context"
+	"io"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// ReportRenderer 定義報告輸出的 port，於伺服器端產生檔案，不依賴外部服務
+type ReportRenderer interface {
+	// RenderPetHealthReport 將寵物健康報告輸出為 PDF
+	RenderPetHealthReport(c context.Context, w io.Writer, report *model.PetHealthReport) error
+}
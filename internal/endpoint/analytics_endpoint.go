@@ -0,0 +1,109 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// AnalyticsEndpoints 聚合所有健康趨勢分析相關的 endpoints
+type AnalyticsEndpoints struct {
+	GetWeightTrendEndpoint     endpoint.Endpoint
+	GetFoodIntakeTrendEndpoint endpoint.Endpoint
+}
+
+// MakeAnalyticsEndpoints 建立健康趨勢分析 endpoints
+func MakeAnalyticsEndpoints(wh *query.GetWeightTrendHandler, fh *query.GetFoodIntakeTrendHandler) AnalyticsEndpoints {
+	return AnalyticsEndpoints{
+		GetWeightTrendEndpoint:     MakeGetWeightTrendEndpoint(wh),
+		GetFoodIntakeTrendEndpoint: MakeGetFoodIntakeTrendEndpoint(fh),
+	}
+}
+
+// GetWeightTrendRequest 查詢體重趨勢的請求結構
+type GetWeightTrendRequest struct {
+	PetID        string    `json:"pet_id"`
+	Interval     string    `json:"interval,omitempty"`
+	StartDate    time.Time `json:"start_date,omitempty"`
+	EndDate      time.Time `json:"end_date,omitempty"`
+	Window       int       `json:"window,omitempty"`
+	ChangeWindow int       `json:"change_window,omitempty"`
+
+	Filter model.HealthLogFilter `json:"-"`
+}
+
+// GetWeightTrendResponse 查詢體重趨勢的回應結構
+type GetWeightTrendResponse struct {
+	Trend *model.WeightTrend `json:"trend,omitempty"`
+	Err   error              `json:"error,omitempty"`
+}
+
+func (r GetWeightTrendResponse) Failed() error { return r.Err }
+
+// MakeGetWeightTrendEndpoint 建立查詢體重趨勢的 endpoint
+func MakeGetWeightTrendEndpoint(h *query.GetWeightTrendHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetWeightTrendRequest)
+		q := query.GetWeightTrendQuery{
+			PetID:        req.PetID,
+			Interval:     model.TrendInterval(req.Interval),
+			StartDate:    req.StartDate,
+			EndDate:      req.EndDate,
+			Window:       req.Window,
+			ChangeWindow: req.ChangeWindow,
+			Filter:       req.Filter,
+		}
+
+		trend, err := h.Handle(c, q)
+		if err != nil {
+			return GetWeightTrendResponse{Err: err}, nil
+		}
+		return GetWeightTrendResponse{Trend: trend}, nil
+	}
+}
+
+// GetFoodIntakeTrendRequest 查詢進食量趨勢的請求結構
+type GetFoodIntakeTrendRequest struct {
+	PetID           string    `json:"pet_id"`
+	StartDate       time.Time `json:"start_date,omitempty"`
+	EndDate         time.Time `json:"end_date,omitempty"`
+	BaselineDays    int       `json:"baseline_days,omitempty"`
+	ThresholdPct    float64   `json:"threshold_pct,omitempty"`
+	ConsecutiveDays int       `json:"consecutive_days,omitempty"`
+
+	Filter model.HealthLogFilter `json:"-"`
+}
+
+// GetFoodIntakeTrendResponse 查詢進食量趨勢的回應結構
+type GetFoodIntakeTrendResponse struct {
+	Trend *model.FoodIntakeTrend `json:"trend,omitempty"`
+	Err   error                  `json:"error,omitempty"`
+}
+
+func (r GetFoodIntakeTrendResponse) Failed() error { return r.Err }
+
+// MakeGetFoodIntakeTrendEndpoint 建立查詢進食量趨勢的 endpoint
+func MakeGetFoodIntakeTrendEndpoint(h *query.GetFoodIntakeTrendHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetFoodIntakeTrendRequest)
+		q := query.GetFoodIntakeTrendQuery{
+			PetID:           req.PetID,
+			StartDate:       req.StartDate,
+			EndDate:         req.EndDate,
+			BaselineDays:    req.BaselineDays,
+			ThresholdPct:    req.ThresholdPct,
+			ConsecutiveDays: req.ConsecutiveDays,
+			Filter:          req.Filter,
+		}
+
+		trend, err := h.Handle(c, q)
+		if err != nil {
+			return GetFoodIntakeTrendResponse{Err: err}, nil
+		}
+		return GetFoodIntakeTrendResponse{Trend: trend}, nil
+	}
+}
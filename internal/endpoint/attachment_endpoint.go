@@ -0,0 +1,162 @@
+package endpoint
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// AttachmentEndpoints 聚合所有附件相關的 endpoints
+type AttachmentEndpoints struct {
+	UploadAttachmentEndpoint   endpoint.Endpoint
+	ListAttachmentsEndpoint    endpoint.Endpoint
+	GetAttachmentEndpoint      endpoint.Endpoint
+	DownloadAttachmentEndpoint endpoint.Endpoint
+	DeleteAttachmentEndpoint   endpoint.Endpoint
+}
+
+// MakeAttachmentEndpoints 建立附件 endpoints
+func MakeAttachmentEndpoints(
+	uh *command.UploadAttachmentHandler,
+	lh *query.ListAttachmentsHandler,
+	gh *query.GetAttachmentHandler,
+	dlh *query.GetAttachmentDownloadHandler,
+	dh *command.DeleteAttachmentHandler,
+) AttachmentEndpoints {
+	return AttachmentEndpoints{
+		UploadAttachmentEndpoint:   MakeUploadAttachmentEndpoint(uh),
+		ListAttachmentsEndpoint:    MakeListAttachmentsEndpoint(lh),
+		GetAttachmentEndpoint:      MakeGetAttachmentEndpoint(gh),
+		DownloadAttachmentEndpoint: MakeDownloadAttachmentEndpoint(dlh),
+		DeleteAttachmentEndpoint:   MakeDeleteAttachmentEndpoint(dh),
+	}
+}
+
+// AttachmentResponse 單一附件的回應結構
+type AttachmentResponse struct {
+	Attachment *model.Attachment `json:"attachment,omitempty"`
+	Err        error             `json:"error,omitempty"`
+}
+
+func (r AttachmentResponse) Failed() error { return r.Err }
+
+// UploadAttachmentRequest 上傳附件的請求結構，由 multipart/form-data 解析而來
+type UploadAttachmentRequest struct {
+	OwnerType model.AttachmentOwnerType `json:"owner_type"`
+	OwnerID   string                    `json:"owner_id"`
+	FileName  string                    `json:"file_name"`
+	Content   io.Reader                 `json:"-"`
+}
+
+// MakeUploadAttachmentEndpoint 建立上傳附件的 endpoint
+func MakeUploadAttachmentEndpoint(h *command.UploadAttachmentHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UploadAttachmentRequest)
+		cmd := command.UploadAttachmentCommand{
+			OwnerType: req.OwnerType,
+			OwnerID:   req.OwnerID,
+			FileName:  req.FileName,
+			Content:   req.Content,
+		}
+
+		attachment, err := h.Handle(c, cmd)
+		if err != nil {
+			return AttachmentResponse{Err: err}, nil
+		}
+		return AttachmentResponse{Attachment: attachment}, nil
+	}
+}
+
+// ListAttachmentsRequest 查詢附件的請求結構
+type ListAttachmentsRequest struct {
+	OwnerType model.AttachmentOwnerType `json:"owner_type"`
+	OwnerID   string                    `json:"owner_id"`
+
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListAttachmentsResponse 查詢附件的回應結構
+type ListAttachmentsResponse struct {
+	Attachments []*model.Attachment `json:"attachments"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListAttachmentsResponse) Failed() error { return r.Err }
+
+// MakeListAttachmentsEndpoint 建立查詢附件的 endpoint
+func MakeListAttachmentsEndpoint(h *query.ListAttachmentsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListAttachmentsRequest)
+
+		page, err := h.Handle(c, query.ListAttachmentsQuery{OwnerType: req.OwnerType, OwnerID: req.OwnerID, Page: req.Page})
+		if err != nil {
+			return ListAttachmentsResponse{Err: err}, nil
+		}
+		return ListAttachmentsResponse{Attachments: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// GetAttachmentRequest 以 ID 操作單一附件的請求結構
+type GetAttachmentRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetAttachmentEndpoint 建立查詢附件中繼資料的 endpoint
+func MakeGetAttachmentEndpoint(h *query.GetAttachmentHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAttachmentRequest)
+
+		attachment, err := h.Handle(c, query.GetAttachmentQuery{ID: req.ID})
+		if err != nil {
+			return AttachmentResponse{Err: err}, nil
+		}
+		return AttachmentResponse{Attachment: attachment}, nil
+	}
+}
+
+// DownloadAttachmentResponse 附件下載連結的回應結構
+type DownloadAttachmentResponse struct {
+	Download *model.AttachmentDownload `json:"download,omitempty"`
+	Err      error                     `json:"error,omitempty"`
+}
+
+func (r DownloadAttachmentResponse) Failed() error { return r.Err }
+
+// MakeDownloadAttachmentEndpoint 建立產生附件下載連結的 endpoint
+func MakeDownloadAttachmentEndpoint(h *query.GetAttachmentDownloadHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAttachmentRequest)
+
+		download, err := h.Handle(c, query.GetAttachmentQuery{ID: req.ID})
+		if err != nil {
+			return DownloadAttachmentResponse{Err: err}, nil
+		}
+		return DownloadAttachmentResponse{Download: download}, nil
+	}
+}
+
+// DeleteAttachmentResponse 刪除附件的回應結構
+type DeleteAttachmentResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r DeleteAttachmentResponse) Failed() error { return r.Err }
+
+// MakeDeleteAttachmentEndpoint 建立刪除附件的 endpoint
+func MakeDeleteAttachmentEndpoint(h *command.DeleteAttachmentHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetAttachmentRequest)
+
+		if err := h.Handle(c, command.DeleteAttachmentCommand{ID: req.ID}); err != nil {
+			return DeleteAttachmentResponse{Err: err}, nil
+		}
+		return DeleteAttachmentResponse{}, nil
+	}
+}
@@ -0,0 +1,218 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// BudgetEndpoints 聚合所有預算相關的 endpoints
+type BudgetEndpoints struct {
+	CreateBudgetEndpoint    endpoint.Endpoint
+	UpdateBudgetEndpoint    endpoint.Endpoint
+	DeleteBudgetEndpoint    endpoint.Endpoint
+	GetBudgetEndpoint       endpoint.Endpoint
+	ListBudgetsEndpoint     endpoint.Endpoint
+	GetBudgetStatusEndpoint endpoint.Endpoint
+}
+
+// MakeBudgetEndpoints 建立預算 endpoints
+func MakeBudgetEndpoints(
+	ch *command.CreateBudgetHandler,
+	uh *command.UpdateBudgetHandler,
+	dh *command.DeleteBudgetHandler,
+	gh *query.GetBudgetHandler,
+	lh *query.ListBudgetsHandler,
+	sh *query.GetBudgetStatusHandler,
+) BudgetEndpoints {
+	return BudgetEndpoints{
+		CreateBudgetEndpoint:    MakeCreateBudgetEndpoint(ch),
+		UpdateBudgetEndpoint:    MakeUpdateBudgetEndpoint(uh),
+		DeleteBudgetEndpoint:    MakeDeleteBudgetEndpoint(dh),
+		GetBudgetEndpoint:       MakeGetBudgetEndpoint(gh),
+		ListBudgetsEndpoint:     MakeListBudgetsEndpoint(lh),
+		GetBudgetStatusEndpoint: MakeGetBudgetStatusEndpoint(sh),
+	}
+}
+
+// BudgetResponse 單一預算的回應結構
+type BudgetResponse struct {
+	Budget *model.Budget `json:"budget,omitempty"`
+	Err    error         `json:"error,omitempty"`
+}
+
+func (r BudgetResponse) Failed() error { return r.Err }
+
+func (r BudgetResponse) ResourceVersion() (int, bool) {
+	if r.Budget == nil {
+		return 0, false
+	}
+	return r.Budget.Version, true
+}
+
+// CreateBudgetRequest 建立預算的請求結構
+// pet_id 留空表示涵蓋所有寵物，category 留空表示涵蓋所有分類
+// thresholds 為花費佔預算的百分比門檻，留空時使用 80 與 100
+type CreateBudgetRequest struct {
+	PetID      string             `json:"pet_id,omitempty"`
+	Category   string             `json:"category,omitempty"`
+	Period     model.BudgetPeriod `json:"period" binding:"required"`
+	Limit      int                `json:"limit" binding:"required,min=1"`
+	Thresholds []int              `json:"thresholds,omitempty"`
+}
+
+// MakeCreateBudgetEndpoint 建立新增預算的 endpoint
+func MakeCreateBudgetEndpoint(h *command.CreateBudgetHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateBudgetRequest)
+		cmd := command.CreateBudgetCommand{
+			PetID:      req.PetID,
+			Category:   req.Category,
+			Period:     req.Period,
+			Limit:      req.Limit,
+			Thresholds: req.Thresholds,
+		}
+
+		budget, err := h.Handle(c, cmd)
+		if err != nil {
+			return BudgetResponse{Err: err}, nil
+		}
+		return BudgetResponse{Budget: budget}, nil
+	}
+}
+
+// UpdateBudgetRequest 更新預算的請求結構
+type UpdateBudgetRequest struct {
+	ID         string             `json:"-"`
+	PetID      string             `json:"pet_id,omitempty"`
+	Category   string             `json:"category,omitempty"`
+	Period     model.BudgetPeriod `json:"period" binding:"required"`
+	Limit      int                `json:"limit" binding:"required,min=1"`
+	Thresholds []int              `json:"thresholds,omitempty"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
+}
+
+// MakeUpdateBudgetEndpoint 建立更新預算的 endpoint
+func MakeUpdateBudgetEndpoint(h *command.UpdateBudgetHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateBudgetRequest)
+		cmd := command.UpdateBudgetCommand{
+			ID:         req.ID,
+			PetID:      req.PetID,
+			Category:   req.Category,
+			Period:     req.Period,
+			Limit:      req.Limit,
+			Thresholds: req.Thresholds,
+
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		budget, err := h.Handle(c, cmd)
+		if err != nil {
+			return BudgetResponse{Err: err}, nil
+		}
+		return BudgetResponse{Budget: budget}, nil
+	}
+}
+
+// DeleteBudgetRequest 刪除預算的請求結構
+type DeleteBudgetRequest struct {
+	ID string `json:"id"`
+}
+
+// DeleteBudgetResponse 刪除預算的回應結構
+type DeleteBudgetResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r DeleteBudgetResponse) Failed() error { return r.Err }
+
+// MakeDeleteBudgetEndpoint 建立刪除預算的 endpoint
+func MakeDeleteBudgetEndpoint(h *command.DeleteBudgetHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteBudgetRequest)
+
+		if err := h.Handle(c, command.DeleteBudgetCommand{ID: req.ID}); err != nil {
+			return DeleteBudgetResponse{Err: err}, nil
+		}
+		return DeleteBudgetResponse{}, nil
+	}
+}
+
+// GetBudgetRequest 取得預算的請求結構
+type GetBudgetRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetBudgetEndpoint 建立取得預算的 endpoint
+func MakeGetBudgetEndpoint(h *query.GetBudgetHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetBudgetRequest)
+
+		budget, err := h.Handle(c, query.GetBudgetQuery{ID: req.ID})
+		if err != nil {
+			return BudgetResponse{Err: err}, nil
+		}
+		return BudgetResponse{Budget: budget}, nil
+	}
+}
+
+// ListBudgetsRequest 查詢預算清單的請求結構
+type ListBudgetsRequest struct {
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListBudgetsResponse 預算清單的回應結構
+type ListBudgetsResponse struct {
+	Budgets []*model.Budget `json:"budgets"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListBudgetsResponse) Failed() error { return r.Err }
+
+// MakeListBudgetsEndpoint 建立查詢預算清單的 endpoint
+func MakeListBudgetsEndpoint(h *query.ListBudgetsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListBudgetsRequest)
+
+		page, err := h.Handle(c, query.ListBudgetsQuery{Page: req.Page})
+		if err != nil {
+			return ListBudgetsResponse{Err: err}, nil
+		}
+		return ListBudgetsResponse{Budgets: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// GetBudgetStatusRequest 查詢預算執行狀況的請求結構
+type GetBudgetStatusRequest struct {
+	ID string `json:"id"`
+}
+
+// GetBudgetStatusResponse 預算執行狀況的回應結構
+type GetBudgetStatusResponse struct {
+	Status *model.BudgetStatus `json:"status,omitempty"`
+	Err    error               `json:"error,omitempty"`
+}
+
+func (r GetBudgetStatusResponse) Failed() error { return r.Err }
+
+// MakeGetBudgetStatusEndpoint 建立查詢預算執行狀況的 endpoint
+func MakeGetBudgetStatusEndpoint(h *query.GetBudgetStatusHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetBudgetStatusRequest)
+
+		status, err := h.Handle(c, query.GetBudgetStatusQuery{ID: req.ID})
+		if err != nil {
+			return GetBudgetStatusResponse{Err: err}, nil
+		}
+		return GetBudgetStatusResponse{Status: status}, nil
+	}
+}
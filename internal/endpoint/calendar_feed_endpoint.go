@@ -0,0 +1,74 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// CalendarFeedEndpoints 聚合行事曆訂閱相關的 endpoints
+type CalendarFeedEndpoints struct {
+	RotateTokenEndpoint endpoint.Endpoint
+	GetFeedEndpoint     endpoint.Endpoint
+}
+
+// MakeCalendarFeedEndpoints 建立行事曆訂閱 endpoints
+func MakeCalendarFeedEndpoints(
+	rh *command.RotateCalendarFeedTokenHandler,
+	gh *query.GetCalendarFeedHandler,
+) CalendarFeedEndpoints {
+	return CalendarFeedEndpoints{
+		RotateTokenEndpoint: MakeRotateCalendarFeedTokenEndpoint(rh),
+		GetFeedEndpoint:     MakeGetCalendarFeedEndpoint(gh),
+	}
+}
+
+// RotateCalendarFeedTokenResponse 產生行事曆訂閱 token 的回應結構，token 只在此回傳一次
+type RotateCalendarFeedTokenResponse struct {
+	Token string              `json:"token,omitempty"`
+	Feed  *model.CalendarFeed `json:"feed,omitempty"`
+	Err   error               `json:"error,omitempty"`
+}
+
+func (r RotateCalendarFeedTokenResponse) Failed() error { return r.Err }
+
+// MakeRotateCalendarFeedTokenEndpoint 建立產生行事曆訂閱 token 的 endpoint
+func MakeRotateCalendarFeedTokenEndpoint(h *command.RotateCalendarFeedTokenHandler) endpoint.Endpoint {
+	return func(c context.Context, _ interface{}) (interface{}, error) {
+		result, err := h.Handle(c, command.RotateCalendarFeedTokenCommand{})
+		if err != nil {
+			return RotateCalendarFeedTokenResponse{Err: err}, nil
+		}
+		return RotateCalendarFeedTokenResponse{Token: result.Token, Feed: result.Feed}, nil
+	}
+}
+
+// GetCalendarFeedRequest 讀取行事曆訂閱的請求結構
+type GetCalendarFeedRequest struct {
+	Token string `json:"token"`
+}
+
+// GetCalendarFeedResponse 行事曆訂閱的回應結構，Content 為 iCalendar 內容
+type GetCalendarFeedResponse struct {
+	Content []byte `json:"-"`
+	Err     error  `json:"error,omitempty"`
+}
+
+func (r GetCalendarFeedResponse) Failed() error { return r.Err }
+
+// MakeGetCalendarFeedEndpoint 建立讀取行事曆訂閱的 endpoint
+func MakeGetCalendarFeedEndpoint(h *query.GetCalendarFeedHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetCalendarFeedRequest)
+
+		content, err := h.Handle(c, query.GetCalendarFeedQuery{Token: req.Token})
+		if err != nil {
+			return GetCalendarFeedResponse{Err: err}, nil
+		}
+		return GetCalendarFeedResponse{Content: content}, nil
+	}
+}
@@ -45,17 +45,12 @@ func NewDashboardEndpoints(handler *query.GetDashboardOverviewHandler) Dashboard
 // MakeGetDashboardOverviewEndpoint 建立 GetOverview endpoint
 func MakeGetDashboardOverviewEndpoint(handler *query.GetDashboardOverviewHandler) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		// 從 context 取得 userID
-		userID, err := contextx.GetUserID(ctx)
-		if err != nil {
+		// 確認 context 中有 userID
+		if _, err := contextx.GetUserID(ctx); err != nil {
 			return GetDashboardOverviewResponse{Err: err}, nil
 		}
 
-		q := query.GetDashboardOverviewQuery{
-			OwnerID: userID,
-		}
-
-		result, err := handler.Handle(ctx, q)
+		result, err := handler.Handle(ctx, query.GetDashboardOverviewQuery{})
 		if err != nil {
 			return GetDashboardOverviewResponse{Err: err}, nil
 		}
@@ -1,7 +1,38 @@
 package endpoint
 
+import "github.com/blackhorseya/petlog/internal/domain/repository"
+
 // Failer is an interface that should be implemented by response types.
 // It allows business logic to declare its own error conditions.
 type Failer interface {
 	Failed() error
 }
+
+// Versioner is implemented by responses that carry a single versioned resource.
+// The transport layer uses it to emit an ETag header.
+type Versioner interface {
+	ResourceVersion() (int, bool)
+}
+
+// Paginator is implemented by list responses.
+// The transport layer uses it to emit an X-Total-Count header.
+type Paginator interface {
+	TotalCount() int
+}
+
+// PageInfo carries the pagination details of a list response.
+// Pass NextCursor back as the cursor query parameter to fetch the next page.
+// It is an encoded offset rather than a keyset cursor, so rows inserted or
+// deleted between requests may shift items across pages.
+type PageInfo struct {
+	Total      int    `json:"total"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// TotalCount returns the number of items matching the query across all pages.
+func (p PageInfo) TotalCount() int { return p.Total }
+
+// newPageInfo builds the pagination details from a page result.
+func newPageInfo[T any](page *repository.Page[T]) PageInfo {
+	return PageInfo{Total: page.Total, NextCursor: page.NextCursor}
+}
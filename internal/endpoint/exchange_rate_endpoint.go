@@ -0,0 +1,80 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// ExchangeRateEndpoints 聚合所有匯率相關的 endpoints
+type ExchangeRateEndpoints struct {
+	ListExchangeRatesEndpoint   endpoint.Endpoint
+	ImportExchangeRatesEndpoint endpoint.Endpoint
+}
+
+// MakeExchangeRateEndpoints 建立匯率 endpoints
+func MakeExchangeRateEndpoints(
+	lh *query.ListExchangeRatesHandler,
+	ih *command.ImportExchangeRatesHandler,
+) ExchangeRateEndpoints {
+	return ExchangeRateEndpoints{
+		ListExchangeRatesEndpoint:   MakeListExchangeRatesEndpoint(lh),
+		ImportExchangeRatesEndpoint: MakeImportExchangeRatesEndpoint(ih),
+	}
+}
+
+// ListExchangeRatesRequest 查詢匯率的請求結構
+type ListExchangeRatesRequest struct {
+	Currency string `json:"currency"`
+}
+
+// ListExchangeRatesResponse 查詢匯率的回應結構
+type ListExchangeRatesResponse struct {
+	Result *query.ListExchangeRatesResult `json:"result,omitempty"`
+	Err    error                          `json:"error,omitempty"`
+}
+
+func (r ListExchangeRatesResponse) Failed() error { return r.Err }
+
+// MakeListExchangeRatesEndpoint 建立查詢匯率的 endpoint
+func MakeListExchangeRatesEndpoint(h *query.ListExchangeRatesHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListExchangeRatesRequest)
+
+		result, err := h.Handle(c, query.ListExchangeRatesQuery{Currency: req.Currency})
+		if err != nil {
+			return ListExchangeRatesResponse{Err: err}, nil
+		}
+		return ListExchangeRatesResponse{Result: result}, nil
+	}
+}
+
+// ImportExchangeRatesRequest 匯入匯率的請求結構，rate 為 1 單位參考貨幣可兌換的 currency 數量
+type ImportExchangeRatesRequest struct {
+	Rates []*model.ExchangeRate `json:"rates" binding:"required"`
+}
+
+// ImportExchangeRatesResponse 匯入匯率的回應結構
+type ImportExchangeRatesResponse struct {
+	Imported int   `json:"imported"`
+	Err      error `json:"error,omitempty"`
+}
+
+func (r ImportExchangeRatesResponse) Failed() error { return r.Err }
+
+// MakeImportExchangeRatesEndpoint 建立匯入匯率的 endpoint
+func MakeImportExchangeRatesEndpoint(h *command.ImportExchangeRatesHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ImportExchangeRatesRequest)
+
+		imported, err := h.Handle(c, command.ImportExchangeRatesCommand{Rates: req.Rates})
+		if err != nil {
+			return ImportExchangeRatesResponse{Err: err}, nil
+		}
+		return ImportExchangeRatesResponse{Imported: imported}, nil
+	}
+}
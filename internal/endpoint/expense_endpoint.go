@@ -7,6 +7,7 @@ import (
 	"github.com/go-kit/kit/endpoint"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
 )
@@ -54,6 +55,9 @@ type CreateExpenseRequest struct {
 	Amount      int       `json:"amount" binding:"required,min=1"`
 	Description string    `json:"description"`
 	Date        time.Time `json:"date" binding:"required"`
+
+	// Currency ISO 4217 幣別代碼，未填時使用者的基準貨幣
+	Currency string `json:"currency,omitempty"`
 }
 
 // CreateExpenseResponse 建立費用紀錄的回應結構
@@ -65,6 +69,8 @@ type CreateExpenseResponse struct {
 
 func (r CreateExpenseResponse) Failed() error { return r.Err }
 
+func (r CreateExpenseResponse) ResourceVersion() (int, bool) { return expenseVersion(r.Expense) }
+
 func MakeCreateExpenseEndpoint(h *command.CreateExpenseHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateExpenseRequest)
@@ -74,6 +80,7 @@ func MakeCreateExpenseEndpoint(h *command.CreateExpenseHandler) endpoint.Endpoin
 			Amount:      req.Amount,
 			Description: req.Description,
 			Date:        req.Date,
+			Currency:    req.Currency,
 		}
 
 		expense, err := h.Handle(c, cmd)
@@ -97,6 +104,8 @@ type GetExpenseResponse struct {
 
 func (r GetExpenseResponse) Failed() error { return r.Err }
 
+func (r GetExpenseResponse) ResourceVersion() (int, bool) { return expenseVersion(r.Expense) }
+
 func MakeGetExpenseEndpoint(h *query.GetExpenseByIDHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetExpenseRequest)
@@ -111,17 +120,21 @@ func MakeGetExpenseEndpoint(h *query.GetExpenseByIDHandler) endpoint.Endpoint {
 }
 
 // ListExpensesRequest 查詢費用紀錄的請求結構
+// 未指定 PetID 時查詢使用者所有寵物的費用
 type ListExpensesRequest struct {
-	PetID     string `json:"pet_id,omitempty"`
-	Category  string `json:"category,omitempty"`
-	StartDate string `json:"start_date,omitempty"`
-	EndDate   string `json:"end_date,omitempty"`
+	PetID     string    `json:"pet_id,omitempty"`
+	Category  string    `json:"category,omitempty"`
+	StartDate time.Time `json:"start_date,omitempty"`
+	EndDate   time.Time `json:"end_date,omitempty"`
+
+	Page repository.PageRequest `json:"-"`
 }
 
 // ListExpensesResponse 查詢費用紀錄的回應結構
 type ListExpensesResponse struct {
 	Expenses []*model.Expense `json:"expenses,omitempty"`
-	Err      error            `json:"error,omitempty"`
+	PageInfo
+	Err error `json:"error,omitempty"`
 }
 
 func (r ListExpensesResponse) Failed() error { return r.Err }
@@ -129,13 +142,19 @@ func (r ListExpensesResponse) Failed() error { return r.Err }
 func MakeListExpensesEndpoint(h *query.ListExpensesByPetHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(ListExpensesRequest)
-		q := query.ListExpensesByPetQuery{PetID: req.PetID}
+		q := query.ListExpensesByPetQuery{
+			PetID:     req.PetID,
+			Category:  req.Category,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			Page:      req.Page,
+		}
 
-		expenses, _, err := h.Handle(c, q)
+		page, err := h.Handle(c, q)
 		if err != nil {
 			return ListExpensesResponse{Err: err}, nil
 		}
-		return ListExpensesResponse{Expenses: expenses, Err: nil}, nil
+		return ListExpensesResponse{Expenses: page.Items, PageInfo: newPageInfo(page), Err: nil}, nil
 	}
 }
 
@@ -147,6 +166,12 @@ type UpdateExpenseRequest struct {
 	Amount      int       `json:"amount" binding:"required,min=1"`
 	Description string    `json:"description"`
 	Date        time.Time `json:"date" binding:"required"`
+
+	// Currency ISO 4217 幣別代碼，未填時維持原幣別
+	Currency string `json:"currency,omitempty"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdateExpenseResponse 編輯費用紀錄的回應結構
@@ -157,6 +182,16 @@ type UpdateExpenseResponse struct {
 
 func (r UpdateExpenseResponse) Failed() error { return r.Err }
 
+func (r UpdateExpenseResponse) ResourceVersion() (int, bool) { return expenseVersion(r.Expense) }
+
+// expenseVersion 取得費用紀錄版本，供 transport 輸出 ETag
+func expenseVersion(exp *model.Expense) (int, bool) {
+	if exp == nil {
+		return 0, false
+	}
+	return exp.Version, true
+}
+
 func MakeUpdateExpenseEndpoint(h *command.UpdateExpenseHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(UpdateExpenseRequest)
@@ -166,6 +201,9 @@ func MakeUpdateExpenseEndpoint(h *command.UpdateExpenseHandler) endpoint.Endpoin
 			Amount:      req.Amount,
 			Description: req.Description,
 			Date:        req.Date,
+			Currency:    req.Currency,
+
+			ExpectedVersion: req.ExpectedVersion,
 		}
 
 		expense, err := h.Handle(c, cmd)
@@ -203,15 +241,25 @@ func MakeDeleteExpenseEndpoint(h *command.DeleteExpenseHandler) endpoint.Endpoin
 
 // GetExpenseSummaryRequest 查詢費用摘要的請求結構
 type GetExpenseSummaryRequest struct {
-	PetID string `json:"pet_id,omitempty"`
+	PetID     string    `json:"pet_id,omitempty"`
+	StartDate time.Time `json:"start_date,omitempty"`
+	EndDate   time.Time `json:"end_date,omitempty"`
+	GroupBy   string    `json:"group_by,omitempty"`
 }
 
 // GetExpenseSummaryResponse 查詢費用摘要的回應結構
 type GetExpenseSummaryResponse struct {
-	TotalAmount   int              `json:"total_amount"`
-	CategoryStats map[string]int   `json:"category_stats"`
-	Recent        []*model.Expense `json:"recent,omitempty"`
-	Err           error            `json:"error,omitempty"`
+	TotalAmount   int                    `json:"total_amount"`
+	Count         int                    `json:"count"`
+	CategoryStats map[string]int         `json:"category_stats"`
+	GroupBy       model.ExpenseGroupBy   `json:"group_by,omitempty"`
+	Series        []*model.ExpenseBucket `json:"series,omitempty"`
+	Recent        []*model.Expense       `json:"recent,omitempty"`
+	Currency      string                 `json:"currency,omitempty"`
+	PetStats      map[string]int         `json:"pet_stats,omitempty"`
+	// Unconverted 缺少匯率而未計入統計的費用，依幣別合計
+	Unconverted []*model.UnconvertedExpense `json:"unconverted,omitempty"`
+	Err         error                       `json:"error,omitempty"`
 }
 
 func (r GetExpenseSummaryResponse) Failed() error { return r.Err }
@@ -219,7 +267,12 @@ func (r GetExpenseSummaryResponse) Failed() error { return r.Err }
 func MakeGetExpenseSummaryEndpoint(h *query.GetExpenseSummaryHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetExpenseSummaryRequest)
-		q := query.GetExpenseSummaryQuery{PetID: req.PetID}
+		q := query.GetExpenseSummaryQuery{
+			PetID:     req.PetID,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			GroupBy:   model.ExpenseGroupBy(req.GroupBy),
+		}
 
 		summary, err := h.Handle(c, q)
 		if err != nil {
@@ -227,8 +280,14 @@ func MakeGetExpenseSummaryEndpoint(h *query.GetExpenseSummaryHandler) endpoint.E
 		}
 		return GetExpenseSummaryResponse{
 			TotalAmount:   summary.TotalAmount,
+			Count:         summary.Count,
 			CategoryStats: summary.CategoryStats,
+			GroupBy:       summary.GroupBy,
+			Series:        summary.Series,
 			Recent:        summary.Recent,
+			Currency:      summary.Currency,
+			PetStats:      summary.PetStats,
+			Unconverted:   summary.Unconverted,
 			Err:           nil,
 		}, nil
 	}
@@ -0,0 +1,128 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// ExportEndpoints 聚合所有資料匯出相關的 endpoints
+type ExportEndpoints struct {
+	RequestExportEndpoint  endpoint.Endpoint
+	ListExportsEndpoint    endpoint.Endpoint
+	GetExportEndpoint      endpoint.Endpoint
+	DownloadExportEndpoint endpoint.Endpoint
+}
+
+// MakeExportEndpoints 建立資料匯出 endpoints
+func MakeExportEndpoints(
+	rh *command.RequestExportHandler,
+	lh *query.ListExportsHandler,
+	gh *query.GetExportHandler,
+	dh *query.GetExportDownloadHandler,
+) ExportEndpoints {
+	return ExportEndpoints{
+		RequestExportEndpoint:  MakeRequestExportEndpoint(rh),
+		ListExportsEndpoint:    MakeListExportsEndpoint(lh),
+		GetExportEndpoint:      MakeGetExportEndpoint(gh),
+		DownloadExportEndpoint: MakeDownloadExportEndpoint(dh),
+	}
+}
+
+// ExportResponse 單一匯出工作的回應結構
+type ExportResponse struct {
+	Export *model.ExportJob `json:"export,omitempty"`
+	Err    error            `json:"error,omitempty"`
+}
+
+func (r ExportResponse) Failed() error { return r.Err }
+
+// RequestExportRequest 匯出資料的請求結構
+type RequestExportRequest struct {
+	// Format 匯出格式：json、csv 或 zip
+	Format model.ExportFormat `json:"format"`
+}
+
+// MakeRequestExportEndpoint 建立匯出資料的 endpoint
+func MakeRequestExportEndpoint(h *command.RequestExportHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(RequestExportRequest)
+
+		job, err := h.Handle(c, command.RequestExportCommand{Format: req.Format})
+		if err != nil {
+			return ExportResponse{Err: err}, nil
+		}
+		return ExportResponse{Export: job}, nil
+	}
+}
+
+// ListExportsRequest 查詢匯出工作列表的請求結構
+type ListExportsRequest struct {
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListExportsResponse 查詢匯出工作列表的回應結構
+type ListExportsResponse struct {
+	Exports []*model.ExportJob `json:"exports"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListExportsResponse) Failed() error { return r.Err }
+
+// MakeListExportsEndpoint 建立查詢匯出工作列表的 endpoint
+func MakeListExportsEndpoint(h *query.ListExportsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListExportsRequest)
+
+		page, err := h.Handle(c, query.ListExportsQuery{Page: req.Page})
+		if err != nil {
+			return ListExportsResponse{Err: err}, nil
+		}
+		return ListExportsResponse{Exports: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// GetExportRequest 以 ID 查詢單一匯出工作的請求結構
+type GetExportRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetExportEndpoint 建立查詢匯出工作狀態的 endpoint
+func MakeGetExportEndpoint(h *query.GetExportHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetExportRequest)
+
+		job, err := h.Handle(c, query.GetExportQuery{ID: req.ID})
+		if err != nil {
+			return ExportResponse{Err: err}, nil
+		}
+		return ExportResponse{Export: job}, nil
+	}
+}
+
+// DownloadExportResponse 匯出檔下載連結的回應結構
+type DownloadExportResponse struct {
+	Download *model.ExportDownload `json:"download,omitempty"`
+	Err      error                 `json:"error,omitempty"`
+}
+
+func (r DownloadExportResponse) Failed() error { return r.Err }
+
+// MakeDownloadExportEndpoint 建立產生匯出檔下載連結的 endpoint
+func MakeDownloadExportEndpoint(h *query.GetExportDownloadHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetExportRequest)
+
+		download, err := h.Handle(c, query.GetExportQuery{ID: req.ID})
+		if err != nil {
+			return DownloadExportResponse{Err: err}, nil
+		}
+		return DownloadExportResponse{Download: download}, nil
+	}
+}
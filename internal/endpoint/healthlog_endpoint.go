@@ -7,6 +7,7 @@ import (
 	"github.com/go-kit/kit/endpoint"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
 )
@@ -16,9 +17,10 @@ type CreateHealthLogRequest struct {
 	PetID          string    `json:"pet_id"`
 	Date           time.Time `json:"date"`
 	WeightKg       float64   `json:"weight_kg,omitempty"`
-	FoodGram       int       `json:"food_gram,omitempty"`
+	FoodGram       *int      `json:"food_gram,omitempty"`
 	LitterNotes    string    `json:"litter_notes,omitempty"`
 	BehaviourNotes string    `json:"behaviour_notes,omitempty"`
+	model.HealthObservations
 }
 
 // CreateHealthLogResponse defines the response structure for the create health log endpoint.
@@ -29,6 +31,8 @@ type CreateHealthLogResponse struct {
 
 func (r CreateHealthLogResponse) Failed() error { return r.Err }
 
+func (r CreateHealthLogResponse) ResourceVersion() (int, bool) { return healthLogVersion(r.HealthLog) }
+
 // GetHealthLogByIDRequest defines the request structure for getting a health log by ID.
 type GetHealthLogByIDRequest struct {
 	ID string `json:"id"`
@@ -42,17 +46,23 @@ type GetHealthLogByIDResponse struct {
 
 func (r GetHealthLogByIDResponse) Failed() error { return r.Err }
 
+func (r GetHealthLogByIDResponse) ResourceVersion() (int, bool) { return healthLogVersion(r.HealthLog) }
+
 // ListHealthLogsByPetRequest defines the request structure for listing health logs by pet.
 type ListHealthLogsByPetRequest struct {
-	PetID     string    `json:"pet_id"`
-	StartDate time.Time `json:"start_date,omitempty"`
-	EndDate   time.Time `json:"end_date,omitempty"`
+	PetID     string                `json:"pet_id"`
+	StartDate time.Time             `json:"start_date,omitempty"`
+	EndDate   time.Time             `json:"end_date,omitempty"`
+	Filter    model.HealthLogFilter `json:"-"`
+
+	Page repository.PageRequest `json:"-"`
 }
 
 // ListHealthLogsByPetResponse defines the response structure for the list health logs by pet endpoint.
 type ListHealthLogsByPetResponse struct {
 	HealthLogs []*model.HealthLog `json:"health_logs"`
-	Err        error              `json:"error,omitempty"`
+	PageInfo
+	Err error `json:"error,omitempty"`
 }
 
 func (r ListHealthLogsByPetResponse) Failed() error { return r.Err }
@@ -63,9 +73,13 @@ type UpdateHealthLogRequest struct {
 	PetID          string    `json:"pet_id"`
 	Date           time.Time `json:"date"`
 	WeightKg       float64   `json:"weight_kg,omitempty"`
-	FoodGram       int       `json:"food_gram,omitempty"`
+	FoodGram       *int      `json:"food_gram,omitempty"`
 	LitterNotes    string    `json:"litter_notes,omitempty"`
 	BehaviourNotes string    `json:"behaviour_notes,omitempty"`
+	model.HealthObservations
+
+	// ExpectedVersion is decoded from the If-Match header.
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdateHealthLogResponse defines the response structure for the update health log endpoint.
@@ -76,6 +90,15 @@ type UpdateHealthLogResponse struct {
 
 func (r UpdateHealthLogResponse) Failed() error { return r.Err }
 
+func (r UpdateHealthLogResponse) ResourceVersion() (int, bool) { return healthLogVersion(r.HealthLog) }
+
+func healthLogVersion(log *model.HealthLog) (int, bool) {
+	if log == nil {
+		return 0, false
+	}
+	return log.Version, true
+}
+
 // DeleteHealthLogRequest defines the request structure for deleting a health log.
 type DeleteHealthLogRequest struct {
 	ID string `json:"id"`
@@ -95,12 +118,13 @@ func MakeCreateHealthLogEndpoint(ch *command.CreateHealthLogHandler) endpoint.En
 		req := request.(CreateHealthLogRequest)
 
 		cmd := command.CreateHealthLogCommand{
-			PetID:          req.PetID,
-			Date:           req.Date,
-			WeightKg:       req.WeightKg,
-			FoodGram:       req.FoodGram,
-			LitterNotes:    req.LitterNotes,
-			BehaviourNotes: req.BehaviourNotes,
+			PetID:              req.PetID,
+			Date:               req.Date,
+			WeightKg:           req.WeightKg,
+			FoodGram:           req.FoodGram,
+			LitterNotes:        req.LitterNotes,
+			BehaviourNotes:     req.BehaviourNotes,
+			HealthObservations: req.HealthObservations,
 		}
 
 		log, err := ch.Handle(c, cmd)
@@ -139,14 +163,16 @@ func MakeListHealthLogsByPetEndpoint(qh *query.ListHealthLogsByPetHandler) endpo
 			PetID:     req.PetID,
 			StartDate: req.StartDate,
 			EndDate:   req.EndDate,
+			Filter:    req.Filter,
+			Page:      req.Page,
 		}
 
-		logs, err := qh.Handle(c, q)
+		page, err := qh.Handle(c, q)
 		if err != nil {
 			return ListHealthLogsByPetResponse{Err: err}, nil
 		}
 
-		return ListHealthLogsByPetResponse{HealthLogs: logs}, nil
+		return ListHealthLogsByPetResponse{HealthLogs: page.Items, PageInfo: newPageInfo(page)}, nil
 	}
 }
 
@@ -156,13 +182,16 @@ func MakeUpdateHealthLogEndpoint(ch *command.UpdateHealthLogHandler) endpoint.En
 		req := request.(UpdateHealthLogRequest)
 
 		cmd := command.UpdateHealthLogCommand{
-			ID:             req.ID,
-			PetID:          req.PetID,
-			Date:           req.Date,
-			WeightKg:       req.WeightKg,
-			FoodGram:       req.FoodGram,
-			LitterNotes:    req.LitterNotes,
-			BehaviourNotes: req.BehaviourNotes,
+			ID:                 req.ID,
+			PetID:              req.PetID,
+			Date:               req.Date,
+			WeightKg:           req.WeightKg,
+			FoodGram:           req.FoodGram,
+			LitterNotes:        req.LitterNotes,
+			BehaviourNotes:     req.BehaviourNotes,
+			HealthObservations: req.HealthObservations,
+
+			ExpectedVersion: req.ExpectedVersion,
 		}
 
 		log, err := ch.Handle(c, cmd)
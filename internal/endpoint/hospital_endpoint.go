@@ -109,6 +109,9 @@ type SearchHospitalsResponse struct {
 
 func (r SearchHospitalsResponse) Failed() error { return r.Err }
 
+// TotalCount 回傳搜尋結果的總筆數，供 X-Total-Count 標頭使用
+func (r SearchHospitalsResponse) TotalCount() int { return int(r.Total) }
+
 func MakeSearchHospitalsEndpoint(h *query.SearchHospitalsHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(SearchHospitalsRequest)
@@ -0,0 +1,225 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// HouseholdEndpoints 聚合所有家庭相關的 endpoints
+type HouseholdEndpoints struct {
+	CreateHouseholdEndpoint  endpoint.Endpoint
+	GetHouseholdEndpoint     endpoint.Endpoint
+	ListHouseholdsEndpoint   endpoint.Endpoint
+	InviteMemberEndpoint     endpoint.Endpoint
+	AcceptInvitationEndpoint endpoint.Endpoint
+	UpdateMemberEndpoint     endpoint.Endpoint
+	RemoveMemberEndpoint     endpoint.Endpoint
+}
+
+// MakeHouseholdEndpoints 建立家庭 endpoints
+func MakeHouseholdEndpoints(
+	ch *command.CreateHouseholdHandler,
+	ih *command.InviteHouseholdMemberHandler,
+	ah *command.AcceptHouseholdInvitationHandler,
+	uh *command.UpdateHouseholdMemberHandler,
+	rh *command.RemoveHouseholdMemberHandler,
+	gh *query.GetHouseholdByIDHandler,
+	lh *query.ListHouseholdsHandler,
+) HouseholdEndpoints {
+	return HouseholdEndpoints{
+		CreateHouseholdEndpoint:  MakeCreateHouseholdEndpoint(ch),
+		GetHouseholdEndpoint:     MakeGetHouseholdEndpoint(gh),
+		ListHouseholdsEndpoint:   MakeListHouseholdsEndpoint(lh),
+		InviteMemberEndpoint:     MakeInviteHouseholdMemberEndpoint(ih),
+		AcceptInvitationEndpoint: MakeAcceptHouseholdInvitationEndpoint(ah),
+		UpdateMemberEndpoint:     MakeUpdateHouseholdMemberEndpoint(uh),
+		RemoveMemberEndpoint:     MakeRemoveHouseholdMemberEndpoint(rh),
+	}
+}
+
+// HouseholdResponse 單一家庭的回應結構
+type HouseholdResponse struct {
+	Household *model.Household `json:"household,omitempty"`
+	Err       error            `json:"error,omitempty"`
+}
+
+func (r HouseholdResponse) Failed() error { return r.Err }
+
+// CreateHouseholdRequest 建立家庭的請求結構
+type CreateHouseholdRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// MakeCreateHouseholdEndpoint 建立新增家庭的 endpoint
+func MakeCreateHouseholdEndpoint(h *command.CreateHouseholdHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateHouseholdRequest)
+
+		household, err := h.Handle(c, command.CreateHouseholdCommand{Name: req.Name})
+		if err != nil {
+			return HouseholdResponse{Err: err}, nil
+		}
+		return HouseholdResponse{Household: household}, nil
+	}
+}
+
+// GetHouseholdRequest 取得家庭的請求結構
+type GetHouseholdRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetHouseholdEndpoint 建立取得家庭的 endpoint
+func MakeGetHouseholdEndpoint(h *query.GetHouseholdByIDHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetHouseholdRequest)
+
+		household, err := h.Handle(c, query.GetHouseholdByIDQuery{ID: req.ID})
+		if err != nil {
+			return HouseholdResponse{Err: err}, nil
+		}
+		return HouseholdResponse{Household: household}, nil
+	}
+}
+
+// ListHouseholdsRequest 列出家庭的請求結構
+type ListHouseholdsRequest struct {
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListHouseholdsResponse 列出家庭的回應結構
+type ListHouseholdsResponse struct {
+	Households []*model.Household `json:"households"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListHouseholdsResponse) Failed() error { return r.Err }
+
+// MakeListHouseholdsEndpoint 建立列出使用者所屬家庭的 endpoint
+func MakeListHouseholdsEndpoint(h *query.ListHouseholdsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListHouseholdsRequest)
+
+		page, err := h.Handle(c, query.ListHouseholdsQuery{Page: req.Page})
+		if err != nil {
+			return ListHouseholdsResponse{Err: err}, nil
+		}
+		return ListHouseholdsResponse{Households: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// InviteHouseholdMemberRequest 邀請家庭成員的請求結構
+// member_expires_at 為接受邀請後的成員到期時間，sitter 必填
+type InviteHouseholdMemberRequest struct {
+	HouseholdID     string              `json:"household_id"`
+	Role            model.HouseholdRole `json:"role" binding:"required"`
+	MemberExpiresAt *time.Time          `json:"member_expires_at,omitempty"`
+}
+
+// InviteHouseholdMemberResponse 邀請家庭成員的回應結構，token 只會回傳這一次
+type InviteHouseholdMemberResponse struct {
+	Token      string                     `json:"token,omitempty"`
+	Invitation *model.HouseholdInvitation `json:"invitation,omitempty"`
+	Err        error                      `json:"error,omitempty"`
+}
+
+func (r InviteHouseholdMemberResponse) Failed() error { return r.Err }
+
+// MakeInviteHouseholdMemberEndpoint 建立邀請家庭成員的 endpoint
+func MakeInviteHouseholdMemberEndpoint(h *command.InviteHouseholdMemberHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(InviteHouseholdMemberRequest)
+		cmd := command.InviteHouseholdMemberCommand{
+			HouseholdID:     req.HouseholdID,
+			Role:            req.Role,
+			MemberExpiresAt: req.MemberExpiresAt,
+		}
+
+		result, err := h.Handle(c, cmd)
+		if err != nil {
+			return InviteHouseholdMemberResponse{Err: err}, nil
+		}
+		return InviteHouseholdMemberResponse{Token: result.Token, Invitation: &result.Invitation}, nil
+	}
+}
+
+// AcceptHouseholdInvitationRequest 接受家庭邀請的請求結構
+type AcceptHouseholdInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// MakeAcceptHouseholdInvitationEndpoint 建立接受家庭邀請的 endpoint
+func MakeAcceptHouseholdInvitationEndpoint(h *command.AcceptHouseholdInvitationHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(AcceptHouseholdInvitationRequest)
+
+		household, err := h.Handle(c, command.AcceptHouseholdInvitationCommand{Token: req.Token})
+		if err != nil {
+			return HouseholdResponse{Err: err}, nil
+		}
+		return HouseholdResponse{Household: household}, nil
+	}
+}
+
+// UpdateHouseholdMemberRequest 調整家庭成員的請求結構
+type UpdateHouseholdMemberRequest struct {
+	HouseholdID string              `json:"household_id"`
+	UserID      string              `json:"user_id"`
+	Role        model.HouseholdRole `json:"role" binding:"required"`
+	ExpiresAt   *time.Time          `json:"expires_at,omitempty"`
+}
+
+// MakeUpdateHouseholdMemberEndpoint 建立調整家庭成員的 endpoint
+func MakeUpdateHouseholdMemberEndpoint(h *command.UpdateHouseholdMemberHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateHouseholdMemberRequest)
+		cmd := command.UpdateHouseholdMemberCommand{
+			HouseholdID: req.HouseholdID,
+			UserID:      req.UserID,
+			Role:        req.Role,
+			ExpiresAt:   req.ExpiresAt,
+		}
+
+		household, err := h.Handle(c, cmd)
+		if err != nil {
+			return HouseholdResponse{Err: err}, nil
+		}
+		return HouseholdResponse{Household: household}, nil
+	}
+}
+
+// RemoveHouseholdMemberRequest 移除家庭成員的請求結構
+type RemoveHouseholdMemberRequest struct {
+	HouseholdID string `json:"household_id"`
+	UserID      string `json:"user_id"`
+}
+
+// RemoveHouseholdMemberResponse 移除家庭成員的回應結構
+type RemoveHouseholdMemberResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r RemoveHouseholdMemberResponse) Failed() error { return r.Err }
+
+// MakeRemoveHouseholdMemberEndpoint 建立移除家庭成員的 endpoint
+func MakeRemoveHouseholdMemberEndpoint(h *command.RemoveHouseholdMemberHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(RemoveHouseholdMemberRequest)
+		cmd := command.RemoveHouseholdMemberCommand{
+			HouseholdID: req.HouseholdID,
+			UserID:      req.UserID,
+		}
+
+		if err := h.Handle(c, cmd); err != nil {
+			return RemoveHouseholdMemberResponse{Err: err}, nil
+		}
+		return RemoveHouseholdMemberResponse{}, nil
+	}
+}
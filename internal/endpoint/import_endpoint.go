@@ -0,0 +1,61 @@
+package endpoint
+
+import (
+	"context"
+	"io"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+)
+
+// ImportEndpoints 聚合批次匯入相關的 endpoints
+type ImportEndpoints struct {
+	ImportRecordsEndpoint endpoint.Endpoint
+}
+
+// MakeImportEndpoints 建立批次匯入 endpoints
+func MakeImportEndpoints(h *command.ImportRecordsHandler) ImportEndpoints {
+	return ImportEndpoints{
+		ImportRecordsEndpoint: MakeImportRecordsEndpoint(h),
+	}
+}
+
+// ImportRecordsRequest 批次匯入的請求結構，由 multipart 表單解析而來
+type ImportRecordsRequest struct {
+	Entity  model.ImportEntity
+	Format  model.ImportFormat
+	PetID   string
+	Mapping map[string]string
+	DryRun  bool
+	Content io.Reader
+}
+
+// ImportRecordsResponse 批次匯入的回應結構，包含每列的匯入結果
+type ImportRecordsResponse struct {
+	Report *model.ImportReport `json:"report,omitempty"`
+	Err    error               `json:"error,omitempty"`
+}
+
+func (r ImportRecordsResponse) Failed() error { return r.Err }
+
+// MakeImportRecordsEndpoint 建立批次匯入的 endpoint
+func MakeImportRecordsEndpoint(h *command.ImportRecordsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ImportRecordsRequest)
+
+		report, err := h.Handle(c, command.ImportRecordsCommand{
+			Entity:  req.Entity,
+			Format:  req.Format,
+			PetID:   req.PetID,
+			Mapping: req.Mapping,
+			DryRun:  req.DryRun,
+			Content: req.Content,
+		})
+		if err != nil {
+			return ImportRecordsResponse{Err: err}, nil
+		}
+		return ImportRecordsResponse{Report: report}, nil
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
 	"github.com/go-kit/kit/endpoint"
@@ -29,6 +30,10 @@ type CreateMedicalRecordResponse struct {
 
 func (r CreateMedicalRecordResponse) Failed() error { return r.Err }
 
+func (r CreateMedicalRecordResponse) ResourceVersion() (int, bool) {
+	return medicalRecordVersion(r.MedicalRecord)
+}
+
 // GetMedicalRecordRequest 取得醫療記錄的請求結構
 type GetMedicalRecordRequest struct {
 	ID string `json:"id"`
@@ -42,17 +47,24 @@ type GetMedicalRecordResponse struct {
 
 func (r GetMedicalRecordResponse) Failed() error { return r.Err }
 
+func (r GetMedicalRecordResponse) ResourceVersion() (int, bool) {
+	return medicalRecordVersion(r.MedicalRecord)
+}
+
 // ListMedicalRecordsByPetRequest 依寵物 ID 列出醫療記錄的請求結構
 type ListMedicalRecordsByPetRequest struct {
 	PetID     string    `json:"pet_id"`
 	StartDate time.Time `json:"start_date"`
 	EndDate   time.Time `json:"end_date"`
+
+	Page repository.PageRequest `json:"-"`
 }
 
 // ListMedicalRecordsByPetResponse 依寵物 ID 列出醫療記錄的回應結構
 type ListMedicalRecordsByPetResponse struct {
 	MedicalRecords []*model.MedicalRecord `json:"medical_records,omitempty"`
-	Err            error                  `json:"error,omitempty"`
+	PageInfo
+	Err error `json:"error,omitempty"`
 }
 
 func (r ListMedicalRecordsByPetResponse) Failed() error { return r.Err }
@@ -66,6 +78,9 @@ type UpdateMedicalRecordRequest struct {
 	Date        time.Time               `json:"date" binding:"required"`
 	NextDueDate *time.Time              `json:"next_due_date,omitempty"`
 	Dosage      string                  `json:"dosage,omitempty"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdateMedicalRecordResponse 更新醫療記錄的回應結構
@@ -76,6 +91,18 @@ type UpdateMedicalRecordResponse struct {
 
 func (r UpdateMedicalRecordResponse) Failed() error { return r.Err }
 
+func (r UpdateMedicalRecordResponse) ResourceVersion() (int, bool) {
+	return medicalRecordVersion(r.MedicalRecord)
+}
+
+// medicalRecordVersion 取得醫療記錄版本，供 transport 輸出 ETag
+func medicalRecordVersion(record *model.MedicalRecord) (int, bool) {
+	if record == nil {
+		return 0, false
+	}
+	return record.Version, true
+}
+
 // DeleteMedicalRecordRequest 刪除醫療記錄的請求結構
 type DeleteMedicalRecordRequest struct {
 	ID string `json:"id"`
@@ -158,11 +185,11 @@ func MakeListMedicalRecordsByPetEndpoint(handler *query.ListMedicalRecordsByPetH
 		if !ok {
 			return ListMedicalRecordsByPetResponse{Err: errors.New("invalid request type")}, nil
 		}
-		medicalRecords, err := handler.Handle(ctx, req.PetID, req.StartDate, req.EndDate)
+		page, err := handler.Handle(ctx, req.PetID, req.StartDate, req.EndDate, req.Page)
 		if err != nil {
 			return ListMedicalRecordsByPetResponse{Err: err}, nil
 		}
-		return ListMedicalRecordsByPetResponse{MedicalRecords: medicalRecords}, nil
+		return ListMedicalRecordsByPetResponse{MedicalRecords: page.Items, PageInfo: newPageInfo(page)}, nil
 	}
 }
 
@@ -181,7 +208,7 @@ func MakeUpdateMedicalRecordEndpoint(handler *command.UpdateMedicalRecordHandler
 			Dosage:      req.Dosage,
 		}
 
-		err := handler.Handle(ctx, medicalRecord)
+		err := handler.Handle(ctx, medicalRecord, req.ExpectedVersion)
 		if err != nil {
 			return UpdateMedicalRecordResponse{Err: err}, nil
 		}
@@ -0,0 +1,328 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// MedicationEndpoints 聚合所有用藥療程相關的 endpoints
+type MedicationEndpoints struct {
+	CreateMedicationRegimenEndpoint endpoint.Endpoint
+	UpdateMedicationRegimenEndpoint endpoint.Endpoint
+	DeleteMedicationRegimenEndpoint endpoint.Endpoint
+	GetMedicationRegimenEndpoint    endpoint.Endpoint
+	ListMedicationRegimensEndpoint  endpoint.Endpoint
+	RecordDoseEndpoint              endpoint.Endpoint
+	ListDoseEventsEndpoint          endpoint.Endpoint
+	GetMedicationAdherenceEndpoint  endpoint.Endpoint
+}
+
+// MakeMedicationEndpoints 建立用藥療程 endpoints
+func MakeMedicationEndpoints(
+	ch *command.CreateMedicationRegimenHandler,
+	uh *command.UpdateMedicationRegimenHandler,
+	dh *command.DeleteMedicationRegimenHandler,
+	rh *command.RecordDoseHandler,
+	gh *query.GetMedicationRegimenHandler,
+	lh *query.ListMedicationRegimensHandler,
+	eh *query.ListDoseEventsHandler,
+	ah *query.GetMedicationAdherenceHandler,
+) MedicationEndpoints {
+	return MedicationEndpoints{
+		CreateMedicationRegimenEndpoint: MakeCreateMedicationRegimenEndpoint(ch),
+		UpdateMedicationRegimenEndpoint: MakeUpdateMedicationRegimenEndpoint(uh),
+		DeleteMedicationRegimenEndpoint: MakeDeleteMedicationRegimenEndpoint(dh),
+		GetMedicationRegimenEndpoint:    MakeGetMedicationRegimenEndpoint(gh),
+		ListMedicationRegimensEndpoint:  MakeListMedicationRegimensEndpoint(lh),
+		RecordDoseEndpoint:              MakeRecordDoseEndpoint(rh),
+		ListDoseEventsEndpoint:          MakeListDoseEventsEndpoint(eh),
+		GetMedicationAdherenceEndpoint:  MakeGetMedicationAdherenceEndpoint(ah),
+	}
+}
+
+// MedicationRegimenResponse 單一用藥療程的回應結構
+type MedicationRegimenResponse struct {
+	MedicationRegimen *model.MedicationRegimen `json:"medication_regimen,omitempty"`
+	Err               error                    `json:"error,omitempty"`
+}
+
+func (r MedicationRegimenResponse) Failed() error { return r.Err }
+
+func (r MedicationRegimenResponse) ResourceVersion() (int, bool) {
+	if r.MedicationRegimen == nil {
+		return 0, false
+	}
+	return r.MedicationRegimen.Version, true
+}
+
+// CreateMedicationRegimenRequest 建立用藥療程的請求結構
+// schedule.frequency 為 daily 或 weekly；interval 留空時為 1；schedule.times 為給藥時間 (HH:MM)；
+// timezone 為解讀給藥時間的 IANA 時區，留空時為 UTC
+type CreateMedicationRegimenRequest struct {
+	PetID           string             `json:"pet_id" binding:"required"`
+	Drug            string             `json:"drug" binding:"required"`
+	Dose            float64            `json:"dose" binding:"required"`
+	Unit            string             `json:"unit" binding:"required"`
+	Schedule        model.DoseSchedule `json:"schedule" binding:"required"`
+	Timezone        string             `json:"timezone,omitempty"`
+	StartDate       time.Time          `json:"start_date" binding:"required"`
+	EndDate         *time.Time         `json:"end_date,omitempty"`
+	HospitalID      string             `json:"hospital_id,omitempty"`
+	MedicalRecordID string             `json:"medical_record_id,omitempty"`
+	Notes           string             `json:"notes,omitempty"`
+}
+
+// MakeCreateMedicationRegimenEndpoint 建立新增用藥療程的 endpoint
+func MakeCreateMedicationRegimenEndpoint(h *command.CreateMedicationRegimenHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateMedicationRegimenRequest)
+		cmd := command.CreateMedicationRegimenCommand{
+			PetID:           req.PetID,
+			Drug:            req.Drug,
+			Dose:            req.Dose,
+			Unit:            req.Unit,
+			Schedule:        req.Schedule,
+			Timezone:        req.Timezone,
+			StartDate:       req.StartDate,
+			EndDate:         req.EndDate,
+			HospitalID:      req.HospitalID,
+			MedicalRecordID: req.MedicalRecordID,
+			Notes:           req.Notes,
+		}
+
+		regimen, err := h.Handle(c, cmd)
+		if err != nil {
+			return MedicationRegimenResponse{Err: err}, nil
+		}
+		return MedicationRegimenResponse{MedicationRegimen: regimen}, nil
+	}
+}
+
+// UpdateMedicationRegimenRequest 編輯用藥療程的請求結構，所屬寵物不可變更
+type UpdateMedicationRegimenRequest struct {
+	ID              string             `json:"-"`
+	Drug            string             `json:"drug" binding:"required"`
+	Dose            float64            `json:"dose" binding:"required"`
+	Unit            string             `json:"unit" binding:"required"`
+	Schedule        model.DoseSchedule `json:"schedule" binding:"required"`
+	Timezone        string             `json:"timezone,omitempty"`
+	StartDate       time.Time          `json:"start_date" binding:"required"`
+	EndDate         *time.Time         `json:"end_date,omitempty"`
+	HospitalID      string             `json:"hospital_id,omitempty"`
+	MedicalRecordID string             `json:"medical_record_id,omitempty"`
+	Notes           string             `json:"notes,omitempty"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
+}
+
+// MakeUpdateMedicationRegimenEndpoint 建立編輯用藥療程的 endpoint
+func MakeUpdateMedicationRegimenEndpoint(h *command.UpdateMedicationRegimenHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateMedicationRegimenRequest)
+		cmd := command.UpdateMedicationRegimenCommand{
+			ID:              req.ID,
+			Drug:            req.Drug,
+			Dose:            req.Dose,
+			Unit:            req.Unit,
+			Schedule:        req.Schedule,
+			Timezone:        req.Timezone,
+			StartDate:       req.StartDate,
+			EndDate:         req.EndDate,
+			HospitalID:      req.HospitalID,
+			MedicalRecordID: req.MedicalRecordID,
+			Notes:           req.Notes,
+
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		regimen, err := h.Handle(c, cmd)
+		if err != nil {
+			return MedicationRegimenResponse{Err: err}, nil
+		}
+		return MedicationRegimenResponse{MedicationRegimen: regimen}, nil
+	}
+}
+
+// DeleteMedicationRegimenRequest 刪除用藥療程的請求結構
+type DeleteMedicationRegimenRequest struct {
+	ID string `json:"id"`
+}
+
+// DeleteMedicationRegimenResponse 刪除用藥療程的回應結構
+type DeleteMedicationRegimenResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r DeleteMedicationRegimenResponse) Failed() error { return r.Err }
+
+// MakeDeleteMedicationRegimenEndpoint 建立刪除用藥療程的 endpoint
+func MakeDeleteMedicationRegimenEndpoint(h *command.DeleteMedicationRegimenHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteMedicationRegimenRequest)
+
+		if err := h.Handle(c, command.DeleteMedicationRegimenCommand{ID: req.ID}); err != nil {
+			return DeleteMedicationRegimenResponse{Err: err}, nil
+		}
+		return DeleteMedicationRegimenResponse{}, nil
+	}
+}
+
+// GetMedicationRegimenRequest 取得用藥療程的請求結構
+type GetMedicationRegimenRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetMedicationRegimenEndpoint 建立取得用藥療程的 endpoint
+func MakeGetMedicationRegimenEndpoint(h *query.GetMedicationRegimenHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetMedicationRegimenRequest)
+
+		regimen, err := h.Handle(c, query.GetMedicationRegimenQuery{ID: req.ID})
+		if err != nil {
+			return MedicationRegimenResponse{Err: err}, nil
+		}
+		return MedicationRegimenResponse{MedicationRegimen: regimen}, nil
+	}
+}
+
+// ListMedicationRegimensRequest 查詢用藥療程清單的請求結構
+type ListMedicationRegimensRequest struct {
+	PetID string                 `form:"pet_id"`
+	Page  repository.PageRequest `json:"-"`
+}
+
+// ListMedicationRegimensResponse 用藥療程清單的回應結構
+type ListMedicationRegimensResponse struct {
+	MedicationRegimens []*model.MedicationRegimen `json:"medication_regimens"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListMedicationRegimensResponse) Failed() error { return r.Err }
+
+// MakeListMedicationRegimensEndpoint 建立查詢用藥療程清單的 endpoint
+func MakeListMedicationRegimensEndpoint(h *query.ListMedicationRegimensHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListMedicationRegimensRequest)
+
+		page, err := h.Handle(c, query.ListMedicationRegimensQuery{PetID: req.PetID, Page: req.Page})
+		if err != nil {
+			return ListMedicationRegimensResponse{Err: err}, nil
+		}
+		return ListMedicationRegimensResponse{MedicationRegimens: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// RecordDoseRequest 勾選單次給藥的請求結構
+// status 為 taken 或 skipped；taken_at 留空時以排定時間記錄
+type RecordDoseRequest struct {
+	RegimenID   string           `json:"-"`
+	ScheduledAt time.Time        `json:"scheduled_at" binding:"required"`
+	Status      model.DoseStatus `json:"status" binding:"required"`
+	TakenAt     *time.Time       `json:"taken_at,omitempty"`
+	Note        string           `json:"note,omitempty"`
+}
+
+// DoseEventResponse 單次給藥的回應結構
+type DoseEventResponse struct {
+	DoseEvent *model.DoseEvent `json:"dose_event,omitempty"`
+	Err       error            `json:"error,omitempty"`
+}
+
+func (r DoseEventResponse) Failed() error { return r.Err }
+
+// MakeRecordDoseEndpoint 建立勾選給藥的 endpoint
+func MakeRecordDoseEndpoint(h *command.RecordDoseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(RecordDoseRequest)
+		cmd := command.RecordDoseCommand{
+			RegimenID:   req.RegimenID,
+			ScheduledAt: req.ScheduledAt,
+			Status:      req.Status,
+			TakenAt:     req.TakenAt,
+			Note:        req.Note,
+		}
+
+		event, err := h.Handle(c, cmd)
+		if err != nil {
+			return DoseEventResponse{Err: err}, nil
+		}
+		return DoseEventResponse{DoseEvent: event}, nil
+	}
+}
+
+// ListDoseEventsRequest 查詢療程給藥紀錄的請求結構
+type ListDoseEventsRequest struct {
+	RegimenID string    `json:"-"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// ListDoseEventsResponse 療程給藥紀錄的回應結構
+type ListDoseEventsResponse struct {
+	DoseEvents []*model.DoseEvent `json:"dose_events"`
+	Err        error              `json:"error,omitempty"`
+}
+
+func (r ListDoseEventsResponse) Failed() error { return r.Err }
+
+// MakeListDoseEventsEndpoint 建立查詢給藥紀錄的 endpoint
+func MakeListDoseEventsEndpoint(h *query.ListDoseEventsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListDoseEventsRequest)
+		q := query.ListDoseEventsQuery{
+			RegimenID: req.RegimenID,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+		}
+
+		events, err := h.Handle(c, q)
+		if err != nil {
+			return ListDoseEventsResponse{Err: err}, nil
+		}
+		return ListDoseEventsResponse{DoseEvents: events}, nil
+	}
+}
+
+// GetMedicationAdherenceRequest 查詢療程服藥率的請求結構
+type GetMedicationAdherenceRequest struct {
+	RegimenID string              `json:"-"`
+	StartDate time.Time           `json:"start_date"`
+	EndDate   time.Time           `json:"end_date"`
+	Interval  model.TrendInterval `json:"interval"`
+}
+
+// GetMedicationAdherenceResponse 療程服藥率的回應結構
+type GetMedicationAdherenceResponse struct {
+	Adherence *model.MedicationAdherence `json:"adherence,omitempty"`
+	Err       error                      `json:"error,omitempty"`
+}
+
+func (r GetMedicationAdherenceResponse) Failed() error { return r.Err }
+
+// MakeGetMedicationAdherenceEndpoint 建立查詢服藥率的 endpoint
+func MakeGetMedicationAdherenceEndpoint(h *query.GetMedicationAdherenceHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetMedicationAdherenceRequest)
+		q := query.GetMedicationAdherenceQuery{
+			RegimenID: req.RegimenID,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			Interval:  req.Interval,
+		}
+
+		adherence, err := h.Handle(c, q)
+		if err != nil {
+			return GetMedicationAdherenceResponse{Err: err}, nil
+		}
+		return GetMedicationAdherenceResponse{Adherence: adherence}, nil
+	}
+}
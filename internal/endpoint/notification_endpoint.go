@@ -0,0 +1,65 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// NotificationEndpoints 聚合所有通知設定相關的 endpoints
+type NotificationEndpoints struct {
+	GetPreferenceEndpoint    endpoint.Endpoint
+	UpdatePreferenceEndpoint endpoint.Endpoint
+}
+
+// MakeNotificationEndpoints 建立通知設定 endpoints
+func MakeNotificationEndpoints(
+	gh *query.GetNotificationPreferenceHandler,
+	uh *command.UpdateNotificationPreferenceHandler,
+) NotificationEndpoints {
+	return NotificationEndpoints{
+		GetPreferenceEndpoint:    MakeGetNotificationPreferenceEndpoint(gh),
+		UpdatePreferenceEndpoint: MakeUpdateNotificationPreferenceEndpoint(uh),
+	}
+}
+
+// NotificationPreferenceResponse 通知偏好的回應結構
+type NotificationPreferenceResponse struct {
+	Preference *model.NotificationPreference `json:"preference,omitempty"`
+	Err        error                         `json:"error,omitempty"`
+}
+
+func (r NotificationPreferenceResponse) Failed() error { return r.Err }
+
+// MakeGetNotificationPreferenceEndpoint 建立取得通知偏好的 endpoint
+func MakeGetNotificationPreferenceEndpoint(h *query.GetNotificationPreferenceHandler) endpoint.Endpoint {
+	return func(c context.Context, _ interface{}) (interface{}, error) {
+		pref, err := h.Handle(c)
+		if err != nil {
+			return NotificationPreferenceResponse{Err: err}, nil
+		}
+		return NotificationPreferenceResponse{Preference: pref}, nil
+	}
+}
+
+// UpdateNotificationPreferenceRequest 更新通知偏好的請求結構
+type UpdateNotificationPreferenceRequest struct {
+	Channels []model.NotificationChannelSetting `json:"channels"`
+}
+
+// MakeUpdateNotificationPreferenceEndpoint 建立更新通知偏好的 endpoint
+func MakeUpdateNotificationPreferenceEndpoint(h *command.UpdateNotificationPreferenceHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateNotificationPreferenceRequest)
+
+		pref, err := h.Handle(c, command.UpdateNotificationPreferenceCommand{Channels: req.Channels})
+		if err != nil {
+			return NotificationPreferenceResponse{Err: err}, nil
+		}
+		return NotificationPreferenceResponse{Preference: pref}, nil
+	}
+}
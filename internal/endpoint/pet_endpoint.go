@@ -2,33 +2,49 @@ package endpoint
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/go-kit/kit/endpoint"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/internal/usecase/command"
 	"github.com/blackhorseya/petlog/internal/usecase/query"
 )
 
 // PetEndpoints collects all of the endpoints that compose a pet service.
 type PetEndpoints struct {
-	CreatePetEndpoint endpoint.Endpoint
-	UpdatePetEndpoint endpoint.Endpoint
-	DeletePetEndpoint endpoint.Endpoint
-	GetPetEndpoint    endpoint.Endpoint
-	ListPetsEndpoint  endpoint.Endpoint
+	CreatePetEndpoint   endpoint.Endpoint
+	UpdatePetEndpoint   endpoint.Endpoint
+	DeletePetEndpoint   endpoint.Endpoint
+	GetPetEndpoint      endpoint.Endpoint
+	ListPetsEndpoint    endpoint.Endpoint
+	RestorePetEndpoint  endpoint.Endpoint
+	ListHistoryEndpoint endpoint.Endpoint
+
+	UploadAvatarEndpoint endpoint.Endpoint
+	GetAvatarEndpoint    endpoint.Endpoint
+
+	ReportEndpoint endpoint.Endpoint
 }
 
 // MakePetEndpoints returns a PetEndpoints struct where each endpoint invokes
 // the corresponding method on the provided service.
-func MakePetEndpoints(ch *command.CreatePetHandler, uh *command.UpdatePetHandler, dh *command.DeletePetHandler, qh *query.GetPetByIDHandler, lh *query.ListPetsByOwnerHandler) PetEndpoints {
+func MakePetEndpoints(ch *command.CreatePetHandler, uh *command.UpdatePetHandler, dh *command.DeletePetHandler, qh *query.GetPetByIDHandler, lh *query.ListPetsByOwnerHandler, rh *command.RestorePetHandler, hh *query.ListPetHistoryHandler, ah *command.UploadPetAvatarHandler, gah *query.GetPetAvatarHandler, rph *query.GetPetReportHandler) PetEndpoints {
 	return PetEndpoints{
-		CreatePetEndpoint: MakeCreatePetEndpoint(ch),
-		UpdatePetEndpoint: MakeUpdatePetEndpoint(uh),
-		DeletePetEndpoint: MakeDeletePetEndpoint(dh),
-		GetPetEndpoint:    MakeGetPetEndpoint(qh),
-		ListPetsEndpoint:  MakeListPetsEndpoint(lh),
+		CreatePetEndpoint:   MakeCreatePetEndpoint(ch),
+		UpdatePetEndpoint:   MakeUpdatePetEndpoint(uh),
+		DeletePetEndpoint:   MakeDeletePetEndpoint(dh),
+		GetPetEndpoint:      MakeGetPetEndpoint(qh),
+		ListPetsEndpoint:    MakeListPetsEndpoint(lh),
+		RestorePetEndpoint:  MakeRestorePetEndpoint(rh),
+		ListHistoryEndpoint: MakeListPetHistoryEndpoint(hh),
+
+		UploadAvatarEndpoint: MakeUploadPetAvatarEndpoint(ah),
+		GetAvatarEndpoint:    MakeGetPetAvatarEndpoint(gah),
+
+		ReportEndpoint: MakeGetPetReportEndpoint(rph),
 	}
 }
 
@@ -39,6 +55,10 @@ type CreatePetRequest struct {
 	DOB         time.Time `json:"dob"`
 	Breed       string    `json:"breed"`
 	MicrochipID string    `json:"microchip_id"`
+	HouseholdID string    `json:"household_id,omitempty"`
+
+	// WeightAlert overrides the default weight change thresholds; omit to use the defaults.
+	WeightAlert *model.WeightAlertThreshold `json:"weight_alert,omitempty"`
 }
 type CreatePetResponse struct {
 	Pet *model.Pet `json:"pet"`
@@ -47,6 +67,8 @@ type CreatePetResponse struct {
 
 func (r CreatePetResponse) Failed() error { return r.Err }
 
+func (r CreatePetResponse) ResourceVersion() (int, bool) { return petVersion(r.Pet) }
+
 func MakeCreatePetEndpoint(h *command.CreatePetHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreatePetRequest)
@@ -56,6 +78,8 @@ func MakeCreatePetEndpoint(h *command.CreatePetHandler) endpoint.Endpoint {
 			DOB:         req.DOB,
 			Breed:       req.Breed,
 			MicrochipID: req.MicrochipID,
+			HouseholdID: req.HouseholdID,
+			WeightAlert: req.WeightAlert,
 		}
 
 		p, err := h.Handle(c, cmd)
@@ -74,13 +98,26 @@ type UpdatePetRequest struct {
 	DOB         time.Time `json:"dob"`
 	Breed       string    `json:"breed"`
 	MicrochipID string    `json:"microchip_id"`
+
+	// HouseholdID moves the pet to another household; omit to keep the current one, "" to remove it.
+	HouseholdID *string `json:"household_id,omitempty"`
+
+	// WeightAlert overrides the default weight change thresholds; omit to keep the stored thresholds,
+	// send an empty object to clear them and use the defaults.
+	WeightAlert *model.WeightAlertThreshold `json:"weight_alert,omitempty"`
+
+	// ExpectedVersion is decoded from the If-Match header.
+	ExpectedVersion *int `json:"-"`
 }
 type UpdatePetResponse struct {
-	Err error `json:"error,omitempty"`
+	Pet *model.Pet `json:"pet,omitempty"`
+	Err error      `json:"error,omitempty"`
 }
 
 func (r UpdatePetResponse) Failed() error { return r.Err }
 
+func (r UpdatePetResponse) ResourceVersion() (int, bool) { return petVersion(r.Pet) }
+
 func MakeUpdatePetEndpoint(h *command.UpdatePetHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(UpdatePetRequest)
@@ -91,19 +128,24 @@ func MakeUpdatePetEndpoint(h *command.UpdatePetHandler) endpoint.Endpoint {
 			DOB:         req.DOB,
 			Breed:       req.Breed,
 			MicrochipID: req.MicrochipID,
+			HouseholdID: req.HouseholdID,
+			WeightAlert: req.WeightAlert,
+
+			ExpectedVersion: req.ExpectedVersion,
 		}
 
-		err := h.Handle(c, cmd)
+		p, err := h.Handle(c, cmd)
 		if err != nil {
 			return UpdatePetResponse{Err: err}, nil
 		}
-		return UpdatePetResponse{Err: nil}, nil
+		return UpdatePetResponse{Pet: p, Err: nil}, nil
 	}
 }
 
 // DeletePet
 type DeletePetRequest struct {
-	ID string
+	ID    string
+	Purge bool
 }
 type DeletePetResponse struct {
 	Err error `json:"error,omitempty"`
@@ -114,7 +156,7 @@ func (r DeletePetResponse) Failed() error { return r.Err }
 func MakeDeletePetEndpoint(h *command.DeletePetHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(DeletePetRequest)
-		cmd := command.DeletePetCommand{ID: req.ID}
+		cmd := command.DeletePetCommand{ID: req.ID, Purge: req.Purge}
 
 		err := h.Handle(c, cmd)
 		if err != nil {
@@ -135,6 +177,8 @@ type GetPetResponse struct {
 
 func (r GetPetResponse) Failed() error { return r.Err }
 
+func (r GetPetResponse) ResourceVersion() (int, bool) { return petVersion(r.Pet) }
+
 func MakeGetPetEndpoint(h *query.GetPetByIDHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetPetRequest)
@@ -150,24 +194,180 @@ func MakeGetPetEndpoint(h *query.GetPetByIDHandler) endpoint.Endpoint {
 
 // ListPets
 type ListPetsRequest struct {
-	// Future pagination/filter fields go here
+	Page repository.PageRequest
 }
 type ListPetsResponse struct {
 	Pets []*model.Pet `json:"pets"`
-	Err  error        `json:"error,omitempty"`
+	PageInfo
+	Err error `json:"error,omitempty"`
 }
 
 func (r ListPetsResponse) Failed() error { return r.Err }
 
 func MakeListPetsEndpoint(h *query.ListPetsByOwnerHandler) endpoint.Endpoint {
 	return func(c context.Context, request interface{}) (interface{}, error) {
-		_ = request.(ListPetsRequest) // No fields in request for now
-		q := query.ListPetsByOwnerQuery{}
+		req := request.(ListPetsRequest)
+		q := query.ListPetsByOwnerQuery{Page: req.Page}
 
-		pets, err := h.Handle(c, q)
+		page, err := h.Handle(c, q)
 		if err != nil {
 			return ListPetsResponse{Err: err}, nil
 		}
-		return ListPetsResponse{Pets: pets, Err: nil}, nil
+		return ListPetsResponse{Pets: page.Items, PageInfo: newPageInfo(page), Err: nil}, nil
+	}
+}
+
+// RestorePet
+type RestorePetRequest struct {
+	ID string
+}
+type RestorePetResponse struct {
+	Pet *model.Pet `json:"pet"`
+	Err error      `json:"error,omitempty"`
+}
+
+func (r RestorePetResponse) Failed() error { return r.Err }
+
+func (r RestorePetResponse) ResourceVersion() (int, bool) { return petVersion(r.Pet) }
+
+func petVersion(p *model.Pet) (int, bool) {
+	if p == nil {
+		return 0, false
+	}
+	return p.Version, true
+}
+
+func MakeRestorePetEndpoint(h *command.RestorePetHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(RestorePetRequest)
+		cmd := command.RestorePetCommand{ID: req.ID}
+
+		p, err := h.Handle(c, cmd)
+		if err != nil {
+			return RestorePetResponse{Err: err}, nil
+		}
+		return RestorePetResponse{Pet: p, Err: nil}, nil
+	}
+}
+
+// ListPetHistory
+type ListPetHistoryRequest struct {
+	PetID      string
+	EntityType string
+	StartDate  time.Time
+	EndDate    time.Time
+	Page       repository.PageRequest
+}
+type ListPetHistoryResponse struct {
+	Entries []*model.AuditEntry `json:"entries"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListPetHistoryResponse) Failed() error { return r.Err }
+
+func MakeListPetHistoryEndpoint(h *query.ListPetHistoryHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListPetHistoryRequest)
+		q := query.ListPetHistoryQuery{
+			PetID:      req.PetID,
+			EntityType: model.AuditEntityType(req.EntityType),
+			StartDate:  req.StartDate,
+			EndDate:    req.EndDate,
+			Page:       req.Page,
+		}
+
+		page, err := h.Handle(c, q)
+		if err != nil {
+			return ListPetHistoryResponse{Err: err}, nil
+		}
+		return ListPetHistoryResponse{Entries: page.Items, PageInfo: newPageInfo(page), Err: nil}, nil
+	}
+}
+
+// UploadPetAvatar
+type UploadPetAvatarRequest struct {
+	ID      string
+	Content io.Reader
+
+	// ExpectedVersion is decoded from the If-Match header.
+	ExpectedVersion *int
+}
+type UploadPetAvatarResponse struct {
+	Pet *model.Pet `json:"pet,omitempty"`
+	Err error      `json:"error,omitempty"`
+}
+
+func (r UploadPetAvatarResponse) Failed() error { return r.Err }
+
+func (r UploadPetAvatarResponse) ResourceVersion() (int, bool) { return petVersion(r.Pet) }
+
+func MakeUploadPetAvatarEndpoint(h *command.UploadPetAvatarHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UploadPetAvatarRequest)
+		cmd := command.UploadPetAvatarCommand{
+			PetID:           req.ID,
+			Content:         req.Content,
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		p, err := h.Handle(c, cmd)
+		if err != nil {
+			return UploadPetAvatarResponse{Err: err}, nil
+		}
+		return UploadPetAvatarResponse{Pet: p, Err: nil}, nil
+	}
+}
+
+// GetPetAvatar
+type GetPetAvatarRequest struct {
+	Path string
+}
+type GetPetAvatarResponse struct {
+	URL string `json:"url,omitempty"`
+	Err error  `json:"error,omitempty"`
+}
+
+func (r GetPetAvatarResponse) Failed() error { return r.Err }
+
+func MakeGetPetAvatarEndpoint(h *query.GetPetAvatarHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetPetAvatarRequest)
+
+		url, err := h.Handle(c, query.GetPetAvatarQuery{Path: req.Path})
+		if err != nil {
+			return GetPetAvatarResponse{Err: err}, nil
+		}
+		return GetPetAvatarResponse{URL: url, Err: nil}, nil
+	}
+}
+
+// GetPetReport
+type GetPetReportRequest struct {
+	ID        string
+	StartDate time.Time
+	EndDate   time.Time
+}
+type GetPetReportResponse struct {
+	Report *model.RenderedReport `json:"-"`
+	Err    error                 `json:"error,omitempty"`
+}
+
+func (r GetPetReportResponse) Failed() error { return r.Err }
+
+func MakeGetPetReportEndpoint(h *query.GetPetReportHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetPetReportRequest)
+		q := query.GetPetReportQuery{
+			PetID:     req.ID,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+		}
+
+		report, err := h.Handle(c, q)
+		if err != nil {
+			return GetPetReportResponse{Err: err}, nil
+		}
+		return GetPetReportResponse{Report: report, Err: nil}, nil
 	}
 }
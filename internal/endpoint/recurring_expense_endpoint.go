@@ -0,0 +1,265 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// RecurringExpenseEndpoints 聚合所有週期性費用相關的 endpoints
+type RecurringExpenseEndpoints struct {
+	CreateRecurringExpenseEndpoint endpoint.Endpoint
+	UpdateRecurringExpenseEndpoint endpoint.Endpoint
+	DeleteRecurringExpenseEndpoint endpoint.Endpoint
+	PauseRecurringExpenseEndpoint  endpoint.Endpoint
+	SkipRecurringExpenseEndpoint   endpoint.Endpoint
+	GetRecurringExpenseEndpoint    endpoint.Endpoint
+	ListRecurringExpensesEndpoint  endpoint.Endpoint
+}
+
+// MakeRecurringExpenseEndpoints 建立週期性費用 endpoints
+func MakeRecurringExpenseEndpoints(
+	ch *command.CreateRecurringExpenseHandler,
+	uh *command.UpdateRecurringExpenseHandler,
+	dh *command.DeleteRecurringExpenseHandler,
+	ph *command.PauseRecurringExpenseHandler,
+	sh *command.SkipRecurringExpenseHandler,
+	gh *query.GetRecurringExpenseHandler,
+	lh *query.ListRecurringExpensesHandler,
+) RecurringExpenseEndpoints {
+	return RecurringExpenseEndpoints{
+		CreateRecurringExpenseEndpoint: MakeCreateRecurringExpenseEndpoint(ch),
+		UpdateRecurringExpenseEndpoint: MakeUpdateRecurringExpenseEndpoint(uh),
+		DeleteRecurringExpenseEndpoint: MakeDeleteRecurringExpenseEndpoint(dh),
+		PauseRecurringExpenseEndpoint:  MakePauseRecurringExpenseEndpoint(ph),
+		SkipRecurringExpenseEndpoint:   MakeSkipRecurringExpenseEndpoint(sh),
+		GetRecurringExpenseEndpoint:    MakeGetRecurringExpenseEndpoint(gh),
+		ListRecurringExpensesEndpoint:  MakeListRecurringExpensesEndpoint(lh),
+	}
+}
+
+// RecurringExpenseResponse 單一週期性費用的回應結構
+type RecurringExpenseResponse struct {
+	RecurringExpense *model.RecurringExpense `json:"recurring_expense,omitempty"`
+	Err              error                   `json:"error,omitempty"`
+}
+
+func (r RecurringExpenseResponse) Failed() error { return r.Err }
+
+func (r RecurringExpenseResponse) ResourceVersion() (int, bool) {
+	if r.RecurringExpense == nil {
+		return 0, false
+	}
+	return r.RecurringExpense.Version, true
+}
+
+// CreateRecurringExpenseRequest 建立週期性費用的請求結構
+// schedule.frequency 為 monthly 或 weekly；interval 留空時為 1；
+// monthly 的 day_of_month 留空時沿用開始日期的日，當月沒有該日時取月底
+type CreateRecurringExpenseRequest struct {
+	PetID       string                `json:"pet_id" binding:"required"`
+	Category    string                `json:"category" binding:"required"`
+	Amount      int                   `json:"amount" binding:"required,min=1"`
+	Description string                `json:"description,omitempty"`
+	Schedule    model.ExpenseSchedule `json:"schedule" binding:"required"`
+	StartDate   time.Time             `json:"start_date" binding:"required"`
+	EndDate     *time.Time            `json:"end_date,omitempty"`
+
+	// Currency ISO 4217 幣別代碼，未填時使用者的基準貨幣
+	Currency string `json:"currency,omitempty"`
+}
+
+// MakeCreateRecurringExpenseEndpoint 建立新增週期性費用的 endpoint
+func MakeCreateRecurringExpenseEndpoint(h *command.CreateRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateRecurringExpenseRequest)
+		cmd := command.CreateRecurringExpenseCommand{
+			PetID:       req.PetID,
+			Category:    req.Category,
+			Amount:      req.Amount,
+			Description: req.Description,
+			Schedule:    req.Schedule,
+			StartDate:   req.StartDate,
+			EndDate:     req.EndDate,
+			Currency:    req.Currency,
+		}
+
+		recurring, err := h.Handle(c, cmd)
+		if err != nil {
+			return RecurringExpenseResponse{Err: err}, nil
+		}
+		return RecurringExpenseResponse{RecurringExpense: recurring}, nil
+	}
+}
+
+// UpdateRecurringExpenseRequest 編輯週期性費用的請求結構，所屬寵物不可變更
+type UpdateRecurringExpenseRequest struct {
+	ID          string                `json:"-"`
+	Category    string                `json:"category" binding:"required"`
+	Amount      int                   `json:"amount" binding:"required,min=1"`
+	Description string                `json:"description,omitempty"`
+	Schedule    model.ExpenseSchedule `json:"schedule" binding:"required"`
+	StartDate   time.Time             `json:"start_date" binding:"required"`
+	EndDate     *time.Time            `json:"end_date,omitempty"`
+
+	// Currency ISO 4217 幣別代碼，未填時維持原幣別
+	Currency string `json:"currency,omitempty"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
+}
+
+// MakeUpdateRecurringExpenseEndpoint 建立編輯週期性費用的 endpoint
+func MakeUpdateRecurringExpenseEndpoint(h *command.UpdateRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateRecurringExpenseRequest)
+		cmd := command.UpdateRecurringExpenseCommand{
+			ID:          req.ID,
+			Category:    req.Category,
+			Amount:      req.Amount,
+			Description: req.Description,
+			Schedule:    req.Schedule,
+			StartDate:   req.StartDate,
+			EndDate:     req.EndDate,
+			Currency:    req.Currency,
+
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		recurring, err := h.Handle(c, cmd)
+		if err != nil {
+			return RecurringExpenseResponse{Err: err}, nil
+		}
+		return RecurringExpenseResponse{RecurringExpense: recurring}, nil
+	}
+}
+
+// DeleteRecurringExpenseRequest 刪除週期性費用的請求結構
+type DeleteRecurringExpenseRequest struct {
+	ID string `json:"id"`
+}
+
+// DeleteRecurringExpenseResponse 刪除週期性費用的回應結構
+type DeleteRecurringExpenseResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r DeleteRecurringExpenseResponse) Failed() error { return r.Err }
+
+// MakeDeleteRecurringExpenseEndpoint 建立刪除週期性費用的 endpoint
+func MakeDeleteRecurringExpenseEndpoint(h *command.DeleteRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteRecurringExpenseRequest)
+
+		if err := h.Handle(c, command.DeleteRecurringExpenseCommand{ID: req.ID}); err != nil {
+			return DeleteRecurringExpenseResponse{Err: err}, nil
+		}
+		return DeleteRecurringExpenseResponse{}, nil
+	}
+}
+
+// PauseRecurringExpenseRequest 暫停或恢復週期性費用的請求結構
+type PauseRecurringExpenseRequest struct {
+	ID     string `json:"-"`
+	Paused bool   `json:"-"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
+}
+
+// MakePauseRecurringExpenseEndpoint 建立暫停或恢復週期性費用的 endpoint
+func MakePauseRecurringExpenseEndpoint(h *command.PauseRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(PauseRecurringExpenseRequest)
+		cmd := command.PauseRecurringExpenseCommand{
+			ID:              req.ID,
+			Paused:          req.Paused,
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		recurring, err := h.Handle(c, cmd)
+		if err != nil {
+			return RecurringExpenseResponse{Err: err}, nil
+		}
+		return RecurringExpenseResponse{RecurringExpense: recurring}, nil
+	}
+}
+
+// SkipRecurringExpenseRequest 略過單次期別的請求結構
+type SkipRecurringExpenseRequest struct {
+	ID   string    `json:"-"`
+	Date time.Time `json:"date" binding:"required"`
+
+	// ExpectedVersion 由 If-Match 標頭解析而來
+	ExpectedVersion *int `json:"-"`
+}
+
+// MakeSkipRecurringExpenseEndpoint 建立略過單次期別的 endpoint
+func MakeSkipRecurringExpenseEndpoint(h *command.SkipRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(SkipRecurringExpenseRequest)
+		cmd := command.SkipRecurringExpenseCommand{
+			ID:              req.ID,
+			Date:            req.Date,
+			ExpectedVersion: req.ExpectedVersion,
+		}
+
+		recurring, err := h.Handle(c, cmd)
+		if err != nil {
+			return RecurringExpenseResponse{Err: err}, nil
+		}
+		return RecurringExpenseResponse{RecurringExpense: recurring}, nil
+	}
+}
+
+// GetRecurringExpenseRequest 取得週期性費用的請求結構
+type GetRecurringExpenseRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetRecurringExpenseEndpoint 建立取得週期性費用的 endpoint
+func MakeGetRecurringExpenseEndpoint(h *query.GetRecurringExpenseHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetRecurringExpenseRequest)
+
+		recurring, err := h.Handle(c, query.GetRecurringExpenseQuery{ID: req.ID})
+		if err != nil {
+			return RecurringExpenseResponse{Err: err}, nil
+		}
+		return RecurringExpenseResponse{RecurringExpense: recurring}, nil
+	}
+}
+
+// ListRecurringExpensesRequest 查詢週期性費用清單的請求結構
+type ListRecurringExpensesRequest struct {
+	PetID string                 `form:"pet_id"`
+	Page  repository.PageRequest `json:"-"`
+}
+
+// ListRecurringExpensesResponse 週期性費用清單的回應結構
+type ListRecurringExpensesResponse struct {
+	RecurringExpenses []*model.RecurringExpense `json:"recurring_expenses"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListRecurringExpensesResponse) Failed() error { return r.Err }
+
+// MakeListRecurringExpensesEndpoint 建立查詢週期性費用清單的 endpoint
+func MakeListRecurringExpensesEndpoint(h *query.ListRecurringExpensesHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListRecurringExpensesRequest)
+
+		page, err := h.Handle(c, query.ListRecurringExpensesQuery{PetID: req.PetID, Page: req.Page})
+		if err != nil {
+			return ListRecurringExpensesResponse{Err: err}, nil
+		}
+		return ListRecurringExpensesResponse{RecurringExpenses: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
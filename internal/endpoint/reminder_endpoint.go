@@ -0,0 +1,233 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// ReminderEndpoints 聚合所有提醒相關的 endpoints
+type ReminderEndpoints struct {
+	CreateReminderEndpoint   endpoint.Endpoint
+	GetReminderEndpoint      endpoint.Endpoint
+	ListRemindersEndpoint    endpoint.Endpoint
+	UpdateReminderEndpoint   endpoint.Endpoint
+	DeleteReminderEndpoint   endpoint.Endpoint
+	SnoozeReminderEndpoint   endpoint.Endpoint
+	CompleteReminderEndpoint endpoint.Endpoint
+}
+
+// MakeReminderEndpoints 建立提醒 endpoints
+func MakeReminderEndpoints(
+	ch *command.CreateReminderHandler,
+	uh *command.UpdateReminderHandler,
+	dh *command.DeleteReminderHandler,
+	sh *command.SnoozeReminderHandler,
+	cph *command.CompleteReminderHandler,
+	gh *query.GetReminderByIDHandler,
+	lh *query.ListRemindersHandler,
+) ReminderEndpoints {
+	return ReminderEndpoints{
+		CreateReminderEndpoint:   MakeCreateReminderEndpoint(ch),
+		GetReminderEndpoint:      MakeGetReminderEndpoint(gh),
+		ListRemindersEndpoint:    MakeListRemindersEndpoint(lh),
+		UpdateReminderEndpoint:   MakeUpdateReminderEndpoint(uh),
+		DeleteReminderEndpoint:   MakeDeleteReminderEndpoint(dh),
+		SnoozeReminderEndpoint:   MakeSnoozeReminderEndpoint(sh),
+		CompleteReminderEndpoint: MakeCompleteReminderEndpoint(cph),
+	}
+}
+
+// CreateReminderRequest 建立提醒的請求結構
+// remind_at 未填寫時，依設定的提前天數自動計算
+type CreateReminderRequest struct {
+	PetID    string                  `json:"pet_id" binding:"required"`
+	Type     model.MedicalRecordType `json:"type,omitempty"`
+	Title    string                  `json:"title" binding:"required"`
+	DueDate  time.Time               `json:"due_date" binding:"required"`
+	RemindAt *time.Time              `json:"remind_at,omitempty"`
+}
+
+// ReminderResponse 單筆提醒的回應結構
+type ReminderResponse struct {
+	Reminder *model.Reminder `json:"reminder,omitempty"`
+	Err      error           `json:"error,omitempty"`
+}
+
+func (r ReminderResponse) Failed() error { return r.Err }
+
+// MakeCreateReminderEndpoint 建立新增提醒的 endpoint
+func MakeCreateReminderEndpoint(h *command.CreateReminderHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CreateReminderRequest)
+		cmd := command.CreateReminderCommand{
+			PetID:    req.PetID,
+			Type:     req.Type,
+			Title:    req.Title,
+			DueDate:  req.DueDate,
+			RemindAt: req.RemindAt,
+		}
+
+		reminder, err := h.Handle(c, cmd)
+		if err != nil {
+			return ReminderResponse{Err: err}, nil
+		}
+		return ReminderResponse{Reminder: reminder}, nil
+	}
+}
+
+// GetReminderRequest 取得提醒的請求結構
+type GetReminderRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeGetReminderEndpoint 建立取得提醒的 endpoint
+func MakeGetReminderEndpoint(h *query.GetReminderByIDHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetReminderRequest)
+
+		reminder, err := h.Handle(c, query.GetReminderByIDQuery{ID: req.ID})
+		if err != nil {
+			return ReminderResponse{Err: err}, nil
+		}
+		return ReminderResponse{Reminder: reminder}, nil
+	}
+}
+
+// ListRemindersRequest 列出提醒的請求結構
+type ListRemindersRequest struct {
+	PetID    string                 `json:"pet_id,omitempty"`
+	Statuses []model.ReminderStatus `json:"statuses,omitempty"`
+
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListRemindersResponse 列出提醒的回應結構
+type ListRemindersResponse struct {
+	Reminders []*model.Reminder `json:"reminders"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListRemindersResponse) Failed() error { return r.Err }
+
+// MakeListRemindersEndpoint 建立列出提醒的 endpoint
+func MakeListRemindersEndpoint(h *query.ListRemindersHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListRemindersRequest)
+		q := query.ListRemindersQuery{
+			PetID:    req.PetID,
+			Statuses: req.Statuses,
+			Page:     req.Page,
+		}
+
+		page, err := h.Handle(c, q)
+		if err != nil {
+			return ListRemindersResponse{Err: err}, nil
+		}
+		return ListRemindersResponse{Reminders: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// UpdateReminderRequest 更新提醒的請求結構
+type UpdateReminderRequest struct {
+	ID       string                  `json:"id"`
+	Type     model.MedicalRecordType `json:"type,omitempty"`
+	Title    string                  `json:"title" binding:"required"`
+	DueDate  time.Time               `json:"due_date" binding:"required"`
+	RemindAt time.Time               `json:"remind_at" binding:"required"`
+}
+
+// MakeUpdateReminderEndpoint 建立更新提醒的 endpoint
+func MakeUpdateReminderEndpoint(h *command.UpdateReminderHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateReminderRequest)
+		cmd := command.UpdateReminderCommand{
+			ID:       req.ID,
+			Type:     req.Type,
+			Title:    req.Title,
+			DueDate:  req.DueDate,
+			RemindAt: req.RemindAt,
+		}
+
+		reminder, err := h.Handle(c, cmd)
+		if err != nil {
+			return ReminderResponse{Err: err}, nil
+		}
+		return ReminderResponse{Reminder: reminder}, nil
+	}
+}
+
+// DeleteReminderRequest 刪除提醒的請求結構
+type DeleteReminderRequest struct {
+	ID string `json:"id"`
+}
+
+// DeleteReminderResponse 刪除提醒的回應結構
+type DeleteReminderResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r DeleteReminderResponse) Failed() error { return r.Err }
+
+// MakeDeleteReminderEndpoint 建立刪除提醒的 endpoint
+func MakeDeleteReminderEndpoint(h *command.DeleteReminderHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(DeleteReminderRequest)
+
+		if err := h.Handle(c, command.DeleteReminderCommand{ID: req.ID}); err != nil {
+			return DeleteReminderResponse{Err: err}, nil
+		}
+		return DeleteReminderResponse{}, nil
+	}
+}
+
+// SnoozeReminderRequest 延後提醒的請求結構
+// until 與 minutes 擇一填寫
+type SnoozeReminderRequest struct {
+	ID      string     `json:"id"`
+	Until   *time.Time `json:"until,omitempty"`
+	Minutes int        `json:"minutes,omitempty"`
+}
+
+// MakeSnoozeReminderEndpoint 建立延後提醒的 endpoint
+func MakeSnoozeReminderEndpoint(h *command.SnoozeReminderHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(SnoozeReminderRequest)
+		cmd := command.SnoozeReminderCommand{
+			ID:      req.ID,
+			Until:   req.Until,
+			Minutes: req.Minutes,
+		}
+
+		reminder, err := h.Handle(c, cmd)
+		if err != nil {
+			return ReminderResponse{Err: err}, nil
+		}
+		return ReminderResponse{Reminder: reminder}, nil
+	}
+}
+
+// CompleteReminderRequest 完成提醒的請求結構
+type CompleteReminderRequest struct {
+	ID string `json:"id"`
+}
+
+// MakeCompleteReminderEndpoint 建立完成提醒的 endpoint
+func MakeCompleteReminderEndpoint(h *command.CompleteReminderHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(CompleteReminderRequest)
+
+		reminder, err := h.Handle(c, command.CompleteReminderCommand{ID: req.ID})
+		if err != nil {
+			return ReminderResponse{Err: err}, nil
+		}
+		return ReminderResponse{Reminder: reminder}, nil
+	}
+}
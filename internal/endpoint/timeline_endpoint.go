@@ -0,0 +1,63 @@
+package endpoint
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// TimelineEndpoints 聚合時間軸相關的 endpoints
+type TimelineEndpoints struct {
+	GetPetTimelineEndpoint endpoint.Endpoint
+}
+
+// MakeTimelineEndpoints 建立時間軸 endpoints
+func MakeTimelineEndpoints(th *query.GetPetTimelineHandler) TimelineEndpoints {
+	return TimelineEndpoints{
+		GetPetTimelineEndpoint: MakeGetPetTimelineEndpoint(th),
+	}
+}
+
+// GetPetTimelineRequest 查詢寵物時間軸的請求結構
+type GetPetTimelineRequest struct {
+	PetID     string                   `json:"pet_id,omitempty"`
+	StartDate time.Time                `json:"start_date"`
+	EndDate   time.Time                `json:"end_date"`
+	Types     []model.TimelineItemType `json:"types,omitempty"`
+
+	Page repository.PageRequest `json:"-"`
+}
+
+// GetPetTimelineResponse 寵物時間軸的回應結構
+type GetPetTimelineResponse struct {
+	Items []*model.TimelineItem `json:"items"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r GetPetTimelineResponse) Failed() error { return r.Err }
+
+// MakeGetPetTimelineEndpoint 建立查詢寵物時間軸的 endpoint
+func MakeGetPetTimelineEndpoint(h *query.GetPetTimelineHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetPetTimelineRequest)
+		q := query.GetPetTimelineQuery{
+			PetID:     req.PetID,
+			StartDate: req.StartDate,
+			EndDate:   req.EndDate,
+			Types:     req.Types,
+			Page:      req.Page,
+		}
+
+		page, err := h.Handle(c, q)
+		if err != nil {
+			return GetPetTimelineResponse{Err: err}, nil
+		}
+		return GetPetTimelineResponse{Items: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
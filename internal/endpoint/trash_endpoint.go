@@ -0,0 +1,95 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// TrashEndpoints 聚合所有垃圾桶相關的 endpoints
+type TrashEndpoints struct {
+	ListTrashEndpoint        endpoint.Endpoint
+	RestoreTrashItemEndpoint endpoint.Endpoint
+	PurgeTrashItemEndpoint   endpoint.Endpoint
+}
+
+// MakeTrashEndpoints 建立垃圾桶 endpoints
+func MakeTrashEndpoints(
+	lh *query.ListTrashHandler,
+	rh *command.RestoreTrashItemHandler,
+	ph *command.PurgeTrashItemHandler,
+) TrashEndpoints {
+	return TrashEndpoints{
+		ListTrashEndpoint:        MakeListTrashEndpoint(lh),
+		RestoreTrashItemEndpoint: MakeRestoreTrashItemEndpoint(rh),
+		PurgeTrashItemEndpoint:   MakePurgeTrashItemEndpoint(ph),
+	}
+}
+
+// ListTrashRequest 列出垃圾桶項目的請求結構
+type ListTrashRequest struct {
+	Type  model.TrashItemType `json:"type,omitempty"`
+	PetID string              `json:"pet_id,omitempty"`
+
+	Page repository.PageRequest `json:"-"`
+}
+
+// ListTrashResponse 列出垃圾桶項目的回應結構
+type ListTrashResponse struct {
+	Items []*model.TrashItem `json:"items"`
+	PageInfo
+	Err error `json:"error,omitempty"`
+}
+
+func (r ListTrashResponse) Failed() error { return r.Err }
+
+// MakeListTrashEndpoint 建立列出垃圾桶項目的 endpoint
+func MakeListTrashEndpoint(h *query.ListTrashHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListTrashRequest)
+
+		page, err := h.Handle(c, query.ListTrashQuery{Type: req.Type, PetID: req.PetID, Page: req.Page})
+		if err != nil {
+			return ListTrashResponse{Err: err}, nil
+		}
+		return ListTrashResponse{Items: page.Items, PageInfo: newPageInfo(page)}, nil
+	}
+}
+
+// TrashItemRequest 指定單一垃圾桶項目的請求結構
+type TrashItemRequest struct {
+	Type model.TrashItemType `json:"type"`
+	ID   string              `json:"id"`
+}
+
+// TrashItemResponse 還原或清除垃圾桶項目的回應結構
+type TrashItemResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r TrashItemResponse) Failed() error { return r.Err }
+
+// MakeRestoreTrashItemEndpoint 建立還原垃圾桶項目的 endpoint
+func MakeRestoreTrashItemEndpoint(h *command.RestoreTrashItemHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(TrashItemRequest)
+
+		err := h.Handle(c, command.RestoreTrashItemCommand{Type: req.Type, ID: req.ID})
+		return TrashItemResponse{Err: err}, nil
+	}
+}
+
+// MakePurgeTrashItemEndpoint 建立永久清除垃圾桶項目的 endpoint
+func MakePurgeTrashItemEndpoint(h *command.PurgeTrashItemHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(TrashItemRequest)
+
+		err := h.Handle(c, command.PurgeTrashItemCommand{Type: req.Type, ID: req.ID})
+		return TrashItemResponse{Err: err}, nil
+	}
+}
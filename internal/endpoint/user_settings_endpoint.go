@@ -0,0 +1,65 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/internal/usecase/query"
+)
+
+// UserSettingsEndpoints 聚合所有使用者設定相關的 endpoints
+type UserSettingsEndpoints struct {
+	GetSettingsEndpoint    endpoint.Endpoint
+	UpdateSettingsEndpoint endpoint.Endpoint
+}
+
+// MakeUserSettingsEndpoints 建立使用者設定 endpoints
+func MakeUserSettingsEndpoints(
+	gh *query.GetUserSettingsHandler,
+	uh *command.UpdateUserSettingsHandler,
+) UserSettingsEndpoints {
+	return UserSettingsEndpoints{
+		GetSettingsEndpoint:    MakeGetUserSettingsEndpoint(gh),
+		UpdateSettingsEndpoint: MakeUpdateUserSettingsEndpoint(uh),
+	}
+}
+
+// UserSettingsResponse 使用者設定的回應結構
+type UserSettingsResponse struct {
+	Settings *model.UserSettings `json:"settings,omitempty"`
+	Err      error               `json:"error,omitempty"`
+}
+
+func (r UserSettingsResponse) Failed() error { return r.Err }
+
+// MakeGetUserSettingsEndpoint 建立取得使用者設定的 endpoint
+func MakeGetUserSettingsEndpoint(h *query.GetUserSettingsHandler) endpoint.Endpoint {
+	return func(c context.Context, _ interface{}) (interface{}, error) {
+		settings, err := h.Handle(c)
+		if err != nil {
+			return UserSettingsResponse{Err: err}, nil
+		}
+		return UserSettingsResponse{Settings: settings}, nil
+	}
+}
+
+// UpdateUserSettingsRequest 更新使用者設定的請求結構
+type UpdateUserSettingsRequest struct {
+	BaseCurrency string `json:"base_currency" binding:"required"`
+}
+
+// MakeUpdateUserSettingsEndpoint 建立更新使用者設定的 endpoint
+func MakeUpdateUserSettingsEndpoint(h *command.UpdateUserSettingsHandler) endpoint.Endpoint {
+	return func(c context.Context, request interface{}) (interface{}, error) {
+		req := request.(UpdateUserSettingsRequest)
+
+		settings, err := h.Handle(c, command.UpdateUserSettingsCommand{BaseCurrency: req.BaseCurrency})
+		if err != nil {
+			return UserSettingsResponse{Err: err}, nil
+		}
+		return UserSettingsResponse{Settings: settings}, nil
+	}
+}
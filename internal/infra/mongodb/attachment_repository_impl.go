@@ -0,0 +1,193 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const attachmentCollectionName = "attachments"
+
+// AttachmentRepositoryImpl 實作 repository.AttachmentRepository 介面，使用 MongoDB。
+type AttachmentRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewAttachmentRepository 建立新的 AttachmentRepositoryImpl。
+func NewAttachmentRepository(db *mongo.Database) repository.AttachmentRepository {
+	repo := &AttachmentRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *AttachmentRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(attachmentCollectionName)
+}
+
+// ensureIndexes 建立附件查詢所需的索引
+func (r *AttachmentRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_type", Value: 1}, {Key: "owner_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("owner_type_owner_id_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("pet_id_created_at"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立附件索引失敗", "error", err)
+	}
+}
+
+// Create 新增附件
+func (r *AttachmentRepositoryImpl) Create(c context.Context, attachment *model.Attachment) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := attachmentMongoFromDomain(attachment)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+	doc.CreatedAt = time.Now()
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立附件失敗", "error", err)
+		return convertMongoError(err)
+	}
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		attachment.ID = oid.Hex()
+	}
+	attachment.CreatedAt = doc.CreatedAt
+
+	ctx.Info("成功建立附件", "attachment_id", attachment.ID)
+	return nil
+}
+
+// FindByID 依 ID 查詢附件
+func (r *AttachmentRepositoryImpl) FindByID(c context.Context, id string) (*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的附件 ID 格式", "attachment_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc attachmentMongo
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	if err := r.collection().FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的附件", "attachment_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找附件時發生錯誤", "error", err, "attachment_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByOwner 查詢費用或醫療記錄的所有附件，依上傳時間由早到晚排列
+func (r *AttachmentRepositoryImpl) FindByOwner(c context.Context, ownerType model.AttachmentOwnerType, ownerID string) ([]*model.Attachment, error) {
+	return r.find(c, bson.M{"owner_type": string(ownerType), "owner_id": ownerID, deletedAtField: notDeleted})
+}
+
+// FindByPetID 查詢寵物所有費用與醫療記錄的附件，依上傳時間由早到晚排列
+func (r *AttachmentRepositoryImpl) FindByPetID(c context.Context, petID string) ([]*model.Attachment, error) {
+	return r.find(c, bson.M{"pet_id": petID, deletedAtField: notDeleted})
+}
+
+// find 依條件查詢附件，依上傳時間由早到晚排列
+func (r *AttachmentRepositoryImpl) find(c context.Context, filter bson.M) ([]*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢附件時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	attachments := make([]*model.Attachment, 0)
+	for cursor.Next(ctx) {
+		var doc attachmentMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼附件時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		attachments = append(attachments, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷附件查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return attachments, nil
+}
+
+// Delete 刪除附件
+func (r *AttachmentRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的附件 ID 格式", "attachment_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除附件失敗", "error", err, "attachment_id", id)
+		return convertMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除附件", "attachment_id", id)
+	return nil
+}
+
+// purgeAttachments 永久刪除符合條件的附件中繼資料（含已封存者），回傳被刪除的附件供呼叫端在交易提交後刪除檔案
+func purgeAttachments(sc context.Context, db *mongo.Database, filter bson.M) ([]*model.Attachment, error) {
+	coll := db.Collection(attachmentCollectionName)
+
+	var docs []attachmentMongo
+	cursor, err := coll.Find(sc, filter)
+	if err != nil {
+		return nil, convertMongoError(err)
+	}
+	if err := cursor.All(sc, &docs); err != nil {
+		return nil, convertMongoError(err)
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	attachments := make([]*model.Attachment, 0, len(docs))
+	ids := make(bson.A, 0, len(docs))
+	for i := range docs {
+		attachments = append(attachments, docs[i].toDomain())
+		ids = append(ids, docs[i].ID)
+	}
+	if _, err := coll.DeleteMany(sc, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return nil, convertMongoError(err)
+	}
+
+	return attachments, nil
+}
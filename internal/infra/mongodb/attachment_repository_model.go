@@ -0,0 +1,71 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// attachmentMongo 為 Attachment 的持久化模型
+type attachmentMongo struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	PetID       string        `bson:"pet_id"`
+	OwnerType   string        `bson:"owner_type"`
+	OwnerID     string        `bson:"owner_id"`
+	FileName    string        `bson:"file_name"`
+	ContentType string        `bson:"content_type"`
+	Size        int64         `bson:"size"`
+	StorageKey  string        `bson:"storage_key"`
+	UploadedBy  string        `bson:"uploaded_by"`
+	CreatedAt   time.Time     `bson:"created_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *attachmentMongo) toDomain() *model.Attachment {
+	if m == nil {
+		return nil
+	}
+	return &model.Attachment{
+		ID:          m.ID.Hex(),
+		PetID:       m.PetID,
+		OwnerType:   model.AttachmentOwnerType(m.OwnerType),
+		OwnerID:     m.OwnerID,
+		FileName:    m.FileName,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		StorageKey:  m.StorageKey,
+		UploadedBy:  m.UploadedBy,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// attachmentMongoFromDomain 將領域模型轉換為持久化模型
+func attachmentMongoFromDomain(a *model.Attachment) (*attachmentMongo, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	if a.ID != "" {
+		var err error
+		objectID, err = bson.ObjectIDFromHex(a.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &attachmentMongo{
+		ID:          objectID,
+		PetID:       a.PetID,
+		OwnerType:   string(a.OwnerType),
+		OwnerID:     a.OwnerID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		StorageKey:  a.StorageKey,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   a.CreatedAt,
+	}, nil
+}
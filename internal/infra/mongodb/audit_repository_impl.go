@@ -0,0 +1,119 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	auditCollectionName = "audit_logs"
+)
+
+// AuditRepositoryImpl 實作 repository.AuditRepository 介面，使用 MongoDB。
+type AuditRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewAuditRepository 建立新的 AuditRepositoryImpl。
+func NewAuditRepository(db *mongo.Database) repository.AuditRepository {
+	repo := &AuditRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *AuditRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(auditCollectionName)
+}
+
+// ensureIndexes 建立稽核紀錄查詢所需的索引
+func (r *AuditRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("pet_id_created_at"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立稽核紀錄索引失敗", "error", err)
+	}
+}
+
+// Create 新增一筆稽核紀錄。
+func (r *AuditRepositoryImpl) Create(c context.Context, entry *model.AuditEntry) error {
+	ctx := contextx.WithContext(c)
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	doc := auditEntryMongoFromDomain(entry)
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立稽核紀錄失敗", "error", err, "entity_type", entry.EntityType, "entity_id", entry.EntityID)
+		return convertMongoError(err)
+	}
+
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		entry.ID = oid.Hex()
+	}
+
+	return nil
+}
+
+// FindByPetID 依寵物查詢稽核紀錄，依建立時間由新到舊排序。
+func (r *AuditRepositoryImpl) FindByPetID(c context.Context, petID string, entityType model.AuditEntityType, startDate, endDate time.Time) ([]*model.AuditEntry, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始查找寵物稽核紀錄", "pet_id", petID, "entity_type", entityType)
+
+	filter := bson.M{"pet_id": petID}
+	if entityType != "" {
+		filter["entity_type"] = string(entityType)
+	}
+
+	dateFilter := bson.M{}
+	if !startDate.IsZero() {
+		dateFilter["$gte"] = startDate
+	}
+	if !endDate.IsZero() {
+		dateFilter["$lte"] = endDate
+	}
+	if len(dateFilter) > 0 {
+		filter["created_at"] = dateFilter
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查找稽核紀錄時發生錯誤", "error", err, "pet_id", petID)
+		return nil, fmt.Errorf("查找稽核紀錄失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := make([]*model.AuditEntry, 0)
+	for cursor.Next(ctx) {
+		var doc auditEntryMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼稽核紀錄時發生錯誤", "error", err)
+			return nil, fmt.Errorf("解碼稽核紀錄失敗: %w", err)
+		}
+		entries = append(entries, doc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷稽核紀錄查詢結果時發生錯誤", "error", err)
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,88 @@
+package mongodb
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// auditEntryMongo 是 AuditEntry 的持久化模型，包含 DB 專用的標籤。
+type auditEntryMongo struct {
+	ID         bson.ObjectID      `bson:"_id,omitempty"`
+	PetID      string             `bson:"pet_id"`
+	ActorID    string             `bson:"actor_id"`
+	EntityType string             `bson:"entity_type"`
+	EntityID   string             `bson:"entity_id"`
+	Action     string             `bson:"action"`
+	Changes    []fieldChangeMongo `bson:"changes,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+// fieldChangeMongo 以 JSON 字串保存異動前後的值，避免不同型別的欄位值在查詢時失真。
+type fieldChangeMongo struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before,omitempty"`
+	After  string `bson:"after,omitempty"`
+}
+
+// toDomain 將持久化模型 (auditEntryMongo) 轉換為領域模型 (model.AuditEntry)。
+func (m *auditEntryMongo) toDomain() *model.AuditEntry {
+	if m == nil {
+		return nil
+	}
+
+	changes := make([]model.FieldChange, 0, len(m.Changes))
+	for _, change := range m.Changes {
+		changes = append(changes, model.FieldChange{
+			Field:  change.Field,
+			Before: rawJSON(change.Before),
+			After:  rawJSON(change.After),
+		})
+	}
+
+	return &model.AuditEntry{
+		ID:         m.ID.Hex(),
+		PetID:      m.PetID,
+		ActorID:    m.ActorID,
+		EntityType: model.AuditEntityType(m.EntityType),
+		EntityID:   m.EntityID,
+		Action:     model.AuditAction(m.Action),
+		Changes:    changes,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// auditEntryMongoFromDomain 將領域模型 (model.AuditEntry) 轉換為持久化模型 (auditEntryMongo)。
+func auditEntryMongoFromDomain(e *model.AuditEntry) *auditEntryMongo {
+	if e == nil {
+		return nil
+	}
+
+	changes := make([]fieldChangeMongo, 0, len(e.Changes))
+	for _, change := range e.Changes {
+		changes = append(changes, fieldChangeMongo{
+			Field:  change.Field,
+			Before: string(change.Before),
+			After:  string(change.After),
+		})
+	}
+
+	return &auditEntryMongo{
+		PetID:      e.PetID,
+		ActorID:    e.ActorID,
+		EntityType: string(e.EntityType),
+		EntityID:   e.EntityID,
+		Action:     string(e.Action),
+		Changes:    changes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func rawJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+	return json.RawMessage(s)
+}
@@ -0,0 +1,201 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const budgetCollectionName = "budgets"
+
+// BudgetRepositoryImpl 實作 repository.BudgetRepository 介面，使用 MongoDB。
+type BudgetRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewBudgetRepository 建立新的 BudgetRepositoryImpl。
+func NewBudgetRepository(db *mongo.Database) repository.BudgetRepository {
+	repo := &BudgetRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *BudgetRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(budgetCollectionName)
+}
+
+// ensureIndexes 建立預算查詢所需的索引
+func (r *BudgetRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "pet_id", Value: 1}},
+			Options: options.Index().SetName("owner_id_pet_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}},
+			Options: options.Index().SetName("pet_id"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立預算索引失敗", "error", err)
+	}
+}
+
+// Create 新增預算
+func (r *BudgetRepositoryImpl) Create(c context.Context, budget *model.Budget) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := budgetMongoFromDomain(budget)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+	doc.Version = initialVersion
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立預算失敗", "error", err)
+		return convertMongoError(err)
+	}
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		budget.ID = oid.Hex()
+	}
+	budget.Version = doc.Version
+	budget.CreatedAt = doc.CreatedAt
+	budget.UpdatedAt = doc.UpdatedAt
+
+	ctx.Info("成功建立預算", "budget_id", budget.ID)
+	return nil
+}
+
+// FindByID 依 ID 查詢預算
+func (r *BudgetRepositoryImpl) FindByID(c context.Context, id string) (*model.Budget, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的預算 ID 格式", "budget_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc budgetMongo
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	if err := r.collection().FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的預算", "budget_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找預算時發生錯誤", "error", err, "budget_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByOwnerID 查詢使用者設定的所有預算
+func (r *BudgetRepositoryImpl) FindByOwnerID(c context.Context, ownerID string) ([]*model.Budget, error) {
+	return r.find(c, bson.M{"owner_id": ownerID, deletedAtField: notDeleted})
+}
+
+// FindApplicable 查詢限定該寵物的預算，以及飼主涵蓋所有寵物的預算
+func (r *BudgetRepositoryImpl) FindApplicable(c context.Context, ownerID, petID string) ([]*model.Budget, error) {
+	return r.find(c, bson.M{deletedAtField: notDeleted, "$or": bson.A{
+		bson.M{"pet_id": petID},
+		bson.M{"owner_id": ownerID, "pet_id": ""},
+	}})
+}
+
+func (r *BudgetRepositoryImpl) find(c context.Context, filter bson.M) ([]*model.Budget, error) {
+	ctx := contextx.WithContext(c)
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢預算時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	budgets := make([]*model.Budget, 0)
+	for cursor.Next(ctx) {
+		var doc budgetMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼預算時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		budgets = append(budgets, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷預算查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return budgets, nil
+}
+
+// Update 依版本更新預算
+func (r *BudgetRepositoryImpl) Update(c context.Context, budget *model.Budget) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := budgetMongoFromDomain(budget)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "budget_id", budget.ID)
+		return err
+	}
+
+	// 以版本比對實作 compare-and-swap，避免警示狀態與使用者編輯互相覆寫
+	filter := bson.M{"_id": doc.ID, deletedAtField: notDeleted, versionField: versionMatch(budget.Version)}
+	doc.UpdatedAt = time.Now()
+	doc.Version = budget.Version + 1
+	result, err := r.collection().UpdateOne(ctx, filter, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新預算失敗", "error", err, "budget_id", budget.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		err := resolveUpdateMiss(ctx, r.collection(), doc.ID)
+		ctx.Warn("更新預算未命中", "budget_id", budget.ID, "version", budget.Version, "error", err)
+		return err
+	}
+
+	budget.Version = doc.Version
+	budget.UpdatedAt = doc.UpdatedAt
+	return nil
+}
+
+// Delete 刪除預算
+func (r *BudgetRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的預算 ID 格式", "budget_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除預算失敗", "error", err, "budget_id", id)
+		return convertMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除預算", "budget_id", id)
+	return nil
+}
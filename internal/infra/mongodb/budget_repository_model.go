@@ -0,0 +1,77 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// budgetMongo 為 Budget 的持久化模型
+type budgetMongo struct {
+	ID                 bson.ObjectID `bson:"_id,omitempty"`
+	OwnerID            string        `bson:"owner_id"`
+	PetID              string        `bson:"pet_id"`
+	Category           string        `bson:"category"`
+	Period             string        `bson:"period"`
+	Limit              int           `bson:"limit"`
+	Thresholds         []int         `bson:"thresholds"`
+	AlertedPeriodStart *time.Time    `bson:"alerted_period_start"`
+	AlertedPct         int           `bson:"alerted_pct"`
+	Version            int           `bson:"version"`
+	CreatedAt          time.Time     `bson:"created_at"`
+	UpdatedAt          time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *budgetMongo) toDomain() *model.Budget {
+	if m == nil {
+		return nil
+	}
+	return &model.Budget{
+		ID:                 m.ID.Hex(),
+		OwnerID:            m.OwnerID,
+		PetID:              m.PetID,
+		Category:           m.Category,
+		Period:             model.BudgetPeriod(m.Period),
+		Limit:              m.Limit,
+		Thresholds:         m.Thresholds,
+		AlertedPeriodStart: m.AlertedPeriodStart,
+		AlertedPct:         m.AlertedPct,
+		Version:            m.Version,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
+
+// budgetMongoFromDomain 將領域模型轉換為持久化模型
+func budgetMongoFromDomain(b *model.Budget) (*budgetMongo, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	if b.ID != "" {
+		var err error
+		objectID, err = bson.ObjectIDFromHex(b.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &budgetMongo{
+		ID:                 objectID,
+		OwnerID:            b.OwnerID,
+		PetID:              b.PetID,
+		Category:           b.Category,
+		Period:             string(b.Period),
+		Limit:              b.Limit,
+		Thresholds:         b.Thresholds,
+		AlertedPeriodStart: b.AlertedPeriodStart,
+		AlertedPct:         b.AlertedPct,
+		Version:            b.Version,
+		CreatedAt:          b.CreatedAt,
+		UpdatedAt:          b.UpdatedAt,
+	}, nil
+}
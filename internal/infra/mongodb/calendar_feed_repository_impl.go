@@ -0,0 +1,96 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const calendarFeedCollectionName = "calendar_feeds"
+
+// CalendarFeedRepositoryImpl 實作 repository.CalendarFeedRepository 介面，使用 MongoDB。
+type CalendarFeedRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewCalendarFeedRepository 建立新的 CalendarFeedRepositoryImpl。
+func NewCalendarFeedRepository(db *mongo.Database) repository.CalendarFeedRepository {
+	repo := &CalendarFeedRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *CalendarFeedRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(calendarFeedCollectionName)
+}
+
+// ensureIndexes 建立訂閱設定查詢所需的索引，每位使用者只有一組訂閱 token
+func (r *CalendarFeedRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("user_id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetName("token_hash_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立行事曆訂閱索引失敗", "error", err)
+	}
+}
+
+// FindByTokenHash 根據訂閱 token 的雜湊值查詢設定
+func (r *CalendarFeedRepositoryImpl) FindByTokenHash(c context.Context, tokenHash string) (*model.CalendarFeed, error) {
+	ctx := contextx.WithContext(c)
+
+	var doc calendarFeedMongo
+	err := r.collection().FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找行事曆訂閱時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// Upsert 建立或更新使用者的訂閱設定，更新時以新的 token 取代舊的
+func (r *CalendarFeedRepositoryImpl) Upsert(c context.Context, feed *model.CalendarFeed) error {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	if feed.RotatedAt.IsZero() {
+		feed.RotatedAt = now
+	}
+	update := bson.M{
+		"$set":         bson.M{"token_hash": feed.TokenHash, "rotated_at": feed.RotatedAt},
+		"$setOnInsert": bson.M{"user_id": feed.UserID, "created_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc calendarFeedMongo
+	err := r.collection().FindOneAndUpdate(ctx, bson.M{"user_id": feed.UserID}, update, opts).Decode(&doc)
+	if err != nil {
+		ctx.Error("更新行事曆訂閱失敗", "error", err, "user_id", feed.UserID)
+		return convertMongoError(err)
+	}
+
+	*feed = *doc.toDomain()
+	ctx.Info("成功更新行事曆訂閱", "user_id", feed.UserID)
+	return nil
+}
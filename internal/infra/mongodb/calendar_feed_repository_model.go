@@ -0,0 +1,28 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// calendarFeedMongo 為 CalendarFeed 的持久化模型
+type calendarFeedMongo struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	UserID    string        `bson:"user_id"`
+	TokenHash string        `bson:"token_hash"`
+	CreatedAt time.Time     `bson:"created_at"`
+	RotatedAt time.Time     `bson:"rotated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *calendarFeedMongo) toDomain() *model.CalendarFeed {
+	return &model.CalendarFeed{
+		ID:        m.ID.Hex(),
+		UserID:    m.UserID,
+		TokenHash: m.TokenHash,
+		CreatedAt: m.CreatedAt,
+		RotatedAt: m.RotatedAt,
+	}
+}
@@ -0,0 +1,166 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	exchangeRateCollectionName = "exchange_rates"
+	userSettingsCollectionName = "user_settings"
+)
+
+// ExchangeRateRepositoryImpl 實作 repository.ExchangeRateRepository 介面，使用 MongoDB。
+type ExchangeRateRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewExchangeRateRepository 建立新的 ExchangeRateRepositoryImpl。
+func NewExchangeRateRepository(db *mongo.Database) repository.ExchangeRateRepository {
+	repo := &ExchangeRateRepositoryImpl{db: db}
+
+	ctx := contextx.WithContext(context.Background())
+	_, err := repo.collection().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "currency", Value: 1}, {Key: "date", Value: 1}},
+		Options: options.Index().SetName("currency_date_unique").SetUnique(true),
+	})
+	if err != nil {
+		ctx.Warn("建立匯率索引失敗", "error", err)
+	}
+
+	return repo
+}
+
+func (r *ExchangeRateRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(exchangeRateCollectionName)
+}
+
+// Upsert 依幣別與日期建立或覆寫匯率
+func (r *ExchangeRateRepositoryImpl) Upsert(c context.Context, rates []*model.ExchangeRate) error {
+	ctx := contextx.WithContext(c)
+	if len(rates) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	models := make([]mongo.WriteModel, 0, len(rates))
+	for _, rate := range rates {
+		rate.UpdatedAt = now
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"currency": rate.Currency, "date": rate.Date}).
+			SetUpdate(bson.M{"$set": bson.M{"rate": rate.Rate, "updated_at": now}}).
+			SetUpsert(true))
+	}
+
+	result, err := r.collection().BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		ctx.Error("寫入匯率失敗", "error", err, "count", len(rates))
+		return convertMongoError(err)
+	}
+
+	ctx.Info("成功寫入匯率", "inserted", result.UpsertedCount, "updated", result.ModifiedCount)
+	return nil
+}
+
+// FindByCurrency 查詢指定幣別的所有匯率，依日期由早到晚排列
+func (r *ExchangeRateRepositoryImpl) FindByCurrency(c context.Context, currency string) ([]*model.ExchangeRate, error) {
+	ctx := contextx.WithContext(c)
+
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}})
+	cursor, err := r.collection().Find(ctx, bson.M{"currency": currency}, opts)
+	if err != nil {
+		ctx.Error("查詢匯率時發生錯誤", "error", err, "currency", currency)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	rates := make([]*model.ExchangeRate, 0)
+	for cursor.Next(ctx) {
+		var doc exchangeRateMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼匯率時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		rates = append(rates, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷匯率查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return rates, nil
+}
+
+// UserSettingsRepositoryImpl 實作 repository.UserSettingsRepository 介面，使用 MongoDB。
+type UserSettingsRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewUserSettingsRepository 建立新的 UserSettingsRepositoryImpl。
+func NewUserSettingsRepository(db *mongo.Database) repository.UserSettingsRepository {
+	repo := &UserSettingsRepositoryImpl{db: db}
+
+	ctx := contextx.WithContext(context.Background())
+	_, err := repo.collection().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("user_id_unique").SetUnique(true),
+	})
+	if err != nil {
+		ctx.Warn("建立使用者設定索引失敗", "error", err)
+	}
+
+	return repo
+}
+
+func (r *UserSettingsRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(userSettingsCollectionName)
+}
+
+// FindByUserID 根據使用者 ID 查詢設定
+func (r *UserSettingsRepositoryImpl) FindByUserID(c context.Context, userID string) (*model.UserSettings, error) {
+	ctx := contextx.WithContext(c)
+
+	var doc userSettingsMongo
+	err := r.collection().FindOne(ctx, bson.M{"user_id": userID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找使用者設定時發生錯誤", "error", err, "user_id", userID)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// Upsert 建立或更新使用者設定
+func (r *UserSettingsRepositoryImpl) Upsert(c context.Context, settings *model.UserSettings) error {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	update := bson.M{
+		"$set":         bson.M{"base_currency": settings.BaseCurrency, "updated_at": now},
+		"$setOnInsert": bson.M{"user_id": settings.UserID, "created_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc userSettingsMongo
+	err := r.collection().FindOneAndUpdate(ctx, bson.M{"user_id": settings.UserID}, update, opts).Decode(&doc)
+	if err != nil {
+		ctx.Error("更新使用者設定失敗", "error", err, "user_id", settings.UserID)
+		return convertMongoError(err)
+	}
+
+	*settings = *doc.toDomain()
+	ctx.Info("成功更新使用者設定", "user_id", settings.UserID)
+	return nil
+}
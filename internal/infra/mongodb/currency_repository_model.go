@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// exchangeRateMongo 為 ExchangeRate 的持久化模型
+type exchangeRateMongo struct {
+	ID        bson.ObjectID `bson:"_id,omitempty"`
+	Currency  string        `bson:"currency"`
+	Date      time.Time     `bson:"date"`
+	Rate      float64       `bson:"rate"`
+	UpdatedAt time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *exchangeRateMongo) toDomain() *model.ExchangeRate {
+	return &model.ExchangeRate{
+		Currency:  m.Currency,
+		Date:      m.Date,
+		Rate:      m.Rate,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// userSettingsMongo 為 UserSettings 的持久化模型
+type userSettingsMongo struct {
+	ID           bson.ObjectID `bson:"_id,omitempty"`
+	UserID       string        `bson:"user_id"`
+	BaseCurrency string        `bson:"base_currency"`
+	CreatedAt    time.Time     `bson:"created_at"`
+	UpdatedAt    time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *userSettingsMongo) toDomain() *model.UserSettings {
+	return &model.UserSettings{
+		ID:           m.ID.Hex(),
+		UserID:       m.UserID,
+		BaseCurrency: m.BaseCurrency,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
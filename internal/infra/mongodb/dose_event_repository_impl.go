@@ -0,0 +1,126 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const doseEventCollectionName = "dose_events"
+
+// DoseEventRepositoryImpl 實作 repository.DoseEventRepository 介面，使用 MongoDB。
+type DoseEventRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewDoseEventRepository 建立新的 DoseEventRepositoryImpl。
+func NewDoseEventRepository(db *mongo.Database) repository.DoseEventRepository {
+	repo := &DoseEventRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *DoseEventRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(doseEventCollectionName)
+}
+
+// ensureIndexes 建立給藥紀錄查詢所需的索引
+func (r *DoseEventRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			// 同一療程的同一次給藥只會有一筆紀錄
+			Keys:    bson.D{{Key: "regimen_id", Value: 1}, {Key: "scheduled_at", Value: 1}},
+			Options: options.Index().SetName("regimen_scheduled_at_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立給藥紀錄索引失敗", "error", err)
+	}
+}
+
+// Upsert 依 (regimen_id, scheduled_at) 新增或覆寫給藥紀錄
+func (r *DoseEventRepositoryImpl) Upsert(c context.Context, event *model.DoseEvent) error {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	filter := bson.M{"regimen_id": event.RegimenID, "scheduled_at": event.ScheduledAt}
+	update := bson.M{
+		"$set": bson.M{
+			"status":      string(event.Status),
+			"taken_at":    event.TakenAt,
+			"recorded_by": event.RecordedBy,
+			"note":        event.Note,
+			"updated_at":  now,
+		},
+		"$setOnInsert": bson.M{"pet_id": event.PetID, "created_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc doseEventMongo
+	err := r.collection().FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err != nil {
+		ctx.Error("更新給藥紀錄失敗", "error", err, "regimen_id", event.RegimenID)
+		return convertMongoError(err)
+	}
+
+	*event = *doc.toDomain()
+	ctx.Info("成功更新給藥紀錄", "regimen_id", event.RegimenID, "dose_event_id", event.ID)
+	return nil
+}
+
+// FindByRegimen 查詢療程在 [from, to) 期間排定的給藥紀錄，依排定時間排序
+func (r *DoseEventRepositoryImpl) FindByRegimen(c context.Context, regimenID string, from, to time.Time) ([]*model.DoseEvent, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := bson.M{
+		"regimen_id":   regimenID,
+		"scheduled_at": bson.M{"$gte": from, "$lt": to},
+		deletedAtField: notDeleted,
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "scheduled_at", Value: 1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢給藥紀錄時發生錯誤", "error", err, "regimen_id", regimenID)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	result := make([]*model.DoseEvent, 0)
+	for cursor.Next(ctx) {
+		var doc doseEventMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼給藥紀錄時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		result = append(result, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷給藥紀錄查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return result, nil
+}
+
+// DeleteByRegimen 刪除療程的所有給藥紀錄
+func (r *DoseEventRepositoryImpl) DeleteByRegimen(c context.Context, regimenID string) error {
+	ctx := contextx.WithContext(c)
+
+	result, err := r.collection().DeleteMany(ctx, bson.M{"regimen_id": regimenID})
+	if err != nil {
+		ctx.Error("刪除給藥紀錄失敗", "error", err, "regimen_id", regimenID)
+		return convertMongoError(err)
+	}
+
+	ctx.Info("成功刪除給藥紀錄", "regimen_id", regimenID, "deleted", result.DeletedCount)
+	return nil
+}
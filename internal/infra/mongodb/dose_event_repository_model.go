@@ -0,0 +1,42 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// doseEventMongo 為 DoseEvent 的持久化模型
+type doseEventMongo struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	RegimenID   string        `bson:"regimen_id"`
+	PetID       string        `bson:"pet_id"`
+	ScheduledAt time.Time     `bson:"scheduled_at"`
+	Status      string        `bson:"status"`
+	TakenAt     *time.Time    `bson:"taken_at"`
+	RecordedBy  string        `bson:"recorded_by,omitempty"`
+	Note        string        `bson:"note,omitempty"`
+	CreatedAt   time.Time     `bson:"created_at"`
+	UpdatedAt   time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *doseEventMongo) toDomain() *model.DoseEvent {
+	if m == nil {
+		return nil
+	}
+	createdAt, updatedAt := m.CreatedAt, m.UpdatedAt
+	return &model.DoseEvent{
+		ID:          m.ID.Hex(),
+		RegimenID:   m.RegimenID,
+		PetID:       m.PetID,
+		ScheduledAt: m.ScheduledAt,
+		Status:      model.DoseStatus(m.Status),
+		TakenAt:     m.TakenAt,
+		RecordedBy:  m.RecordedBy,
+		Note:        m.Note,
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/blackhorseya/petlog/pkg/contextx"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 const expenseCollectionName = "expenses"
@@ -26,13 +27,37 @@ type expenseRepository struct {
 
 // NewExpenseRepository 建立新的 expenseRepository 實例
 func NewExpenseRepository(db *mongo.Database) repository.ExpenseRepository {
-	return &expenseRepository{db: db}
+	repo := &expenseRepository{db: db}
+	repo.ensureIndexes()
+	return repo
 }
 
 func (r *expenseRepository) collection() *mongo.Collection {
 	return r.db.Collection(expenseCollectionName)
 }
 
+// ensureIndexes 建立費用查詢所需的索引
+// 週期性費用的每個期別只能產生一筆費用，唯一索引確保產生器重複執行時不會重複建立
+// 批次匯入的去重鍵同樣以唯一索引確保同一筆資料只匯入一次
+func (r *expenseRepository) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "recurring_expense_id", Value: 1}, {Key: "occurrence_date", Value: 1}},
+			Options: options.Index().
+				SetName("recurring_expense_occurrence_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"recurring_expense_id": bson.M{"$exists": true}}),
+		},
+		importKeyIndex(),
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立費用索引失敗", "error", err)
+	}
+}
+
 // Create 新增費用紀錄
 func (r *expenseRepository) Create(c context.Context, expense *model.Expense) error {
 	ctx := contextx.WithContext(c)
@@ -44,6 +69,7 @@ func (r *expenseRepository) Create(c context.Context, expense *model.Expense) er
 	now := time.Now()
 	doc.CreatedAt = now
 	doc.UpdatedAt = now
+	doc.Version = initialVersion
 	result, err := r.collection().InsertOne(ctx, doc)
 	if err != nil {
 		ctx.Error("建立費用紀錄失敗", "error", err)
@@ -52,6 +78,7 @@ func (r *expenseRepository) Create(c context.Context, expense *model.Expense) er
 	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
 		expense.ID = oid.Hex()
 	}
+	expense.Version = doc.Version
 	ctx.Info("成功建立費用紀錄", "expense_id", expense.ID)
 	return nil
 }
@@ -64,7 +91,7 @@ func (r *expenseRepository) FindByID(c context.Context, id string) (*model.Expen
 		ctx.Warn("無效的費用紀錄 ID 格式", "expense_id", id, "error", err)
 		return nil, domain.ErrInvalidID
 	}
-	filter := bson.M{"_id": objectID}
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
 	var doc expenseMongo
 	err = r.collection().FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
@@ -88,8 +115,10 @@ func (r *expenseRepository) Update(c context.Context, expense *model.Expense) er
 		ctx.Error("領域模型轉換失敗", "error", err, "expense_id", expense.ID)
 		return err
 	}
-	filter := bson.M{"_id": doc.ID}
+	// 以版本比對實作 compare-and-swap，避免覆寫他人已儲存的變更
+	filter := bson.M{"_id": doc.ID, deletedAtField: notDeleted, versionField: versionMatch(expense.Version)}
 	doc.UpdatedAt = time.Now()
+	doc.Version = expense.Version + 1
 	update := bson.M{"$set": doc}
 	result, err := r.collection().UpdateOne(ctx, filter, update)
 	if err != nil {
@@ -97,14 +126,16 @@ func (r *expenseRepository) Update(c context.Context, expense *model.Expense) er
 		return convertMongoError(err)
 	}
 	if result.MatchedCount == 0 {
-		ctx.Warn("找不到要更新的費用紀錄", "expense_id", expense.ID)
-		return domain.ErrNotFound
+		err := resolveUpdateMiss(ctx, r.collection(), doc.ID)
+		ctx.Warn("更新費用紀錄未命中", "expense_id", expense.ID, "version", expense.Version, "error", err)
+		return err
 	}
-	ctx.Info("成功更新費用紀錄", "expense_id", expense.ID)
+	expense.Version = doc.Version
+	ctx.Info("成功更新費用紀錄", "expense_id", expense.ID, "version", expense.Version)
 	return nil
 }
 
-// Delete 刪除費用紀錄
+// Delete 軟刪除費用紀錄，移至垃圾桶
 func (r *expenseRepository) Delete(c context.Context, id string) error {
 	ctx := contextx.WithContext(c)
 	objectID, err := bson.ObjectIDFromHex(id)
@@ -112,13 +143,15 @@ func (r *expenseRepository) Delete(c context.Context, id string) error {
 		ctx.Warn("無效的費用紀錄 ID 格式", "expense_id", id, "error", err)
 		return domain.ErrInvalidID
 	}
-	filter := bson.M{"_id": objectID}
-	result, err := r.collection().DeleteOne(ctx, filter)
+	// 軟刪除：標記刪除時間，移至垃圾桶
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	update := bson.M{"$set": bson.M{deletedAtField: time.Now()}}
+	result, err := r.collection().UpdateOne(ctx, filter, update)
 	if err != nil {
 		ctx.Error("刪除費用紀錄失敗", "error", err, "expense_id", id)
 		return convertMongoError(err)
 	}
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		ctx.Warn("找不到要刪除的費用紀錄", "expense_id", id)
 		return domain.ErrNotFound
 	}
@@ -126,6 +159,18 @@ func (r *expenseRepository) Delete(c context.Context, id string) error {
 	return nil
 }
 
+// FindImportKeys 查詢指定寵物已使用的匯入去重鍵
+func (r *expenseRepository) FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error) {
+	ctx := contextx.WithContext(c)
+	found, err := findImportKeys(ctx, r.collection(), petID, keys)
+	if err != nil {
+		ctx.Error("查詢匯入去重鍵失敗", "error", err, "pet_id", petID)
+		return nil, err
+	}
+	ctx.Info("成功查詢匯入去重鍵", "pet_id", petID, "keys", len(keys), "found", len(found))
+	return found, nil
+}
+
 // FindAll 查詢費用紀錄（支援 options pattern）
 func (r *expenseRepository) FindAll(c context.Context, opts ...repository.ExpenseQueryOption) ([]*model.Expense, int, error) {
 	ctx := contextx.WithContext(c)
@@ -134,24 +179,21 @@ func (r *expenseRepository) FindAll(c context.Context, opts ...repository.Expens
 	for _, opt := range opts {
 		opt(queryOpts)
 	}
-	filter := bson.M{}
-	if queryOpts.PetID != nil {
-		filter["pet_id"] = *queryOpts.PetID
-	}
-	if queryOpts.Category != nil {
-		filter["category"] = *queryOpts.Category
-	}
-	if queryOpts.StartDate != nil || queryOpts.EndDate != nil {
-		dateCond := bson.M{}
-		if queryOpts.StartDate != nil {
-			dateCond["$gte"] = *queryOpts.StartDate
-		}
-		if queryOpts.EndDate != nil {
-			dateCond["$lte"] = *queryOpts.EndDate
+	filter := expenseFilter(queryOpts)
+	if queryOpts.Page != nil {
+		expenses, total, err := findPage[*model.Expense, expenseMongo](ctx, r.collection(), filter, *queryOpts.Page)
+		if err != nil {
+			ctx.Error("分頁查詢費用紀錄時發生錯誤", "error", err)
+			return nil, 0, convertMongoError(err)
 		}
-		filter["date"] = dateCond
+		ctx.Info("成功分頁查詢費用紀錄", "count", len(expenses), "total", total)
+		return expenses, total, nil
+	}
+	findOpts := options.Find()
+	if queryOpts.Recent > 0 {
+		findOpts.SetSort(bson.D{{Key: "date", Value: -1}, {Key: "created_at", Value: -1}}).SetLimit(int64(queryOpts.Recent))
 	}
-	cursor, err := r.collection().Find(ctx, filter)
+	cursor, err := r.collection().Find(ctx, filter, findOpts)
 	if err != nil {
 		ctx.Error("查詢費用紀錄時發生錯誤", "error", err)
 		return nil, 0, convertMongoError(err)
@@ -174,3 +216,91 @@ func (r *expenseRepository) FindAll(c context.Context, opts ...repository.Expens
 	ctx.Info("成功查詢費用紀錄", "count", total)
 	return expenses, total, nil
 }
+
+// Aggregate 以聚合管線依分組方式彙總費用
+// 匯率依消費日期而定，因此同一分組再依幣別與日期拆分，由呼叫端換算後合併
+func (r *expenseRepository) Aggregate(c context.Context, groupBy model.ExpenseGroupBy, opts ...repository.ExpenseQueryOption) ([]*model.ExpenseBucketPart, error) {
+	ctx := contextx.WithContext(c)
+	queryOpts := &repository.ExpenseQueryOptions{}
+	for _, opt := range opts {
+		opt(queryOpts)
+	}
+
+	var groupKey interface{}
+	switch groupBy {
+	case model.ExpenseGroupByCategory:
+		groupKey = "$category"
+	case model.ExpenseGroupByPet:
+		groupKey = "$pet_id"
+	default:
+		groupKey = bson.M{"$dateTrunc": bson.M{"date": "$date", "unit": string(groupBy), "timezone": "UTC"}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: expenseFilter(queryOpts)}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"key":      groupKey,
+				"currency": bson.M{"$ifNull": bson.A{"$currency", ""}},
+				"day":      bson.M{"$dateTrunc": bson.M{"date": "$date", "unit": "day", "timezone": "UTC"}},
+			},
+			"total": bson.M{"$sum": "$amount"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.key", Value: 1}, {Key: "_id.day", Value: 1}}}},
+	}
+
+	cursor, err := r.collection().Aggregate(ctx, pipeline)
+	if err != nil {
+		ctx.Error("彙總費用紀錄時發生錯誤", "error", err, "group_by", groupBy)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	parts := make([]*model.ExpenseBucketPart, 0)
+	for cursor.Next(ctx) {
+		var doc expenseBucketMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼費用彙總結果時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		parts = append(parts, doc.toDomain(groupBy))
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷費用彙總結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return parts, nil
+}
+
+// expenseFilter 將查詢選項轉換為 MongoDB 查詢條件
+// 同時指定 PetIDs 與 PetID 時兩者皆須符合，PetID 不會擴大 PetIDs 限定的存取範圍
+func expenseFilter(queryOpts *repository.ExpenseQueryOptions) bson.M {
+	filter := bson.M{deletedAtField: notDeleted}
+	switch {
+	case queryOpts.PetIDs != nil && queryOpts.PetID != nil:
+		filter["$and"] = bson.A{
+			bson.M{"pet_id": bson.M{"$in": queryOpts.PetIDs}},
+			bson.M{"pet_id": *queryOpts.PetID},
+		}
+	case queryOpts.PetIDs != nil:
+		filter["pet_id"] = bson.M{"$in": queryOpts.PetIDs}
+	case queryOpts.PetID != nil:
+		filter["pet_id"] = *queryOpts.PetID
+	}
+	if queryOpts.Category != nil {
+		filter["category"] = *queryOpts.Category
+	}
+	if queryOpts.StartDate != nil || queryOpts.EndDate != nil {
+		dateCond := bson.M{}
+		if queryOpts.StartDate != nil {
+			dateCond["$gte"] = *queryOpts.StartDate
+		}
+		if queryOpts.EndDate != nil {
+			dateCond["$lte"] = *queryOpts.EndDate
+		}
+		filter["date"] = dateCond
+	}
+	return filter
+}
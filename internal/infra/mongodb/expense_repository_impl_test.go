@@ -0,0 +1,62 @@
+package mongodb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestExpenseFilter_PetScope(t *testing.T) {
+	build := func(opts ...repository.ExpenseQueryOption) bson.M {
+		queryOpts := &repository.ExpenseQueryOptions{}
+		for _, opt := range opts {
+			opt(queryOpts)
+		}
+		return expenseFilter(queryOpts)
+	}
+
+	tests := []struct {
+		name string
+		opts []repository.ExpenseQueryOption
+		want bson.M
+	}{
+		{
+			name: "只指定寵物",
+			opts: []repository.ExpenseQueryOption{repository.WithPetID("pet-1")},
+			want: bson.M{deletedAtField: notDeleted, "pet_id": "pet-1"},
+		},
+		{
+			name: "只限定寵物群組",
+			opts: []repository.ExpenseQueryOption{repository.WithPetIDs([]string{"pet-1", "pet-2"})},
+			want: bson.M{deletedAtField: notDeleted, "pet_id": bson.M{"$in": []string{"pet-1", "pet-2"}}},
+		},
+		{
+			name: "指定範圍外的寵物時仍受群組限制",
+			opts: []repository.ExpenseQueryOption{
+				repository.WithPetID("pet-other"),
+				repository.WithPetIDs([]string{"pet-1", "pet-2"}),
+			},
+			want: bson.M{deletedAtField: notDeleted, "$and": bson.A{
+				bson.M{"pet_id": bson.M{"$in": []string{"pet-1", "pet-2"}}},
+				bson.M{"pet_id": "pet-other"},
+			}},
+		},
+		{
+			name: "空的寵物群組不會被指定寵物放寬",
+			opts: []repository.ExpenseQueryOption{repository.WithPetIDs(nil), repository.WithPetID("pet-other")},
+			want: bson.M{deletedAtField: notDeleted, "$and": bson.A{
+				bson.M{"pet_id": bson.M{"$in": []string{}}},
+				bson.M{"pet_id": "pet-other"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := build(tt.opts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -17,8 +17,19 @@ type expenseMongo struct {
 	Amount      int           `bson:"amount"`
 	Description string        `bson:"description,omitempty"`
 	Date        time.Time     `bson:"date"`
+	Version     int           `bson:"version"`
 	CreatedAt   time.Time     `bson:"created_at"`
 	UpdatedAt   time.Time     `bson:"updated_at"`
+
+	// 週期性費用的來源與期別，搭配唯一索引避免重複產生
+	RecurringExpenseID string     `bson:"recurring_expense_id,omitempty"`
+	OccurrenceDate     *time.Time `bson:"occurrence_date,omitempty"`
+
+	// 原始幣別，舊資料沒有此欄位
+	Currency string `bson:"currency,omitempty"`
+
+	// 批次匯入的去重鍵，搭配唯一索引避免重複匯入
+	ImportKey string `bson:"import_key,omitempty"`
 }
 
 // toDomain 轉換為領域模型
@@ -33,8 +44,16 @@ func (e *expenseMongo) toDomain() *model.Expense {
 		Amount:      e.Amount,
 		Description: e.Description,
 		Date:        e.Date,
+		Version:     e.Version,
 		CreatedAt:   e.CreatedAt,
 		UpdatedAt:   e.UpdatedAt,
+
+		RecurringExpenseID: e.RecurringExpenseID,
+		OccurrenceDate:     e.OccurrenceDate,
+
+		Currency: e.Currency,
+
+		ImportKey: e.ImportKey,
 	}
 }
 
@@ -61,7 +80,46 @@ func expenseMongoFromDomain(exp *model.Expense) (*expenseMongo, error) {
 		Amount:      exp.Amount,
 		Description: exp.Description,
 		Date:        exp.Date,
+		Version:     exp.Version,
 		CreatedAt:   exp.CreatedAt,
 		UpdatedAt:   exp.UpdatedAt,
+
+		RecurringExpenseID: exp.RecurringExpenseID,
+		OccurrenceDate:     exp.OccurrenceDate,
+
+		Currency: exp.Currency,
+
+		ImportKey: exp.ImportKey,
 	}, nil
 }
+
+// expenseBucketMongo 為費用聚合結果，_id.key 依分組方式為日期或字串
+type expenseBucketMongo struct {
+	ID struct {
+		Key      bson.RawValue `bson:"key"`
+		Currency string        `bson:"currency"`
+		Day      time.Time     `bson:"day"`
+	} `bson:"_id"`
+	Total int `bson:"total"`
+	Count int `bson:"count"`
+}
+
+// toDomain 轉換為領域模型，時間分組會附上區間起點與顯示鍵
+func (b *expenseBucketMongo) toDomain(groupBy model.ExpenseGroupBy) *model.ExpenseBucketPart {
+	part := &model.ExpenseBucketPart{
+		Currency: b.ID.Currency,
+		Date:     b.ID.Day.UTC(),
+		Total:    b.Total,
+		Count:    b.Count,
+	}
+	if groupBy.IsTimeBased() {
+		if dt, ok := b.ID.Key.DateTimeOK(); ok {
+			start := time.UnixMilli(dt).UTC()
+			part.PeriodStart = &start
+			part.Key = groupBy.PeriodKey(start)
+		}
+		return part
+	}
+	part.Key, _ = b.ID.Key.StringValueOK()
+	return part
+}
@@ -0,0 +1,213 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const exportJobCollectionName = "export_jobs"
+
+// ExportJobRepositoryImpl 實作 repository.ExportJobRepository 介面，使用 MongoDB。
+type ExportJobRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewExportJobRepository 建立新的 ExportJobRepositoryImpl。
+func NewExportJobRepository(db *mongo.Database) repository.ExportJobRepository {
+	repo := &ExportJobRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *ExportJobRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(exportJobCollectionName)
+}
+
+// ensureIndexes 建立匯出工作查詢所需的索引
+func (r *ExportJobRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("owner_id_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+			Options: options.Index().SetName("status_created_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetName("expires_at"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立匯出工作索引失敗", "error", err)
+	}
+}
+
+// Create 新增匯出工作
+func (r *ExportJobRepositoryImpl) Create(c context.Context, job *model.ExportJob) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := exportJobMongoFromDomain(job)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+	doc.CreatedAt = time.Now()
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立匯出工作失敗", "error", err)
+		return convertMongoError(err)
+	}
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		job.ID = oid.Hex()
+	}
+	job.CreatedAt = doc.CreatedAt
+
+	ctx.Info("成功建立匯出工作", "export_id", job.ID)
+	return nil
+}
+
+// FindByID 依 ID 查詢匯出工作
+func (r *ExportJobRepositoryImpl) FindByID(c context.Context, id string) (*model.ExportJob, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的匯出工作 ID 格式", "export_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc exportJobMongo
+	if err := r.collection().FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的匯出工作", "export_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找匯出工作時發生錯誤", "error", err, "export_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByOwnerID 查詢使用者的所有匯出工作，依建立時間由新到舊排列
+func (r *ExportJobRepositoryImpl) FindByOwnerID(c context.Context, ownerID string) ([]*model.ExportJob, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	return r.find(c, bson.M{"owner_id": ownerID}, opts)
+}
+
+// ClaimNext 以單一原子更新領取下一個工作，多個排程器同時執行時不會重複處理
+func (r *ExportJobRepositoryImpl) ClaimNext(c context.Context, now, staleBefore time.Time) (*model.ExportJob, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": string(model.ExportStatusPending)},
+		bson.M{"status": string(model.ExportStatusRunning), "started_at": bson.M{"$lt": staleBefore}},
+	}}
+	update := bson.M{"$set": bson.M{"status": string(model.ExportStatusRunning), "started_at": now}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var doc exportJobMongo
+	if err := r.collection().FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("領取匯出工作失敗", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// Update 更新匯出工作
+func (r *ExportJobRepositoryImpl) Update(c context.Context, job *model.ExportJob) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := exportJobMongoFromDomain(job)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.collection().UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新匯出工作失敗", "error", err, "export_id", job.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindExpired 查詢保留期限已過的工作
+func (r *ExportJobRepositoryImpl) FindExpired(c context.Context, now time.Time) ([]*model.ExportJob, error) {
+	return r.find(c, bson.M{"expires_at": bson.M{"$lte": now}}, options.Find())
+}
+
+// Delete 刪除匯出工作
+func (r *ExportJobRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的匯出工作 ID 格式", "export_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除匯出工作失敗", "error", err, "export_id", id)
+		return convertMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除匯出工作", "export_id", id)
+	return nil
+}
+
+// find 依條件查詢匯出工作
+func (r *ExportJobRepositoryImpl) find(c context.Context, filter bson.M, opts *options.FindOptionsBuilder) ([]*model.ExportJob, error) {
+	ctx := contextx.WithContext(c)
+
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢匯出工作時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	jobs := make([]*model.ExportJob, 0)
+	for cursor.Next(ctx) {
+		var doc exportJobMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼匯出工作時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		jobs = append(jobs, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷匯出工作查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return jobs, nil
+}
@@ -0,0 +1,77 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// exportJobMongo 為 ExportJob 的持久化模型
+type exportJobMongo struct {
+	ID          bson.ObjectID `bson:"_id,omitempty"`
+	OwnerID     string        `bson:"owner_id"`
+	Format      string        `bson:"format"`
+	Status      string        `bson:"status"`
+	FileName    string        `bson:"file_name"`
+	Size        int64         `bson:"size"`
+	StorageKey  string        `bson:"storage_key"`
+	Error       string        `bson:"error"`
+	CreatedAt   time.Time     `bson:"created_at"`
+	StartedAt   *time.Time    `bson:"started_at"`
+	CompletedAt *time.Time    `bson:"completed_at"`
+	ExpiresAt   *time.Time    `bson:"expires_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *exportJobMongo) toDomain() *model.ExportJob {
+	if m == nil {
+		return nil
+	}
+	return &model.ExportJob{
+		ID:          m.ID.Hex(),
+		OwnerID:     m.OwnerID,
+		Format:      model.ExportFormat(m.Format),
+		Status:      model.ExportStatus(m.Status),
+		FileName:    m.FileName,
+		Size:        m.Size,
+		StorageKey:  m.StorageKey,
+		Error:       m.Error,
+		CreatedAt:   m.CreatedAt,
+		StartedAt:   m.StartedAt,
+		CompletedAt: m.CompletedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
+
+// exportJobMongoFromDomain 將領域模型轉換為持久化模型
+func exportJobMongoFromDomain(j *model.ExportJob) (*exportJobMongo, error) {
+	if j == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	if j.ID != "" {
+		var err error
+		objectID, err = bson.ObjectIDFromHex(j.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &exportJobMongo{
+		ID:          objectID,
+		OwnerID:     j.OwnerID,
+		Format:      string(j.Format),
+		Status:      string(j.Status),
+		FileName:    j.FileName,
+		Size:        j.Size,
+		StorageKey:  j.StorageKey,
+		Error:       j.Error,
+		CreatedAt:   j.CreatedAt,
+		StartedAt:   j.StartedAt,
+		CompletedAt: j.CompletedAt,
+		ExpiresAt:   j.ExpiresAt,
+	}, nil
+}
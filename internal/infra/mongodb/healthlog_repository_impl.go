@@ -24,13 +24,24 @@ type HealthLogRepositoryImpl struct {
 
 // NewHealthLogRepository creates a new HealthLogRepositoryImpl.
 func NewHealthLogRepository(db *mongo.Database) (repository.HealthLogRepository, error) {
-	return &HealthLogRepositoryImpl{db: db}, nil
+	repo := &HealthLogRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo, nil
 }
 
 func (r *HealthLogRepositoryImpl) collection() *mongo.Collection {
 	return r.db.Collection(healthLogCollectionName)
 }
 
+// ensureIndexes 建立健康日誌所需的索引
+func (r *HealthLogRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, []mongo.IndexModel{importKeyIndex()}); err != nil {
+		ctx.Warn("建立健康日誌索引失敗", "error", err)
+	}
+}
+
 // Create inserts a new health log record into the database.
 func (r *HealthLogRepositoryImpl) Create(c context.Context, log *model.HealthLog) error {
 	ctx := contextx.WithContext(c)
@@ -45,6 +56,7 @@ func (r *HealthLogRepositoryImpl) Create(c context.Context, log *model.HealthLog
 	now := time.Now()
 	logDoc.CreatedAt = now
 	logDoc.UpdatedAt = now
+	logDoc.Version = initialVersion
 
 	result, err := r.collection().InsertOne(ctx, logDoc)
 	if err != nil {
@@ -55,6 +67,7 @@ func (r *HealthLogRepositoryImpl) Create(c context.Context, log *model.HealthLog
 	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
 		log.ID = oid.Hex()
 	}
+	log.Version = logDoc.Version
 
 	ctx.Info("成功建立健康日誌", "log_id", log.ID)
 	return nil
@@ -71,7 +84,7 @@ func (r *HealthLogRepositoryImpl) FindByID(c context.Context, id string) (*model
 		return nil, domain.ErrInvalidID
 	}
 
-	filter := bson.M{"_id": objectID}
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
 	var logDoc healthLogMongo
 
 	err = r.collection().FindOne(ctx, filter).Decode(&logDoc)
@@ -86,18 +99,12 @@ func (r *HealthLogRepositoryImpl) FindByID(c context.Context, id string) (*model
 	return log, nil
 }
 
-// FindByPetID retrieves all health logs for a specific pet within a given date range.
-func (r *HealthLogRepositoryImpl) FindByPetID(c context.Context, petID string, startDate, endDate time.Time) ([]*model.HealthLog, error) {
+// FindByPetID retrieves all health logs for a specific pet within a given date range matching the observation filter.
+func (r *HealthLogRepositoryImpl) FindByPetID(c context.Context, petID string, startDate, endDate time.Time, logFilter model.HealthLogFilter) ([]*model.HealthLog, error) {
 	ctx := contextx.WithContext(c)
 	ctx.Info("開始根據寵物 ID 和日期範圍查找健康日誌", "pet_id", petID, "start_date", startDate, "end_date", endDate)
 
-	filter := bson.M{
-		"pet_id": petID,
-		"date": bson.M{
-			"$gte": startDate,
-			"$lte": endDate,
-		},
-	}
+	filter := petHealthLogFilter(petID, startDate, endDate, logFilter)
 
 	cursor, err := r.collection().Find(ctx, filter)
 	if err != nil {
@@ -125,6 +132,35 @@ func (r *HealthLogRepositoryImpl) FindByPetID(c context.Context, petID string, s
 	return logs, nil
 }
 
+// ListByPetID retrieves one page of a pet's health logs matching the date range and observation filter.
+func (r *HealthLogRepositoryImpl) ListByPetID(c context.Context, petID string, startDate, endDate time.Time, logFilter model.HealthLogFilter, page repository.PageRequest) ([]*model.HealthLog, int, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := petHealthLogFilter(petID, startDate, endDate, logFilter)
+	logs, total, err := findPage[*model.HealthLog, healthLogMongo](ctx, r.collection(), filter, page)
+	if err != nil {
+		ctx.Error("分頁查找健康日誌時發生錯誤", "error", err, "pet_id", petID)
+		return nil, 0, fmt.Errorf("查找健康日誌失敗: %w", err)
+	}
+
+	ctx.Info("成功分頁查找健康日誌", "pet_id", petID, "count", len(logs), "total", total)
+	return logs, total, nil
+}
+
+// petHealthLogFilter builds the query for a pet's non-deleted health logs within a date range.
+func petHealthLogFilter(petID string, startDate, endDate time.Time, logFilter model.HealthLogFilter) bson.M {
+	filter := bson.M{
+		"pet_id": petID,
+		"date": bson.M{
+			"$gte": startDate,
+			"$lte": endDate,
+		},
+		deletedAtField: notDeleted,
+	}
+	healthLogFilterToBSON(filter, logFilter)
+	return filter
+}
+
 // Update modifies an existing health log record.
 func (r *HealthLogRepositoryImpl) Update(c context.Context, log *model.HealthLog) error {
 	ctx := contextx.WithContext(c)
@@ -136,9 +172,11 @@ func (r *HealthLogRepositoryImpl) Update(c context.Context, log *model.HealthLog
 		return err
 	}
 
-	filter := bson.M{"_id": logDoc.ID}
+	// 以版本比對實作 compare-and-swap，避免覆寫他人已儲存的變更
+	filter := bson.M{"_id": logDoc.ID, deletedAtField: notDeleted, versionField: versionMatch(log.Version)}
 
 	logDoc.UpdatedAt = time.Now()
+	logDoc.Version = log.Version + 1
 	update := bson.M{"$set": logDoc}
 
 	result, err := r.collection().UpdateOne(ctx, filter, update)
@@ -148,15 +186,31 @@ func (r *HealthLogRepositoryImpl) Update(c context.Context, log *model.HealthLog
 	}
 
 	if result.MatchedCount == 0 {
-		ctx.Warn("找不到要更新的健康日誌", "log_id", log.ID)
-		return domain.ErrNotFound
+		err := resolveUpdateMiss(ctx, r.collection(), logDoc.ID)
+		ctx.Warn("更新健康日誌未命中", "log_id", log.ID, "version", log.Version, "error", err)
+		return err
 	}
 
-	ctx.Info("成功更新健康日誌", "log_id", log.ID)
+	log.Version = logDoc.Version
+	ctx.Info("成功更新健康日誌", "log_id", log.ID, "version", log.Version)
 	return nil
 }
 
-// Delete removes a health log record from the database.
+// FindImportKeys returns the IDs of the pet's health logs already created with the given import keys.
+func (r *HealthLogRepositoryImpl) FindImportKeys(c context.Context, petID string, keys []string) (map[string]string, error) {
+	ctx := contextx.WithContext(c)
+
+	found, err := findImportKeys(ctx, r.collection(), petID, keys)
+	if err != nil {
+		ctx.Error("查詢匯入去重鍵失敗", "error", err, "pet_id", petID)
+		return nil, err
+	}
+
+	ctx.Info("成功查詢匯入去重鍵", "pet_id", petID, "keys", len(keys), "found", len(found))
+	return found, nil
+}
+
+// Delete soft-deletes a health log record by moving it to the trash.
 func (r *HealthLogRepositoryImpl) Delete(c context.Context, id string) error {
 	ctx := contextx.WithContext(c)
 	ctx.Info("開始刪除健康日誌", "log_id", id)
@@ -167,15 +221,17 @@ func (r *HealthLogRepositoryImpl) Delete(c context.Context, id string) error {
 		return domain.ErrInvalidID
 	}
 
-	filter := bson.M{"_id": objectID}
+	// 軟刪除：標記刪除時間，移至垃圾桶
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	update := bson.M{"$set": bson.M{deletedAtField: time.Now()}}
 
-	result, err := r.collection().DeleteOne(ctx, filter)
+	result, err := r.collection().UpdateOne(ctx, filter, update)
 	if err != nil {
 		ctx.Error("刪除健康日誌失敗", "error", err, "log_id", id)
 		return convertMongoError(err)
 	}
 
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		ctx.Warn("找不到要刪除的健康日誌", "log_id", id)
 		return domain.ErrNotFound
 	}
@@ -184,6 +240,68 @@ func (r *HealthLogRepositoryImpl) Delete(c context.Context, id string) error {
 	return nil
 }
 
+// AggregateDailyFoodIntake 以聚合管線在資料庫端依 UTC 日期加總進食量，避免將所有日誌載入記憶體
+// 未記錄進食量的日誌沒有 food_gram 欄位，記錄為 0 公克的日誌仍會計入，代表當天未進食
+func (r *HealthLogRepositoryImpl) AggregateDailyFoodIntake(c context.Context, petID string, startDate, endDate time.Time, logFilter model.HealthLogFilter) ([]*model.DailyFoodIntake, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始彙總每日進食量", "pet_id", petID, "start_date", startDate, "end_date", endDate)
+
+	match := bson.M{
+		"pet_id":       petID,
+		"date":         bson.M{"$gte": startDate, "$lte": endDate},
+		"food_gram":    bson.M{"$exists": true},
+		deletedAtField: notDeleted,
+	}
+	healthLogFilterToBSON(match, logFilter)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"$dateToString": bson.M{
+				"format":   "%Y-%m-%d",
+				"date":     "$date",
+				"timezone": "UTC",
+			}},
+			"food_gram": bson.M{"$sum": "$food_gram"},
+			"log_count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+
+	cursor, err := r.collection().Aggregate(ctx, pipeline)
+	if err != nil {
+		ctx.Error("彙總每日進食量時發生錯誤", "error", err, "pet_id", petID)
+		return nil, fmt.Errorf("彙總每日進食量失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	intakes := make([]*model.DailyFoodIntake, 0)
+	for cursor.Next(ctx) {
+		var doc struct {
+			Day      string `bson:"_id"`
+			FoodGram int    `bson:"food_gram"`
+			LogCount int    `bson:"log_count"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼每日進食量時發生錯誤", "error", err, "pet_id", petID)
+			return nil, fmt.Errorf("解碼每日進食量失敗: %w", err)
+		}
+
+		date, err := time.Parse(time.DateOnly, doc.Day)
+		if err != nil {
+			return nil, fmt.Errorf("解析彙總日期失敗: %w", err)
+		}
+		intakes = append(intakes, &model.DailyFoodIntake{Date: date, FoodGram: doc.FoodGram, LogCount: doc.LogCount})
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷每日進食量結果時發生錯誤", "error", err, "pet_id", petID)
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	return intakes, nil
+}
+
 // CountByPetIDs 實作根據寵物 ID 列表統計健康日誌總數的功能，用於聚合查詢
 func (r *HealthLogRepositoryImpl) CountByPetIDs(c context.Context, petIDs []string) (int, error) {
 	ctx := contextx.WithContext(c)
@@ -196,7 +314,10 @@ func (r *HealthLogRepositoryImpl) CountByPetIDs(c context.Context, petIDs []stri
 	}
 
 	// 建立查詢過濾器，使用 $in 操作符
-	filter := bson.D{{Key: "pet_id", Value: bson.D{{Key: "$in", Value: petIDs}}}}
+	filter := bson.D{
+		{Key: "pet_id", Value: bson.D{{Key: "$in", Value: petIDs}}},
+		{Key: deletedAtField, Value: notDeleted},
+	}
 
 	// 執行計數操作
 	count, err := r.collection().CountDocuments(ctx, filter)
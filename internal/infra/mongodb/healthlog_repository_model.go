@@ -14,11 +14,21 @@ type healthLogMongo struct {
 	PetID          string        `bson:"pet_id"`
 	Date           time.Time     `bson:"date"`
 	WeightKg       float64       `bson:"weight_kg,omitempty"`
-	FoodGram       int           `bson:"food_gram,omitempty"`
+	FoodGram       *int          `bson:"food_gram,omitempty"`
 	LitterNotes    string        `bson:"litter_notes,omitempty"`
 	BehaviourNotes string        `bson:"behaviour_notes,omitempty"`
-	CreatedAt      time.Time     `bson:"created_at"`
-	UpdatedAt      time.Time     `bson:"updated_at"`
+	// 結構化觀察不使用 omitempty，更新時才能清除先前記錄的值
+	UrineClumps      *int      `bson:"urine_clumps"`
+	StoolConsistency *int      `bson:"stool_consistency"`
+	VomitingCount    int       `bson:"vomiting_count"`
+	ActivityLevel    string    `bson:"activity_level"`
+	MoodTags         []string  `bson:"mood_tags"`
+	Version          int       `bson:"version"`
+	CreatedAt        time.Time `bson:"created_at"`
+	UpdatedAt        time.Time `bson:"updated_at"`
+
+	// 批次匯入的去重鍵，搭配唯一索引避免重複匯入
+	ImportKey string `bson:"import_key,omitempty"`
 }
 
 // toDomain converts the persistence model to a domain model.
@@ -35,6 +45,15 @@ func (h *healthLogMongo) toDomain() *model.HealthLog {
 		FoodGram:       h.FoodGram,
 		LitterNotes:    h.LitterNotes,
 		BehaviourNotes: h.BehaviourNotes,
+		HealthObservations: model.HealthObservations{
+			UrineClumps:      h.UrineClumps,
+			StoolConsistency: h.StoolConsistency,
+			VomitingCount:    h.VomitingCount,
+			ActivityLevel:    model.ActivityLevel(h.ActivityLevel),
+			MoodTags:         moodTagsToDomain(h.MoodTags),
+		},
+		Version:   h.Version,
+		ImportKey: h.ImportKey,
 	}
 }
 
@@ -55,12 +74,64 @@ func healthLogMongoFromDomain(h *model.HealthLog) (*healthLogMongo, error) {
 	}
 
 	return &healthLogMongo{
-		ID:             objectID,
-		PetID:          h.PetID,
-		Date:           h.Date,
-		WeightKg:       h.WeightKg,
-		FoodGram:       h.FoodGram,
-		LitterNotes:    h.LitterNotes,
-		BehaviourNotes: h.BehaviourNotes,
+		ID:               objectID,
+		PetID:            h.PetID,
+		Date:             h.Date,
+		WeightKg:         h.WeightKg,
+		FoodGram:         h.FoodGram,
+		LitterNotes:      h.LitterNotes,
+		BehaviourNotes:   h.BehaviourNotes,
+		UrineClumps:      h.UrineClumps,
+		StoolConsistency: h.StoolConsistency,
+		VomitingCount:    h.VomitingCount,
+		ActivityLevel:    string(h.ActivityLevel),
+		MoodTags:         moodTagsFromDomain(h.MoodTags),
+		Version:          h.Version,
+		ImportKey:        h.ImportKey,
 	}, nil
 }
+
+func moodTagsToDomain(tags []string) []model.MoodTag {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]model.MoodTag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, model.MoodTag(tag))
+	}
+	return result
+}
+
+func moodTagsFromDomain(tags []model.MoodTag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, string(tag))
+	}
+	return result
+}
+
+// healthLogFilterToBSON 將結構化觀察的篩選條件加入查詢條件
+func healthLogFilterToBSON(filter bson.M, f model.HealthLogFilter) {
+	if f.HasVomiting {
+		filter["vomiting_count"] = bson.M{"$gt": 0}
+	}
+	if f.StoolConsistencyMin > 0 || f.StoolConsistencyMax > 0 {
+		stool := bson.M{}
+		if f.StoolConsistencyMin > 0 {
+			stool["$gte"] = f.StoolConsistencyMin
+		}
+		if f.StoolConsistencyMax > 0 {
+			stool["$lte"] = f.StoolConsistencyMax
+		}
+		filter["stool_consistency"] = stool
+	}
+	if f.ActivityLevel != "" {
+		filter["activity_level"] = string(f.ActivityLevel)
+	}
+	if f.MoodTag != "" {
+		filter["mood_tags"] = string(f.MoodTag)
+	}
+}
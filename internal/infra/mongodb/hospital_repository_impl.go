@@ -5,6 +5,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/blackhorseya/petlog/internal/domain"
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/pkg/contextx"
@@ -188,7 +189,7 @@ func (r *hospitalMongoRepo) GetByID(c context.Context, id string) (*model.Hospit
 	objectID, err := bson.ObjectIDFromHex(id)
 	if err != nil {
 		ctx.Error("無效的醫院 ID", "id", id, "error", err)
-		return nil, convertMongoError(err)
+		return nil, domain.ErrInvalidID
 	}
 
 	collection := r.db.Collection(hospitalCollection)
@@ -0,0 +1,175 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	householdCollectionName = "households"
+)
+
+// HouseholdRepositoryImpl 實作 repository.HouseholdRepository 介面，使用 MongoDB。
+type HouseholdRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewHouseholdRepository 建立新的 HouseholdRepositoryImpl。
+func NewHouseholdRepository(db *mongo.Database) repository.HouseholdRepository {
+	repo := &HouseholdRepositoryImpl{db: db}
+
+	ctx := contextx.WithContext(context.Background())
+	_, err := repo.collection().Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+			Options: options.Index().SetName("members_user_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "invitations.token_hash", Value: 1}},
+			Options: options.Index().SetName("invitations_token_hash"),
+		},
+	})
+	if err != nil {
+		ctx.Warn("建立家庭索引失敗", "error", err)
+	}
+
+	return repo
+}
+
+func (r *HouseholdRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(householdCollectionName)
+}
+
+// Create 建立新的家庭。
+func (r *HouseholdRepositoryImpl) Create(c context.Context, household *model.Household) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始建立家庭", "name", household.Name)
+
+	doc, err := householdMongoFromDomain(household)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立家庭失敗", "error", err)
+		return convertMongoError(err)
+	}
+
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		household.ID = oid.Hex()
+	}
+	household.CreatedAt = now
+	household.UpdatedAt = now
+
+	ctx.Info("成功建立家庭", "household_id", household.ID)
+	return nil
+}
+
+// FindByID 根據 ID 查詢家庭。
+func (r *HouseholdRepositoryImpl) FindByID(c context.Context, id string) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的家庭 ID 格式", "household_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	return r.findOne(ctx, bson.M{"_id": objectID})
+}
+
+// FindByMemberUserID 查詢使用者所屬的所有家庭。
+func (r *HouseholdRepositoryImpl) FindByMemberUserID(c context.Context, userID string) ([]*model.Household, error) {
+	ctx := contextx.WithContext(c)
+
+	cursor, err := r.collection().Find(ctx, bson.M{"members.user_id": userID})
+	if err != nil {
+		ctx.Error("查找使用者家庭時發生錯誤", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("查找使用者家庭失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	households := make([]*model.Household, 0)
+	for cursor.Next(ctx) {
+		var doc householdMongo
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("解碼家庭資料失敗: %w", err)
+		}
+		households = append(households, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	return households, nil
+}
+
+// FindByInvitationTokenHash 根據邀請 token 雜湊值查詢家庭。
+func (r *HouseholdRepositoryImpl) FindByInvitationTokenHash(c context.Context, tokenHash string) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+	return r.findOne(ctx, bson.M{"invitations.token_hash": tokenHash})
+}
+
+// Update 更新家庭（包含成員與邀請）。
+func (r *HouseholdRepositoryImpl) Update(c context.Context, household *model.Household) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始更新家庭", "household_id", household.ID)
+
+	doc, err := householdMongoFromDomain(household)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "household_id", household.ID)
+		return err
+	}
+
+	doc.UpdatedAt = time.Now()
+	update := bson.M{"$set": bson.M{
+		"name":        doc.Name,
+		"members":     doc.Members,
+		"invitations": doc.Invitations,
+		"updated_at":  doc.UpdatedAt,
+	}}
+
+	result, err := r.collection().UpdateOne(ctx, bson.M{"_id": doc.ID}, update)
+	if err != nil {
+		ctx.Error("更新家庭失敗", "error", err, "household_id", household.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		ctx.Warn("找不到要更新的家庭", "household_id", household.ID)
+		return domain.ErrNotFound
+	}
+
+	household.UpdatedAt = doc.UpdatedAt
+	ctx.Info("成功更新家庭", "household_id", household.ID)
+	return nil
+}
+
+// findOne 依條件查詢單一家庭
+func (r *HouseholdRepositoryImpl) findOne(ctx *contextx.Contextx, filter bson.M) (*model.Household, error) {
+	var doc householdMongo
+	err := r.collection().FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找家庭時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	return doc.toDomain(), nil
+}
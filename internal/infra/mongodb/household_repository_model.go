@@ -0,0 +1,122 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// householdMemberMongo 是 HouseholdMember 的持久化模型
+type householdMemberMongo struct {
+	UserID    string     `bson:"user_id"`
+	Role      string     `bson:"role"`
+	ExpiresAt *time.Time `bson:"expires_at,omitempty"`
+	JoinedAt  time.Time  `bson:"joined_at"`
+}
+
+// householdInvitationMongo 是 HouseholdInvitation 的持久化模型
+type householdInvitationMongo struct {
+	TokenHash       string     `bson:"token_hash"`
+	Role            string     `bson:"role"`
+	InvitedBy       string     `bson:"invited_by"`
+	ExpiresAt       time.Time  `bson:"expires_at"`
+	MemberExpiresAt *time.Time `bson:"member_expires_at,omitempty"`
+	CreatedAt       time.Time  `bson:"created_at"`
+}
+
+// householdMongo 是 Household 的持久化模型，包含 DB 專用的標籤。
+type householdMongo struct {
+	ID          bson.ObjectID              `bson:"_id,omitempty"`
+	Name        string                     `bson:"name"`
+	Members     []householdMemberMongo     `bson:"members"`
+	Invitations []householdInvitationMongo `bson:"invitations"`
+	CreatedAt   time.Time                  `bson:"created_at"`
+	UpdatedAt   time.Time                  `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型 (householdMongo) 轉換為領域模型 (model.Household)。
+func (m *householdMongo) toDomain() *model.Household {
+	if m == nil {
+		return nil
+	}
+
+	members := make([]model.HouseholdMember, 0, len(m.Members))
+	for _, mm := range m.Members {
+		members = append(members, model.HouseholdMember{
+			UserID:    mm.UserID,
+			Role:      model.HouseholdRole(mm.Role),
+			ExpiresAt: mm.ExpiresAt,
+			JoinedAt:  mm.JoinedAt,
+		})
+	}
+
+	invitations := make([]model.HouseholdInvitation, 0, len(m.Invitations))
+	for _, inv := range m.Invitations {
+		invitations = append(invitations, model.HouseholdInvitation{
+			TokenHash:       inv.TokenHash,
+			Role:            model.HouseholdRole(inv.Role),
+			InvitedBy:       inv.InvitedBy,
+			ExpiresAt:       inv.ExpiresAt,
+			MemberExpiresAt: inv.MemberExpiresAt,
+			CreatedAt:       inv.CreatedAt,
+		})
+	}
+
+	return &model.Household{
+		ID:          m.ID.Hex(),
+		Name:        m.Name,
+		Members:     members,
+		Invitations: invitations,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+// householdMongoFromDomain 將領域模型 (model.Household) 轉換為持久化模型 (householdMongo)。
+func householdMongoFromDomain(h *model.Household) (*householdMongo, error) {
+	if h == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	var err error
+	if h.ID != "" {
+		objectID, err = bson.ObjectIDFromHex(h.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	members := make([]householdMemberMongo, 0, len(h.Members))
+	for _, m := range h.Members {
+		members = append(members, householdMemberMongo{
+			UserID:    m.UserID,
+			Role:      string(m.Role),
+			ExpiresAt: m.ExpiresAt,
+			JoinedAt:  m.JoinedAt,
+		})
+	}
+
+	invitations := make([]householdInvitationMongo, 0, len(h.Invitations))
+	for _, inv := range h.Invitations {
+		invitations = append(invitations, householdInvitationMongo{
+			TokenHash:       inv.TokenHash,
+			Role:            string(inv.Role),
+			InvitedBy:       inv.InvitedBy,
+			ExpiresAt:       inv.ExpiresAt,
+			MemberExpiresAt: inv.MemberExpiresAt,
+			CreatedAt:       inv.CreatedAt,
+		})
+	}
+
+	return &householdMongo{
+		ID:          objectID,
+		Name:        h.Name,
+		Members:     members,
+		Invitations: invitations,
+		CreatedAt:   h.CreatedAt,
+		UpdatedAt:   h.UpdatedAt,
+	}, nil
+}
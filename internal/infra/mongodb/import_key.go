@@ -0,0 +1,54 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// importKeyField 批次匯入的去重鍵欄位，手動建立的資料沒有此欄位
+const importKeyField = "import_key"
+
+// importKeyIndex 同一寵物的去重鍵唯一，並行匯入同一檔案時由資料庫擋下重複寫入
+func importKeyIndex() mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{{Key: "pet_id", Value: 1}, {Key: importKeyField, Value: 1}},
+		Options: options.Index().
+			SetName("pet_import_key_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{importKeyField: bson.M{"$exists": true}}),
+	}
+}
+
+// findImportKeys 查詢已使用的去重鍵，垃圾桶中的資料仍占用去重鍵，因此不排除已刪除的文件
+func findImportKeys(c context.Context, coll *mongo.Collection, petID string, keys []string) (map[string]string, error) {
+	found := make(map[string]string)
+	if len(keys) == 0 {
+		return found, nil
+	}
+
+	filter := bson.M{"pet_id": petID, importKeyField: bson.M{"$in": keys}}
+	projection := options.Find().SetProjection(bson.M{"_id": 1, importKeyField: 1})
+	cursor, err := coll.Find(c, filter, projection)
+	if err != nil {
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(c)
+
+	for cursor.Next(c) {
+		var doc struct {
+			ID        bson.ObjectID `bson:"_id"`
+			ImportKey string        `bson:"import_key"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, convertMongoError(err)
+		}
+		found[doc.ImportKey] = doc.ID.Hex()
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, convertMongoError(err)
+	}
+	return found, nil
+}
@@ -46,6 +46,7 @@ func (r *MedicalRecordRepositoryImpl) Create(c context.Context, record *model.Me
 	now := time.Now()
 	doc.CreatedAt = now
 	doc.UpdatedAt = now
+	doc.Version = initialVersion
 
 	result, err := r.collection().InsertOne(ctx, doc)
 	if err != nil {
@@ -56,6 +57,7 @@ func (r *MedicalRecordRepositoryImpl) Create(c context.Context, record *model.Me
 	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
 		record.ID = oid.Hex()
 	}
+	record.Version = doc.Version
 
 	ctx.Info("成功建立醫療記錄", "record_id", record.ID, "pet_id", record.PetID)
 	return nil
@@ -72,7 +74,7 @@ func (r *MedicalRecordRepositoryImpl) FindByID(c context.Context, id string) (*m
 		return nil, domain.ErrInvalidID
 	}
 
-	filter := bson.M{"_id": objectID}
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
 	var doc medicalRecordMongo
 
 	err = r.collection().FindOne(ctx, filter).Decode(&doc)
@@ -95,8 +97,54 @@ func (r *MedicalRecordRepositoryImpl) FindByPetID(c context.Context, petID strin
 	ctx := contextx.WithContext(c)
 	ctx.Info("開始根據寵物 ID 和日期範圍查找醫療記錄", "pet_id", petID, "start_date", startDate, "end_date", endDate)
 
+	filter := petMedicalRecordFilter(petID, startDate, endDate)
+
+	cursor, err := r.collection().Find(ctx, filter)
+	if err != nil {
+		ctx.Error("查找醫療記錄時發生錯誤", "error", err, "pet_id", petID)
+		return nil, fmt.Errorf("查找醫療記錄失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []*model.MedicalRecord
+	for cursor.Next(ctx) {
+		var doc medicalRecordMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼醫療記錄時發生錯誤", "error", err, "pet_id", petID)
+			return nil, fmt.Errorf("解碼醫療記錄失敗: %w", err)
+		}
+		records = append(records, doc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷醫療記錄查詢結果時發生錯誤", "error", err, "pet_id", petID)
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	ctx.Info("成功找到醫療記錄", "pet_id", petID, "count", len(records))
+	return records, nil
+}
+
+// ListByPetID 分頁查詢特定寵物的醫療記錄（依日期範圍）。
+func (r *MedicalRecordRepositoryImpl) ListByPetID(c context.Context, petID string, startDate, endDate time.Time, page repository.PageRequest) ([]*model.MedicalRecord, int, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := petMedicalRecordFilter(petID, startDate, endDate)
+	records, total, err := findPage[*model.MedicalRecord, medicalRecordMongo](ctx, r.collection(), filter, page)
+	if err != nil {
+		ctx.Error("分頁查找醫療記錄時發生錯誤", "error", err, "pet_id", petID)
+		return nil, 0, fmt.Errorf("查找醫療記錄失敗: %w", err)
+	}
+
+	ctx.Info("成功分頁查找醫療記錄", "pet_id", petID, "count", len(records), "total", total)
+	return records, total, nil
+}
+
+// petMedicalRecordFilter 產生寵物未刪除醫療記錄的查詢條件，日期零值的一端不限制
+func petMedicalRecordFilter(petID string, startDate, endDate time.Time) bson.M {
 	filter := bson.M{
-		"pet_id": petID,
+		"pet_id":       petID,
+		deletedAtField: notDeleted,
 	}
 
 	dateCond := bson.M{}
@@ -109,11 +157,45 @@ func (r *MedicalRecordRepositoryImpl) FindByPetID(c context.Context, petID strin
 	if len(dateCond) > 0 {
 		filter["date"] = dateCond
 	}
+	return filter
+}
+
+// FindUpcomingDue 查詢下次到期日落在 [from, to] 區間內的醫療記錄。
+func (r *MedicalRecordRepositoryImpl) FindUpcomingDue(c context.Context, from, to time.Time) ([]*model.MedicalRecord, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始查找即將到期的醫療記錄", "from", from, "to", to)
+
+	filter := bson.M{
+		"next_due_date": bson.M{
+			"$gte": from,
+			"$lte": to,
+		},
+		deletedAtField: notDeleted,
+	}
+	return r.findDue(ctx, filter)
+}
+
+// FindDueByPetID 查詢特定寵物下次到期日落在 [from, to] 區間內的醫療記錄。
+func (r *MedicalRecordRepositoryImpl) FindDueByPetID(c context.Context, petID string, from, to time.Time) ([]*model.MedicalRecord, error) {
+	ctx := contextx.WithContext(c)
 
+	filter := bson.M{
+		"pet_id": petID,
+		"next_due_date": bson.M{
+			"$gte": from,
+			"$lte": to,
+		},
+		deletedAtField: notDeleted,
+	}
+	return r.findDue(ctx, filter)
+}
+
+// findDue 依條件查詢有下次到期日的醫療記錄
+func (r *MedicalRecordRepositoryImpl) findDue(ctx *contextx.Contextx, filter bson.M) ([]*model.MedicalRecord, error) {
 	cursor, err := r.collection().Find(ctx, filter)
 	if err != nil {
-		ctx.Error("查找醫療記錄時發生錯誤", "error", err, "pet_id", petID)
-		return nil, fmt.Errorf("查找醫療記錄失敗: %w", err)
+		ctx.Error("查找即將到期的醫療記錄時發生錯誤", "error", err)
+		return nil, fmt.Errorf("查找即將到期的醫療記錄失敗: %w", err)
 	}
 	defer cursor.Close(ctx)
 
@@ -121,18 +203,18 @@ func (r *MedicalRecordRepositoryImpl) FindByPetID(c context.Context, petID strin
 	for cursor.Next(ctx) {
 		var doc medicalRecordMongo
 		if err := cursor.Decode(&doc); err != nil {
-			ctx.Error("解碼醫療記錄時發生錯誤", "error", err, "pet_id", petID)
+			ctx.Error("解碼醫療記錄時發生錯誤", "error", err)
 			return nil, fmt.Errorf("解碼醫療記錄失敗: %w", err)
 		}
 		records = append(records, doc.toDomain())
 	}
 
 	if err := cursor.Err(); err != nil {
-		ctx.Error("遍歷醫療記錄查詢結果時發生錯誤", "error", err, "pet_id", petID)
+		ctx.Error("遍歷即將到期的醫療記錄時發生錯誤", "error", err)
 		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
 	}
 
-	ctx.Info("成功找到醫療記錄", "pet_id", petID, "count", len(records))
+	ctx.Info("成功找到即將到期的醫療記錄", "count", len(records))
 	return records, nil
 }
 
@@ -147,8 +229,10 @@ func (r *MedicalRecordRepositoryImpl) Update(c context.Context, record *model.Me
 		return err
 	}
 
-	filter := bson.M{"_id": doc.ID}
+	// 以版本比對實作 compare-and-swap，避免覆寫他人已儲存的變更
+	filter := bson.M{"_id": doc.ID, deletedAtField: notDeleted, versionField: versionMatch(record.Version)}
 	doc.UpdatedAt = time.Now()
+	doc.Version = record.Version + 1
 	update := bson.M{"$set": doc}
 
 	result, err := r.collection().UpdateOne(ctx, filter, update)
@@ -158,15 +242,17 @@ func (r *MedicalRecordRepositoryImpl) Update(c context.Context, record *model.Me
 	}
 
 	if result.MatchedCount == 0 {
-		ctx.Warn("找不到要更新的醫療記錄", "record_id", record.ID)
-		return domain.ErrNotFound
+		err := resolveUpdateMiss(ctx, r.collection(), doc.ID)
+		ctx.Warn("更新醫療記錄未命中", "record_id", record.ID, "version", record.Version, "error", err)
+		return err
 	}
 
-	ctx.Info("成功更新醫療記錄", "record_id", record.ID)
+	record.Version = doc.Version
+	ctx.Info("成功更新醫療記錄", "record_id", record.ID, "version", record.Version)
 	return nil
 }
 
-// Delete 刪除醫療記錄。
+// Delete 軟刪除醫療記錄，移至垃圾桶。
 func (r *MedicalRecordRepositoryImpl) Delete(c context.Context, id string) error {
 	ctx := contextx.WithContext(c)
 	ctx.Info("開始刪除醫療記錄", "record_id", id)
@@ -177,15 +263,17 @@ func (r *MedicalRecordRepositoryImpl) Delete(c context.Context, id string) error
 		return domain.ErrInvalidID
 	}
 
-	filter := bson.M{"_id": objectID}
+	// 軟刪除：標記刪除時間，移至垃圾桶
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	update := bson.M{"$set": bson.M{deletedAtField: time.Now()}}
 
-	result, err := r.collection().DeleteOne(ctx, filter)
+	result, err := r.collection().UpdateOne(ctx, filter, update)
 	if err != nil {
 		ctx.Error("刪除醫療記錄失敗", "error", err, "record_id", id)
 		return convertMongoError(err)
 	}
 
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		ctx.Warn("找不到要刪除的醫療記錄", "record_id", id)
 		return domain.ErrNotFound
 	}
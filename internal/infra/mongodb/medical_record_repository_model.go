@@ -17,6 +17,7 @@ type medicalRecordMongo struct {
 	Date        time.Time     `bson:"date"`
 	NextDueDate *time.Time    `bson:"next_due_date,omitempty"`
 	Dosage      string        `bson:"dosage,omitempty"`
+	Version     int           `bson:"version"`
 	CreatedAt   time.Time     `bson:"created_at"`
 	UpdatedAt   time.Time     `bson:"updated_at"`
 }
@@ -34,6 +35,7 @@ func (m *medicalRecordMongo) toDomain() *model.MedicalRecord {
 		Date:        m.Date,
 		NextDueDate: m.NextDueDate,
 		Dosage:      m.Dosage,
+		Version:     m.Version,
 	}
 }
 
@@ -60,5 +62,6 @@ func medicalRecordMongoFromDomain(m *model.MedicalRecord) (*medicalRecordMongo,
 		Date:        m.Date,
 		NextDueDate: m.NextDueDate,
 		Dosage:      m.Dosage,
+		Version:     m.Version,
 	}, nil
 }
@@ -0,0 +1,205 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const medicationRegimenCollectionName = "medication_regimens"
+
+// MedicationRegimenRepositoryImpl 實作 repository.MedicationRegimenRepository 介面，使用 MongoDB。
+type MedicationRegimenRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewMedicationRegimenRepository 建立新的 MedicationRegimenRepositoryImpl。
+func NewMedicationRegimenRepository(db *mongo.Database) repository.MedicationRegimenRepository {
+	repo := &MedicationRegimenRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *MedicationRegimenRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(medicationRegimenCollectionName)
+}
+
+// ensureIndexes 建立用藥療程查詢所需的索引
+func (r *MedicationRegimenRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}},
+			Options: options.Index().SetName("pet_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "end_date", Value: 1}},
+			Options: options.Index().SetName("end_date"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立用藥療程索引失敗", "error", err)
+	}
+}
+
+// Create 新增用藥療程
+func (r *MedicationRegimenRepositoryImpl) Create(c context.Context, regimen *model.MedicationRegimen) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := medicationRegimenMongoFromDomain(regimen)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+	doc.Version = initialVersion
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立用藥療程失敗", "error", err)
+		return convertMongoError(err)
+	}
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		regimen.ID = oid.Hex()
+	}
+	regimen.Version = doc.Version
+	regimen.CreatedAt = doc.CreatedAt
+	regimen.UpdatedAt = doc.UpdatedAt
+
+	ctx.Info("成功建立用藥療程", "regimen_id", regimen.ID)
+	return nil
+}
+
+// FindByID 依 ID 查詢用藥療程
+func (r *MedicationRegimenRepositoryImpl) FindByID(c context.Context, id string) (*model.MedicationRegimen, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的用藥療程 ID 格式", "regimen_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc medicationRegimenMongo
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	if err := r.collection().FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的用藥療程", "regimen_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找用藥療程時發生錯誤", "error", err, "regimen_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByPetIDs 查詢指定寵物群組的用藥療程
+func (r *MedicationRegimenRepositoryImpl) FindByPetIDs(c context.Context, petIDs []string) ([]*model.MedicationRegimen, error) {
+	if petIDs == nil {
+		petIDs = []string{}
+	}
+	return r.find(c, bson.M{"pet_id": bson.M{"$in": petIDs}, deletedAtField: notDeleted})
+}
+
+// FindActive 查詢未設定結束日期，或結束日期的隔天仍晚於 now 的用藥療程，已隨寵物封存的療程不列入
+// 結束日期為療程時區的零時，此處往前多取一天，實際是否結束由呼叫端依時區判斷
+func (r *MedicationRegimenRepositoryImpl) FindActive(c context.Context, now time.Time) ([]*model.MedicationRegimen, error) {
+	return r.find(c, bson.M{deletedAtField: notDeleted, "$or": bson.A{
+		bson.M{"end_date": nil},
+		bson.M{"end_date": bson.M{"$gte": now.AddDate(0, 0, -2)}},
+	}})
+}
+
+func (r *MedicationRegimenRepositoryImpl) find(c context.Context, filter bson.M) ([]*model.MedicationRegimen, error) {
+	ctx := contextx.WithContext(c)
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢用藥療程時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	result := make([]*model.MedicationRegimen, 0)
+	for cursor.Next(ctx) {
+		var doc medicationRegimenMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼用藥療程時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		result = append(result, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷用藥療程查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return result, nil
+}
+
+// Update 依版本更新用藥療程
+func (r *MedicationRegimenRepositoryImpl) Update(c context.Context, regimen *model.MedicationRegimen) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := medicationRegimenMongoFromDomain(regimen)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "regimen_id", regimen.ID)
+		return err
+	}
+
+	// 以版本比對實作 compare-and-swap，避免漏服偵測與使用者編輯互相覆寫
+	filter := bson.M{"_id": doc.ID, deletedAtField: notDeleted, versionField: versionMatch(regimen.Version)}
+	doc.UpdatedAt = time.Now()
+	doc.Version = regimen.Version + 1
+	result, err := r.collection().UpdateOne(ctx, filter, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新用藥療程失敗", "error", err, "regimen_id", regimen.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		err := resolveUpdateMiss(ctx, r.collection(), doc.ID)
+		ctx.Warn("更新用藥療程未命中", "regimen_id", regimen.ID, "version", regimen.Version, "error", err)
+		return err
+	}
+
+	regimen.Version = doc.Version
+	regimen.UpdatedAt = doc.UpdatedAt
+	return nil
+}
+
+// Delete 刪除用藥療程
+func (r *MedicationRegimenRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的用藥療程 ID 格式", "regimen_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除用藥療程失敗", "error", err, "regimen_id", id)
+		return convertMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除用藥療程", "regimen_id", id)
+	return nil
+}
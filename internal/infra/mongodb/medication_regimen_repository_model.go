@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// medicationRegimenMongo 為 MedicationRegimen 的持久化模型
+type medicationRegimenMongo struct {
+	ID                   bson.ObjectID `bson:"_id,omitempty"`
+	PetID                string        `bson:"pet_id"`
+	Drug                 string        `bson:"drug"`
+	Dose                 float64       `bson:"dose"`
+	Unit                 string        `bson:"unit"`
+	Frequency            string        `bson:"frequency"`
+	Interval             int           `bson:"interval"`
+	Times                []string      `bson:"times"`
+	Timezone             string        `bson:"timezone"`
+	StartDate            time.Time     `bson:"start_date"`
+	EndDate              *time.Time    `bson:"end_date"`
+	HospitalID           string        `bson:"hospital_id,omitempty"`
+	MedicalRecordID      string        `bson:"medical_record_id,omitempty"`
+	Notes                string        `bson:"notes,omitempty"`
+	MissedCheckedThrough *time.Time    `bson:"missed_checked_through"`
+	Version              int           `bson:"version"`
+	CreatedAt            time.Time     `bson:"created_at"`
+	UpdatedAt            time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *medicationRegimenMongo) toDomain() *model.MedicationRegimen {
+	if m == nil {
+		return nil
+	}
+	return &model.MedicationRegimen{
+		ID:    m.ID.Hex(),
+		PetID: m.PetID,
+		Drug:  m.Drug,
+		Dose:  m.Dose,
+		Unit:  m.Unit,
+		Schedule: model.DoseSchedule{
+			Frequency: model.DoseFrequency(m.Frequency),
+			Interval:  m.Interval,
+			Times:     m.Times,
+		},
+		Timezone:             m.Timezone,
+		StartDate:            m.StartDate,
+		EndDate:              m.EndDate,
+		HospitalID:           m.HospitalID,
+		MedicalRecordID:      m.MedicalRecordID,
+		Notes:                m.Notes,
+		MissedCheckedThrough: m.MissedCheckedThrough,
+		Version:              m.Version,
+		CreatedAt:            m.CreatedAt,
+		UpdatedAt:            m.UpdatedAt,
+	}
+}
+
+// medicationRegimenMongoFromDomain 將領域模型轉換為持久化模型
+func medicationRegimenMongoFromDomain(r *model.MedicationRegimen) (*medicationRegimenMongo, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	if r.ID != "" {
+		var err error
+		objectID, err = bson.ObjectIDFromHex(r.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &medicationRegimenMongo{
+		ID:                   objectID,
+		PetID:                r.PetID,
+		Drug:                 r.Drug,
+		Dose:                 r.Dose,
+		Unit:                 r.Unit,
+		Frequency:            string(r.Schedule.Frequency),
+		Interval:             r.Schedule.Interval,
+		Times:                r.Schedule.Times,
+		Timezone:             r.Timezone,
+		StartDate:            r.StartDate,
+		EndDate:              r.EndDate,
+		HospitalID:           r.HospitalID,
+		MedicalRecordID:      r.MedicalRecordID,
+		Notes:                r.Notes,
+		MissedCheckedThrough: r.MissedCheckedThrough,
+		Version:              r.Version,
+		CreatedAt:            r.CreatedAt,
+		UpdatedAt:            r.UpdatedAt,
+	}, nil
+}
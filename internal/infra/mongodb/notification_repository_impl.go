@@ -0,0 +1,209 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	notificationPreferenceCollectionName = "notification_preferences"
+	notificationDeliveryCollectionName   = "notification_deliveries"
+)
+
+// NotificationPreferenceRepositoryImpl 實作 repository.NotificationPreferenceRepository 介面，使用 MongoDB。
+type NotificationPreferenceRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewNotificationPreferenceRepository 建立新的 NotificationPreferenceRepositoryImpl。
+func NewNotificationPreferenceRepository(db *mongo.Database) repository.NotificationPreferenceRepository {
+	repo := &NotificationPreferenceRepositoryImpl{db: db}
+
+	ctx := contextx.WithContext(context.Background())
+	_, err := repo.collection().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}},
+		Options: options.Index().SetName("user_id_unique").SetUnique(true),
+	})
+	if err != nil {
+		ctx.Warn("建立通知偏好索引失敗", "error", err)
+	}
+
+	return repo
+}
+
+func (r *NotificationPreferenceRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(notificationPreferenceCollectionName)
+}
+
+// FindByUserID 根據使用者 ID 查詢通知偏好設定。
+func (r *NotificationPreferenceRepositoryImpl) FindByUserID(c context.Context, userID string) (*model.NotificationPreference, error) {
+	ctx := contextx.WithContext(c)
+
+	var doc notificationPreferenceMongo
+	err := r.collection().FindOne(ctx, bson.M{"user_id": userID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找通知偏好時發生錯誤", "error", err, "user_id", userID)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// Upsert 建立或更新使用者的通知偏好設定。
+func (r *NotificationPreferenceRepositoryImpl) Upsert(c context.Context, pref *model.NotificationPreference) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始更新通知偏好", "user_id", pref.UserID)
+
+	channels := make([]notificationChannelSettingMongo, 0, len(pref.Channels))
+	for _, ch := range pref.Channels {
+		channels = append(channels, notificationChannelSettingMongo{
+			Channel: string(ch.Channel),
+			Target:  ch.Target,
+			Enabled: ch.Enabled,
+		})
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set":         bson.M{"channels": channels, "updated_at": now},
+		"$setOnInsert": bson.M{"user_id": pref.UserID, "created_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var doc notificationPreferenceMongo
+	err := r.collection().FindOneAndUpdate(ctx, bson.M{"user_id": pref.UserID}, update, opts).Decode(&doc)
+	if err != nil {
+		ctx.Error("更新通知偏好失敗", "error", err, "user_id", pref.UserID)
+		return convertMongoError(err)
+	}
+
+	*pref = *doc.toDomain()
+	ctx.Info("成功更新通知偏好", "user_id", pref.UserID)
+	return nil
+}
+
+// NotificationDeliveryRepositoryImpl 實作 repository.NotificationDeliveryRepository 介面，使用 MongoDB。
+type NotificationDeliveryRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewNotificationDeliveryRepository 建立新的 NotificationDeliveryRepositoryImpl。
+func NewNotificationDeliveryRepository(db *mongo.Database) repository.NotificationDeliveryRepository {
+	repo := &NotificationDeliveryRepositoryImpl{db: db}
+
+	ctx := contextx.WithContext(context.Background())
+	_, err := repo.collection().Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+			Options: options.Index().SetName("status_next_attempt_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "reminder_id", Value: 1}},
+			Options: options.Index().SetName("reminder_id_index"),
+		},
+	})
+	if err != nil {
+		ctx.Warn("建立通知投遞索引失敗", "error", err)
+	}
+
+	return repo
+}
+
+func (r *NotificationDeliveryRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(notificationDeliveryCollectionName)
+}
+
+// Create 建立新的投遞紀錄。
+func (r *NotificationDeliveryRepositoryImpl) Create(c context.Context, delivery *model.NotificationDelivery) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := notificationDeliveryMongoFromDomain(delivery)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立通知投遞紀錄失敗", "error", err, "user_id", delivery.UserID)
+		return convertMongoError(err)
+	}
+
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		delivery.ID = oid.Hex()
+	}
+	delivery.CreatedAt = now
+	delivery.UpdatedAt = now
+	return nil
+}
+
+// Update 更新投遞紀錄。
+func (r *NotificationDeliveryRepositoryImpl) Update(c context.Context, delivery *model.NotificationDelivery) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := notificationDeliveryMongoFromDomain(delivery)
+	if err != nil {
+		return err
+	}
+
+	doc.UpdatedAt = time.Now()
+	result, err := r.collection().UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新通知投遞紀錄失敗", "error", err, "delivery_id", delivery.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	delivery.UpdatedAt = doc.UpdatedAt
+	return nil
+}
+
+// FindRetryable 查詢在指定時間點前應重試的失敗投遞。
+func (r *NotificationDeliveryRepositoryImpl) FindRetryable(c context.Context, now time.Time) ([]*model.NotificationDelivery, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := bson.M{
+		"status":          string(model.DeliveryStatusFailed),
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}})
+
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查找待重試的通知失敗", "error", err)
+		return nil, fmt.Errorf("查找待重試的通知失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	deliveries := make([]*model.NotificationDelivery, 0)
+	for cursor.Next(ctx) {
+		var doc notificationDeliveryMongo
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("解碼通知投遞紀錄失敗: %w", err)
+		}
+		deliveries = append(deliveries, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,135 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// notificationChannelSettingMongo 是 NotificationChannelSetting 的持久化模型
+type notificationChannelSettingMongo struct {
+	Channel string `bson:"channel"`
+	Target  string `bson:"target"`
+	Enabled bool   `bson:"enabled"`
+}
+
+// notificationPreferenceMongo 是 NotificationPreference 的持久化模型，包含 DB 專用的標籤。
+type notificationPreferenceMongo struct {
+	ID        bson.ObjectID                     `bson:"_id,omitempty"`
+	UserID    string                            `bson:"user_id"`
+	Channels  []notificationChannelSettingMongo `bson:"channels"`
+	CreatedAt time.Time                         `bson:"created_at"`
+	UpdatedAt time.Time                         `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型 (model.NotificationPreference)。
+func (m *notificationPreferenceMongo) toDomain() *model.NotificationPreference {
+	if m == nil {
+		return nil
+	}
+	channels := make([]model.NotificationChannelSetting, 0, len(m.Channels))
+	for _, ch := range m.Channels {
+		channels = append(channels, model.NotificationChannelSetting{
+			Channel: model.NotificationChannel(ch.Channel),
+			Target:  ch.Target,
+			Enabled: ch.Enabled,
+		})
+	}
+	return &model.NotificationPreference{
+		ID:        m.ID.Hex(),
+		UserID:    m.UserID,
+		Channels:  channels,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+// notificationDeliveryMongo 是 NotificationDelivery 的持久化模型，包含 DB 專用的標籤。
+type notificationDeliveryMongo struct {
+	ID            bson.ObjectID `bson:"_id,omitempty"`
+	UserID        string        `bson:"user_id"`
+	Channel       string        `bson:"channel"`
+	Target        string        `bson:"target"`
+	Title         string        `bson:"title"`
+	Body          string        `bson:"body"`
+	PetID         string        `bson:"pet_id,omitempty"`
+	ReminderID    string        `bson:"reminder_id,omitempty"`
+	BudgetID      string        `bson:"budget_id,omitempty"`
+	ExportID      string        `bson:"export_id,omitempty"`
+	DueDate       time.Time     `bson:"due_date,omitempty"`
+	Status        string        `bson:"status"`
+	Attempts      int           `bson:"attempts"`
+	LastError     string        `bson:"last_error,omitempty"`
+	NextAttemptAt *time.Time    `bson:"next_attempt_at,omitempty"`
+	SentAt        *time.Time    `bson:"sent_at,omitempty"`
+	CreatedAt     time.Time     `bson:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型轉換為領域模型 (model.NotificationDelivery)。
+func (m *notificationDeliveryMongo) toDomain() *model.NotificationDelivery {
+	if m == nil {
+		return nil
+	}
+	return &model.NotificationDelivery{
+		ID:      m.ID.Hex(),
+		UserID:  m.UserID,
+		Channel: model.NotificationChannel(m.Channel),
+		Target:  m.Target,
+		Message: model.NotificationMessage{
+			Title:      m.Title,
+			Body:       m.Body,
+			PetID:      m.PetID,
+			ReminderID: m.ReminderID,
+			BudgetID:   m.BudgetID,
+			ExportID:   m.ExportID,
+			DueDate:    m.DueDate,
+		},
+		Status:        model.DeliveryStatus(m.Status),
+		Attempts:      m.Attempts,
+		LastError:     m.LastError,
+		NextAttemptAt: m.NextAttemptAt,
+		SentAt:        m.SentAt,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+// notificationDeliveryMongoFromDomain 將領域模型轉換為持久化模型 (notificationDeliveryMongo)。
+func notificationDeliveryMongoFromDomain(d *model.NotificationDelivery) (*notificationDeliveryMongo, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	var err error
+	if d.ID != "" {
+		objectID, err = bson.ObjectIDFromHex(d.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &notificationDeliveryMongo{
+		ID:            objectID,
+		UserID:        d.UserID,
+		Channel:       string(d.Channel),
+		Target:        d.Target,
+		Title:         d.Message.Title,
+		Body:          d.Message.Body,
+		PetID:         d.Message.PetID,
+		ReminderID:    d.Message.ReminderID,
+		BudgetID:      d.Message.BudgetID,
+		ExportID:      d.Message.ExportID,
+		DueDate:       d.Message.DueDate,
+		Status:        string(d.Status),
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		NextAttemptAt: d.NextAttemptAt,
+		SentAt:        d.SentAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+)
+
+// pageFindOptions 依分頁條件設定排序、略過與筆數；排序欄位即為 bson 欄位名稱，相同時再依 _id 排序讓分頁結果穩定
+func pageFindOptions(page repository.PageRequest) *options.FindOptionsBuilder {
+	direction := 1
+	if page.Order == repository.SortDesc {
+		direction = -1
+	}
+	return options.Find().
+		SetSort(bson.D{{Key: page.Sort, Value: direction}, {Key: "_id", Value: direction}}).
+		SetSkip(int64(page.Offset)).
+		SetLimit(int64(page.Limit))
+}
+
+// findPage 分頁查詢並統計符合條件的總筆數，D 為集合的文件型別
+func findPage[T any, D any, PD interface {
+	*D
+	toDomain() T
+}](c context.Context, coll *mongo.Collection, filter bson.M, page repository.PageRequest) ([]T, int, error) {
+	total, err := coll.CountDocuments(c, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cursor, err := coll.Find(c, filter, pageFindOptions(page))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(c)
+
+	items := make([]T, 0, page.Limit)
+	for cursor.Next(c) {
+		var doc D
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, 0, err
+		}
+		items = append(items, PD(&doc).toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, 0, err
+	}
+	return items, int(total), nil
+}
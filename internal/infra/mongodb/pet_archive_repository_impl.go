@@ -0,0 +1,268 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// deletedAtField 軟刪除時間欄位，個別刪除或隨寵物一同封存的文件皆會設定
+const deletedAtField = "deleted_at"
+
+// notDeleted 查詢條件：排除已刪除的文件，所有一般查詢都應套用
+var notDeleted = bson.M{"$exists": false}
+
+// petDependentCollections 會隨寵物一同封存、還原與清除的集合，皆以 pet_id 關聯
+// 涵蓋所有寵物的預算 pet_id 為空，不受個別寵物封存影響
+var petDependentCollections = []string{
+	healthLogCollectionName,
+	medicalRecordCollectionName,
+	expenseCollectionName,
+	reminderCollectionName,
+	medicationRegimenCollectionName,
+	doseEventCollectionName,
+	recurringExpenseCollectionName,
+	attachmentCollectionName,
+	budgetCollectionName,
+}
+
+// petArchiveRepo 實作 repository.PetArchiveRepository，所有跨集合操作都在交易中執行
+type petArchiveRepo struct {
+	db *mongo.Database
+}
+
+// NewPetArchiveRepository 建立新的 petArchiveRepo
+func NewPetArchiveRepository(db *mongo.Database) repository.PetArchiveRepository {
+	return &petArchiveRepo{db: db}
+}
+
+// withTransaction 在 MongoDB 交易中執行 fn，任一步驟失敗即整體回滾；暫時性錯誤時 fn 可能被重新執行
+func withTransaction(c context.Context, db *mongo.Database, fn func(sc context.Context) error) error {
+	session, err := db.Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("建立 MongoDB session 失敗: %w", err)
+	}
+	defer session.EndSession(c)
+
+	_, err = session.WithTransaction(c, func(sc context.Context) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// Archive 封存寵物及其所有關聯紀錄
+func (r *petArchiveRepo) Archive(c context.Context, petID string, archivedAt time.Time) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始封存寵物", "pet_id", petID)
+
+	objectID, err := bson.ObjectIDFromHex(petID)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "pet_id", petID, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	// 以毫秒精度儲存，確保還原時能精準比對一同封存的紀錄
+	archivedAt = archivedAt.Truncate(time.Millisecond)
+	set := bson.M{"$set": bson.M{deletedAtField: archivedAt}}
+
+	err = withTransaction(ctx, r.db, func(sc context.Context) error {
+		result, err := r.db.Collection(petCollection).UpdateOne(sc,
+			bson.M{"_id": objectID, deletedAtField: notDeleted}, set)
+		if err != nil {
+			return convertMongoError(err)
+		}
+		if result.MatchedCount == 0 {
+			return domain.ErrNotFound
+		}
+
+		for _, name := range petDependentCollections {
+			if _, err := r.db.Collection(name).UpdateMany(sc,
+				bson.M{"pet_id": petID, deletedAtField: notDeleted}, set); err != nil {
+				return fmt.Errorf("封存 %s 失敗: %w", name, convertMongoError(err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ctx.Error("封存寵物失敗", "error", err, "pet_id", petID)
+		return err
+	}
+
+	ctx.Info("成功封存寵物", "pet_id", petID)
+	return nil
+}
+
+// FindArchivedByID 查詢已封存的寵物
+func (r *petArchiveRepo) FindArchivedByID(c context.Context, petID string) (*model.Pet, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(petID)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "pet_id", petID, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	filter := bson.M{"_id": objectID, deletedAtField: bson.M{"$exists": true}}
+
+	var petDoc petMongo
+	err = r.db.Collection(petCollection).FindOne(ctx, filter).Decode(&petDoc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到已封存的寵物", "pet_id", petID)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找已封存寵物時發生錯誤", "error", err, "pet_id", petID)
+		return nil, convertMongoError(err)
+	}
+
+	return petDoc.toDomain(), nil
+}
+
+// FindArchivedByScope 查詢使用者建立或屬於指定家庭的已封存寵物
+func (r *petArchiveRepo) FindArchivedByScope(c context.Context, ownerID string, householdIDs []string) ([]*model.Pet, error) {
+	scope := bson.A{bson.M{"owner_id": ownerID}}
+	if len(householdIDs) > 0 {
+		scope = append(scope, bson.M{"household_id": bson.M{"$in": householdIDs}})
+	}
+
+	return r.findArchived(c, bson.M{"$or": scope, deletedAtField: bson.M{"$exists": true}})
+}
+
+// FindArchivedBefore 查詢在指定時間點前封存的寵物
+func (r *petArchiveRepo) FindArchivedBefore(c context.Context, cutoff time.Time) ([]*model.Pet, error) {
+	return r.findArchived(c, bson.M{deletedAtField: bson.M{"$lt": cutoff}})
+}
+
+func (r *petArchiveRepo) findArchived(c context.Context, filter bson.M) ([]*model.Pet, error) {
+	ctx := contextx.WithContext(c)
+
+	cursor, err := r.db.Collection(petCollection).Find(ctx, filter)
+	if err != nil {
+		ctx.Error("查詢封存寵物時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	pets := make([]*model.Pet, 0)
+	for cursor.Next(ctx) {
+		var petDoc petMongo
+		if err := cursor.Decode(&petDoc); err != nil {
+			ctx.Error("解碼寵物資料時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		pets = append(pets, petDoc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return pets, nil
+}
+
+// Restore 還原寵物及與其一同封存的關聯紀錄
+// 只還原 deleted_at 與寵物相同的紀錄，先前個別刪除的紀錄仍留在垃圾桶
+// 週期性費用與手動暫停後恢復相同，從還原時間點繼續產生，不補產封存期間的費用
+func (r *petArchiveRepo) Restore(c context.Context, pet *model.Pet) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始還原寵物", "pet_id", pet.ID)
+
+	if pet.DeletedAt == nil {
+		return fmt.Errorf("%w: pet %s is not archived", domain.ErrInvalidParameter, pet.ID)
+	}
+
+	objectID, err := bson.ObjectIDFromHex(pet.ID)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "pet_id", pet.ID, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	unset := bson.M{"$unset": bson.M{deletedAtField: ""}}
+	restoredAt := time.Now()
+
+	err = withTransaction(ctx, r.db, func(sc context.Context) error {
+		result, err := r.db.Collection(petCollection).UpdateOne(sc,
+			bson.M{"_id": objectID, deletedAtField: *pet.DeletedAt}, unset)
+		if err != nil {
+			return convertMongoError(err)
+		}
+		if result.MatchedCount == 0 {
+			return domain.ErrNotFound
+		}
+
+		if _, err := r.db.Collection(recurringExpenseCollectionName).UpdateMany(sc,
+			bson.M{"pet_id": pet.ID, deletedAtField: *pet.DeletedAt},
+			bson.M{"$max": bson.M{"generated_through": restoredAt}, "$inc": bson.M{versionField: 1}}); err != nil {
+			return fmt.Errorf("更新 %s 產生進度失敗: %w", recurringExpenseCollectionName, convertMongoError(err))
+		}
+
+		for _, name := range petDependentCollections {
+			if _, err := r.db.Collection(name).UpdateMany(sc,
+				bson.M{"pet_id": pet.ID, deletedAtField: *pet.DeletedAt}, unset); err != nil {
+				return fmt.Errorf("還原 %s 失敗: %w", name, convertMongoError(err))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ctx.Error("還原寵物失敗", "error", err, "pet_id", pet.ID)
+		return err
+	}
+
+	pet.DeletedAt = nil
+	ctx.Info("成功還原寵物", "pet_id", pet.ID)
+	return nil
+}
+
+// Purge 在交易中永久刪除寵物及所有關聯紀錄，回傳被刪除的附件
+func (r *petArchiveRepo) Purge(c context.Context, petID string) ([]*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始永久清除寵物", "pet_id", petID)
+
+	objectID, err := bson.ObjectIDFromHex(petID)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "pet_id", petID, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var attachments []*model.Attachment
+	err = withTransaction(ctx, r.db, func(sc context.Context) error {
+		// 附件需先取出儲存鍵值，交易提交後才能刪除檔案
+		purged, err := purgeAttachments(sc, r.db, bson.M{"pet_id": petID})
+		if err != nil {
+			return fmt.Errorf("清除 %s 失敗: %w", attachmentCollectionName, err)
+		}
+		attachments = purged
+
+		for _, name := range petDependentCollections {
+			if _, err := r.db.Collection(name).DeleteMany(sc, bson.M{"pet_id": petID}); err != nil {
+				return fmt.Errorf("清除 %s 失敗: %w", name, convertMongoError(err))
+			}
+		}
+
+		result, err := r.db.Collection(petCollection).DeleteOne(sc, bson.M{"_id": objectID})
+		if err != nil {
+			return convertMongoError(err)
+		}
+		if result.DeletedCount == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		ctx.Error("永久清除寵物失敗", "error", err, "pet_id", petID)
+		return nil, err
+	}
+
+	ctx.Info("成功永久清除寵物", "pet_id", petID, "attachments", len(attachments))
+	return attachments, nil
+}
@@ -47,6 +47,7 @@ func (r *petMongoRepo) Create(c context.Context, pet *model.Pet) error {
 	now := time.Now()
 	petDoc.CreatedAt = now
 	petDoc.UpdatedAt = now
+	petDoc.Version = initialVersion
 
 	// 執行插入操作
 	collection := r.db.Collection(petCollection)
@@ -61,6 +62,7 @@ func (r *petMongoRepo) Create(c context.Context, pet *model.Pet) error {
 	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
 		pet.ID = oid.Hex()
 	}
+	pet.Version = petDoc.Version
 
 	ctx.Info("成功建立寵物", "pet_id", pet.ID, "pet_name", pet.Name)
 	return nil
@@ -79,7 +81,7 @@ func (r *petMongoRepo) FindByID(c context.Context, id string) (*model.Pet, error
 	}
 
 	// 建立查詢過濾器
-	filter := bson.D{{Key: "_id", Value: objectID}}
+	filter := bson.D{{Key: "_id", Value: objectID}, {Key: deletedAtField, Value: notDeleted}}
 
 	// 執行查詢操作
 	collection := r.db.Collection(petCollection)
@@ -107,7 +109,7 @@ func (r *petMongoRepo) FindByOwnerID(c context.Context, ownerID string) ([]*mode
 	ctx.Info("開始根據擁有者 ID 查找寵物", "owner_id", ownerID)
 
 	// 建立查詢過濾器
-	filter := bson.D{{Key: "owner_id", Value: ownerID}}
+	filter := bson.D{{Key: "owner_id", Value: ownerID}, {Key: deletedAtField, Value: notDeleted}}
 
 	// 執行查詢操作
 	collection := r.db.Collection(petCollection)
@@ -153,11 +155,16 @@ func (r *petMongoRepo) Update(c context.Context, pet *model.Pet) error {
 		return err
 	}
 
-	// 建立查詢過濾器
-	filter := bson.D{{Key: "_id", Value: petDoc.ID}}
+	// 建立查詢過濾器，以版本比對實作 compare-and-swap，避免覆寫他人已儲存的變更
+	filter := bson.D{
+		{Key: "_id", Value: petDoc.ID},
+		{Key: deletedAtField, Value: notDeleted},
+		{Key: versionField, Value: versionMatch(pet.Version)},
+	}
 
 	// 設定更新內容
 	petDoc.UpdatedAt = time.Now()
+	petDoc.Version = pet.Version + 1
 	update := bson.D{
 		{Key: "$set", Value: petDoc},
 	}
@@ -171,16 +178,20 @@ func (r *petMongoRepo) Update(c context.Context, pet *model.Pet) error {
 	}
 
 	// 檢查是否有文件被更新
+	// 未命中時區分寵物不存在與版本衝突
 	if result.MatchedCount == 0 {
-		ctx.Warn("找不到要更新的寵物", "pet_id", pet.ID)
-		return domain.ErrNotFound // 返回標準的領域錯誤
+		err := resolveUpdateMiss(ctx, collection, petDoc.ID)
+		ctx.Warn("更新寵物未命中", "pet_id", pet.ID, "version", pet.Version, "error", err)
+		return err
 	}
 
+	pet.Version = petDoc.Version
+
 	ctx.Info("成功更新寵物", "pet_id", pet.ID, "pet_name", pet.Name, "modified_count", result.ModifiedCount)
 	return nil
 }
 
-// Delete 實作刪除寵物的功能
+// Delete 實作軟刪除寵物的功能（不含關聯紀錄，連同紀錄封存請使用 PetArchiveRepository）
 func (r *petMongoRepo) Delete(c context.Context, id string) error {
 	ctx := contextx.WithContext(c)
 	ctx.Info("開始刪除寵物", "pet_id", id)
@@ -192,24 +203,25 @@ func (r *petMongoRepo) Delete(c context.Context, id string) error {
 		return domain.ErrInvalidID
 	}
 
-	// 建立查詢過濾器
-	filter := bson.D{{Key: "_id", Value: objectID}}
+	// 建立查詢過濾器，軟刪除：僅標記刪除時間
+	filter := bson.D{{Key: "_id", Value: objectID}, {Key: deletedAtField, Value: notDeleted}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: deletedAtField, Value: time.Now()}}}}
 
 	// 執行刪除操作
 	collection := r.db.Collection(petCollection)
-	result, err := collection.DeleteOne(ctx, filter)
+	result, err := collection.UpdateOne(ctx, filter, update)
 	if err != nil {
 		ctx.Error("刪除寵物失敗", "error", err, "pet_id", id)
 		return convertMongoError(err)
 	}
 
 	// 檢查是否有文件被刪除
-	if result.DeletedCount == 0 {
+	if result.MatchedCount == 0 {
 		ctx.Warn("找不到要刪除的寵物", "pet_id", id)
 		return domain.ErrNotFound // 返回標準的領域錯誤
 	}
 
-	ctx.Info("成功刪除寵物", "pet_id", id, "deleted_count", result.DeletedCount)
+	ctx.Info("成功刪除寵物", "pet_id", id, "modified_count", result.ModifiedCount)
 	return nil
 }
 
@@ -225,7 +237,7 @@ func (r *petMongoRepo) FindIDsByOwnerID(c context.Context, ownerID string) ([]st
 	}
 
 	// 建立查詢過濾器
-	filter := bson.D{{Key: "owner_id", Value: ownerID}}
+	filter := bson.D{{Key: "owner_id", Value: ownerID}, {Key: deletedAtField, Value: notDeleted}}
 
 	// 執行查詢操作，在 MongoDB v2 中直接使用 Find
 	collection := r.db.Collection(petCollection)
@@ -260,3 +272,44 @@ func (r *petMongoRepo) FindIDsByOwnerID(c context.Context, ownerID string) ([]st
 	ctx.Info("成功查找寵物 ID", "owner_id", ownerID, "count", len(petIDs))
 	return petIDs, nil
 }
+
+// FindByHouseholdIDs 實作根據家庭 ID 列表查找寵物的功能
+func (r *petMongoRepo) FindByHouseholdIDs(c context.Context, householdIDs []string) ([]*model.Pet, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始根據家庭 ID 列表查找寵物", "household_ids_count", len(householdIDs))
+
+	if len(householdIDs) == 0 {
+		return []*model.Pet{}, nil
+	}
+
+	filter := bson.D{
+		{Key: "household_id", Value: bson.D{{Key: "$in", Value: householdIDs}}},
+		{Key: deletedAtField, Value: notDeleted},
+	}
+
+	collection := r.db.Collection(petCollection)
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		ctx.Error("查詢寵物時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	pets := make([]*model.Pet, 0)
+	for cursor.Next(ctx) {
+		var petDoc petMongo
+		if err := cursor.Decode(&petDoc); err != nil {
+			ctx.Error("解碼寵物資料時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		pets = append(pets, petDoc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	ctx.Info("成功查找家庭寵物", "count", len(pets))
+	return pets, nil
+}
@@ -11,15 +11,21 @@ import (
 // petMongo 是 Pet 的持久化模型，包含 DB 專用的標籤。
 // 特別注意 ID 欄位使用 bson.ObjectID。
 type petMongo struct {
-	ID          bson.ObjectID `bson:"_id,omitempty"`
-	OwnerID     string        `bson:"owner_id"`
-	Name        string        `bson:"name"`
-	AvatarURL   string        `bson:"avatar_url,omitempty"`
-	DOB         time.Time     `bson:"dob,omitempty"`
-	Breed       string        `bson:"breed,omitempty"`
-	MicrochipID string        `bson:"microchip_id,omitempty"`
-	CreatedAt   time.Time     `bson:"created_at"`
-	UpdatedAt   time.Time     `bson:"updated_at"`
+	ID          bson.ObjectID     `bson:"_id,omitempty"`
+	OwnerID     string            `bson:"owner_id"`
+	HouseholdID string            `bson:"household_id,omitempty"`
+	Name        string            `bson:"name"`
+	AvatarURL   string            `bson:"avatar_url,omitempty"`
+	DOB         time.Time         `bson:"dob,omitempty"`
+	Breed       string            `bson:"breed,omitempty"`
+	MicrochipID string            `bson:"microchip_id,omitempty"`
+	WeightAlert *weightAlertMongo `bson:"weight_alert"`
+	Version     int               `bson:"version"`
+	DeletedAt   *time.Time        `bson:"deleted_at,omitempty"`
+	CreatedAt   time.Time         `bson:"created_at"`
+	UpdatedAt   time.Time         `bson:"updated_at"`
+
+	Avatar *petAvatarMongo `bson:"avatar"`
 }
 
 // toDomain 將持久化模型 (petMongo) 轉換為領域模型 (model.Pet)。
@@ -32,13 +38,18 @@ func (pm *petMongo) toDomain() *model.Pet {
 	return &model.Pet{
 		ID:          pm.ID.Hex(),
 		OwnerID:     pm.OwnerID,
+		HouseholdID: pm.HouseholdID,
 		Name:        pm.Name,
 		AvatarURL:   pm.AvatarURL,
 		DOB:         pm.DOB,
 		Breed:       pm.Breed,
 		MicrochipID: pm.MicrochipID,
+		WeightAlert: pm.WeightAlert.toDomain(),
+		Version:     pm.Version,
+		DeletedAt:   pm.DeletedAt,
 		CreatedAt:   pm.CreatedAt,
 		UpdatedAt:   pm.UpdatedAt,
+		Avatar:      pm.Avatar.toDomain(),
 	}
 }
 
@@ -67,12 +78,71 @@ func petMongoFromDomain(p *model.Pet) (*petMongo, error) {
 	return &petMongo{
 		ID:          objectID,
 		OwnerID:     p.OwnerID,
+		HouseholdID: p.HouseholdID,
 		Name:        p.Name,
 		AvatarURL:   p.AvatarURL,
 		DOB:         p.DOB,
 		Breed:       p.Breed,
 		MicrochipID: p.MicrochipID,
+		WeightAlert: weightAlertMongoFromDomain(p.WeightAlert),
+		Version:     p.Version,
+		DeletedAt:   p.DeletedAt,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
+		Avatar:      petAvatarMongoFromDomain(p.Avatar),
 	}, nil
 }
+
+// weightAlertMongo 是寵物體重警示門檻的持久化模型。
+type weightAlertMongo struct {
+	LossPct float64 `bson:"loss_pct"`
+	GainPct float64 `bson:"gain_pct"`
+}
+
+func (w *weightAlertMongo) toDomain() *model.WeightAlertThreshold {
+	if w == nil {
+		return nil
+	}
+	return &model.WeightAlertThreshold{LossPct: w.LossPct, GainPct: w.GainPct}
+}
+
+func weightAlertMongoFromDomain(w *model.WeightAlertThreshold) *weightAlertMongo {
+	if w == nil {
+		return nil
+	}
+	return &weightAlertMongo{LossPct: w.LossPct, GainPct: w.GainPct}
+}
+
+// petAvatarMongo 是上傳頭像的持久化模型。
+type petAvatarMongo struct {
+	Key        string                 `bson:"key"`
+	Thumbnails []avatarThumbnailMongo `bson:"thumbnails"`
+	UploadedAt time.Time              `bson:"uploaded_at"`
+}
+
+type avatarThumbnailMongo struct {
+	Size int    `bson:"size"`
+	URL  string `bson:"url"`
+}
+
+func (a *petAvatarMongo) toDomain() *model.PetAvatar {
+	if a == nil {
+		return nil
+	}
+	thumbnails := make([]model.AvatarThumbnail, 0, len(a.Thumbnails))
+	for _, t := range a.Thumbnails {
+		thumbnails = append(thumbnails, model.AvatarThumbnail{Size: t.Size, URL: t.URL})
+	}
+	return &model.PetAvatar{Key: a.Key, Thumbnails: thumbnails, UploadedAt: a.UploadedAt}
+}
+
+func petAvatarMongoFromDomain(a *model.PetAvatar) *petAvatarMongo {
+	if a == nil {
+		return nil
+	}
+	thumbnails := make([]avatarThumbnailMongo, 0, len(a.Thumbnails))
+	for _, t := range a.Thumbnails {
+		thumbnails = append(thumbnails, avatarThumbnailMongo{Size: t.Size, URL: t.URL})
+	}
+	return &petAvatarMongo{Key: a.Key, Thumbnails: thumbnails, UploadedAt: a.UploadedAt}
+}
@@ -0,0 +1,201 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const recurringExpenseCollectionName = "recurring_expenses"
+
+// RecurringExpenseRepositoryImpl 實作 repository.RecurringExpenseRepository 介面，使用 MongoDB。
+type RecurringExpenseRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewRecurringExpenseRepository 建立新的 RecurringExpenseRepositoryImpl。
+func NewRecurringExpenseRepository(db *mongo.Database) repository.RecurringExpenseRepository {
+	repo := &RecurringExpenseRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *RecurringExpenseRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(recurringExpenseCollectionName)
+}
+
+// ensureIndexes 建立週期性費用查詢所需的索引
+func (r *RecurringExpenseRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}},
+			Options: options.Index().SetName("pet_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "paused", Value: 1}},
+			Options: options.Index().SetName("paused"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立週期性費用索引失敗", "error", err)
+	}
+}
+
+// Create 新增週期性費用
+func (r *RecurringExpenseRepositoryImpl) Create(c context.Context, recurring *model.RecurringExpense) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := recurringExpenseMongoFromDomain(recurring)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err)
+		return err
+	}
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+	doc.Version = initialVersion
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立週期性費用失敗", "error", err)
+		return convertMongoError(err)
+	}
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		recurring.ID = oid.Hex()
+	}
+	recurring.Version = doc.Version
+	recurring.CreatedAt = doc.CreatedAt
+	recurring.UpdatedAt = doc.UpdatedAt
+
+	ctx.Info("成功建立週期性費用", "recurring_expense_id", recurring.ID)
+	return nil
+}
+
+// FindByID 依 ID 查詢週期性費用
+func (r *RecurringExpenseRepositoryImpl) FindByID(c context.Context, id string) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的週期性費用 ID 格式", "recurring_expense_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc recurringExpenseMongo
+	filter := bson.M{"_id": objectID, deletedAtField: notDeleted}
+	if err := r.collection().FindOne(ctx, filter).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的週期性費用", "recurring_expense_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找週期性費用時發生錯誤", "error", err, "recurring_expense_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByPetIDs 查詢指定寵物群組的週期性費用
+func (r *RecurringExpenseRepositoryImpl) FindByPetIDs(c context.Context, petIDs []string) ([]*model.RecurringExpense, error) {
+	if petIDs == nil {
+		petIDs = []string{}
+	}
+	return r.find(c, bson.M{"pet_id": bson.M{"$in": petIDs}, deletedAtField: notDeleted})
+}
+
+// FindActive 查詢未暫停的週期性費用
+func (r *RecurringExpenseRepositoryImpl) FindActive(c context.Context) ([]*model.RecurringExpense, error) {
+	return r.find(c, bson.M{"paused": false, deletedAtField: notDeleted})
+}
+
+func (r *RecurringExpenseRepositoryImpl) find(c context.Context, filter bson.M) ([]*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection().Find(ctx, filter, opts)
+	if err != nil {
+		ctx.Error("查詢週期性費用時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+	defer cursor.Close(ctx)
+
+	result := make([]*model.RecurringExpense, 0)
+	for cursor.Next(ctx) {
+		var doc recurringExpenseMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼週期性費用時發生錯誤", "error", err)
+			return nil, convertMongoError(err)
+		}
+		result = append(result, doc.toDomain())
+	}
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷週期性費用查詢結果時發生錯誤", "error", err)
+		return nil, convertMongoError(err)
+	}
+
+	return result, nil
+}
+
+// Update 依版本更新週期性費用
+func (r *RecurringExpenseRepositoryImpl) Update(c context.Context, recurring *model.RecurringExpense) error {
+	ctx := contextx.WithContext(c)
+
+	doc, err := recurringExpenseMongoFromDomain(recurring)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "recurring_expense_id", recurring.ID)
+		return err
+	}
+
+	// 以版本比對實作 compare-and-swap，避免產生器與使用者編輯互相覆寫
+	filter := bson.M{"_id": doc.ID, deletedAtField: notDeleted, versionField: versionMatch(recurring.Version)}
+	doc.UpdatedAt = time.Now()
+	doc.Version = recurring.Version + 1
+	result, err := r.collection().UpdateOne(ctx, filter, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新週期性費用失敗", "error", err, "recurring_expense_id", recurring.ID)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		err := resolveUpdateMiss(ctx, r.collection(), doc.ID)
+		ctx.Warn("更新週期性費用未命中", "recurring_expense_id", recurring.ID, "version", recurring.Version, "error", err)
+		return err
+	}
+
+	recurring.Version = doc.Version
+	recurring.UpdatedAt = doc.UpdatedAt
+	return nil
+}
+
+// Delete 刪除週期性費用，已產生的費用不受影響
+func (r *RecurringExpenseRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的週期性費用 ID 格式", "recurring_expense_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除週期性費用失敗", "error", err, "recurring_expense_id", id)
+		return convertMongoError(err)
+	}
+	if result.DeletedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除週期性費用", "recurring_expense_id", id)
+	return nil
+}
@@ -0,0 +1,94 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// recurringExpenseMongo 為 RecurringExpense 的持久化模型
+type recurringExpenseMongo struct {
+	ID               bson.ObjectID `bson:"_id,omitempty"`
+	PetID            string        `bson:"pet_id"`
+	Category         string        `bson:"category"`
+	Amount           int           `bson:"amount"`
+	Description      string        `bson:"description,omitempty"`
+	Frequency        string        `bson:"frequency"`
+	Interval         int           `bson:"interval"`
+	DayOfMonth       int           `bson:"day_of_month,omitempty"`
+	StartDate        time.Time     `bson:"start_date"`
+	EndDate          *time.Time    `bson:"end_date"`
+	Paused           bool          `bson:"paused"`
+	SkippedDates     []time.Time   `bson:"skipped_dates"`
+	GeneratedThrough *time.Time    `bson:"generated_through"`
+	Version          int           `bson:"version"`
+	CreatedAt        time.Time     `bson:"created_at"`
+	UpdatedAt        time.Time     `bson:"updated_at"`
+	Currency         string        `bson:"currency,omitempty"`
+}
+
+// toDomain 將持久化模型轉換為領域模型
+func (m *recurringExpenseMongo) toDomain() *model.RecurringExpense {
+	if m == nil {
+		return nil
+	}
+	return &model.RecurringExpense{
+		ID:          m.ID.Hex(),
+		PetID:       m.PetID,
+		Category:    m.Category,
+		Amount:      m.Amount,
+		Description: m.Description,
+		Schedule: model.ExpenseSchedule{
+			Frequency:  model.RecurrenceFrequency(m.Frequency),
+			Interval:   m.Interval,
+			DayOfMonth: m.DayOfMonth,
+		},
+		StartDate:        m.StartDate,
+		EndDate:          m.EndDate,
+		Paused:           m.Paused,
+		SkippedDates:     m.SkippedDates,
+		GeneratedThrough: m.GeneratedThrough,
+		Version:          m.Version,
+		CreatedAt:        m.CreatedAt,
+		UpdatedAt:        m.UpdatedAt,
+		Currency:         m.Currency,
+	}
+}
+
+// recurringExpenseMongoFromDomain 將領域模型轉換為持久化模型
+func recurringExpenseMongoFromDomain(r *model.RecurringExpense) (*recurringExpenseMongo, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	if r.ID != "" {
+		var err error
+		objectID, err = bson.ObjectIDFromHex(r.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &recurringExpenseMongo{
+		ID:               objectID,
+		PetID:            r.PetID,
+		Category:         r.Category,
+		Amount:           r.Amount,
+		Description:      r.Description,
+		Frequency:        string(r.Schedule.Frequency),
+		Interval:         r.Schedule.Interval,
+		DayOfMonth:       r.Schedule.DayOfMonth,
+		StartDate:        r.StartDate,
+		EndDate:          r.EndDate,
+		Paused:           r.Paused,
+		SkippedDates:     r.SkippedDates,
+		GeneratedThrough: r.GeneratedThrough,
+		Version:          r.Version,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+		Currency:         r.Currency,
+	}, nil
+}
@@ -0,0 +1,269 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	reminderCollectionName = "reminders"
+)
+
+// ReminderRepositoryImpl 實作 repository.ReminderRepository 介面，使用 MongoDB。
+type ReminderRepositoryImpl struct {
+	db *mongo.Database
+}
+
+// NewReminderRepository 建立新的 ReminderRepositoryImpl。
+func NewReminderRepository(db *mongo.Database) repository.ReminderRepository {
+	repo := &ReminderRepositoryImpl{db: db}
+	repo.ensureIndexes()
+	return repo
+}
+
+func (r *ReminderRepositoryImpl) collection() *mongo.Collection {
+	return r.db.Collection(reminderCollectionName)
+}
+
+// ensureIndexes 建立提醒查詢所需的索引
+func (r *ReminderRepositoryImpl) ensureIndexes() {
+	ctx := contextx.WithContext(context.Background())
+
+	indexes := []mongo.IndexModel{
+		{
+			// 同一筆醫療記錄的同一個到期日只會產生一筆提醒
+			Keys: bson.D{{Key: "medical_record_id", Value: 1}, {Key: "due_date", Value: 1}},
+			Options: options.Index().
+				SetName("medical_record_due_date_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{
+					"medical_record_id": bson.M{"$exists": true},
+				}),
+		},
+		{
+			// 同一用藥療程的同一次漏服只會產生一筆提醒
+			Keys: bson.D{{Key: "medication_regimen_id", Value: 1}, {Key: "due_date", Value: 1}},
+			Options: options.Index().
+				SetName("medication_regimen_due_date_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{
+					"medication_regimen_id": bson.M{"$exists": true},
+				}),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "remind_at", Value: 1}},
+			Options: options.Index().SetName("status_remind_at"),
+		},
+		{
+			Keys:    bson.D{{Key: "pet_id", Value: 1}},
+			Options: options.Index().SetName("pet_id_index"),
+		},
+	}
+
+	if _, err := r.collection().Indexes().CreateMany(ctx, indexes); err != nil {
+		ctx.Warn("建立提醒索引失敗", "error", err)
+	}
+}
+
+// Create 新增一筆提醒。
+func (r *ReminderRepositoryImpl) Create(c context.Context, reminder *model.Reminder) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始建立提醒", "pet_id", reminder.PetID, "medical_record_id", reminder.MedicalRecordID)
+
+	doc, err := reminderMongoFromDomain(reminder)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "pet_id", reminder.PetID)
+		return err
+	}
+
+	now := time.Now()
+	doc.CreatedAt = now
+	doc.UpdatedAt = now
+
+	result, err := r.collection().InsertOne(ctx, doc)
+	if err != nil {
+		ctx.Error("建立提醒失敗", "error", err, "pet_id", reminder.PetID)
+		return convertMongoError(err)
+	}
+
+	if oid, ok := result.InsertedID.(bson.ObjectID); ok {
+		reminder.ID = oid.Hex()
+	}
+	reminder.CreatedAt = now
+	reminder.UpdatedAt = now
+
+	ctx.Info("成功建立提醒", "reminder_id", reminder.ID, "pet_id", reminder.PetID)
+	return nil
+}
+
+// FindByID 依 ID 查詢單一提醒。
+func (r *ReminderRepositoryImpl) FindByID(c context.Context, id string) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的提醒 ID 格式", "reminder_id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc reminderMongo
+	err = r.collection().FindOne(ctx, bson.M{"_id": objectID}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			ctx.Warn("找不到指定的提醒", "reminder_id", id)
+			return nil, domain.ErrNotFound
+		}
+		ctx.Error("查找提醒時發生錯誤", "error", err, "reminder_id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindByPetIDs 查詢指定寵物群組的提醒，依到期日排序。
+func (r *ReminderRepositoryImpl) FindByPetIDs(c context.Context, petIDs []string, statuses ...model.ReminderStatus) ([]*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始根據寵物 ID 列表查找提醒", "pet_ids_count", len(petIDs), "statuses", statuses)
+
+	if len(petIDs) == 0 {
+		return []*model.Reminder{}, nil
+	}
+
+	filter := bson.M{"pet_id": bson.M{"$in": petIDs}}
+	if len(statuses) > 0 {
+		values := make([]string, 0, len(statuses))
+		for _, s := range statuses {
+			values = append(values, string(s))
+		}
+		filter["status"] = bson.M{"$in": values}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "due_date", Value: 1}})
+	return r.find(ctx, filter, opts)
+}
+
+// FindByMedicalRecord 查詢由指定醫療記錄與到期日產生的提醒。
+func (r *ReminderRepositoryImpl) FindByMedicalRecord(c context.Context, medicalRecordID string, dueDate time.Time) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := bson.M{
+		"medical_record_id": medicalRecordID,
+		"due_date":          dueDate,
+	}
+
+	var doc reminderMongo
+	err := r.collection().FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(), nil
+}
+
+// FindDue 查詢在指定時間點前應發送的提醒。
+func (r *ReminderRepositoryImpl) FindDue(c context.Context, now time.Time) ([]*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始查找到期的提醒", "now", now)
+
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"status": string(model.ReminderStatusPending), "remind_at": bson.M{"$lte": now}},
+			bson.M{"status": string(model.ReminderStatusSnoozed), "snoozed_until": bson.M{"$lte": now}},
+		},
+		deletedAtField: notDeleted,
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "remind_at", Value: 1}})
+	return r.find(ctx, filter, opts)
+}
+
+// Update 更新提醒。
+func (r *ReminderRepositoryImpl) Update(c context.Context, reminder *model.Reminder) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始更新提醒", "reminder_id", reminder.ID)
+
+	doc, err := reminderMongoFromDomain(reminder)
+	if err != nil {
+		ctx.Error("領域模型轉換失敗", "error", err, "reminder_id", reminder.ID)
+		return err
+	}
+
+	doc.UpdatedAt = time.Now()
+	result, err := r.collection().UpdateOne(ctx, bson.M{"_id": doc.ID}, bson.M{"$set": doc})
+	if err != nil {
+		ctx.Error("更新提醒失敗", "error", err, "reminder_id", reminder.ID)
+		return convertMongoError(err)
+	}
+
+	if result.MatchedCount == 0 {
+		ctx.Warn("找不到要更新的提醒", "reminder_id", reminder.ID)
+		return domain.ErrNotFound
+	}
+
+	reminder.UpdatedAt = doc.UpdatedAt
+	ctx.Info("成功更新提醒", "reminder_id", reminder.ID)
+	return nil
+}
+
+// Delete 刪除提醒。
+func (r *ReminderRepositoryImpl) Delete(c context.Context, id string) error {
+	ctx := contextx.WithContext(c)
+	ctx.Info("開始刪除提醒", "reminder_id", id)
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的提醒 ID 格式", "reminder_id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	result, err := r.collection().DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		ctx.Error("刪除提醒失敗", "error", err, "reminder_id", id)
+		return convertMongoError(err)
+	}
+
+	if result.DeletedCount == 0 {
+		ctx.Warn("找不到要刪除的提醒", "reminder_id", id)
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功刪除提醒", "reminder_id", id)
+	return nil
+}
+
+// find 執行查詢並將結果轉換為領域模型
+func (r *ReminderRepositoryImpl) find(ctx *contextx.Contextx, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*model.Reminder, error) {
+	cursor, err := r.collection().Find(ctx, filter, opts...)
+	if err != nil {
+		ctx.Error("查找提醒時發生錯誤", "error", err)
+		return nil, fmt.Errorf("查找提醒失敗: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	reminders := make([]*model.Reminder, 0)
+	for cursor.Next(ctx) {
+		var doc reminderMongo
+		if err := cursor.Decode(&doc); err != nil {
+			ctx.Error("解碼提醒時發生錯誤", "error", err)
+			return nil, fmt.Errorf("解碼提醒失敗: %w", err)
+		}
+		reminders = append(reminders, doc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		ctx.Error("遍歷提醒查詢結果時發生錯誤", "error", err)
+		return nil, fmt.Errorf("遍歷查詢結果失敗: %w", err)
+	}
+
+	return reminders, nil
+}
@@ -0,0 +1,83 @@
+package mongodb
+
+import (
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// reminderMongo 是 Reminder 的持久化模型，包含 DB 專用的標籤。
+type reminderMongo struct {
+	ID                  bson.ObjectID `bson:"_id,omitempty"`
+	PetID               string        `bson:"pet_id"`
+	MedicalRecordID     string        `bson:"medical_record_id,omitempty"`
+	MedicationRegimenID string        `bson:"medication_regimen_id,omitempty"`
+	Type                string        `bson:"type"`
+	Title               string        `bson:"title"`
+	DueDate             time.Time     `bson:"due_date"`
+	RemindAt            time.Time     `bson:"remind_at"`
+	Status              string        `bson:"status"`
+	SnoozedUntil        *time.Time    `bson:"snoozed_until,omitempty"`
+	SentAt              *time.Time    `bson:"sent_at,omitempty"`
+	CompletedAt         *time.Time    `bson:"completed_at,omitempty"`
+	CreatedAt           time.Time     `bson:"created_at"`
+	UpdatedAt           time.Time     `bson:"updated_at"`
+}
+
+// toDomain 將持久化模型 (reminderMongo) 轉換為領域模型 (model.Reminder)。
+func (m *reminderMongo) toDomain() *model.Reminder {
+	if m == nil {
+		return nil
+	}
+	return &model.Reminder{
+		ID:                  m.ID.Hex(),
+		PetID:               m.PetID,
+		MedicalRecordID:     m.MedicalRecordID,
+		MedicationRegimenID: m.MedicationRegimenID,
+		Type:                model.MedicalRecordType(m.Type),
+		Title:               m.Title,
+		DueDate:             m.DueDate,
+		RemindAt:            m.RemindAt,
+		Status:              model.ReminderStatus(m.Status),
+		SnoozedUntil:        m.SnoozedUntil,
+		SentAt:              m.SentAt,
+		CompletedAt:         m.CompletedAt,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+}
+
+// reminderMongoFromDomain 將領域模型 (model.Reminder) 轉換為持久化模型 (reminderMongo)。
+func reminderMongoFromDomain(r *model.Reminder) (*reminderMongo, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	var objectID bson.ObjectID
+	var err error
+	if r.ID != "" {
+		objectID, err = bson.ObjectIDFromHex(r.ID)
+		if err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &reminderMongo{
+		ID:                  objectID,
+		PetID:               r.PetID,
+		MedicalRecordID:     r.MedicalRecordID,
+		MedicationRegimenID: r.MedicationRegimenID,
+		Type:                string(r.Type),
+		Title:               r.Title,
+		DueDate:             r.DueDate,
+		RemindAt:            r.RemindAt,
+		Status:              string(r.Status),
+		SnoozedUntil:        r.SnoozedUntil,
+		SentAt:              r.SentAt,
+		CompletedAt:         r.CompletedAt,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}, nil
+}
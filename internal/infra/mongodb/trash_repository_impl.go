@@ -0,0 +1,263 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// trashCollections 垃圾桶紀錄種類與集合的對應
+var trashCollections = map[model.TrashItemType]string{
+	model.TrashItemHealthLog:     healthLogCollectionName,
+	model.TrashItemMedicalRecord: medicalRecordCollectionName,
+	model.TrashItemExpense:       expenseCollectionName,
+}
+
+// trashAttachmentOwners 可帶有附件的紀錄種類，永久清除時一併刪除附件
+var trashAttachmentOwners = map[model.TrashItemType]model.AttachmentOwnerType{
+	model.TrashItemMedicalRecord: model.AttachmentOwnerMedicalRecord,
+	model.TrashItemExpense:       model.AttachmentOwnerExpense,
+}
+
+// trashItemTypes 列出所有種類時的固定順序
+var trashItemTypes = []model.TrashItemType{
+	model.TrashItemHealthLog,
+	model.TrashItemMedicalRecord,
+	model.TrashItemExpense,
+}
+
+// trashRepo 實作 repository.TrashRepository
+type trashRepo struct {
+	db *mongo.Database
+}
+
+// NewTrashRepository 建立新的 trashRepo
+func NewTrashRepository(db *mongo.Database) repository.TrashRepository {
+	return &trashRepo{db: db}
+}
+
+// collection 取得種類對應的集合，寵物或未知種類回傳 ErrInvalidParameter
+func (r *trashRepo) collection(itemType model.TrashItemType) (*mongo.Collection, error) {
+	name, ok := trashCollections[itemType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported trash item type %q", domain.ErrInvalidParameter, itemType)
+	}
+	return r.db.Collection(name), nil
+}
+
+// FindByPetIDs 列出指定寵物群組已刪除的紀錄，依刪除時間由新到舊排序
+func (r *trashRepo) FindByPetIDs(c context.Context, petIDs []string, itemType model.TrashItemType) ([]*model.TrashItem, error) {
+	ctx := contextx.WithContext(c)
+
+	items := make([]*model.TrashItem, 0)
+	if len(petIDs) == 0 {
+		return items, nil
+	}
+
+	types := trashItemTypes
+	if itemType != "" {
+		types = []model.TrashItemType{itemType}
+	}
+
+	filter := bson.M{"pet_id": bson.M{"$in": petIDs}, deletedAtField: bson.M{"$exists": true}}
+	for _, t := range types {
+		coll, err := r.collection(t)
+		if err != nil {
+			return nil, err
+		}
+
+		cursor, err := coll.Find(ctx, filter)
+		if err != nil {
+			ctx.Error("查詢垃圾桶紀錄時發生錯誤", "error", err, "type", t)
+			return nil, convertMongoError(err)
+		}
+
+		var docs []trashRecordMongo
+		if err := cursor.All(ctx, &docs); err != nil {
+			ctx.Error("解碼垃圾桶紀錄時發生錯誤", "error", err, "type", t)
+			return nil, convertMongoError(err)
+		}
+		for i := range docs {
+			items = append(items, docs[i].toDomain(t))
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// FindByID 查詢垃圾桶中的單筆紀錄
+func (r *trashRepo) FindByID(c context.Context, itemType model.TrashItemType, id string) (*model.TrashItem, error) {
+	ctx := contextx.WithContext(c)
+
+	coll, err := r.collection(itemType)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var doc trashRecordMongo
+	filter := bson.M{"_id": objectID, deletedAtField: bson.M{"$exists": true}}
+	if err := coll.FindOne(ctx, filter).Decode(&doc); err != nil {
+		ctx.Warn("查找垃圾桶紀錄時發生錯誤", "error", err, "type", itemType, "id", id)
+		return nil, convertMongoError(err)
+	}
+
+	return doc.toDomain(itemType), nil
+}
+
+// Restore 移除紀錄的刪除標記
+func (r *trashRepo) Restore(c context.Context, itemType model.TrashItemType, id string) error {
+	ctx := contextx.WithContext(c)
+
+	coll, err := r.collection(itemType)
+	if err != nil {
+		return err
+	}
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "id", id, "error", err)
+		return domain.ErrInvalidID
+	}
+
+	filter := bson.M{"_id": objectID, deletedAtField: bson.M{"$exists": true}}
+	update := bson.M{"$unset": bson.M{deletedAtField: ""}}
+	result, err := coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		ctx.Error("還原垃圾桶紀錄失敗", "error", err, "type", itemType, "id", id)
+		return convertMongoError(err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotFound
+	}
+
+	ctx.Info("成功還原垃圾桶紀錄", "type", itemType, "id", id)
+	return nil
+}
+
+// Purge 在交易中永久刪除垃圾桶中的紀錄及其附件，未刪除的紀錄不受影響，回傳被刪除的附件
+func (r *trashRepo) Purge(c context.Context, itemType model.TrashItemType, id string) ([]*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	coll, err := r.collection(itemType)
+	if err != nil {
+		return nil, err
+	}
+
+	objectID, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		ctx.Warn("無效的 ID 格式", "id", id, "error", err)
+		return nil, domain.ErrInvalidID
+	}
+
+	var attachments []*model.Attachment
+	err = withTransaction(ctx, r.db, func(sc context.Context) error {
+		filter := bson.M{"_id": objectID, deletedAtField: bson.M{"$exists": true}}
+		result, err := coll.DeleteOne(sc, filter)
+		if err != nil {
+			return convertMongoError(err)
+		}
+		if result.DeletedCount == 0 {
+			return domain.ErrNotFound
+		}
+
+		attachments, err = r.purgeAttachments(sc, itemType, bson.A{id})
+		return err
+	})
+	if err != nil {
+		ctx.Error("永久清除垃圾桶紀錄失敗", "error", err, "type", itemType, "id", id)
+		return nil, err
+	}
+
+	ctx.Info("成功永久清除垃圾桶紀錄", "type", itemType, "id", id, "attachments", len(attachments))
+	return attachments, nil
+}
+
+// PurgeDeletedBefore 永久刪除在指定時間點前刪除的紀錄及其附件，每個種類各自在交易中執行
+func (r *trashRepo) PurgeDeletedBefore(c context.Context, cutoff time.Time) (int, []*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	filter := bson.M{deletedAtField: bson.M{"$lt": cutoff}}
+	purged := 0
+	var attachments []*model.Attachment
+	for _, t := range trashItemTypes {
+		coll, err := r.collection(t)
+		if err != nil {
+			return purged, attachments, err
+		}
+
+		var deleted int
+		var removed []*model.Attachment
+		err = withTransaction(ctx, r.db, func(sc context.Context) error {
+			var docs []struct {
+				ID bson.ObjectID `bson:"_id"`
+			}
+			cursor, err := coll.Find(sc, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+			if err != nil {
+				return convertMongoError(err)
+			}
+			if err := cursor.All(sc, &docs); err != nil {
+				return convertMongoError(err)
+			}
+			if len(docs) == 0 {
+				deleted, removed = 0, nil
+				return nil
+			}
+
+			objectIDs := make(bson.A, 0, len(docs))
+			hexIDs := make(bson.A, 0, len(docs))
+			for _, doc := range docs {
+				objectIDs = append(objectIDs, doc.ID)
+				hexIDs = append(hexIDs, doc.ID.Hex())
+			}
+
+			result, err := coll.DeleteMany(sc, bson.M{"_id": bson.M{"$in": objectIDs}})
+			if err != nil {
+				return convertMongoError(err)
+			}
+			deleted = int(result.DeletedCount)
+
+			removed, err = r.purgeAttachments(sc, t, hexIDs)
+			return err
+		})
+		if err != nil {
+			ctx.Error("清除逾期垃圾桶紀錄失敗", "error", err, "type", t)
+			return purged, attachments, err
+		}
+		purged += deleted
+		attachments = append(attachments, removed...)
+	}
+
+	return purged, attachments, nil
+}
+
+// purgeAttachments 刪除指定紀錄的附件中繼資料，沒有附件的種類直接略過
+func (r *trashRepo) purgeAttachments(sc context.Context, itemType model.TrashItemType, ownerIDs bson.A) ([]*model.Attachment, error) {
+	ownerType, ok := trashAttachmentOwners[itemType]
+	if !ok {
+		return nil, nil
+	}
+	attachments, err := purgeAttachments(sc, r.db, bson.M{"owner_type": string(ownerType), "owner_id": bson.M{"$in": ownerIDs}})
+	if err != nil {
+		return nil, fmt.Errorf("清除 %s 失敗: %w", attachmentCollectionName, err)
+	}
+	return attachments, nil
+}
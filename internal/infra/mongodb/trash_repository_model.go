@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// trashRecordMongo 涵蓋健康日誌、醫療記錄與費用文件中產生垃圾桶簡述所需的欄位
+type trashRecordMongo struct {
+	ID          bson.ObjectID `bson:"_id"`
+	PetID       string        `bson:"pet_id"`
+	Date        time.Time     `bson:"date"`
+	Type        string        `bson:"type,omitempty"`
+	Description string        `bson:"description,omitempty"`
+	Category    string        `bson:"category,omitempty"`
+	Amount      int           `bson:"amount,omitempty"`
+	DeletedAt   time.Time     `bson:"deleted_at"`
+}
+
+// toDomain 將文件轉換為垃圾桶項目，並依種類產生列表用的簡述
+func (m *trashRecordMongo) toDomain(itemType model.TrashItemType) *model.TrashItem {
+	date := m.Date.Format(time.DateOnly)
+
+	var summary string
+	switch itemType {
+	case model.TrashItemHealthLog:
+		summary = fmt.Sprintf("%s 健康日誌", date)
+	case model.TrashItemMedicalRecord:
+		summary = fmt.Sprintf("%s %s %s", date, m.Type, m.Description)
+	case model.TrashItemExpense:
+		summary = fmt.Sprintf("%s %s %d %s", date, m.Category, m.Amount, m.Description)
+	}
+
+	return &model.TrashItem{
+		Type:      itemType,
+		ID:        m.ID.Hex(),
+		PetID:     m.PetID,
+		Summary:   strings.TrimSpace(summary),
+		DeletedAt: m.DeletedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// versionField 樂觀並行控制的版本欄位，建立時為 1，每次更新遞增
+const versionField = "version"
+
+// initialVersion 新建立文件的版本
+const initialVersion = 1
+
+// versionMatch 產生比對版本的查詢條件
+// 版本 0 代表加入版本欄位前建立的舊文件，同時比對欄位不存在的情況
+func versionMatch(version int) interface{} {
+	if version == 0 {
+		return bson.M{"$in": bson.A{0, nil}}
+	}
+	return version
+}
+
+// resolveUpdateMiss 在依版本更新未命中時判斷原因：文件不存在回傳 ErrNotFound，否則為版本衝突
+func resolveUpdateMiss(c context.Context, coll *mongo.Collection, id bson.ObjectID) error {
+	count, err := coll.CountDocuments(c, bson.M{"_id": id, deletedAtField: notDeleted})
+	if err != nil {
+		return convertMongoError(err)
+	}
+	if count == 0 {
+		return domain.ErrNotFound
+	}
+	return domain.ErrUpdateConflict
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+// EmailSender 透過 SMTP 發送 Email 通知
+type EmailSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// NewEmailSender 建立新的 EmailSender
+func NewEmailSender(cfg config.SMTPConfig) service.NotificationSender {
+	return &EmailSender{
+		addr:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		host:     cfg.Host,
+		username: cfg.Username,
+		password: cfg.Password,
+		from:     cfg.From,
+	}
+}
+
+// Channel 回傳 Email 管道
+func (s *EmailSender) Channel() model.NotificationChannel {
+	return model.NotificationChannelEmail
+}
+
+// Send 將通知寄送至指定的 Email 地址
+func (s *EmailSender) Send(c context.Context, target string, msg *model.NotificationMessage) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	// net/smtp 不支援 context，改在送出前檢查是否已取消
+	if err := c.Err(); err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(s.addr, auth, s.from, []string{target}, s.buildMessage(target, msg)); err != nil {
+		return fmt.Errorf("寄送 Email 失敗: %w", err)
+	}
+	return nil
+}
+
+// buildMessage 組成 RFC 5322 格式的郵件內容
+func (s *EmailSender) buildMessage(to string, msg *model.NotificationMessage) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + s.from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", msg.Title) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(formatText(msg), "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
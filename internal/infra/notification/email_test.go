@@ -0,0 +1,91 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// fakeSMTPServer 是最小化的 SMTP 替身，只記錄收到的信件內容
+func fakeSMTPServer(t *testing.T) (addr string, received chan string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("啟動 SMTP 替身失敗: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received = make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					received <- data.String()
+					reply("250 OK")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "DATA"):
+				inData = true
+				reply("354 End data with <CR><LF>.<CR><LF>")
+			case strings.HasPrefix(cmd, "QUIT"):
+				reply("221 Bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), received
+}
+
+func TestEmailSender_Send(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	host, portStr, _ := net.SplitHostPort(addr)
+	port, _ := strconv.Atoi(portStr)
+
+	sender := NewEmailSender(config.SMTPConfig{Host: host, Port: port, From: "noreply@petlog.test"})
+	err := sender.Send(context.Background(), "owner@petlog.test", &model.NotificationMessage{Title: "回診", Body: "記得帶健康手冊"})
+	if err != nil {
+		t.Fatalf("預期無錯誤，實際為 %v", err)
+	}
+
+	msg := <-received
+	if !strings.Contains(msg, "To: owner@petlog.test") {
+		t.Errorf("信件缺少收件者: %s", msg)
+	}
+	if !strings.Contains(msg, "記得帶健康手冊") {
+		t.Errorf("信件缺少內文: %s", msg)
+	}
+}
@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+const linePushPath = "/v2/bot/message/push"
+
+// LineSender 透過 LINE Messaging API 的 push message 發送通知
+type LineSender struct {
+	baseURL     string
+	accessToken string
+	httpClient  *http.Client
+}
+
+// NewLineSender 建立新的 LineSender
+func NewLineSender(baseURL, accessToken string, httpClient *http.Client) service.NotificationSender {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &LineSender{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		accessToken: accessToken,
+		httpClient:  httpClient,
+	}
+}
+
+type linePushRequest struct {
+	To       string            `json:"to"`
+	Messages []lineTextMessage `json:"messages"`
+}
+
+type lineTextMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Channel 回傳 LINE 管道
+func (s *LineSender) Channel() model.NotificationChannel {
+	return model.NotificationChannelLine
+}
+
+// Send 將通知以文字訊息推送給指定的 LINE 使用者
+func (s *LineSender) Send(c context.Context, target string, msg *model.NotificationMessage) error {
+	ctx := contextx.WithContext(c)
+
+	payload, err := json.Marshal(linePushRequest{
+		To:       target,
+		Messages: []lineTextMessage{{Type: "text", Text: formatText(msg)}},
+	})
+	if err != nil {
+		return fmt.Errorf("序列化 LINE 訊息失敗: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+linePushPath, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("建立 LINE 請求失敗: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.accessToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("呼叫 LINE API 失敗: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		ctx.Warn("LINE API 回應錯誤", "status", resp.StatusCode, "body", string(body))
+		return fmt.Errorf("LINE API 回應狀態 %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// formatText 將通知組成純文字內容
+func formatText(msg *model.NotificationMessage) string {
+	if msg.Body == "" {
+		return msg.Title
+	}
+	if msg.Title == "" {
+		return msg.Body
+	}
+	return msg.Title + "\n" + msg.Body
+}
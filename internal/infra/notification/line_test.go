@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestLineSender_Send(t *testing.T) {
+	t.Run("應以 Bearer token 推送文字訊息", func(t *testing.T) {
+		var got linePushRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != linePushPath {
+				t.Errorf("預期路徑 %s，實際為 %s", linePushPath, r.URL.Path)
+			}
+			if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+				t.Errorf("預期 Authorization 為 Bearer token，實際為 %s", auth)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatalf("解析請求失敗: %v", err)
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		sender := NewLineSender(srv.URL, "token", srv.Client())
+		err := sender.Send(context.Background(), "U123", &model.NotificationMessage{Title: "疫苗接種", Body: "明天到期"})
+		if err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if got.To != "U123" || len(got.Messages) != 1 || got.Messages[0].Text != "疫苗接種\n明天到期" {
+			t.Errorf("推送內容不符: %+v", got)
+		}
+	})
+
+	t.Run("非 200 回應應回傳錯誤", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		sender := NewLineSender(srv.URL, "token", srv.Client())
+		if err := sender.Send(context.Background(), "U123", &model.NotificationMessage{Title: "x"}); err == nil {
+			t.Error("預期回傳錯誤")
+		}
+	})
+}
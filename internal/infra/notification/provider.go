@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+// ProvideSenders 依配置建立可用的通知 sender，未設定的管道不會被啟用
+func ProvideSenders(cfg config.Config) []service.NotificationSender {
+	conf := cfg.Notification
+	httpClient := &http.Client{Timeout: conf.Webhook.Timeout}
+
+	var senders []service.NotificationSender
+	if conf.Line.ChannelAccessToken != "" {
+		senders = append(senders, NewLineSender(conf.Line.APIBaseURL, conf.Line.ChannelAccessToken, httpClient))
+	}
+	if conf.SMTP.Host != "" {
+		senders = append(senders, NewEmailSender(conf.SMTP))
+	}
+	if conf.Webhook.Secret != "" {
+		senders = append(senders, NewWebhookSender(conf.Webhook.Secret, httpClient))
+	}
+	return senders
+}
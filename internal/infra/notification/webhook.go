@@ -0,0 +1,105 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+const (
+	// SignatureHeader 為 webhook 簽章的 header，格式為 "sha256=<hex>"
+	SignatureHeader = "X-Petlog-Signature"
+	// TimestampHeader 為簽章時間戳的 header（Unix 秒），接收端可用於防止重放
+	TimestampHeader = "X-Petlog-Timestamp"
+)
+
+// WebhookSender 以 HMAC-SHA256 簽章的 JSON POST 發送通知至使用者指定的 URL
+type WebhookSender struct {
+	secret     []byte
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewWebhookSender 建立新的 WebhookSender
+func NewWebhookSender(secret string, httpClient *http.Client) service.NotificationSender {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSender{
+		secret:     []byte(secret),
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+type webhookPayload struct {
+	Event   string                     `json:"event"`
+	Message *model.NotificationMessage `json:"message"`
+	SentAt  time.Time                  `json:"sent_at"`
+}
+
+// Channel 回傳 Webhook 管道
+func (s *WebhookSender) Channel() model.NotificationChannel {
+	return model.NotificationChannelWebhook
+}
+
+// Send 將通知 POST 至指定的 webhook URL
+func (s *WebhookSender) Send(c context.Context, target string, msg *model.NotificationMessage) error {
+	now := s.now()
+
+	event := "reminder.due"
+	if msg.BudgetID != "" {
+		event = model.BudgetThresholdCrossedEventName
+	} else if msg.ExportID != "" {
+		event = model.ExportCompletedEventName
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		Event:   event,
+		Message: msg,
+		SentAt:  now,
+	})
+	if err != nil {
+		return fmt.Errorf("序列化 webhook 內容失敗: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req, err := http.NewRequestWithContext(c, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("建立 webhook 請求失敗: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(s.secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("呼叫 webhook 失敗: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook 回應狀態 %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign 計算 "<timestamp>.<body>" 的 HMAC-SHA256 簽章，接收端可用相同方式驗證
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
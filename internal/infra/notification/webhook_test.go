@@ -0,0 +1,44 @@
+package notification
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestWebhookSender_Send(t *testing.T) {
+	secret := []byte("s3cret")
+
+	t.Run("應附上可驗證的簽章", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			want := "sha256=" + Sign(secret, r.Header.Get(TimestampHeader), body)
+			if got := r.Header.Get(SignatureHeader); got != want {
+				t.Errorf("簽章不符，預期 %s，實際為 %s", want, got)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		sender := NewWebhookSender(string(secret), srv.Client())
+		if err := sender.Send(context.Background(), srv.URL, &model.NotificationMessage{Title: "驅蟲"}); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+	})
+
+	t.Run("非 2xx 回應應回傳錯誤", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		sender := NewWebhookSender(string(secret), srv.Client())
+		if err := sender.Send(context.Background(), srv.URL, &model.NotificationMessage{Title: "驅蟲"}); err == nil {
+			t.Error("預期回傳錯誤")
+		}
+	})
+}
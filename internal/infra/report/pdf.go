@@ -0,0 +1,444 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+const (
+	fontFamily = "report"
+	dateLayout = "2006-01-02"
+
+	pageMargin   = 15.0
+	lineHeight   = 5.0
+	cellPadding  = 1.5
+	chartHeight  = 70.0
+	chartYLabelW = 14.0
+)
+
+var (
+	headerFill = [3]int{232, 238, 247}
+	accent     = [3]int{37, 99, 235}
+	alert      = [3]int{220, 38, 38}
+	muted      = [3]int{107, 114, 128}
+)
+
+// ProvideReportRenderer 依配置建立 PDF 報告產生器
+// 未設定字型時使用內建的 Go 字型，僅支援拉丁字元；報告需顯示中文名稱或描述時請設定含 CJK 字元的 TrueType 字型
+func ProvideReportRenderer(cfg config.Config) (service.ReportRenderer, error) {
+	regular, bold := goregular.TTF, gobold.TTF
+	if path := cfg.Report.FontPath; path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("讀取報告字型失敗: %w", err)
+		}
+		regular, bold = data, data
+	}
+	if path := cfg.Report.BoldFontPath; path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("讀取報告粗體字型失敗: %w", err)
+		}
+		bold = data
+	}
+	return NewPDFRenderer(regular, bold), nil
+}
+
+// PDFRenderer 以 gofpdf 於伺服器端產生 PDF 報告
+type PDFRenderer struct {
+	regular []byte
+	bold    []byte
+}
+
+// NewPDFRenderer 以 TrueType 字型建立 PDFRenderer
+func NewPDFRenderer(regular, bold []byte) *PDFRenderer {
+	return &PDFRenderer{regular: regular, bold: bold}
+}
+
+// RenderPetHealthReport 輸出寵物基本資料、體重圖表、疫苗與用藥紀錄及值得注意的健康觀察
+func (r *PDFRenderer) RenderPetHealthReport(c context.Context, w io.Writer, report *model.PetHealthReport) error {
+	if err := c.Err(); err != nil {
+		return err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", r.bold)
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin+5)
+	pdf.SetTitle("Pet Health Report - "+report.Pet.Name, true)
+	pdf.SetCreator("petlog", true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin)
+		pdf.SetFont(fontFamily, "", 8)
+		setTextColor(pdf, muted)
+		pdf.CellFormat(0, lineHeight, fmt.Sprintf("Generated %s · Page %d/{nb}", report.GeneratedAt.UTC().Format("2006-01-02 15:04 UTC"), pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	d := &document{pdf: pdf}
+	d.title(report)
+	d.profile(report)
+	d.weightChart(report)
+	d.vaccinations(report.Vaccinations)
+	d.medications(report.Medications)
+	d.observations(report.Observations)
+
+	if err := pdf.Error(); err != nil {
+		return fmt.Errorf("產生 PDF 失敗: %w", err)
+	}
+	return pdf.Output(w)
+}
+
+// document 包裝單次輸出的排版狀態
+type document struct {
+	pdf *gofpdf.Fpdf
+}
+
+func (d *document) contentWidth() float64 {
+	w, _ := d.pdf.GetPageSize()
+	return w - 2*pageMargin
+}
+
+func (d *document) title(report *model.PetHealthReport) {
+	pdf := d.pdf
+	pdf.SetFont(fontFamily, "B", 18)
+	setTextColor(pdf, [3]int{})
+	pdf.CellFormat(0, 9, "Pet Health Report", "", 1, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 10)
+	setTextColor(pdf, muted)
+	pdf.CellFormat(0, lineHeight+1, fmt.Sprintf("%s  ·  %s to %s", report.Pet.Name, formatDate(report.StartDate), formatDate(report.EndDate)), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+}
+
+func (d *document) heading(text string) {
+	pdf := d.pdf
+	// 標題與下方至少一列內容需在同一頁
+	_, pageH := pdf.GetPageSize()
+	if pdf.GetY()+8+3*lineHeight > pageH-pageMargin-5 {
+		pdf.AddPage()
+	}
+	pdf.Ln(3)
+	pdf.SetFont(fontFamily, "B", 12)
+	setTextColor(pdf, accent)
+	pdf.CellFormat(0, 7, text, "B", 1, "L", false, 0, "")
+	pdf.Ln(2)
+	setTextColor(pdf, [3]int{})
+}
+
+func (d *document) note(text string) {
+	d.pdf.SetFont(fontFamily, "", 9)
+	setTextColor(d.pdf, muted)
+	d.pdf.MultiCell(0, lineHeight, text, "", "L", false)
+	setTextColor(d.pdf, [3]int{})
+}
+
+func (d *document) profile(report *model.PetHealthReport) {
+	pet := report.Pet
+	d.heading("Profile")
+
+	latest, change := "-", "-"
+	if report.LatestWeightKg != nil {
+		latest = fmt.Sprintf("%.2f kg", *report.LatestWeightKg)
+		change = fmt.Sprintf("%+.1f%%", *report.WeightChangePct)
+	}
+
+	rows := [][2]string{
+		{"Name", pet.Name},
+		{"Breed", orDash(pet.Breed)},
+		{"Date of birth", dateOfBirth(pet.DOB, report.EndDate)},
+		{"Microchip", orDash(pet.MicrochipID)},
+		{"Latest weight", latest},
+		{"Weight change in period", change},
+		{"Health logs in period", fmt.Sprintf("%d", report.HealthLogCount)},
+	}
+	labelW := 50.0
+	for _, row := range rows {
+		d.pdf.SetFont(fontFamily, "B", 10)
+		d.pdf.CellFormat(labelW, lineHeight+1, row[0], "", 0, "L", false, 0, "")
+		d.pdf.SetFont(fontFamily, "", 10)
+		d.pdf.MultiCell(d.contentWidth()-labelW, lineHeight+1, row[1], "", "L", false)
+	}
+}
+
+// weightChart 繪製體重折線圖，快速增減的區間以紅色標示
+func (d *document) weightChart(report *model.PetHealthReport) {
+	pdf := d.pdf
+	d.heading(fmt.Sprintf("Weight (%s average)", intervalLabel(report.WeightInterval)))
+
+	points := report.Weights
+	if len(points) == 0 {
+		d.note("No weight recorded in this period.")
+		return
+	}
+
+	_, pageH := pdf.GetPageSize()
+	if pdf.GetY()+chartHeight+10 > pageH-pageMargin-5 {
+		pdf.AddPage()
+	}
+
+	left := pageMargin + chartYLabelW
+	top := pdf.GetY() + 2
+	width := d.contentWidth() - chartYLabelW
+	bottom := top + chartHeight
+
+	minW, maxW := points[0].WeightKg, points[0].WeightKg
+	for _, p := range points {
+		minW = math.Min(minW, p.WeightKg)
+		maxW = math.Max(maxW, p.WeightKg)
+	}
+	// 上下保留空間，體重沒有變化時仍有可讀的刻度
+	pad := math.Max((maxW-minW)*0.15, 0.1)
+	minW, maxW = math.Max(0, minW-pad), maxW+pad
+
+	start, end := report.StartDate, report.EndDate
+	// 週或月分組的區間起點可能早於報告開始日，限制在圖表範圍內
+	x := func(t time.Time) float64 {
+		ratio := float64(t.Sub(start)) / float64(end.Sub(start))
+		return left + width*math.Min(math.Max(ratio, 0), 1)
+	}
+	y := func(kg float64) float64 {
+		return bottom - chartHeight*(kg-minW)/(maxW-minW)
+	}
+
+	// 格線與刻度
+	pdf.SetFont(fontFamily, "", 7)
+	pdf.SetLineWidth(0.1)
+	pdf.SetDrawColor(220, 220, 220)
+	setTextColor(pdf, muted)
+	const yTicks = 4
+	for i := 0; i <= yTicks; i++ {
+		kg := minW + (maxW-minW)*float64(i)/yTicks
+		pdf.Line(left, y(kg), left+width, y(kg))
+		pdf.SetXY(pageMargin, y(kg)-2)
+		pdf.CellFormat(chartYLabelW-1, 4, fmt.Sprintf("%.1f", kg), "", 0, "R", false, 0, "")
+	}
+	for _, t := range []time.Time{start, start.Add(end.Sub(start) / 2), end} {
+		pdf.SetXY(x(t)-12, bottom+1)
+		pdf.CellFormat(24, 4, formatDate(t), "", 0, "C", false, 0, "")
+	}
+	pdf.SetDrawColor(150, 150, 150)
+	pdf.Rect(left, top, width, chartHeight, "D")
+
+	// 折線與資料點
+	pdf.SetLineWidth(0.5)
+	setDrawColor(pdf, accent)
+	for i := 1; i < len(points); i++ {
+		pdf.Line(x(points[i-1].PeriodStart), y(points[i-1].WeightKg), x(points[i].PeriodStart), y(points[i].WeightKg))
+	}
+	for _, p := range points {
+		color := accent
+		if p.Alert != "" {
+			color = alert
+		}
+		setFillColor(pdf, color)
+		pdf.Circle(x(p.PeriodStart), y(p.WeightKg), 0.9, "F")
+	}
+
+	pdf.SetLineWidth(0.2)
+	pdf.SetDrawColor(0, 0, 0)
+	setTextColor(pdf, [3]int{})
+	pdf.SetXY(pageMargin, bottom+6)
+	if hasWeightAlert(points) {
+		d.note("Red points mark periods with rapid weight loss or gain.")
+	}
+}
+
+func (d *document) vaccinations(records []*model.MedicalRecord) {
+	d.heading("Vaccinations")
+	if len(records) == 0 {
+		d.note("No vaccination recorded.")
+		return
+	}
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		rows = append(rows, []string{formatDate(r.Date), r.Description, formatOptionalDate(r.NextDueDate)})
+	}
+	d.table([]string{"Date", "Vaccine", "Next due"}, []float64{0.18, 0.62, 0.20}, rows)
+}
+
+func (d *document) medications(records []*model.MedicalRecord) {
+	d.heading("Medications")
+	if len(records) == 0 {
+		d.note("No medication recorded in this period.")
+		return
+	}
+	rows := make([][]string, 0, len(records))
+	for _, r := range records {
+		rows = append(rows, []string{formatDate(r.Date), r.Description, orDash(r.Dosage), formatOptionalDate(r.NextDueDate)})
+	}
+	d.table([]string{"Date", "Medication", "Dosage", "Next due"}, []float64{0.16, 0.44, 0.22, 0.18}, rows)
+}
+
+func (d *document) observations(observations []model.NotableObservation) {
+	d.heading("Notable observations")
+	if len(observations) == 0 {
+		d.note("No notable observation in this period.")
+		return
+	}
+	rows := make([][]string, 0, len(observations))
+	for _, o := range observations {
+		notes := make([]string, 0, 2)
+		for _, n := range []string{o.Log.LitterNotes, o.Log.BehaviourNotes} {
+			if n = strings.TrimSpace(n); n != "" {
+				notes = append(notes, n)
+			}
+		}
+		rows = append(rows, []string{formatDate(o.Log.Date), describeFlags(o), orDash(strings.Join(notes, " / "))})
+	}
+	d.table([]string{"Date", "Observation", "Notes"}, []float64{0.16, 0.42, 0.42}, rows)
+}
+
+// table 繪製自動換列的表格，換頁時重複表頭；widths 為各欄佔內容寬度的比例
+func (d *document) table(headers []string, widths []float64, rows [][]string) {
+	pdf := d.pdf
+	cols := make([]float64, len(widths))
+	for i, w := range widths {
+		cols[i] = d.contentWidth() * w
+	}
+	_, pageH := pdf.GetPageSize()
+	limit := pageH - pageMargin - 5
+
+	var drawRow func(cells []string, header bool)
+	drawRow = func(cells []string, header bool) {
+		style := ""
+		if header {
+			style = "B"
+		}
+		pdf.SetFont(fontFamily, style, 9)
+
+		lines := make([][]string, len(cells))
+		height := 0.0
+		for i, cell := range cells {
+			lines[i] = pdf.SplitText(cell, cols[i]-2*cellPadding)
+			if len(lines[i]) == 0 {
+				lines[i] = []string{""}
+			}
+			height = math.Max(height, float64(len(lines[i]))*lineHeight+cellPadding)
+		}
+		if pdf.GetY()+height > limit {
+			pdf.AddPage()
+			if !header {
+				drawRow(headers, true)
+				pdf.SetFont(fontFamily, "", 9)
+			}
+		}
+
+		rectStyle := "D"
+		if header {
+			rectStyle = "FD"
+			setFillColor(pdf, headerFill)
+		}
+		pdf.SetDrawColor(210, 210, 210)
+		x, y := pageMargin, pdf.GetY()
+		for i := range cells {
+			pdf.Rect(x, y, cols[i], height, rectStyle)
+			for j, line := range lines[i] {
+				pdf.SetXY(x+cellPadding, y+cellPadding/2+float64(j)*lineHeight)
+				pdf.CellFormat(cols[i]-2*cellPadding, lineHeight, line, "", 0, "L", false, 0, "")
+			}
+			x += cols[i]
+		}
+		pdf.SetXY(pageMargin, y+height)
+	}
+
+	drawRow(headers, true)
+	for _, row := range rows {
+		drawRow(row, false)
+	}
+}
+
+// describeFlags 將觀察標示轉為文字，附上實際記錄的數值
+func describeFlags(o model.NotableObservation) string {
+	parts := make([]string, 0, len(o.Flags))
+	for _, flag := range o.Flags {
+		switch flag {
+		case model.ObservationVomiting:
+			parts = append(parts, fmt.Sprintf("Vomiting ×%d", o.Log.VomitingCount))
+		case model.ObservationAbnormalStool:
+			parts = append(parts, fmt.Sprintf("Stool consistency %d/7 (Bristol)", *o.Log.StoolConsistency))
+		case model.ObservationNoUrine:
+			parts = append(parts, "No urine clumps")
+		case model.ObservationLowActivity:
+			parts = append(parts, "Low activity")
+		case model.ObservationConcerningMood:
+			moods := make([]string, 0, len(o.Log.MoodTags))
+			for _, tag := range o.Log.MoodTags {
+				moods = append(moods, string(tag))
+			}
+			parts = append(parts, "Mood: "+strings.Join(moods, ", "))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func hasWeightAlert(points []model.WeightTrendPoint) bool {
+	for _, p := range points {
+		if p.Alert != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func intervalLabel(interval model.TrendInterval) string {
+	switch interval {
+	case model.TrendIntervalDay:
+		return "daily"
+	case model.TrendIntervalMonth:
+		return "monthly"
+	default:
+		return "weekly"
+	}
+}
+
+// dateOfBirth 回傳生日與截至報告結束日的年齡
+func dateOfBirth(dob, at time.Time) string {
+	if dob.IsZero() {
+		return "-"
+	}
+	months := (at.Year()-dob.Year())*12 + int(at.Month()-dob.Month())
+	if at.Day() < dob.Day() {
+		months--
+	}
+	if months < 0 {
+		return formatDate(dob)
+	}
+	return fmt.Sprintf("%s (%dy %dm)", formatDate(dob), months/12, months%12)
+}
+
+func formatDate(t time.Time) string {
+	return t.UTC().Format(dateLayout)
+}
+
+func formatOptionalDate(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return formatDate(*t)
+}
+
+func orDash(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "-"
+	}
+	return s
+}
+
+func setTextColor(pdf *gofpdf.Fpdf, c [3]int) { pdf.SetTextColor(c[0], c[1], c[2]) }
+func setDrawColor(pdf *gofpdf.Fpdf, c [3]int) { pdf.SetDrawColor(c[0], c[1], c[2]) }
+func setFillColor(pdf *gofpdf.Fpdf, c [3]int) { pdf.SetFillColor(c[0], c[1], c[2]) }
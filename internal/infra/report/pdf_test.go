@@ -0,0 +1,85 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestPDFRenderer_RenderPetHealthReport(t *testing.T) {
+	renderer := NewPDFRenderer(goregular.TTF, gobold.TTF)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 3, 0)
+
+	render := func(t *testing.T, report *model.PetHealthReport) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := renderer.RenderPetHealthReport(context.Background(), &buf, report); err != nil {
+			t.Fatalf("產生報告失敗: %v", err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+			t.Fatalf("輸出不是 PDF")
+		}
+		return buf.Bytes()
+	}
+
+	t.Run("沒有任何紀錄仍可產生報告", func(t *testing.T) {
+		render(t, &model.PetHealthReport{
+			Pet:         &model.Pet{ID: "pet-1", Name: "Mochi"},
+			StartDate:   start,
+			EndDate:     end,
+			GeneratedAt: end,
+		})
+	})
+
+	t.Run("大量紀錄自動換頁", func(t *testing.T) {
+		report := &model.PetHealthReport{
+			Pet:            &model.Pet{ID: "pet-1", Name: "Mochi", Breed: "British Shorthair", DOB: start.AddDate(-3, 0, 0)},
+			StartDate:      start,
+			EndDate:        end,
+			GeneratedAt:    end,
+			WeightInterval: model.TrendIntervalDay,
+		}
+		for i := range 90 {
+			day := start.AddDate(0, 0, i)
+			point := model.WeightTrendPoint{PeriodStart: day, WeightKg: 4 + float64(i%7)/10, SampleCount: 1}
+			report.Weights = append(report.Weights, point)
+
+			report.Medications = append(report.Medications, &model.MedicalRecord{
+				Type:        model.RecordTypeMedication,
+				Date:        day,
+				Description: fmt.Sprintf("Antibiotic course day %d with a long note that wraps across several lines of the table cell", i+1),
+				Dosage:      "50mg twice daily",
+			})
+			report.Observations = append(report.Observations, model.NotableObservation{
+				Log:   &model.HealthLog{Date: day, BehaviourNotes: "Hiding under the bed"},
+				Flags: []model.ObservationFlag{model.ObservationVomiting, model.ObservationConcerningMood},
+			})
+		}
+
+		small := render(t, &model.PetHealthReport{Pet: report.Pet, StartDate: start, EndDate: end, GeneratedAt: end})
+		large := render(t, report)
+		if len(large) <= len(small) {
+			t.Errorf("預期大量紀錄的報告較大，實際為 %d <= %d", len(large), len(small))
+		}
+		if bytes.Count(large, []byte("/Type /Page\n")) < 3 {
+			t.Errorf("預期報告超過 3 頁")
+		}
+	})
+
+	t.Run("已取消的請求不產生報告", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var buf bytes.Buffer
+		if err := renderer.RenderPetHealthReport(ctx, &buf, &model.PetHealthReport{Pet: &model.Pet{}}); err == nil {
+			t.Error("預期回傳錯誤")
+		}
+	})
+}
@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+// BlobRoutePath 為本機儲存下載連結的路由前綴，由 transport 層掛載 FileSystemStore
+const BlobRoutePath = "/api/v1/blobs/"
+
+// FileSystemStore 將附件保存於本機目錄，下載連結指向 API 本身並以 HMAC-SHA256 簽章防止竄改
+// 同時實作 http.Handler，負責驗證簽章並回傳檔案內容
+type FileSystemStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewFileSystemStore 建立新的 FileSystemStore
+func NewFileSystemStore(root, baseURL string, secret []byte) *FileSystemStore {
+	return &FileSystemStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+		now:     time.Now,
+	}
+}
+
+// path 將鍵值轉換為根目錄下的檔案路徑，鍵值中的 .. 無法跳出根目錄
+func (s *FileSystemStore) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+// Put 先寫入暫存檔再更名，避免讀取到寫入一半的檔案
+func (s *FileSystemStore) Put(_ context.Context, obj service.BlobObject, body io.Reader, _ int64) error {
+	target := s.path(obj.Key)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("建立附件目錄失敗: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("建立暫存檔失敗: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return fmt.Errorf("寫入附件失敗: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("寫入附件失敗: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return fmt.Errorf("寫入附件失敗: %w", err)
+	}
+	return nil
+}
+
+// Open 開啟檔案供讀取
+func (s *FileSystemStore) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: blob %s", domain.ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("讀取附件失敗: %w", err)
+	}
+	return f, nil
+}
+
+// Delete 刪除檔案，檔案不存在時不視為錯誤
+func (s *FileSystemStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("刪除附件失敗: %w", err)
+	}
+	return nil
+}
+
+// SignedURL 產生 {baseURL}/api/v1/blobs/{key}?expires=&type=&name=&signature= 形式的下載連結
+func (s *FileSystemStore) SignedURL(_ context.Context, obj service.BlobObject, expiresAt time.Time) (string, error) {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("type", obj.ContentType)
+	query.Set("name", obj.FileName)
+	query.Set("signature", s.sign(obj.Key, expires, obj.ContentType, obj.FileName))
+
+	return s.baseURL + BlobRoutePath + escapeKey(obj.Key) + "?" + query.Encode(), nil
+}
+
+// sign 計算下載連結的 HMAC-SHA256 簽章，涵蓋鍵值、期限與回應標頭
+func (s *FileSystemStore) sign(key, expires, contentType, fileName string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	for _, part := range []string{key, expires, contentType, fileName} {
+		mac.Write([]byte(part))
+		mac.Write([]byte("\n"))
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHTTP 驗證下載連結的簽章與期限後回傳檔案內容
+func (s *FileSystemStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, ok := strings.CutPrefix(r.URL.Path, BlobRoutePath)
+	if !ok || key == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	expires := query.Get("expires")
+	contentType := query.Get("type")
+	fileName := query.Get("name")
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > expiresAt {
+		http.Error(w, "下載連結已失效", http.StatusForbidden)
+		return
+	}
+	want := s.sign(key, expires, contentType, fileName)
+	if !hmac.Equal([]byte(want), []byte(query.Get("signature"))) {
+		http.Error(w, "下載連結簽章無效", http.StatusForbidden)
+		return
+	}
+
+	file, err := os.Open(s.path(key))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		http.Error(w, "讀取附件失敗", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", contentDisposition(fileName))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", info.ModTime(), file)
+}
+
+// contentDisposition 產生附件下載的 Content-Disposition 標頭
+func contentDisposition(fileName string) string {
+	if fileName == "" {
+		return "attachment"
+	}
+	return mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+}
+
+// escapeKey 逐段編碼鍵值，保留路徑分隔符號
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+func TestFileSystemStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileSystemStore(t.TempDir(), "http://localhost:8080/", []byte("s3cret"))
+	obj := service.BlobObject{Key: "attachments/pet-1/a.pdf", ContentType: "application/pdf", FileName: "收據.pdf"}
+
+	if err := store.Put(ctx, obj, strings.NewReader("%PDF"), 4); err != nil {
+		t.Fatalf("寫入失敗: %v", err)
+	}
+
+	download := func(rawURL string) *httptest.ResponseRecorder {
+		u, _ := url.Parse(rawURL)
+		rec := httptest.NewRecorder()
+		store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+		return rec
+	}
+
+	t.Run("以簽章連結下載", func(t *testing.T) {
+		signed, err := store.SignedURL(ctx, obj, time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatalf("產生下載連結失敗: %v", err)
+		}
+		if !strings.HasPrefix(signed, "http://localhost:8080"+BlobRoutePath+"attachments/pet-1/a.pdf?") {
+			t.Errorf("下載連結不符: %s", signed)
+		}
+
+		rec := download(signed)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("預期 200，實際為 %d", rec.Code)
+		}
+		if body, _ := io.ReadAll(rec.Body); string(body) != "%PDF" {
+			t.Errorf("內容不符: %s", body)
+		}
+		if got := rec.Header().Get("Content-Type"); got != "application/pdf" {
+			t.Errorf("預期 Content-Type 為 application/pdf，實際為 %s", got)
+		}
+		if got := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(got, "attachment;") {
+			t.Errorf("預期以附件下載，實際為 %s", got)
+		}
+	})
+
+	t.Run("竄改內容類型的連結被拒絕", func(t *testing.T) {
+		signed, _ := store.SignedURL(ctx, obj, time.Now().Add(time.Minute))
+		tampered := strings.Replace(signed, "type=application%2Fpdf", "type=text%2Fhtml", 1)
+		if rec := download(tampered); rec.Code != http.StatusForbidden {
+			t.Errorf("預期 403，實際為 %d", rec.Code)
+		}
+	})
+
+	t.Run("過期的連結被拒絕", func(t *testing.T) {
+		signed, _ := store.SignedURL(ctx, obj, time.Now().Add(-time.Second))
+		if rec := download(signed); rec.Code != http.StatusForbidden {
+			t.Errorf("預期 403，實際為 %d", rec.Code)
+		}
+	})
+
+	t.Run("讀取檔案內容", func(t *testing.T) {
+		rc, err := store.Open(ctx, obj.Key)
+		if err != nil {
+			t.Fatalf("讀取失敗: %v", err)
+		}
+		defer rc.Close()
+		if body, _ := io.ReadAll(rc); string(body) != "%PDF" {
+			t.Errorf("內容不符: %s", body)
+		}
+	})
+
+	t.Run("鍵值無法跳出根目錄", func(t *testing.T) {
+		if got := store.path("../../etc/passwd"); !strings.HasPrefix(got, store.root) {
+			t.Errorf("路徑跳出根目錄: %s", got)
+		}
+	})
+
+	t.Run("刪除後不存在的檔案不視為錯誤", func(t *testing.T) {
+		if err := store.Delete(ctx, obj.Key); err != nil {
+			t.Fatalf("刪除失敗: %v", err)
+		}
+		if err := store.Delete(ctx, obj.Key); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+		if _, err := store.Open(ctx, obj.Key); !domain.IsNotFound(err) {
+			t.Errorf("預期 ErrNotFound，實際為 %v", err)
+		}
+		signed, _ := store.SignedURL(ctx, obj, time.Now().Add(time.Minute))
+		if rec := download(signed); rec.Code != http.StatusNotFound {
+			t.Errorf("預期 404，實際為 %d", rec.Code)
+		}
+	})
+}
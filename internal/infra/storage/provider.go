@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+// ErrSigningSecretRequired 本機儲存未設定下載連結簽章金鑰
+var ErrSigningSecretRequired = errors.New("本機儲存需設定 STORAGE_SIGNING_SECRET")
+
+// ProvideBlobStore 依配置建立附件儲存
+//
+// local 僅適用於 API 與排程器共用同一個檔案系統的部署；
+// 兩者分別執行（例如各自為獨立的 Lambda）時須使用 s3，否則排程器產生的匯出檔無法由 API 下載
+func ProvideBlobStore(cfg config.Config) (service.BlobStore, error) {
+	conf := cfg.Storage
+	switch conf.Driver {
+	case "s3":
+		return NewS3Store(conf.S3, nil)
+	case "local", "":
+		if conf.SigningSecret == "" {
+			return nil, ErrSigningSecretRequired
+		}
+		return NewFileSystemStore(conf.LocalDir, conf.PublicBaseURL, []byte(conf.SigningSecret)), nil
+	default:
+		return nil, fmt.Errorf("不支援的附件儲存方式: %s", conf.Driver)
+	}
+}
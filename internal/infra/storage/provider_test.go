@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/config"
+)
+
+func TestProvideBlobStore(t *testing.T) {
+	t.Run("本機儲存未設定簽章金鑰時啟動失敗", func(t *testing.T) {
+		cfg := config.Config{Storage: config.StorageConfig{Driver: "local", LocalDir: t.TempDir()}}
+		if _, err := ProvideBlobStore(cfg); !errors.Is(err, ErrSigningSecretRequired) {
+			t.Errorf("預期 ErrSigningSecretRequired，實際為 %v", err)
+		}
+	})
+
+	t.Run("本機儲存設定簽章金鑰後可建立", func(t *testing.T) {
+		cfg := config.Config{Storage: config.StorageConfig{Driver: "local", LocalDir: t.TempDir(), SigningSecret: "s3cret"}}
+		if _, err := ProvideBlobStore(cfg); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
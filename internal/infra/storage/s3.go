@@ -0,0 +1,290 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+)
+
+const (
+	// sigV4Algorithm AWS Signature Version 4 的演算法名稱
+	sigV4Algorithm = "AWS4-HMAC-SHA256"
+	// sigV4TimeFormat x-amz-date 的時間格式
+	sigV4TimeFormat = "20060102T150405Z"
+	// unsignedPayload 不對內容計算雜湊時的 payload 標記
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// maxPresignExpiry S3 預簽連結的有效期限上限
+	maxPresignExpiry = 7 * 24 * time.Hour
+)
+
+// S3Store 將附件保存於 S3 相容的物件儲存（AWS S3、MinIO 等），以 Signature V4 簽署請求並產生預簽下載連結
+type S3Store struct {
+	endpoint   *url.URL
+	region     string
+	bucket     string
+	accessKey  string
+	secretKey  string
+	pathStyle  bool
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewS3Store 建立新的 S3Store
+func NewS3Store(conf config.S3Config, httpClient *http.Client) (*S3Store, error) {
+	if conf.Endpoint == "" || conf.Bucket == "" || conf.AccessKeyID == "" || conf.SecretAccessKey == "" {
+		return nil, fmt.Errorf("S3 儲存需設定 endpoint、bucket 與存取金鑰")
+	}
+	endpoint, err := url.Parse(strings.TrimRight(conf.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("無效的 S3 endpoint: %s", conf.Endpoint)
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &S3Store{
+		endpoint:   endpoint,
+		region:     conf.Region,
+		bucket:     conf.Bucket,
+		accessKey:  conf.AccessKeyID,
+		secretKey:  conf.SecretAccessKey,
+		pathStyle:  conf.UsePathStyle,
+		httpClient: httpClient,
+		now:        time.Now,
+	}, nil
+}
+
+// objectURL 回傳物件的網址，依設定採用 path-style 或 virtual-hosted-style 定址
+func (s *S3Store) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	objectPath := "/" + strings.TrimPrefix(key, "/")
+	if s.pathStyle {
+		objectPath = "/" + s.bucket + objectPath
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = s.endpoint.Path + objectPath
+	u.RawPath = awsURIEncode(u.Path, false)
+	return &u
+}
+
+// Put 以 PUT Object 上傳檔案
+func (s *S3Store) Put(c context.Context, obj service.BlobObject, body io.Reader, size int64) error {
+	req, err := http.NewRequestWithContext(c, http.MethodPut, s.objectURL(obj.Key).String(), body)
+	if err != nil {
+		return fmt.Errorf("建立 S3 請求失敗: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", obj.ContentType)
+
+	return s.do(req, http.StatusOK)
+}
+
+// Open 以 GET Object 讀取檔案
+func (s *S3Store) Open(c context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(c, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("建立 S3 請求失敗: %w", err)
+	}
+	s.signRequest(req, s.now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("呼叫 S3 失敗: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: object %s", domain.ErrNotFound, key)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, fmt.Errorf("S3 回應狀態 %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// Delete 以 DELETE Object 刪除檔案，S3 對不存在的物件同樣回應成功
+func (s *S3Store) Delete(c context.Context, key string) error {
+	req, err := http.NewRequestWithContext(c, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return fmt.Errorf("建立 S3 請求失敗: %w", err)
+	}
+
+	return s.do(req, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+// SignedURL 產生預簽的 GET Object 連結，並覆寫下載時的 Content-Type 與檔名
+func (s *S3Store) SignedURL(_ context.Context, obj service.BlobObject, expiresAt time.Time) (string, error) {
+	now := s.now().UTC()
+	expiresIn := expiresAt.Sub(now).Truncate(time.Second)
+	if expiresIn <= 0 || expiresIn > maxPresignExpiry {
+		return "", fmt.Errorf("S3 預簽連結的有效期限必須介於 1 秒至 7 天")
+	}
+
+	query := url.Values{}
+	if obj.ContentType != "" {
+		query.Set("response-content-type", obj.ContentType)
+	}
+	query.Set("response-content-disposition", contentDisposition(obj.FileName))
+
+	return s.presign(http.MethodGet, obj.Key, query, now, expiresIn).String(), nil
+}
+
+// presign 以查詢參數帶入簽章，產生可直接存取的預簽網址
+func (s *S3Store) presign(method, key string, query url.Values, now time.Time, expiresIn time.Duration) *url.URL {
+	u := s.objectURL(key)
+	amzDate := now.Format(sigV4TimeFormat)
+	scope := s.scope(now)
+
+	query.Set("X-Amz-Algorithm", sigV4Algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expiresIn/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", s.signature(now, amzDate, scope, canonicalRequest))
+	u.RawQuery = canonicalQuery(query)
+	return u
+}
+
+// do 以標頭簽署請求並送出，回應狀態不在預期內時回傳錯誤
+func (s *S3Store) do(req *http.Request, expected ...int) error {
+	s.signRequest(req, s.now().UTC())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("呼叫 S3 失敗: %w", err)
+	}
+	defer resp.Body.Close()
+
+	for _, status := range expected {
+		if resp.StatusCode == status {
+			return nil
+		}
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("S3 回應狀態 %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// signRequest 以 Authorization 標頭簽署請求，簽署 host、x-amz-content-sha256、x-amz-date 與 Content-Type
+func (s *S3Store) signRequest(req *http.Request, now time.Time) {
+	amzDate := now.Format(sigV4TimeFormat)
+	scope := s.scope(now)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": unsignedPayload,
+		"x-amz-date":           amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm, s.accessKey, scope, signedHeaders, s.signature(now, amzDate, scope, canonicalRequest)))
+}
+
+// scope 回傳簽章的憑證範圍 <date>/<region>/s3/aws4_request
+func (s *S3Store) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.region + "/s3/aws4_request"
+}
+
+// signature 依 Signature V4 由正規化請求計算簽章
+func (s *S3Store) signature(now time.Time, amzDate, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{sigV4Algorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery 依參數名稱排序並以 RFC 3986 編碼查詢字串
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, awsURIEncode(key, true)+"="+awsURIEncode(value, true))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// awsURIEncode 依 Signature V4 規則編碼，只保留 A-Z a-z 0-9 - _ . ~；encodeSlash 為 false 時保留 /
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
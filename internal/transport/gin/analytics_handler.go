@@ -0,0 +1,190 @@
+package gin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterAnalyticsRoutes registers analytics-related routes on the given Gin engine.
+func RegisterAnalyticsRoutes(r *gin.Engine, cfg config.Config, e endpoint.AnalyticsEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	analyticsRoutes := v1.Group("/analytics")
+	analyticsRoutes.Use(EnsureValidToken(cfg))
+	{
+		analyticsRoutes.GET("/weight-trend", GetWeightTrend(e, opts...))
+		analyticsRoutes.GET("/food-intake", GetFoodIntakeTrend(e, opts...))
+	}
+}
+
+// GetWeightTrend godoc
+// @Summary      查詢體重趨勢
+// @Description  依日、週或月分組計算寵物的平均體重、移動平均與變化百分比，並依寵物門檻標示快速減重或增重
+// @Tags         analytics
+// @Accept       json
+// @Produce      json
+// @Param        pet_id         query     string  true   "寵物ID"
+// @Param        interval       query     string  false  "分組單位 (day, week, month)，預設 week"
+// @Param        start_date     query     string  false  "開始日期 (RFC3339 格式)"
+// @Param        end_date       query     string  false  "結束日期 (RFC3339 格式)"
+// @Param        window         query     int     false  "移動平均涵蓋的區間數，預設 3"
+// @Param        change_window  query     int     false  "變化百分比比較的區間數，預設 1"
+// @Param        vomiting               query  bool    false  "僅納入有嘔吐紀錄的日誌"
+// @Param        stool_consistency_min  query  int     false  "糞便型態下限 (1-7)"
+// @Param        stool_consistency_max  query  int     false  "糞便型態上限 (1-7)"
+// @Param        activity_level         query  string  false  "活動量 (low, normal, high)"
+// @Param        mood_tag               query  string  false  "情緒標籤"
+// @Success      200  {object}  endpoint.GetWeightTrendResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/analytics/weight-trend [get]
+func GetWeightTrend(e endpoint.AnalyticsEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetWeightTrendEndpoint,
+		decodeGetWeightTrendRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetFoodIntakeTrend godoc
+// @Summary      查詢進食量趨勢
+// @Description  加總寵物每日進食量並與前幾日的滾動基準比較，連續多日低於門檻時回傳食慾下降警示
+// @Tags         analytics
+// @Accept       json
+// @Produce      json
+// @Param        pet_id            query     string  true   "寵物ID"
+// @Param        start_date        query     string  false  "開始日期 (RFC3339 格式)，預設 30 天前"
+// @Param        end_date          query     string  false  "結束日期 (RFC3339 格式)，預設現在"
+// @Param        baseline_days     query     int     false  "滾動基準涵蓋的天數"
+// @Param        threshold_pct     query     number  false  "低於基準的此百分比視為食慾下降"
+// @Param        consecutive_days  query     int     false  "連續多少天食慾下降時發出警示"
+// @Param        vomiting               query  bool    false  "僅納入有嘔吐紀錄的日誌"
+// @Param        stool_consistency_min  query  int     false  "糞便型態下限 (1-7)"
+// @Param        stool_consistency_max  query  int     false  "糞便型態上限 (1-7)"
+// @Param        activity_level         query  string  false  "活動量 (low, normal, high)"
+// @Param        mood_tag               query  string  false  "情緒標籤"
+// @Success      200  {object}  endpoint.GetFoodIntakeTrendResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/analytics/food-intake [get]
+func GetFoodIntakeTrend(e endpoint.AnalyticsEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetFoodIntakeTrendEndpoint,
+		decodeGetFoodIntakeTrendRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeGetWeightTrendRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	req := endpoint.GetWeightTrendRequest{
+		PetID:    ginctx.Query("pet_id"),
+		Interval: ginctx.Query("interval"),
+	}
+	if req.PetID == "" {
+		return nil, fmt.Errorf("%w: pet_id is required", domain.ErrInvalidParameter)
+	}
+
+	if req.StartDate, err = parseDateQuery(ginctx, "start_date"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseDateQuery(ginctx, "end_date"); err != nil {
+		return nil, err
+	}
+	if req.Window, err = parseIntQuery(ginctx, "window"); err != nil {
+		return nil, err
+	}
+	if req.ChangeWindow, err = parseIntQuery(ginctx, "change_window"); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = parseHealthLogFilter(ginctx.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeGetFoodIntakeTrendRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	req := endpoint.GetFoodIntakeTrendRequest{PetID: ginctx.Query("pet_id")}
+	if req.PetID == "" {
+		return nil, fmt.Errorf("%w: pet_id is required", domain.ErrInvalidParameter)
+	}
+
+	if req.StartDate, err = parseDateQuery(ginctx, "start_date"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseDateQuery(ginctx, "end_date"); err != nil {
+		return nil, err
+	}
+	if req.BaselineDays, err = parseIntQuery(ginctx, "baseline_days"); err != nil {
+		return nil, err
+	}
+	if req.ConsecutiveDays, err = parseIntQuery(ginctx, "consecutive_days"); err != nil {
+		return nil, err
+	}
+	if value := ginctx.Query("threshold_pct"); value != "" {
+		if req.ThresholdPct, err = strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%w: invalid threshold_pct: %w", domain.ErrInvalidParameter, err)
+		}
+	}
+	if req.Filter, err = parseHealthLogFilter(ginctx.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// parseDateQuery 解析 RFC3339 格式的查詢參數，未提供時回傳零值
+func parseDateQuery(ginctx *gin.Context, key string) (time.Time, error) {
+	value := ginctx.Query(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid %s: %w", domain.ErrInvalidParameter, key, err)
+	}
+	return parsed, nil
+}
+
+// parseIntQuery 解析整數查詢參數，未提供時回傳 0
+func parseIntQuery(ginctx *gin.Context, key string) (int, error) {
+	value := ginctx.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: %w", domain.ErrInvalidParameter, key, err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,234 @@
+package gin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+const (
+	// multipartOverhead multipart 表單中檔案以外欄位與邊界字串允許的額外大小
+	multipartOverhead = 1 << 20
+	// multipartMemory 解析 multipart 表單時保留於記憶體的大小，超過的部分寫入暫存檔
+	multipartMemory = 8 << 20
+)
+
+// RegisterAttachmentRoutes registers attachment routes on the given Gin engine.
+// 儲存本身可提供下載（本機檔案系統）時，一併掛載不需登入、以簽章驗證的下載路由
+func RegisterAttachmentRoutes(r *gin.Engine, cfg config.Config, e endpoint.AttachmentEndpoints, store service.BlobStore, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Public endpoints
+	v1 := r.Group("/api/v1")
+	if h, ok := store.(http.Handler); ok {
+		v1.GET("/blobs/*key", gin.WrapH(h))
+	}
+
+	// Private endpoints
+	attachmentRoutes := v1.Group("/attachments")
+	attachmentRoutes.Use(EnsureValidToken(cfg))
+	{
+		attachmentRoutes.POST("", UploadAttachment(e, cfg.Storage.MaxUploadSize, opts...))
+		attachmentRoutes.GET("", ListAttachments(e, opts...))
+		attachmentRoutes.GET("/:id", GetAttachment(e, opts...))
+		attachmentRoutes.GET("/:id/download", DownloadAttachment(e, opts...))
+		attachmentRoutes.DELETE("/:id", DeleteAttachment(e, opts...))
+	}
+}
+
+// UploadAttachment godoc
+// @Summary      上傳附件
+// @Description  以 multipart/form-data 上傳收據、發票或證明文件並連結至費用或醫療記錄，支援 JPEG、PNG、WebP、GIF 與 PDF
+// @Tags         attachments
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        owner_type  formData  string  true  "所屬資料種類"  Enums(expense, medical_record)
+// @Param        owner_id    formData  string  true  "費用或醫療記錄ID"
+// @Param        file        formData  file    true  "附件檔案"
+// @Success      200  {object}  endpoint.AttachmentResponse
+// @Failure      400  {object}  endpoint.AttachmentResponse
+// @Failure      401  {object}  endpoint.AttachmentResponse
+// @Failure      403  {object}  endpoint.AttachmentResponse
+// @Failure      404  {object}  endpoint.AttachmentResponse
+// @Failure      500  {object}  endpoint.AttachmentResponse
+// @Security     BearerAuth
+// @Router       /api/v1/attachments [post]
+func UploadAttachment(e endpoint.AttachmentEndpoints, maxSize int64, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UploadAttachmentEndpoint,
+		decodeUploadAttachmentRequest(maxSize),
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListAttachments godoc
+// @Summary      查詢附件
+// @Description  查詢費用或醫療記錄的所有附件，預設依上傳時間由早到晚排列
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        owner_type  query     string  true  "所屬資料種類"  Enums(expense, medical_record)
+// @Param        owner_id    query     string  true  "費用或醫療記錄ID"
+// @Param        limit       query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset      query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor      query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort        query     string  false  "排序欄位 (created_at, file_name, size)，預設 created_at"
+// @Param        order       query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListAttachmentsResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListAttachmentsResponse
+// @Failure      401  {object}  endpoint.ListAttachmentsResponse
+// @Failure      403  {object}  endpoint.ListAttachmentsResponse
+// @Failure      404  {object}  endpoint.ListAttachmentsResponse
+// @Failure      500  {object}  endpoint.ListAttachmentsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/attachments [get]
+func ListAttachments(e endpoint.AttachmentEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListAttachmentsEndpoint,
+		decodeListAttachmentsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetAttachment godoc
+// @Summary      取得附件資訊
+// @Description  取得附件的檔名、類型與大小等中繼資料
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "附件ID"
+// @Success      200  {object}  endpoint.AttachmentResponse
+// @Failure      400  {object}  endpoint.AttachmentResponse
+// @Failure      401  {object}  endpoint.AttachmentResponse
+// @Failure      403  {object}  endpoint.AttachmentResponse
+// @Failure      404  {object}  endpoint.AttachmentResponse
+// @Failure      500  {object}  endpoint.AttachmentResponse
+// @Security     BearerAuth
+// @Router       /api/v1/attachments/{id} [get]
+func GetAttachment(e endpoint.AttachmentEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetAttachmentEndpoint,
+		decodeGetAttachmentRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DownloadAttachment godoc
+// @Summary      取得附件下載連結
+// @Description  產生附件的限時簽章下載連結，連結到期後需重新取得
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "附件ID"
+// @Success      200  {object}  endpoint.DownloadAttachmentResponse
+// @Failure      400  {object}  endpoint.DownloadAttachmentResponse
+// @Failure      401  {object}  endpoint.DownloadAttachmentResponse
+// @Failure      403  {object}  endpoint.DownloadAttachmentResponse
+// @Failure      404  {object}  endpoint.DownloadAttachmentResponse
+// @Failure      500  {object}  endpoint.DownloadAttachmentResponse
+// @Security     BearerAuth
+// @Router       /api/v1/attachments/{id}/download [get]
+func DownloadAttachment(e endpoint.AttachmentEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DownloadAttachmentEndpoint,
+		decodeGetAttachmentRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DeleteAttachment godoc
+// @Summary      刪除附件
+// @Description  刪除附件的中繼資料與檔案
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "附件ID"
+// @Success      200  {object}  endpoint.DeleteAttachmentResponse
+// @Failure      400  {object}  endpoint.DeleteAttachmentResponse
+// @Failure      401  {object}  endpoint.DeleteAttachmentResponse
+// @Failure      403  {object}  endpoint.DeleteAttachmentResponse
+// @Failure      404  {object}  endpoint.DeleteAttachmentResponse
+// @Failure      500  {object}  endpoint.DeleteAttachmentResponse
+// @Security     BearerAuth
+// @Router       /api/v1/attachments/{id} [delete]
+func DeleteAttachment(e endpoint.AttachmentEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DeleteAttachmentEndpoint,
+		decodeGetAttachmentRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// decodeUploadAttachmentRequest 解析 multipart 表單，請求本文超過大小上限時直接拒絕
+// 檔案內容讀入記憶體後即清除暫存檔，交由用例驗證實際大小與類型
+func decodeUploadAttachmentRequest(maxSize int64) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (request interface{}, err error) {
+		r.Body = http.MaxBytesReader(nil, r.Body, maxSize+multipartOverhead)
+		if err := r.ParseMultipartForm(multipartMemory); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, fmt.Errorf("%w: 附件超過大小上限（上限 %d 位元組）", domain.ErrInvalidParameter, maxSize)
+			}
+			return nil, fmt.Errorf("%w: invalid multipart form: %w", domain.ErrInvalidParameter, err)
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			return nil, fmt.Errorf("%w: file is required: %w", domain.ErrInvalidParameter, err)
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return nil, fmt.Errorf("讀取附件失敗: %w", err)
+		}
+
+		return endpoint.UploadAttachmentRequest{
+			OwnerType: model.AttachmentOwnerType(r.FormValue("owner_type")),
+			OwnerID:   r.FormValue("owner_id"),
+			FileName:  header.Filename,
+			Content:   bytes.NewReader(content),
+		}, nil
+	}
+}
+
+func decodeListAttachmentsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	q := r.URL.Query()
+	page, err := parsePageQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListAttachmentsRequest{
+		OwnerType: model.AttachmentOwnerType(q.Get("owner_type")),
+		OwnerID:   q.Get("owner_id"),
+		Page:      page,
+	}, nil
+}
+
+func decodeGetAttachmentRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetAttachmentRequest{ID: ginctx.Param("id")}, nil
+}
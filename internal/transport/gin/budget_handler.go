@@ -0,0 +1,236 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterBudgetRoutes registers budget-related routes on the given Gin engine.
+func RegisterBudgetRoutes(r *gin.Engine, cfg config.Config, e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	budgetRoutes := v1.Group("/budgets")
+	budgetRoutes.Use(EnsureValidToken(cfg))
+	{
+		budgetRoutes.POST("", CreateBudget(e, opts...))
+		budgetRoutes.GET("", ListBudgets(e, opts...))
+		budgetRoutes.GET("/:id", GetBudget(e, opts...))
+		budgetRoutes.PUT("/:id", UpdateBudget(e, opts...))
+		budgetRoutes.DELETE("/:id", DeleteBudget(e, opts...))
+		budgetRoutes.GET("/:id/status", GetBudgetStatus(e, opts...))
+	}
+}
+
+// CreateBudget godoc
+// @Summary      建立預算
+// @Description  依寵物（或所有寵物）與分類設定每月或每年的預算，花費達門檻時發出警示
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.CreateBudgetRequest  true  "預算設定"
+// @Success      200  {object}  endpoint.BudgetResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.BudgetResponse
+// @Failure      401  {object}  endpoint.BudgetResponse
+// @Failure      403  {object}  endpoint.BudgetResponse
+// @Failure      500  {object}  endpoint.BudgetResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets [post]
+func CreateBudget(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CreateBudgetEndpoint,
+		decodeCreateBudgetRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListBudgets godoc
+// @Summary      查詢預算清單
+// @Description  列出目前使用者設定的所有預算
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (created_at, limit)，預設 created_at"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListBudgetsResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListBudgetsResponse
+// @Failure      401  {object}  endpoint.ListBudgetsResponse
+// @Failure      500  {object}  endpoint.ListBudgetsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets [get]
+func ListBudgets(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListBudgetsEndpoint,
+		decodeListBudgetsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetBudget godoc
+// @Summary      取得預算
+// @Description  取得指定 ID 的預算設定
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "預算ID"
+// @Success      200  {object}  endpoint.BudgetResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.BudgetResponse
+// @Failure      401  {object}  endpoint.BudgetResponse
+// @Failure      403  {object}  endpoint.BudgetResponse
+// @Failure      404  {object}  endpoint.BudgetResponse
+// @Failure      500  {object}  endpoint.BudgetResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets/{id} [get]
+func GetBudget(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetBudgetEndpoint,
+		decodeGetBudgetRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateBudget godoc
+// @Summary      更新預算
+// @Description  更新預算設定；範圍或金額變更後，本期警示會重新計算
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                          true   "預算ID"
+// @Param        data      body      endpoint.UpdateBudgetRequest    true   "預算設定"
+// @Param        If-Match  header    string                          false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.BudgetResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.BudgetResponse
+// @Failure      401  {object}  endpoint.BudgetResponse
+// @Failure      403  {object}  endpoint.BudgetResponse
+// @Failure      404  {object}  endpoint.BudgetResponse
+// @Failure      409  {object}  endpoint.BudgetResponse
+// @Failure      412  {object}  endpoint.BudgetResponse
+// @Failure      500  {object}  endpoint.BudgetResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets/{id} [put]
+func UpdateBudget(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateBudgetEndpoint,
+		decodeUpdateBudgetRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DeleteBudget godoc
+// @Summary      刪除預算
+// @Description  刪除指定 ID 的預算
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "預算ID"
+// @Success      200  {object}  endpoint.DeleteBudgetResponse
+// @Failure      400  {object}  endpoint.DeleteBudgetResponse
+// @Failure      401  {object}  endpoint.DeleteBudgetResponse
+// @Failure      403  {object}  endpoint.DeleteBudgetResponse
+// @Failure      404  {object}  endpoint.DeleteBudgetResponse
+// @Failure      500  {object}  endpoint.DeleteBudgetResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets/{id} [delete]
+func DeleteBudget(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DeleteBudgetEndpoint,
+		decodeDeleteBudgetRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetBudgetStatus godoc
+// @Summary      查詢預算執行狀況
+// @Description  回報目前週期的已花費、預算上限、剩餘額度，以及依本月平均每日花費推估的月底花費
+// @Tags         budgets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "預算ID"
+// @Success      200  {object}  endpoint.GetBudgetStatusResponse
+// @Failure      400  {object}  endpoint.GetBudgetStatusResponse
+// @Failure      401  {object}  endpoint.GetBudgetStatusResponse
+// @Failure      403  {object}  endpoint.GetBudgetStatusResponse
+// @Failure      404  {object}  endpoint.GetBudgetStatusResponse
+// @Failure      500  {object}  endpoint.GetBudgetStatusResponse
+// @Security     BearerAuth
+// @Router       /api/v1/budgets/{id}/status [get]
+func GetBudgetStatus(e endpoint.BudgetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetBudgetStatusEndpoint,
+		decodeGetBudgetStatusRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateBudgetRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.CreateBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	return req, nil
+}
+
+func decodeListBudgetsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListBudgetsRequest{Page: page}, nil
+}
+
+func decodeGetBudgetRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetBudgetRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodeUpdateBudgetRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.UpdateBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	req.ID = ginctx.Param("id")
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteBudgetRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.DeleteBudgetRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodeGetBudgetStatusRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetBudgetStatusRequest{ID: ginctx.Param("id")}, nil
+}
@@ -0,0 +1,100 @@
+package gin
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterCalendarFeedRoutes registers calendar-feed-related routes on the given Gin engine.
+// 訂閱內容由行事曆用戶端直接讀取，無法帶登入憑證，因此以網址中的秘密 token 驗證
+func RegisterCalendarFeedRoutes(r *gin.Engine, cfg config.Config, e endpoint.CalendarFeedEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Public endpoints
+	v1 := r.Group("/api/v1")
+	feedRoutes := v1.Group("/calendar-feed")
+	feedRoutes.GET("/:token", GetCalendarFeed(e, opts...))
+
+	// Private endpoints
+	privateRoutes := feedRoutes.Group("")
+	privateRoutes.Use(EnsureValidToken(cfg))
+	{
+		privateRoutes.POST("/token", RotateCalendarFeedToken(e, opts...))
+	}
+}
+
+// RotateCalendarFeedToken godoc
+// @Summary      產生行事曆訂閱 token
+// @Description  為目前使用者產生新的 iCalendar 訂閱 token，舊 token 的訂閱隨即失效；token 只在此回傳一次
+// @Description  訂閱網址為 /api/v1/calendar-feed/{token}.ics，可加入 Google 或 Apple 行事曆
+// @Tags         calendar-feed
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  endpoint.RotateCalendarFeedTokenResponse
+// @Failure      401  {object}  endpoint.RotateCalendarFeedTokenResponse
+// @Failure      500  {object}  endpoint.RotateCalendarFeedTokenResponse
+// @Security     BearerAuth
+// @Router       /api/v1/calendar-feed/token [post]
+func RotateCalendarFeedToken(e endpoint.CalendarFeedEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RotateTokenEndpoint,
+		httptransport.NopRequestDecoder,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetCalendarFeed godoc
+// @Summary      讀取行事曆訂閱
+// @Description  以訂閱 token 輸出可存取寵物的醫療記錄下次到期日（疫苗、驅蟲、用藥等）為 iCalendar 全天事件並附提醒
+// @Description  事件 UID 以醫療記錄產生，到期日變更時行事曆會更新原事件而不會重複建立
+// @Tags         calendar-feed
+// @Produce      text/calendar
+// @Param        token  path      string  true  "訂閱 token，可加上 .ics 副檔名"
+// @Success      200  {string}  string  "iCalendar 內容"
+// @Failure      404  {object}  endpoint.GetCalendarFeedResponse
+// @Failure      500  {object}  endpoint.GetCalendarFeedResponse
+// @Router       /api/v1/calendar-feed/{token} [get]
+func GetCalendarFeed(e endpoint.CalendarFeedEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetFeedEndpoint,
+		decodeGetCalendarFeedRequest,
+		encodeCalendarFeedResponse,
+		options...,
+	))
+}
+
+func decodeGetCalendarFeedRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetCalendarFeedRequest{Token: strings.TrimSuffix(ginctx.Param("token"), ".ics")}, nil
+}
+
+func encodeCalendarFeedResponse(c context.Context, w http.ResponseWriter, response interface{}) error {
+	resp, _ := response.(endpoint.GetCalendarFeedResponse)
+	if resp.Err != nil {
+		encodeError(c, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", behavior.CalendarFeedContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": "petlog.ics"}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(resp.Content)
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/blackhorseya/petlog/internal/domain"
 	"github.com/blackhorseya/petlog/internal/endpoint"
@@ -34,6 +35,18 @@ func encodeResponse(c context.Context, w http.ResponseWriter, response interface
 		return nil
 	}
 
+	// 回傳單一可版本控管資源時輸出 ETag，用戶端可於 PUT 時以 If-Match 帶回
+	if v, ok := response.(endpoint.Versioner); ok {
+		if version, ok := v.ResourceVersion(); ok {
+			w.Header().Set("ETag", formatETag(version))
+		}
+	}
+
+	// 列表回應輸出符合條件的總筆數，分頁資訊同時放在回應本文
+	if p, ok := response.(endpoint.Paginator); ok {
+		w.Header().Set("X-Total-Count", strconv.Itoa(p.TotalCount()))
+	}
+
 	return json.NewEncoder(w).Encode(response)
 }
 
@@ -49,10 +62,14 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 		status = http.StatusNotFound // 404
 	} else if domain.IsInvalidID(err) || domain.IsInvalidParameter(err) {
 		status = http.StatusBadRequest // 400
+	} else if domain.IsForbidden(err) {
+		status = http.StatusForbidden // 403
 	} else if domain.IsDuplicateEntry(err) {
 		status = http.StatusConflict // 409
 	} else if domain.IsUpdateConflict(err) {
 		status = http.StatusConflict // 409
+	} else if domain.IsPreconditionFailed(err) {
+		status = http.StatusPreconditionFailed // 412
 	}
 
 	w.WriteHeader(status)
@@ -0,0 +1,36 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+// formatETag 將資源版本格式化為強 ETag，例如 "3"
+func formatETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseIfMatch 解析 If-Match 標頭為預期版本
+// 未帶標頭或為 "*" 時回傳 nil，表示不檢查版本；無法解析或為弱 ETag 時視為前置條件不符
+func parseIfMatch(r *http.Request) (*int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed If-Match header %q", domain.ErrPreconditionFailed, value)
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown entity tag %q", domain.ErrPreconditionFailed, value)
+	}
+
+	return &version, nil
+}
@@ -0,0 +1,88 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterExchangeRateRoutes registers exchange-rate routes on the given Gin engine.
+func RegisterExchangeRateRoutes(r *gin.Engine, cfg config.Config, e endpoint.ExchangeRateEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	rateRoutes := v1.Group("/exchange-rates")
+	rateRoutes.Use(EnsureValidToken(cfg))
+	{
+		rateRoutes.GET("", ListExchangeRates(e, opts...))
+		rateRoutes.PUT("", ImportExchangeRates(e, opts...))
+	}
+}
+
+// ListExchangeRates godoc
+// @Summary      查詢匯率
+// @Description  查詢指定幣別的匯率表，匯率為 1 單位參考貨幣可兌換的數量，依日期由早到晚排列
+// @Tags         exchange-rates
+// @Accept       json
+// @Produce      json
+// @Param        currency  query     string  true  "ISO 4217 幣別代碼"
+// @Success      200  {object}  endpoint.ListExchangeRatesResponse
+// @Failure      400  {object}  endpoint.ListExchangeRatesResponse
+// @Failure      401  {object}  endpoint.ListExchangeRatesResponse
+// @Failure      500  {object}  endpoint.ListExchangeRatesResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exchange-rates [get]
+func ListExchangeRates(e endpoint.ExchangeRateEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListExchangeRatesEndpoint,
+		decodeListExchangeRatesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ImportExchangeRates godoc
+// @Summary      匯入匯率
+// @Description  管理者匯入匯率，同幣別同日期的匯率會被覆寫
+// @Tags         exchange-rates
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.ImportExchangeRatesRequest  true  "匯率資料"
+// @Success      200  {object}  endpoint.ImportExchangeRatesResponse
+// @Failure      400  {object}  endpoint.ImportExchangeRatesResponse
+// @Failure      401  {object}  endpoint.ImportExchangeRatesResponse
+// @Failure      403  {object}  endpoint.ImportExchangeRatesResponse
+// @Failure      500  {object}  endpoint.ImportExchangeRatesResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exchange-rates [put]
+func ImportExchangeRates(e endpoint.ExchangeRateEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ImportExchangeRatesEndpoint,
+		decodeImportExchangeRatesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeListExchangeRatesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	return endpoint.ListExchangeRatesRequest{Currency: r.URL.Query().Get("currency")}, nil
+}
+
+func decodeImportExchangeRatesRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.ImportExchangeRatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -45,8 +45,10 @@ func RegisterExpenseRoutes(r *gin.Engine, cfg config.Config, e endpoint.ExpenseE
 // @Produce      json
 // @Param        data  body      endpoint.CreateExpenseRequest  true  "費用資料"
 // @Success      201  {object}  endpoint.CreateExpenseResponse
+// @Header       201  {string}  ETag  "資源版本"
 // @Failure      400  {object}  endpoint.CreateExpenseResponse
 // @Failure      401  {object}  endpoint.CreateExpenseResponse
+// @Failure      403  {object}  endpoint.CreateExpenseResponse
 // @Failure      500  {object}  endpoint.CreateExpenseResponse
 // @Security     BearerAuth
 // @Router       /api/v1/expenses [post]
@@ -67,8 +69,10 @@ func CreateExpense(e endpoint.ExpenseEndpoints, options ...httptransport.ServerO
 // @Produce      json
 // @Param        id   path      string  true  "費用ID"
 // @Success      200  {object}  endpoint.GetExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400  {object}  endpoint.GetExpenseResponse
 // @Failure      401  {object}  endpoint.GetExpenseResponse
+// @Failure      403  {object}  endpoint.GetExpenseResponse
 // @Failure      404  {object}  endpoint.GetExpenseResponse
 // @Failure      500  {object}  endpoint.GetExpenseResponse
 // @Security     BearerAuth
@@ -90,10 +94,15 @@ func GetExpense(e endpoint.ExpenseEndpoints, options ...httptransport.ServerOpti
 // @Produce      json
 // @Param        id    path      string  true  "費用ID"
 // @Param        data  body      endpoint.UpdateExpenseRequest  true  "費用資料"
+// @Param        If-Match  header  string  false  "最後取得的 ETag，版本不符時回傳 412"
 // @Success      200  {object}  endpoint.UpdateExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400  {object}  endpoint.UpdateExpenseResponse
 // @Failure      401  {object}  endpoint.UpdateExpenseResponse
+// @Failure      403  {object}  endpoint.UpdateExpenseResponse
 // @Failure      404  {object}  endpoint.UpdateExpenseResponse
+// @Failure      409  {object}  endpoint.UpdateExpenseResponse
+// @Failure      412  {object}  endpoint.UpdateExpenseResponse
 // @Failure      500  {object}  endpoint.UpdateExpenseResponse
 // @Security     BearerAuth
 // @Router       /api/v1/expenses/{id} [put]
@@ -116,6 +125,7 @@ func UpdateExpense(e endpoint.ExpenseEndpoints, options ...httptransport.ServerO
 // @Success      204  {object}  endpoint.DeleteExpenseResponse
 // @Failure      400  {object}  endpoint.DeleteExpenseResponse
 // @Failure      401  {object}  endpoint.DeleteExpenseResponse
+// @Failure      403  {object}  endpoint.DeleteExpenseResponse
 // @Failure      404  {object}  endpoint.DeleteExpenseResponse
 // @Failure      500  {object}  endpoint.DeleteExpenseResponse
 // @Security     BearerAuth
@@ -131,7 +141,7 @@ func DeleteExpense(e endpoint.ExpenseEndpoints, options ...httptransport.ServerO
 
 // ListExpenses godoc
 // @Summary      查詢費用紀錄
-// @Description  依條件查詢費用紀錄
+// @Description  依條件查詢費用紀錄，未指定寵物時查詢使用者自己的與所屬家庭的所有寵物
 // @Tags         expenses
 // @Accept       json
 // @Produce      json
@@ -139,9 +149,16 @@ func DeleteExpense(e endpoint.ExpenseEndpoints, options ...httptransport.ServerO
 // @Param        category   query     string  false  "分類"
 // @Param        start_date query     string  false  "起始日期 (RFC3339)"
 // @Param        end_date   query     string  false  "結束日期 (RFC3339)"
+// @Param        limit      query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset     query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor     query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort       query     string  false  "排序欄位 (date, amount, category, created_at)，預設 date"
+// @Param        order      query     string  false  "排序方向 (asc, desc)，預設 desc"
 // @Success      200  {object}  endpoint.ListExpensesResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
 // @Failure      400  {object}  endpoint.ListExpensesResponse
 // @Failure      401  {object}  endpoint.ListExpensesResponse
+// @Failure      403  {object}  endpoint.ListExpensesResponse
 // @Failure      500  {object}  endpoint.ListExpensesResponse
 // @Security     BearerAuth
 // @Router       /api/v1/expenses [get]
@@ -177,6 +194,10 @@ func decodeUpdateExpenseRequest(c context.Context, r *http.Request) (request int
 		return nil, err
 	}
 	req.ID = id
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -188,26 +209,33 @@ func decodeDeleteExpenseRequest(c context.Context, r *http.Request) (request int
 
 func decodeListExpensesRequest(c context.Context, r *http.Request) (request interface{}, err error) {
 	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
-	petID := ginctx.Query("pet_id")
-	category := ginctx.Query("category")
-	startDate := ginctx.Query("start_date")
-	endDate := ginctx.Query("end_date")
-
-	return endpoint.ListExpensesRequest{
-		PetID:     petID,
-		Category:  category,
-		StartDate: startDate,
-		EndDate:   endDate,
-	}, nil
+	req := endpoint.ListExpensesRequest{
+		PetID:    ginctx.Query("pet_id"),
+		Category: ginctx.Query("category"),
+	}
+
+	if req.StartDate, err = parseDateQuery(ginctx, "start_date"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseDateQuery(ginctx, "end_date"); err != nil {
+		return nil, err
+	}
+	if req.Page, err = parsePageQuery(r.URL.Query()); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 // GetExpenseSummary godoc
 // @Summary      查詢費用摘要
-// @Description  查詢本月總支出、分類統計等摘要
+// @Description  查詢期間內的總支出、分類統計、各寵物支出、依月／季／年／分類／寵物分組的圖表序列，以及依日期排序的最近紀錄；未指定寵物時彙總使用者所有寵物
 // @Tags         expenses
 // @Accept       json
 // @Produce      json
-// @Param        pet_id     query     string  false  "寵物ID"
+// @Param        pet_id      query     string  false  "寵物ID"
+// @Param        start_date  query     string  false  "開始日期 (RFC3339 格式)"
+// @Param        end_date    query     string  false  "結束日期 (RFC3339 格式)"
+// @Param        group_by    query     string  false  "分組方式 (month, quarter, year, category, pet)，預設 month"
 // @Success      200   {object}  endpoint.GetExpenseSummaryResponse
 // @Failure      400   {object}  endpoint.GetExpenseSummaryResponse
 // @Failure      401   {object}  endpoint.GetExpenseSummaryResponse
@@ -225,6 +253,16 @@ func GetExpenseSummary(e endpoint.ExpenseEndpoints, options ...httptransport.Ser
 
 func decodeGetExpenseSummaryRequest(c context.Context, r *http.Request) (request interface{}, err error) {
 	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
-	petID := ginctx.Query("pet_id")
-	return endpoint.GetExpenseSummaryRequest{PetID: petID}, nil
+	req := endpoint.GetExpenseSummaryRequest{
+		PetID:   ginctx.Query("pet_id"),
+		GroupBy: ginctx.Query("group_by"),
+	}
+
+	if req.StartDate, err = parseDateQuery(ginctx, "start_date"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseDateQuery(ginctx, "end_date"); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
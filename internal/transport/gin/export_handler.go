@@ -0,0 +1,152 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterExportRoutes registers data export routes on the given Gin engine.
+func RegisterExportRoutes(r *gin.Engine, cfg config.Config, e endpoint.ExportEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	exportRoutes := v1.Group("/exports")
+	exportRoutes.Use(EnsureValidToken(cfg))
+	{
+		exportRoutes.POST("", RequestExport(e, opts...))
+		exportRoutes.GET("", ListExports(e, opts...))
+		exportRoutes.GET("/:id", GetExport(e, opts...))
+		exportRoutes.GET("/:id/download", DownloadExport(e, opts...))
+	}
+}
+
+// RequestExport godoc
+// @Summary      匯出資料
+// @Description  匯出使用者所有寵物、健康日誌、醫療記錄與費用。json 為含格式版本的單一 JSON 檔；csv 為每種資料各一個 CSV 的 ZIP；zip 同時包含 JSON、CSV 與附件檔案。資料量小時直接完成（status=completed），否則於背景產生（status=pending），完成後發送通知，可查詢工作狀態後下載
+// @Tags         exports
+// @Accept       json
+// @Produce      json
+// @Param        export  body      endpoint.RequestExportRequest  true  "匯出格式"
+// @Success      200  {object}  endpoint.ExportResponse
+// @Failure      400  {object}  endpoint.ExportResponse
+// @Failure      401  {object}  endpoint.ExportResponse
+// @Failure      500  {object}  endpoint.ExportResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exports [post]
+func RequestExport(e endpoint.ExportEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RequestExportEndpoint,
+		decodeRequestExportRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListExports godoc
+// @Summary      查詢匯出紀錄
+// @Description  列出使用者尚未逾期的匯出工作，預設由新到舊排列
+// @Tags         exports
+// @Accept       json
+// @Produce      json
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (created_at)，預設 created_at"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 desc"
+// @Success      200  {object}  endpoint.ListExportsResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListExportsResponse
+// @Failure      401  {object}  endpoint.ListExportsResponse
+// @Failure      500  {object}  endpoint.ListExportsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exports [get]
+func ListExports(e endpoint.ExportEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListExportsEndpoint,
+		decodeListExportsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetExport godoc
+// @Summary      查詢匯出工作狀態
+// @Description  取得匯出工作的狀態，status 為 completed 時即可下載
+// @Tags         exports
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "匯出工作ID"
+// @Success      200  {object}  endpoint.ExportResponse
+// @Failure      400  {object}  endpoint.ExportResponse
+// @Failure      401  {object}  endpoint.ExportResponse
+// @Failure      404  {object}  endpoint.ExportResponse
+// @Failure      500  {object}  endpoint.ExportResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exports/{id} [get]
+func GetExport(e endpoint.ExportEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetExportEndpoint,
+		decodeGetExportRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DownloadExport godoc
+// @Summary      取得匯出檔下載連結
+// @Description  產生已完成匯出檔的限時簽章下載連結，尚未完成時回傳 409
+// @Tags         exports
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "匯出工作ID"
+// @Success      200  {object}  endpoint.DownloadExportResponse
+// @Failure      400  {object}  endpoint.DownloadExportResponse
+// @Failure      401  {object}  endpoint.DownloadExportResponse
+// @Failure      404  {object}  endpoint.DownloadExportResponse
+// @Failure      409  {object}  endpoint.DownloadExportResponse
+// @Failure      500  {object}  endpoint.DownloadExportResponse
+// @Security     BearerAuth
+// @Router       /api/v1/exports/{id}/download [get]
+func DownloadExport(e endpoint.ExportEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DownloadExportEndpoint,
+		decodeGetExportRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeRequestExportRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.RequestExportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	return req, nil
+}
+
+func decodeListExportsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListExportsRequest{Page: page}, nil
+}
+
+func decodeGetExportRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetExportRequest{ID: ginctx.Param("id")}, nil
+}
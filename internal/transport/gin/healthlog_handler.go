@@ -3,10 +3,15 @@ package gin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/endpoint"
 	"github.com/gin-gonic/gin"
 	httptransport "github.com/go-kit/kit/transport/http"
@@ -41,8 +46,10 @@ func RegisterHealthLogRoutes(r *gin.Engine, cfg config.Config, e endpoint.Health
 // @Produce      json
 // @Param        healthLog  body      endpoint.CreateHealthLogRequest  true  "健康日誌資訊"
 // @Success      200      {object}  endpoint.CreateHealthLogResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400      {object}  map[string]interface{}
 // @Failure      401      {object}  map[string]interface{}
+// @Failure      403      {object}  map[string]interface{}
 // @Failure      500      {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/health-logs [post]
@@ -63,8 +70,10 @@ func CreateHealthLog(e endpoint.HealthLogEndpoints, options ...httptransport.Ser
 // @Produce      json
 // @Param        id   path      string  true  "健康日誌 ID"
 // @Success      200  {object}  endpoint.GetHealthLogByIDResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -80,16 +89,28 @@ func GetHealthLogByID(e endpoint.HealthLogEndpoints, options ...httptransport.Se
 
 // ListHealthLogsByPet godoc
 // @Summary      列出寵物的健康日誌
-// @Description  根據寵物 ID 和可選的日期範圍列出健康日誌
+// @Description  根據寵物 ID 和可選的日期範圍列出健康日誌，可依結構化觀察條件篩選
 // @Tags         health-logs
 // @Accept       json
 // @Produce      json
 // @Param        pet_id      query     string  true   "寵物 ID"
 // @Param        start_date  query     string  false  "開始日期 (RFC3339 格式)"
 // @Param        end_date    query     string  false  "結束日期 (RFC3339 格式)"
+// @Param        vomiting               query  bool    false  "僅列出有嘔吐紀錄的日誌"
+// @Param        stool_consistency_min  query  int     false  "糞便型態下限 (1-7)"
+// @Param        stool_consistency_max  query  int     false  "糞便型態上限 (1-7)"
+// @Param        activity_level         query  string  false  "活動量 (low, normal, high)"
+// @Param        mood_tag               query  string  false  "情緒標籤"
+// @Param        limit       query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset      query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor      query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort        query     string  false  "排序欄位 (date, weight_kg, food_gram, created_at)，預設 date"
+// @Param        order       query     string  false  "排序方向 (asc, desc)，預設 desc"
 // @Success      200         {object}  endpoint.ListHealthLogsByPetResponse
+// @Header       200         {integer} X-Total-Count  "符合條件的總筆數"
 // @Failure      400         {object}  map[string]interface{}
 // @Failure      401         {object}  map[string]interface{}
+// @Failure      403         {object}  map[string]interface{}
 // @Failure      500         {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/health-logs [get]
@@ -110,10 +131,15 @@ func ListHealthLogsByPet(e endpoint.HealthLogEndpoints, options ...httptransport
 // @Produce      json
 // @Param        id        path      string                             true  "健康日誌 ID"
 // @Param        healthLog body      endpoint.UpdateHealthLogRequest    true  "更新的健康日誌資訊"
+// @Param        If-Match  header  string  false  "最後取得的 ETag，版本不符時回傳 412"
 // @Success      200       {object}  endpoint.UpdateHealthLogResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400       {object}  map[string]interface{}
 // @Failure      401       {object}  map[string]interface{}
+// @Failure      403       {object}  map[string]interface{}
 // @Failure      404       {object}  map[string]interface{}
+// @Failure      409       {object}  map[string]interface{}
+// @Failure      412       {object}  map[string]interface{}
 // @Failure      500       {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/health-logs/{id} [put]
@@ -136,6 +162,7 @@ func UpdateHealthLog(e endpoint.HealthLogEndpoints, options ...httptransport.Ser
 // @Success      200 {object}  endpoint.DeleteHealthLogResponse
 // @Failure      400 {object}  map[string]interface{}
 // @Failure      401 {object}  map[string]interface{}
+// @Failure      403 {object}  map[string]interface{}
 // @Failure      404 {object}  map[string]interface{}
 // @Failure      500 {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -207,9 +234,45 @@ func decodeListHealthLogsByPetRequest(_ context.Context, r *http.Request) (inter
 		}
 	}
 
+	filter, err := parseHealthLogFilter(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	req.Filter = filter
+
+	if req.Page, err = parsePageQuery(r.URL.Query()); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
+// parseHealthLogFilter 解析健康日誌結構化觀察的篩選參數
+func parseHealthLogFilter(values url.Values) (model.HealthLogFilter, error) {
+	var filter model.HealthLogFilter
+	var err error
+
+	if value := values.Get("vomiting"); value != "" {
+		if filter.HasVomiting, err = strconv.ParseBool(value); err != nil {
+			return filter, fmt.Errorf("%w: invalid vomiting: %w", domain.ErrInvalidParameter, err)
+		}
+	}
+	if value := values.Get("stool_consistency_min"); value != "" {
+		if filter.StoolConsistencyMin, err = strconv.Atoi(value); err != nil {
+			return filter, fmt.Errorf("%w: invalid stool_consistency_min: %w", domain.ErrInvalidParameter, err)
+		}
+	}
+	if value := values.Get("stool_consistency_max"); value != "" {
+		if filter.StoolConsistencyMax, err = strconv.Atoi(value); err != nil {
+			return filter, fmt.Errorf("%w: invalid stool_consistency_max: %w", domain.ErrInvalidParameter, err)
+		}
+	}
+	filter.ActivityLevel = model.ActivityLevel(values.Get("activity_level"))
+	filter.MoodTag = model.MoodTag(values.Get("mood_tag"))
+
+	return filter, nil
+}
+
 // decodeUpdateHealthLogRequest is a transport/http.DecodeRequestFunc that decodes a
 // JSON-encoded update health log request from the HTTP request body and URL.
 func decodeUpdateHealthLogRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -237,6 +300,12 @@ func decodeUpdateHealthLogRequest(_ context.Context, r *http.Request) (interface
 	// 確保 ID 來自 URL 路徑
 	req.ID = id
 
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+	req.ExpectedVersion = expectedVersion
+
 	return req, nil
 }
 
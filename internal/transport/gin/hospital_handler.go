@@ -47,6 +47,7 @@ func RegisterHospitalRoutes(r *gin.Engine, cfg config.Config, e endpoint.Hospita
 // @Param        limit        query     int     false  "每頁數量（預設20）"
 // @Param        sort_by      query     string  false  "排序方式（distance, name）"
 // @Success      200          {object}  endpoint.SearchHospitalsResponse
+// @Header       200          {integer} X-Total-Count  "符合條件的總筆數"
 // @Failure      400          {object}  map[string]interface{}
 // @Failure      500          {object}  map[string]interface{}
 // @Router       /api/v1/hospitals [get]
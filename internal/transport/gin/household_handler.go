@@ -0,0 +1,270 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterHouseholdRoutes registers household-related routes on the given Gin engine.
+func RegisterHouseholdRoutes(r *gin.Engine, cfg config.Config, e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	householdRoutes := v1.Group("/households")
+	householdRoutes.Use(EnsureValidToken(cfg))
+	{
+		householdRoutes.POST("", CreateHousehold(e, opts...))
+		householdRoutes.GET("", ListHouseholds(e, opts...))
+		householdRoutes.POST("/invitations/accept", AcceptHouseholdInvitation(e, opts...))
+		householdRoutes.GET("/:id", GetHousehold(e, opts...))
+		householdRoutes.POST("/:id/invitations", InviteHouseholdMember(e, opts...))
+		householdRoutes.PUT("/:id/members/:user_id", UpdateHouseholdMember(e, opts...))
+		householdRoutes.DELETE("/:id/members/:user_id", RemoveHouseholdMember(e, opts...))
+	}
+}
+
+// CreateHousehold godoc
+// @Summary      建立家庭
+// @Description  建立共同照顧寵物的家庭，建立者自動成為 owner
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.CreateHouseholdRequest  true  "家庭資料"
+// @Success      200  {object}  endpoint.HouseholdResponse
+// @Failure      400  {object}  endpoint.HouseholdResponse
+// @Failure      401  {object}  endpoint.HouseholdResponse
+// @Failure      500  {object}  endpoint.HouseholdResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households [post]
+func CreateHousehold(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CreateHouseholdEndpoint,
+		decodeCreateHouseholdRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListHouseholds godoc
+// @Summary      查詢所屬家庭
+// @Description  列出目前使用者仍有效的家庭成員資格
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (name, created_at)，預設 name"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListHouseholdsResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListHouseholdsResponse
+// @Failure      401  {object}  endpoint.ListHouseholdsResponse
+// @Failure      500  {object}  endpoint.ListHouseholdsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households [get]
+func ListHouseholds(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListHouseholdsEndpoint,
+		decodeListHouseholdsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetHousehold godoc
+// @Summary      取得家庭
+// @Description  取得指定 ID 的家庭與成員，僅家庭成員可檢視
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "家庭ID"
+// @Success      200  {object}  endpoint.HouseholdResponse
+// @Failure      400  {object}  endpoint.HouseholdResponse
+// @Failure      401  {object}  endpoint.HouseholdResponse
+// @Failure      403  {object}  endpoint.HouseholdResponse
+// @Failure      404  {object}  endpoint.HouseholdResponse
+// @Failure      500  {object}  endpoint.HouseholdResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households/{id} [get]
+func GetHousehold(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetHouseholdEndpoint,
+		decodeGetHouseholdRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// InviteHouseholdMember godoc
+// @Summary      邀請家庭成員
+// @Description  由 owner 產生邀請 token，token 只會回傳一次；sitter 角色須設定 member_expires_at
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string  true  "家庭ID"
+// @Param        data  body      endpoint.InviteHouseholdMemberRequest  true  "邀請設定"
+// @Success      200  {object}  endpoint.InviteHouseholdMemberResponse
+// @Failure      400  {object}  endpoint.InviteHouseholdMemberResponse
+// @Failure      401  {object}  endpoint.InviteHouseholdMemberResponse
+// @Failure      403  {object}  endpoint.InviteHouseholdMemberResponse
+// @Failure      404  {object}  endpoint.InviteHouseholdMemberResponse
+// @Failure      500  {object}  endpoint.InviteHouseholdMemberResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households/{id}/invitations [post]
+func InviteHouseholdMember(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.InviteMemberEndpoint,
+		decodeInviteHouseholdMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// AcceptHouseholdInvitation godoc
+// @Summary      接受家庭邀請
+// @Description  以邀請 token 加入家庭，邀請使用後即失效
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.AcceptHouseholdInvitationRequest  true  "邀請 token"
+// @Success      200  {object}  endpoint.HouseholdResponse
+// @Failure      400  {object}  endpoint.HouseholdResponse
+// @Failure      401  {object}  endpoint.HouseholdResponse
+// @Failure      404  {object}  endpoint.HouseholdResponse
+// @Failure      500  {object}  endpoint.HouseholdResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households/invitations/accept [post]
+func AcceptHouseholdInvitation(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.AcceptInvitationEndpoint,
+		decodeAcceptHouseholdInvitationRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateHouseholdMember godoc
+// @Summary      調整家庭成員
+// @Description  由 owner 調整成員角色與到期日，家庭至少需保留一位 owner
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string  true  "家庭ID"
+// @Param        user_id  path      string  true  "成員使用者ID"
+// @Param        data     body      endpoint.UpdateHouseholdMemberRequest  true  "成員設定"
+// @Success      200  {object}  endpoint.HouseholdResponse
+// @Failure      400  {object}  endpoint.HouseholdResponse
+// @Failure      401  {object}  endpoint.HouseholdResponse
+// @Failure      403  {object}  endpoint.HouseholdResponse
+// @Failure      404  {object}  endpoint.HouseholdResponse
+// @Failure      500  {object}  endpoint.HouseholdResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households/{id}/members/{user_id} [put]
+func UpdateHouseholdMember(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateMemberEndpoint,
+		decodeUpdateHouseholdMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// RemoveHouseholdMember godoc
+// @Summary      移除家庭成員
+// @Description  owner 可移除任何成員，一般成員可移除自己以退出家庭
+// @Tags         households
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string  true  "家庭ID"
+// @Param        user_id  path      string  true  "成員使用者ID"
+// @Success      200  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Failure      400  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Failure      401  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Failure      403  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Failure      404  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Failure      500  {object}  endpoint.RemoveHouseholdMemberResponse
+// @Security     BearerAuth
+// @Router       /api/v1/households/{id}/members/{user_id} [delete]
+func RemoveHouseholdMember(e endpoint.HouseholdEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RemoveMemberEndpoint,
+		decodeRemoveHouseholdMemberRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateHouseholdRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.CreateHouseholdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeListHouseholdsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListHouseholdsRequest{Page: page}, nil
+}
+
+func decodeGetHouseholdRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+	return endpoint.GetHouseholdRequest{ID: id}, nil
+}
+
+func decodeInviteHouseholdMemberRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+
+	var req endpoint.InviteHouseholdMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.HouseholdID = id
+	return req, nil
+}
+
+func decodeAcceptHouseholdInvitationRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.AcceptHouseholdInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeUpdateHouseholdMemberRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.UpdateHouseholdMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.HouseholdID = ginctx.Param("id")
+	req.UserID = ginctx.Param("user_id")
+	return req, nil
+}
+
+func decodeRemoveHouseholdMemberRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.RemoveHouseholdMemberRequest{
+		HouseholdID: ginctx.Param("id"),
+		UserID:      ginctx.Param("user_id"),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package gin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterImportRoutes registers bulk import routes on the given Gin engine.
+func RegisterImportRoutes(r *gin.Engine, cfg config.Config, e endpoint.ImportEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	importRoutes := v1.Group("/imports")
+	importRoutes.Use(EnsureValidToken(cfg))
+	{
+		importRoutes.POST("", ImportRecords(e, cfg.Import.MaxFileSize, opts...))
+	}
+}
+
+// ImportRecords godoc
+// @Summary      批次匯入健康日誌或費用
+// @Description  以 multipart/form-data 上傳 CSV 或 XLSX（第一個工作表），第一列為標題列。欄位名稱與資料匯出的 CSV 相同，其他名稱可透過 mapping 對應。每列以建立資料時相同的規則驗證並回傳逐列報告；dry_run=true 時僅驗證不寫入。每列以去重鍵（dedupe_key 欄位，未提供時為該列內容）寫入，重複匯入同一檔案時已存在的資料會略過
+// @Tags         imports
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file     formData  file    true   "匯入檔（.csv 或 .xlsx）"
+// @Param        entity   formData  string  true   "資料種類：health_logs 或 expenses"
+// @Param        pet_id   formData  string  false  "預設寵物，檔案沒有 pet_id 欄位或欄位空白時使用"
+// @Param        mapping  formData  string  false  "欄位對應 JSON，例如 {\"date\":\"日期\",\"weight_kg\":\"體重\"}"
+// @Param        format   formData  string  false  "檔案格式：csv 或 xlsx，未指定時依副檔名判斷"
+// @Param        dry_run  formData  bool    false  "僅驗證不寫入"
+// @Success      200  {object}  endpoint.ImportRecordsResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/imports [post]
+func ImportRecords(e endpoint.ImportEndpoints, maxSize int64, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ImportRecordsEndpoint,
+		decodeImportRecordsRequest(maxSize),
+		encodeResponse,
+		options...,
+	))
+}
+
+// decodeImportRecordsRequest 解析 multipart 表單，請求本文超過大小上限時直接拒絕
+func decodeImportRecordsRequest(maxSize int64) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (request interface{}, err error) {
+		r.Body = http.MaxBytesReader(nil, r.Body, maxSize+multipartOverhead)
+		if err := r.ParseMultipartForm(multipartMemory); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, fmt.Errorf("%w: 匯入檔超過大小上限（上限 %d 位元組）", domain.ErrInvalidParameter, maxSize)
+			}
+			return nil, fmt.Errorf("%w: invalid multipart form: %w", domain.ErrInvalidParameter, err)
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			return nil, fmt.Errorf("%w: file is required: %w", domain.ErrInvalidParameter, err)
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return nil, fmt.Errorf("讀取匯入檔失敗: %w", err)
+		}
+
+		req := endpoint.ImportRecordsRequest{
+			Entity:  model.ImportEntity(r.FormValue("entity")),
+			Format:  model.ImportFormat(r.FormValue("format")),
+			PetID:   r.FormValue("pet_id"),
+			Content: bytes.NewReader(content),
+		}
+		if req.Format == "" {
+			req.Format = behavior.DetectImportFormat(header.Filename)
+		}
+		if mapping := r.FormValue("mapping"); mapping != "" {
+			if err := json.Unmarshal([]byte(mapping), &req.Mapping); err != nil {
+				return nil, fmt.Errorf("%w: invalid mapping: %w", domain.ErrInvalidParameter, err)
+			}
+		}
+		if dryRun := r.FormValue("dry_run"); dryRun != "" {
+			if req.DryRun, err = strconv.ParseBool(dryRun); err != nil {
+				return nil, fmt.Errorf("%w: invalid dry_run: %w", domain.ErrInvalidParameter, err)
+			}
+		}
+		return req, nil
+	}
+}
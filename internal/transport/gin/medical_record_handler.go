@@ -44,8 +44,10 @@ func RegisterMedicalRecordRoutes(r *gin.Engine, cfg config.Config, e endpoint.Me
 // @Produce      json
 // @Param        medicalRecord  body      endpoint.CreateMedicalRecordRequest  true  "醫療記錄資訊"
 // @Success      200           {object}  endpoint.CreateMedicalRecordResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400           {object}  map[string]interface{}
 // @Failure      401           {object}  map[string]interface{}
+// @Failure      403           {object}  map[string]interface{}
 // @Failure      500           {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/medical-records [post]
@@ -66,8 +68,10 @@ func CreateMedicalRecord(e endpoint.MedicalRecordEndpoints, options ...httptrans
 // @Produce      json
 // @Param        id   path      string  true  "醫療記錄 ID"
 // @Success      200  {object}  endpoint.GetMedicalRecordResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/medical-records/{id} [get]
@@ -88,10 +92,15 @@ func GetMedicalRecord(e endpoint.MedicalRecordEndpoints, options ...httptranspor
 // @Produce      json
 // @Param        id            path      string                                true  "醫療記錄 ID"
 // @Param        medicalRecord body      endpoint.UpdateMedicalRecordRequest   true  "要更新的醫療記錄資訊"
+// @Param        If-Match  header  string  false  "最後取得的 ETag，版本不符時回傳 412"
 // @Success      200           {object}  endpoint.UpdateMedicalRecordResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400           {object}  map[string]interface{}
 // @Failure      401           {object}  map[string]interface{}
+// @Failure      403           {object}  map[string]interface{}
 // @Failure      404           {object}  map[string]interface{}
+// @Failure      409           {object}  map[string]interface{}
+// @Failure      412           {object}  map[string]interface{}
 // @Failure      500           {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/medical-records/{id} [put]
@@ -114,6 +123,7 @@ func UpdateMedicalRecord(e endpoint.MedicalRecordEndpoints, options ...httptrans
 // @Success      200 {object}  endpoint.DeleteMedicalRecordResponse
 // @Failure      404 {object}  map[string]interface{}
 // @Failure      401 {object}  map[string]interface{}
+// @Failure      403 {object}  map[string]interface{}
 // @Failure      500 {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/medical-records/{id} [delete]
@@ -135,9 +145,16 @@ func DeleteMedicalRecord(e endpoint.MedicalRecordEndpoints, options ...httptrans
 // @Param        pet_id      query     string  true   "寵物 ID"
 // @Param        start_date  query     string  false  "開始日期 (RFC3339 格式)"
 // @Param        end_date    query     string  false  "結束日期 (RFC3339 格式)"
+// @Param        limit       query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset      query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor      query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort        query     string  false  "排序欄位 (date, type, next_due_date, created_at)，預設 date"
+// @Param        order       query     string  false  "排序方向 (asc, desc)，預設 desc"
 // @Success      200         {object}  endpoint.ListMedicalRecordsByPetResponse
+// @Header       200         {integer} X-Total-Count  "符合條件的總筆數"
 // @Failure      400         {object}  map[string]interface{}
 // @Failure      401         {object}  map[string]interface{}
+// @Failure      403         {object}  map[string]interface{}
 // @Failure      500         {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/medical-records [get]
@@ -176,6 +193,10 @@ func decodeUpdateMedicalRecordRequest(c context.Context, r *http.Request) (reque
 		return nil, err
 	}
 	req.ID = id
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -213,5 +234,9 @@ func decodeListMedicalRecordsByPetRequest(_ context.Context, r *http.Request) (r
 		req.EndDate = endDate
 	}
 
+	if req.Page, err = parsePageQuery(r.URL.Query()); err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
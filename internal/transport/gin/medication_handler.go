@@ -0,0 +1,344 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterMedicationRoutes registers medication-regimen-related routes on the given Gin engine.
+func RegisterMedicationRoutes(r *gin.Engine, cfg config.Config, e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	medicationRoutes := v1.Group("/medication-regimens")
+	medicationRoutes.Use(EnsureValidToken(cfg))
+	{
+		medicationRoutes.POST("", CreateMedicationRegimen(e, opts...))
+		medicationRoutes.GET("", ListMedicationRegimens(e, opts...))
+		medicationRoutes.GET("/:id", GetMedicationRegimen(e, opts...))
+		medicationRoutes.PUT("/:id", UpdateMedicationRegimen(e, opts...))
+		medicationRoutes.DELETE("/:id", DeleteMedicationRegimen(e, opts...))
+		medicationRoutes.GET("/:id/doses", ListDoseEvents(e, opts...))
+		medicationRoutes.POST("/:id/doses", RecordDose(e, opts...))
+		medicationRoutes.GET("/:id/adherence", GetMedicationAdherence(e, opts...))
+	}
+}
+
+// CreateMedicationRegimen godoc
+// @Summary      建立用藥療程
+// @Description  設定長期用藥的藥品、劑量、給藥排程、起訖日期與開立處方的醫院
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.CreateMedicationRegimenRequest  true  "用藥療程設定"
+// @Success      200  {object}  endpoint.MedicationRegimenResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.MedicationRegimenResponse
+// @Failure      401  {object}  endpoint.MedicationRegimenResponse
+// @Failure      403  {object}  endpoint.MedicationRegimenResponse
+// @Failure      404  {object}  endpoint.MedicationRegimenResponse
+// @Failure      500  {object}  endpoint.MedicationRegimenResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens [post]
+func CreateMedicationRegimen(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CreateMedicationRegimenEndpoint,
+		decodeCreateMedicationRegimenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListMedicationRegimens godoc
+// @Summary      查詢用藥療程清單
+// @Description  列出指定寵物的用藥療程，未指定寵物時列出所有可存取寵物的用藥療程
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        pet_id  query     string  false  "寵物ID"
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (start_date, drug, created_at)，預設 start_date"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListMedicationRegimensResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListMedicationRegimensResponse
+// @Failure      401  {object}  endpoint.ListMedicationRegimensResponse
+// @Failure      403  {object}  endpoint.ListMedicationRegimensResponse
+// @Failure      500  {object}  endpoint.ListMedicationRegimensResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens [get]
+func ListMedicationRegimens(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListMedicationRegimensEndpoint,
+		decodeListMedicationRegimensRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetMedicationRegimen godoc
+// @Summary      取得用藥療程
+// @Description  取得指定 ID 的用藥療程設定
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "用藥療程ID"
+// @Success      200  {object}  endpoint.MedicationRegimenResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.MedicationRegimenResponse
+// @Failure      401  {object}  endpoint.MedicationRegimenResponse
+// @Failure      403  {object}  endpoint.MedicationRegimenResponse
+// @Failure      404  {object}  endpoint.MedicationRegimenResponse
+// @Failure      500  {object}  endpoint.MedicationRegimenResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id} [get]
+func GetMedicationRegimen(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetMedicationRegimenEndpoint,
+		decodeGetMedicationRegimenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateMedicationRegimen godoc
+// @Summary      編輯用藥療程
+// @Description  編輯藥品、劑量或排程；已勾選的給藥紀錄維持原樣
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                                   true   "用藥療程ID"
+// @Param        data      body      endpoint.UpdateMedicationRegimenRequest  true   "用藥療程設定"
+// @Param        If-Match  header    string                                   false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.MedicationRegimenResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.MedicationRegimenResponse
+// @Failure      401  {object}  endpoint.MedicationRegimenResponse
+// @Failure      403  {object}  endpoint.MedicationRegimenResponse
+// @Failure      404  {object}  endpoint.MedicationRegimenResponse
+// @Failure      409  {object}  endpoint.MedicationRegimenResponse
+// @Failure      412  {object}  endpoint.MedicationRegimenResponse
+// @Failure      500  {object}  endpoint.MedicationRegimenResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id} [put]
+func UpdateMedicationRegimen(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateMedicationRegimenEndpoint,
+		decodeUpdateMedicationRegimenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DeleteMedicationRegimen godoc
+// @Summary      刪除用藥療程
+// @Description  刪除用藥療程，療程的給藥紀錄會一併刪除
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "用藥療程ID"
+// @Success      200  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Failure      400  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Failure      401  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Failure      403  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Failure      404  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Failure      500  {object}  endpoint.DeleteMedicationRegimenResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id} [delete]
+func DeleteMedicationRegimen(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DeleteMedicationRegimenEndpoint,
+		decodeDeleteMedicationRegimenRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListDoseEvents godoc
+// @Summary      查詢給藥紀錄
+// @Description  列出療程在期間內排定的每次給藥：taken/skipped 為照護者勾選的紀錄，
+// @Description  未勾選的給藥在寬限期內為 pending，超過寬限期為 missed；未指定期間時為前 7 天至後 1 天，期間不可超過 1 年
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true   "用藥療程ID"
+// @Param        start_date  query     string  false  "開始時間 (RFC3339)"
+// @Param        end_date    query     string  false  "結束時間 (RFC3339)"
+// @Success      200  {object}  endpoint.ListDoseEventsResponse
+// @Failure      400  {object}  endpoint.ListDoseEventsResponse
+// @Failure      401  {object}  endpoint.ListDoseEventsResponse
+// @Failure      403  {object}  endpoint.ListDoseEventsResponse
+// @Failure      404  {object}  endpoint.ListDoseEventsResponse
+// @Failure      500  {object}  endpoint.ListDoseEventsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id}/doses [get]
+func ListDoseEvents(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListDoseEventsEndpoint,
+		decodeListDoseEventsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// RecordDose godoc
+// @Summary      勾選給藥
+// @Description  照護者勾選排定的單次給藥為 taken 或 skipped，重複勾選同一次給藥時覆寫前一次的紀錄
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string                      true  "用藥療程ID"
+// @Param        data  body      endpoint.RecordDoseRequest  true  "給藥紀錄"
+// @Success      200  {object}  endpoint.DoseEventResponse
+// @Failure      400  {object}  endpoint.DoseEventResponse
+// @Failure      401  {object}  endpoint.DoseEventResponse
+// @Failure      403  {object}  endpoint.DoseEventResponse
+// @Failure      404  {object}  endpoint.DoseEventResponse
+// @Failure      500  {object}  endpoint.DoseEventResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id}/doses [post]
+func RecordDose(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RecordDoseEndpoint,
+		decodeRecordDoseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetMedicationAdherence godoc
+// @Summary      查詢服藥率
+// @Description  依日、週或月統計排定、已服、略過、漏服與尚未到期的給藥次數，
+// @Description  服藥率為已到期給藥中已服的百分比，略過的給藥不計入；未指定期間時為最近 30 天，期間不可超過 1 年
+// @Tags         medication-regimens
+// @Accept       json
+// @Produce      json
+// @Param        id          path      string  true   "用藥療程ID"
+// @Param        start_date  query     string  false  "開始時間 (RFC3339)"
+// @Param        end_date    query     string  false  "結束時間 (RFC3339)"
+// @Param        interval    query     string  false  "分組單位 (day, week, month)，預設 week"
+// @Success      200  {object}  endpoint.GetMedicationAdherenceResponse
+// @Failure      400  {object}  endpoint.GetMedicationAdherenceResponse
+// @Failure      401  {object}  endpoint.GetMedicationAdherenceResponse
+// @Failure      403  {object}  endpoint.GetMedicationAdherenceResponse
+// @Failure      404  {object}  endpoint.GetMedicationAdherenceResponse
+// @Failure      500  {object}  endpoint.GetMedicationAdherenceResponse
+// @Security     BearerAuth
+// @Router       /api/v1/medication-regimens/{id}/adherence [get]
+func GetMedicationAdherence(e endpoint.MedicationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetMedicationAdherenceEndpoint,
+		decodeGetMedicationAdherenceRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateMedicationRegimenRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.CreateMedicationRegimenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	return req, nil
+}
+
+func decodeListMedicationRegimensRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListMedicationRegimensRequest{PetID: ginctx.Query("pet_id"), Page: page}, nil
+}
+
+func decodeGetMedicationRegimenRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetMedicationRegimenRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodeUpdateMedicationRegimenRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.UpdateMedicationRegimenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	req.ID = ginctx.Param("id")
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteMedicationRegimenRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.DeleteMedicationRegimenRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodeListDoseEventsRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	startDate, err := parseDateQuery(ginctx, "start_date")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateQuery(ginctx, "end_date")
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.ListDoseEventsRequest{
+		RegimenID: ginctx.Param("id"),
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, nil
+}
+
+func decodeRecordDoseRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.RecordDoseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	req.RegimenID = ginctx.Param("id")
+	return req, nil
+}
+
+func decodeGetMedicationAdherenceRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	startDate, err := parseDateQuery(ginctx, "start_date")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateQuery(ginctx, "end_date")
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.GetMedicationAdherenceRequest{
+		RegimenID: ginctx.Param("id"),
+		StartDate: startDate,
+		EndDate:   endDate,
+		Interval:  model.TrendInterval(ginctx.Query("interval")),
+	}, nil
+}
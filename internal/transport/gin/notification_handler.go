@@ -0,0 +1,81 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterNotificationRoutes registers notification-preference routes on the given Gin engine.
+func RegisterNotificationRoutes(r *gin.Engine, cfg config.Config, e endpoint.NotificationEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	prefRoutes := v1.Group("/notification-preferences")
+	prefRoutes.Use(EnsureValidToken(cfg))
+	{
+		prefRoutes.GET("", GetNotificationPreference(e, opts...))
+		prefRoutes.PUT("", UpdateNotificationPreference(e, opts...))
+	}
+}
+
+// GetNotificationPreference godoc
+// @Summary      取得通知偏好
+// @Description  取得目前使用者各通知管道（LINE、Email、Webhook）的設定
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  endpoint.NotificationPreferenceResponse
+// @Failure      401  {object}  endpoint.NotificationPreferenceResponse
+// @Failure      500  {object}  endpoint.NotificationPreferenceResponse
+// @Security     BearerAuth
+// @Router       /api/v1/notification-preferences [get]
+func GetNotificationPreference(e endpoint.NotificationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetPreferenceEndpoint,
+		httptransport.NopRequestDecoder,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateNotificationPreference godoc
+// @Summary      更新通知偏好
+// @Description  設定目前使用者各通知管道的目標與啟用狀態
+// @Tags         notifications
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.UpdateNotificationPreferenceRequest  true  "通知偏好"
+// @Success      200  {object}  endpoint.NotificationPreferenceResponse
+// @Failure      400  {object}  endpoint.NotificationPreferenceResponse
+// @Failure      401  {object}  endpoint.NotificationPreferenceResponse
+// @Failure      500  {object}  endpoint.NotificationPreferenceResponse
+// @Security     BearerAuth
+// @Router       /api/v1/notification-preferences [put]
+func UpdateNotificationPreference(e endpoint.NotificationEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdatePreferenceEndpoint,
+		decodeUpdateNotificationPreferenceRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeUpdateNotificationPreferenceRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.UpdateNotificationPreferenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
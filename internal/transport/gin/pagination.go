@@ -0,0 +1,33 @@
+package gin
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+)
+
+// parsePageQuery 解析列表共用的分頁與排序查詢參數：limit、offset、cursor、sort、order
+// 排序欄位與預設值由查詢處理器依各列表的排序規格驗證
+func parsePageQuery(values url.Values) (repository.PageRequest, error) {
+	page := repository.PageRequest{
+		Cursor: values.Get("cursor"),
+		Sort:   values.Get("sort"),
+		Order:  repository.SortOrder(values.Get("order")),
+	}
+
+	for key, target := range map[string]*int{"limit": &page.Limit, "offset": &page.Offset} {
+		value := values.Get(key)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return page, fmt.Errorf("%w: invalid %s: %w", domain.ErrInvalidParameter, key, err)
+		}
+		*target = parsed
+	}
+	return page, nil
+}
@@ -1,11 +1,20 @@
 package gin
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
 	"github.com/blackhorseya/petlog/internal/endpoint"
 	"github.com/gin-gonic/gin"
 	httptransport "github.com/go-kit/kit/transport/http"
@@ -21,9 +30,10 @@ func RegisterPetRoutes(r *gin.Engine, cfg config.Config, e endpoint.PetEndpoints
 	opts = append(opts, options...)
 
 	// Public endpoints
+	v1 := r.Group("/api/v1")
+	v1.GET("/avatars/*path", GetPetAvatar(e, opts...))
 
 	// Private endpoints
-	v1 := r.Group("/api/v1")
 	petRoutes := v1.Group("/pets")
 	petRoutes.Use(EnsureValidToken(cfg))
 	{
@@ -31,6 +41,10 @@ func RegisterPetRoutes(r *gin.Engine, cfg config.Config, e endpoint.PetEndpoints
 		petRoutes.GET("/:id", GetPet(e, opts...))
 		petRoutes.PUT("/:id", UpdatePet(e, opts...))
 		petRoutes.DELETE("/:id", DeletePet(e, opts...))
+		petRoutes.POST("/:id/restore", RestorePet(e, opts...))
+		petRoutes.GET("/:id/history", ListPetHistory(e, opts...))
+		petRoutes.GET("/:id/report.pdf", GetPetReport(e, opts...))
+		petRoutes.POST("/:id/avatar", UploadPetAvatar(e, cfg.Storage.MaxUploadSize, opts...))
 		petRoutes.GET("", ListPets(e, opts...))
 	}
 }
@@ -43,6 +57,7 @@ func RegisterPetRoutes(r *gin.Engine, cfg config.Config, e endpoint.PetEndpoints
 // @Produce      json
 // @Param        pet  body      endpoint.CreatePetRequest  true  "寵物資訊"
 // @Success      200  {object}  endpoint.CreatePetResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -64,6 +79,7 @@ func CreatePet(e endpoint.PetEndpoints, options ...httptransport.ServerOption) g
 // @Produce      json
 // @Param        id   path      string  true  "寵物ID"
 // @Success      200  {object}  endpoint.GetPetResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -85,9 +101,13 @@ func GetPet(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gin.
 // @Produce      json
 // @Param        id   path      string  true  "寵物ID"
 // @Param        pet  body      endpoint.UpdatePetRequest  true  "要更新的寵物資訊"
+// @Param        If-Match  header  string  false  "最後取得的 ETag，版本不符時回傳 412"
 // @Success      200  {object}  endpoint.UpdatePetResponse
+// @Header       200  {string}  ETag  "資源版本"
 // @Failure      400  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
+// @Failure      409  {object}  map[string]interface{}
+// @Failure      412  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/pets/{id} [put]
@@ -102,12 +122,14 @@ func UpdatePet(e endpoint.PetEndpoints, options ...httptransport.ServerOption) g
 
 // DeletePet godoc
 // @Summary      刪除寵物
-// @Description  根據寵物ID刪除寵物資料
+// @Description  預設封存寵物及其健康日誌、醫療記錄與費用，可於還原期限內還原；purge=true 時永久清除
 // @Tags         pets
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "寵物ID"
+// @Param        id     path      string  true   "寵物ID"
+// @Param        purge  query     bool    false  "是否永久清除"
 // @Success      200  {object}  endpoint.DeletePetResponse
+// @Failure      403  {object}  map[string]interface{}
 // @Failure      404  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
@@ -121,13 +143,43 @@ func DeletePet(e endpoint.PetEndpoints, options ...httptransport.ServerOption) g
 	))
 }
 
+// RestorePet godoc
+// @Summary      還原寵物
+// @Description  在還原期限內還原已封存的寵物及與其一同封存的紀錄
+// @Tags         pets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "寵物ID"
+// @Success      200  {object}  endpoint.RestorePetResponse
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/pets/{id}/restore [post]
+func RestorePet(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RestorePetEndpoint,
+		decodeRestorePetRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
 // ListPets godoc
 // @Summary      列出所有寵物
-// @Description  取得目前使用者擁有的所有寵物列表
+// @Description  取得目前使用者擁有及所屬家庭共享的所有寵物列表
 // @Tags         pets
 // @Accept       json
 // @Produce      json
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (name, dob, created_at)，預設 name"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
 // @Success      200  {object}  endpoint.ListPetsResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  map[string]interface{}
 // @Failure      500  {object}  map[string]interface{}
 // @Security     BearerAuth
 // @Router       /api/v1/pets [get]
@@ -140,6 +192,109 @@ func ListPets(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gi
 	))
 }
 
+// ListPetHistory godoc
+// @Summary      查詢寵物異動歷程
+// @Description  列出寵物與其健康日誌、醫療記錄、費用、提醒的欄位異動紀錄，依時間由新到舊排序
+// @Tags         pets
+// @Accept       json
+// @Produce      json
+// @Param        id           path      string  true   "寵物ID"
+// @Param        entity_type  query     string  false  "資料種類 (pet, health_log, medical_record, expense, reminder)"
+// @Param        start_date   query     string  false  "開始時間 (RFC3339 格式)"
+// @Param        end_date     query     string  false  "結束時間 (RFC3339 格式)"
+// @Param        limit        query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset       query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor       query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort         query     string  false  "排序欄位 (created_at)，預設 created_at"
+// @Param        order        query     string  false  "排序方向 (asc, desc)，預設 desc"
+// @Success      200  {object}  endpoint.ListPetHistoryResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/pets/{id}/history [get]
+func ListPetHistory(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListHistoryEndpoint,
+		decodeListPetHistoryRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetPetReport godoc
+// @Summary      下載寵物健康報告
+// @Description  產生可於就診時提供給獸醫的 PDF 報告，包含寵物資料、體重趨勢圖、疫苗與用藥紀錄，以及期間內值得注意的健康日誌觀察；未指定期間時為過去一年
+// @Tags         pets
+// @Produce      application/pdf
+// @Param        id          path      string  true   "寵物ID"
+// @Param        start_date  query     string  false  "開始時間 (RFC3339 格式)"
+// @Param        end_date    query     string  false  "結束時間 (RFC3339 格式)"
+// @Success      200  {file}    file
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      401  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/pets/{id}/report.pdf [get]
+func GetPetReport(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ReportEndpoint,
+		decodeGetPetReportRequest,
+		encodePetReportResponse,
+		options...,
+	))
+}
+
+// UploadPetAvatar godoc
+// @Summary      上傳寵物頭像
+// @Description  以 multipart/form-data 上傳 JPEG、PNG、WebP 或 GIF 頭像，移除 EXIF 後產生多種尺寸的縮圖並自動設定 avatar_url，舊頭像會一併清除
+// @Tags         pets
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id    path      string  true  "寵物ID"
+// @Param        file  formData  file    true  "頭像圖片"
+// @Param        If-Match  header  string  false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.UploadPetAvatarResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  map[string]interface{}
+// @Failure      403  {object}  map[string]interface{}
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      412  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Security     BearerAuth
+// @Router       /api/v1/pets/{id}/avatar [post]
+func UploadPetAvatar(e endpoint.PetEndpoints, maxSize int64, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UploadAvatarEndpoint,
+		decodeUploadPetAvatarRequest(maxSize),
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetPetAvatar godoc
+// @Summary      取得寵物頭像
+// @Description  公開的頭像網址，轉址至限時下載連結
+// @Tags         pets
+// @Param        path  path  string  true  "頭像路徑（<寵物ID>/<鍵值>/<尺寸>.jpg）"
+// @Success      302
+// @Failure      404  {object}  map[string]interface{}
+// @Failure      500  {object}  map[string]interface{}
+// @Router       /api/v1/avatars/{path} [get]
+func GetPetAvatar(e endpoint.PetEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetAvatarEndpoint,
+		decodeGetPetAvatarRequest,
+		encodeRedirectResponse,
+		options...,
+	))
+}
+
 func decodeCreatePetRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
 	var req endpoint.CreatePetRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -163,15 +318,143 @@ func decodeUpdatePetRequest(c context.Context, r *http.Request) (request interfa
 		return nil, err
 	}
 	req.ID = id
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
 func decodeDeletePetRequest(c context.Context, r *http.Request) (request interface{}, err error) {
 	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
 	id := ginctx.Param("id")
-	return endpoint.DeletePetRequest{ID: id}, nil
+	purge := ginctx.Query("purge") == "true"
+	return endpoint.DeletePetRequest{ID: id, Purge: purge}, nil
+}
+
+func decodeRestorePetRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+	return endpoint.RestorePetRequest{ID: id}, nil
+}
+
+func decodeListPetsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListPetsRequest{Page: page}, nil
 }
 
-func decodeListPetsRequest(_ context.Context, _ *http.Request) (request interface{}, err error) {
-	return endpoint.ListPetsRequest{}, nil
+func decodeListPetHistoryRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	req := endpoint.ListPetHistoryRequest{
+		PetID:      ginctx.Param("id"),
+		EntityType: ginctx.Query("entity_type"),
+	}
+
+	if startDateStr := ginctx.Query("start_date"); startDateStr != "" {
+		startDate, err := time.Parse(time.RFC3339, startDateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date: %w", err)
+		}
+		req.StartDate = startDate
+	}
+
+	if endDateStr := ginctx.Query("end_date"); endDateStr != "" {
+		endDate, err := time.Parse(time.RFC3339, endDateStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date: %w", err)
+		}
+		req.EndDate = endDate
+	}
+
+	if req.Page, err = parsePageQuery(r.URL.Query()); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeGetPetReportRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	req := endpoint.GetPetReportRequest{ID: ginctx.Param("id")}
+
+	if req.StartDate, err = parseDateQuery(ginctx, "start_date"); err != nil {
+		return nil, err
+	}
+	if req.EndDate, err = parseDateQuery(ginctx, "end_date"); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// decodeUploadPetAvatarRequest 解析 multipart 表單，請求本文超過大小上限時直接拒絕
+func decodeUploadPetAvatarRequest(maxSize int64) httptransport.DecodeRequestFunc {
+	return func(c context.Context, r *http.Request) (request interface{}, err error) {
+		ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+		r.Body = http.MaxBytesReader(nil, r.Body, maxSize+multipartOverhead)
+		if err := r.ParseMultipartForm(multipartMemory); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, fmt.Errorf("%w: 頭像超過大小上限（上限 %d 位元組）", domain.ErrInvalidParameter, maxSize)
+			}
+			return nil, fmt.Errorf("%w: invalid multipart form: %w", domain.ErrInvalidParameter, err)
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			return nil, fmt.Errorf("%w: file is required: %w", domain.ErrInvalidParameter, err)
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return nil, fmt.Errorf("讀取頭像失敗: %w", err)
+		}
+
+		req := endpoint.UploadPetAvatarRequest{ID: ginctx.Param("id"), Content: bytes.NewReader(content)}
+		if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+}
+
+func decodeGetPetAvatarRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetPetAvatarRequest{Path: strings.TrimPrefix(ginctx.Param("path"), "/")}, nil
+}
+
+// encodeRedirectResponse 以 302 轉址至限時下載連結；連結會過期，因此只允許短暫快取
+func encodeRedirectResponse(c context.Context, w http.ResponseWriter, response interface{}) error {
+	resp, _ := response.(endpoint.GetPetAvatarResponse)
+	if resp.Err != nil {
+		encodeError(c, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Location", resp.URL)
+	w.Header().Set("Cache-Control", "private, max-age=60")
+	w.WriteHeader(http.StatusFound)
+	return nil
+}
+
+// encodePetReportResponse 以附件形式輸出 PDF 報告；報告含健康資料，不允許共用快取
+func encodePetReportResponse(c context.Context, w http.ResponseWriter, response interface{}) error {
+	resp, _ := response.(endpoint.GetPetReportResponse)
+	if resp.Err != nil {
+		encodeError(c, resp.Err, w)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", resp.Report.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.Report.FileName}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Report.Content)))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(resp.Report.Content)
+	return err
 }
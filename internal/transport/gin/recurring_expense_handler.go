@@ -0,0 +1,325 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterRecurringExpenseRoutes registers recurring-expense-related routes on the given Gin engine.
+func RegisterRecurringExpenseRoutes(r *gin.Engine, cfg config.Config, e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	recurringRoutes := v1.Group("/recurring-expenses")
+	recurringRoutes.Use(EnsureValidToken(cfg))
+	{
+		recurringRoutes.POST("", CreateRecurringExpense(e, opts...))
+		recurringRoutes.GET("", ListRecurringExpenses(e, opts...))
+		recurringRoutes.GET("/:id", GetRecurringExpense(e, opts...))
+		recurringRoutes.PUT("/:id", UpdateRecurringExpense(e, opts...))
+		recurringRoutes.DELETE("/:id", DeleteRecurringExpense(e, opts...))
+		recurringRoutes.POST("/:id/pause", PauseRecurringExpense(e, opts...))
+		recurringRoutes.POST("/:id/resume", ResumeRecurringExpense(e, opts...))
+		recurringRoutes.POST("/:id/skip", SkipRecurringExpense(e, opts...))
+	}
+}
+
+// CreateRecurringExpense godoc
+// @Summary      建立週期性費用
+// @Description  設定每月固定日期或每隔數週的固定費用，排程器會依期別自動產生費用
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.CreateRecurringExpenseRequest  true  "週期性費用設定"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses [post]
+func CreateRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CreateRecurringExpenseEndpoint,
+		decodeCreateRecurringExpenseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListRecurringExpenses godoc
+// @Summary      查詢週期性費用清單
+// @Description  列出指定寵物的週期性費用，未指定寵物時列出所有可存取寵物的週期性費用
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        pet_id  query     string  false  "寵物ID"
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (start_date, amount, created_at)，預設 start_date"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListRecurringExpensesResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListRecurringExpensesResponse
+// @Failure      401  {object}  endpoint.ListRecurringExpensesResponse
+// @Failure      403  {object}  endpoint.ListRecurringExpensesResponse
+// @Failure      500  {object}  endpoint.ListRecurringExpensesResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses [get]
+func ListRecurringExpenses(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListRecurringExpensesEndpoint,
+		decodeListRecurringExpensesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetRecurringExpense godoc
+// @Summary      取得週期性費用
+// @Description  取得指定 ID 的週期性費用設定
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "週期性費用ID"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id} [get]
+func GetRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetRecurringExpenseEndpoint,
+		decodeGetRecurringExpenseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateRecurringExpense godoc
+// @Summary      編輯週期性費用
+// @Description  編輯金額、分類或排程；只影響尚未產生的期別，已產生的費用維持原樣
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                                  true   "週期性費用ID"
+// @Param        data      body      endpoint.UpdateRecurringExpenseRequest  true   "週期性費用設定"
+// @Param        If-Match  header    string                                  false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      409  {object}  endpoint.RecurringExpenseResponse
+// @Failure      412  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id} [put]
+func UpdateRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateRecurringExpenseEndpoint,
+		decodeUpdateRecurringExpenseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DeleteRecurringExpense godoc
+// @Summary      刪除週期性費用
+// @Description  刪除週期性費用設定，已產生的費用不會一併刪除
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "週期性費用ID"
+// @Success      200  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Failure      400  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Failure      401  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Failure      403  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Failure      404  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Failure      500  {object}  endpoint.DeleteRecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id} [delete]
+func DeleteRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DeleteRecurringExpenseEndpoint,
+		decodeDeleteRecurringExpenseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// PauseRecurringExpense godoc
+// @Summary      暫停週期性費用
+// @Description  暫停後排程不再產生費用
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true   "週期性費用ID"
+// @Param        If-Match  header    string  false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      409  {object}  endpoint.RecurringExpenseResponse
+// @Failure      412  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id}/pause [post]
+func PauseRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.PauseRecurringExpenseEndpoint,
+		decodePauseRecurringExpenseRequest(true),
+		encodeResponse,
+		options...,
+	))
+}
+
+// ResumeRecurringExpense godoc
+// @Summary      恢復週期性費用
+// @Description  恢復排程，自恢復當下起產生費用，不補產生暫停期間的費用
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true   "週期性費用ID"
+// @Param        If-Match  header    string  false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      409  {object}  endpoint.RecurringExpenseResponse
+// @Failure      412  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id}/resume [post]
+func ResumeRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.PauseRecurringExpenseEndpoint,
+		decodePauseRecurringExpenseRequest(false),
+		encodeResponse,
+		options...,
+	))
+}
+
+// SkipRecurringExpense godoc
+// @Summary      略過單次期別
+// @Description  略過尚未產生費用的單一期別，該期別不會產生費用
+// @Tags         recurring-expenses
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                                true   "週期性費用ID"
+// @Param        data      body      endpoint.SkipRecurringExpenseRequest  true   "要略過的期別日期"
+// @Param        If-Match  header    string                                false  "最後取得的 ETag，版本不符時回傳 412"
+// @Success      200  {object}  endpoint.RecurringExpenseResponse
+// @Header       200  {string}  ETag  "資源版本"
+// @Failure      400  {object}  endpoint.RecurringExpenseResponse
+// @Failure      401  {object}  endpoint.RecurringExpenseResponse
+// @Failure      403  {object}  endpoint.RecurringExpenseResponse
+// @Failure      404  {object}  endpoint.RecurringExpenseResponse
+// @Failure      409  {object}  endpoint.RecurringExpenseResponse
+// @Failure      412  {object}  endpoint.RecurringExpenseResponse
+// @Failure      500  {object}  endpoint.RecurringExpenseResponse
+// @Security     BearerAuth
+// @Router       /api/v1/recurring-expenses/{id}/skip [post]
+func SkipRecurringExpense(e endpoint.RecurringExpenseEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.SkipRecurringExpenseEndpoint,
+		decodeSkipRecurringExpenseRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateRecurringExpenseRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.CreateRecurringExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	return req, nil
+}
+
+func decodeListRecurringExpensesRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListRecurringExpensesRequest{PetID: ginctx.Query("pet_id"), Page: page}, nil
+}
+
+func decodeGetRecurringExpenseRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.GetRecurringExpenseRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodeUpdateRecurringExpenseRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.UpdateRecurringExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	req.ID = ginctx.Param("id")
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeDeleteRecurringExpenseRequest(c context.Context, _ *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.DeleteRecurringExpenseRequest{ID: ginctx.Param("id")}, nil
+}
+
+func decodePauseRecurringExpenseRequest(paused bool) httptransport.DecodeRequestFunc {
+	return func(c context.Context, r *http.Request) (request interface{}, err error) {
+		ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+		req := endpoint.PauseRecurringExpenseRequest{ID: ginctx.Param("id"), Paused: paused}
+		if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+}
+
+func decodeSkipRecurringExpenseRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var req endpoint.SkipRecurringExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, err)
+	}
+	req.ID = ginctx.Param("id")
+
+	if req.ExpectedVersion, err = parseIfMatch(r); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
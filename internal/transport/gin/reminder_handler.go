@@ -0,0 +1,285 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterReminderRoutes registers reminder-related routes on the given Gin engine.
+func RegisterReminderRoutes(r *gin.Engine, cfg config.Config, e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	reminderRoutes := v1.Group("/reminders")
+	reminderRoutes.Use(EnsureValidToken(cfg))
+	{
+		reminderRoutes.POST("", CreateReminder(e, opts...))
+		reminderRoutes.GET("", ListReminders(e, opts...))
+		reminderRoutes.GET("/:id", GetReminder(e, opts...))
+		reminderRoutes.PUT("/:id", UpdateReminder(e, opts...))
+		reminderRoutes.DELETE("/:id", DeleteReminder(e, opts...))
+		reminderRoutes.POST("/:id/snooze", SnoozeReminder(e, opts...))
+		reminderRoutes.POST("/:id/complete", CompleteReminder(e, opts...))
+	}
+}
+
+// CreateReminder godoc
+// @Summary      新增提醒
+// @Description  為寵物建立一筆手動提醒
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.CreateReminderRequest  true  "提醒資料"
+// @Success      200  {object}  endpoint.ReminderResponse
+// @Failure      400  {object}  endpoint.ReminderResponse
+// @Failure      401  {object}  endpoint.ReminderResponse
+// @Failure      403  {object}  endpoint.ReminderResponse
+// @Failure      500  {object}  endpoint.ReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders [post]
+func CreateReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CreateReminderEndpoint,
+		decodeCreateReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// ListReminders godoc
+// @Summary      查詢提醒
+// @Description  列出使用者寵物的提醒，可依寵物與狀態篩選
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        pet_id  query     string  false  "寵物ID"
+// @Param        status  query     string  false  "狀態，以逗號分隔 (pending,sent,snoozed,done)"
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (due_date, remind_at, title, created_at)，預設 due_date"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.ListRemindersResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListRemindersResponse
+// @Failure      401  {object}  endpoint.ListRemindersResponse
+// @Failure      403  {object}  endpoint.ListRemindersResponse
+// @Failure      500  {object}  endpoint.ListRemindersResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders [get]
+func ListReminders(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListRemindersEndpoint,
+		decodeListRemindersRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// GetReminder godoc
+// @Summary      取得單筆提醒
+// @Description  取得指定 ID 的提醒
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "提醒ID"
+// @Success      200  {object}  endpoint.ReminderResponse
+// @Failure      400  {object}  endpoint.ReminderResponse
+// @Failure      401  {object}  endpoint.ReminderResponse
+// @Failure      403  {object}  endpoint.ReminderResponse
+// @Failure      404  {object}  endpoint.ReminderResponse
+// @Failure      500  {object}  endpoint.ReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders/{id} [get]
+func GetReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetReminderEndpoint,
+		decodeGetReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateReminder godoc
+// @Summary      編輯提醒
+// @Description  編輯指定 ID 的提醒內容與提醒時間
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string  true  "提醒ID"
+// @Param        data  body      endpoint.UpdateReminderRequest  true  "提醒資料"
+// @Success      200  {object}  endpoint.ReminderResponse
+// @Failure      400  {object}  endpoint.ReminderResponse
+// @Failure      401  {object}  endpoint.ReminderResponse
+// @Failure      403  {object}  endpoint.ReminderResponse
+// @Failure      404  {object}  endpoint.ReminderResponse
+// @Failure      500  {object}  endpoint.ReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders/{id} [put]
+func UpdateReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateReminderEndpoint,
+		decodeUpdateReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// DeleteReminder godoc
+// @Summary      刪除提醒
+// @Description  刪除指定 ID 的提醒
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "提醒ID"
+// @Success      204  {object}  endpoint.DeleteReminderResponse
+// @Failure      400  {object}  endpoint.DeleteReminderResponse
+// @Failure      401  {object}  endpoint.DeleteReminderResponse
+// @Failure      403  {object}  endpoint.DeleteReminderResponse
+// @Failure      404  {object}  endpoint.DeleteReminderResponse
+// @Failure      500  {object}  endpoint.DeleteReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders/{id} [delete]
+func DeleteReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.DeleteReminderEndpoint,
+		decodeDeleteReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// SnoozeReminder godoc
+// @Summary      延後提醒
+// @Description  將提醒延後至指定時間，或延後指定分鐘數
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id    path      string  true  "提醒ID"
+// @Param        data  body      endpoint.SnoozeReminderRequest  true  "延後設定"
+// @Success      200  {object}  endpoint.ReminderResponse
+// @Failure      400  {object}  endpoint.ReminderResponse
+// @Failure      401  {object}  endpoint.ReminderResponse
+// @Failure      403  {object}  endpoint.ReminderResponse
+// @Failure      404  {object}  endpoint.ReminderResponse
+// @Failure      500  {object}  endpoint.ReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders/{id}/snooze [post]
+func SnoozeReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.SnoozeReminderEndpoint,
+		decodeSnoozeReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// CompleteReminder godoc
+// @Summary      完成提醒
+// @Description  將指定 ID 的提醒標記為已完成
+// @Tags         reminders
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "提醒ID"
+// @Success      200  {object}  endpoint.ReminderResponse
+// @Failure      400  {object}  endpoint.ReminderResponse
+// @Failure      401  {object}  endpoint.ReminderResponse
+// @Failure      403  {object}  endpoint.ReminderResponse
+// @Failure      404  {object}  endpoint.ReminderResponse
+// @Failure      500  {object}  endpoint.ReminderResponse
+// @Security     BearerAuth
+// @Router       /api/v1/reminders/{id}/complete [post]
+func CompleteReminder(e endpoint.ReminderEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.CompleteReminderEndpoint,
+		decodeCompleteReminderRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeCreateReminderRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.CreateReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func decodeListRemindersRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	var statuses []model.ReminderStatus
+	for _, s := range strings.Split(ginctx.Query("status"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			statuses = append(statuses, model.ReminderStatus(s))
+		}
+	}
+
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.ListRemindersRequest{
+		PetID:    ginctx.Query("pet_id"),
+		Statuses: statuses,
+		Page:     page,
+	}, nil
+}
+
+func decodeGetReminderRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+	return endpoint.GetReminderRequest{ID: id}, nil
+}
+
+func decodeUpdateReminderRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+
+	var req endpoint.UpdateReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.ID = id
+	return req, nil
+}
+
+func decodeDeleteReminderRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+	return endpoint.DeleteReminderRequest{ID: id}, nil
+}
+
+func decodeSnoozeReminderRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+
+	var req endpoint.SnoozeReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	req.ID = id
+	return req, nil
+}
+
+func decodeCompleteReminderRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	id := ginctx.Param("id")
+	return endpoint.CompleteReminderRequest{ID: id}, nil
+}
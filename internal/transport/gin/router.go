@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/service"
 	"github.com/blackhorseya/petlog/internal/endpoint"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -22,8 +23,8 @@ func NewGinEngine() *gin.Engine {
 
 	corsConfig := cors.DefaultConfig()
 	corsConfig.AllowAllOrigins = true
-	corsConfig.AddAllowHeaders("Authorization", "Accept", "Cache-Control")
-	corsConfig.AddExposeHeaders("X-Total-Count")
+	corsConfig.AddAllowHeaders("Authorization", "Accept", "Cache-Control", "If-Match")
+	corsConfig.AddExposeHeaders("X-Total-Count", "ETag")
 	r.Use(cors.New(corsConfig))
 
 	// Swagger documentation endpoint
@@ -44,6 +45,22 @@ func NewHTTPHandler(
 	medicalRecordEndpoints endpoint.MedicalRecordEndpoints,
 	expenseEndpoints endpoint.ExpenseEndpoints,
 	hospitalEndpoints endpoint.HospitalEndpoints,
+	reminderEndpoints endpoint.ReminderEndpoints,
+	notificationEndpoints endpoint.NotificationEndpoints,
+	householdEndpoints endpoint.HouseholdEndpoints,
+	trashEndpoints endpoint.TrashEndpoints,
+	analyticsEndpoints endpoint.AnalyticsEndpoints,
+	budgetEndpoints endpoint.BudgetEndpoints,
+	recurringExpenseEndpoints endpoint.RecurringExpenseEndpoints,
+	exchangeRateEndpoints endpoint.ExchangeRateEndpoints,
+	userSettingsEndpoints endpoint.UserSettingsEndpoints,
+	attachmentEndpoints endpoint.AttachmentEndpoints,
+	blobStore service.BlobStore,
+	exportEndpoints endpoint.ExportEndpoints,
+	importEndpoints endpoint.ImportEndpoints,
+	timelineEndpoints endpoint.TimelineEndpoints,
+	calendarFeedEndpoints endpoint.CalendarFeedEndpoints,
+	medicationEndpoints endpoint.MedicationEndpoints,
 	options []httptransport.ServerOption,
 ) http.Handler {
 	// Register routes for the "pet" module.
@@ -64,5 +81,50 @@ func NewHTTPHandler(
 	// Register routes for the "hospital" module.
 	RegisterHospitalRoutes(r, cfg, hospitalEndpoints, options...)
 
+	// Register routes for the "reminder" module.
+	RegisterReminderRoutes(r, cfg, reminderEndpoints, options...)
+
+	// Register routes for the "notification" module.
+	RegisterNotificationRoutes(r, cfg, notificationEndpoints, options...)
+
+	// Register routes for the "household" module.
+	RegisterHouseholdRoutes(r, cfg, householdEndpoints, options...)
+
+	// Register routes for the "trash" module.
+	RegisterTrashRoutes(r, cfg, trashEndpoints, options...)
+
+	// Register routes for the "analytics" module.
+	RegisterAnalyticsRoutes(r, cfg, analyticsEndpoints, options...)
+
+	// Register routes for the "budget" module.
+	RegisterBudgetRoutes(r, cfg, budgetEndpoints, options...)
+
+	// Register routes for the "recurring-expense" module.
+	RegisterRecurringExpenseRoutes(r, cfg, recurringExpenseEndpoints, options...)
+
+	// Register routes for the "exchange-rate" module.
+	RegisterExchangeRateRoutes(r, cfg, exchangeRateEndpoints, options...)
+
+	// Register routes for the "user-settings" module.
+	RegisterUserSettingsRoutes(r, cfg, userSettingsEndpoints, options...)
+
+	// Register routes for the "attachment" module.
+	RegisterAttachmentRoutes(r, cfg, attachmentEndpoints, blobStore, options...)
+
+	// Register routes for the "export" module.
+	RegisterExportRoutes(r, cfg, exportEndpoints, options...)
+
+	// Register routes for the "import" module.
+	RegisterImportRoutes(r, cfg, importEndpoints, options...)
+
+	// Register routes for the "timeline" module.
+	RegisterTimelineRoutes(r, cfg, timelineEndpoints, options...)
+
+	// Register routes for the "calendar-feed" module.
+	RegisterCalendarFeedRoutes(r, cfg, calendarFeedEndpoints, options...)
+
+	// Register routes for the "medication-regimen" module.
+	RegisterMedicationRoutes(r, cfg, medicationEndpoints, options...)
+
 	return r
 }
@@ -0,0 +1,98 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterTimelineRoutes registers timeline-related routes on the given Gin engine.
+func RegisterTimelineRoutes(r *gin.Engine, cfg config.Config, e endpoint.TimelineEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	timelineRoutes := v1.Group("/timeline")
+	timelineRoutes.Use(EnsureValidToken(cfg))
+	{
+		timelineRoutes.GET("", GetPetTimeline(e, opts...))
+	}
+}
+
+// GetPetTimeline godoc
+// @Summary      查詢寵物時間軸
+// @Description  合併健康日誌、醫療記錄、費用與醫療記錄的下次到期日，依時間排序後分頁，供日曆檢視使用
+// @Description  未指定寵物時列出所有可存取寵物的事件，未指定期間時為前後一個月，期間不可超過 1 年
+// @Tags         timeline
+// @Accept       json
+// @Produce      json
+// @Param        pet_id      query     string  false  "寵物ID"
+// @Param        start_date  query     string  false  "開始時間 (RFC3339)"
+// @Param        end_date    query     string  false  "結束時間 (RFC3339)"
+// @Param        type        query     string  false  "種類，以逗號分隔 (health_log,medical_record,expense,due)"
+// @Param        limit       query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset      query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor      query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort        query     string  false  "排序欄位 (date, type)，預設 date"
+// @Param        order       query     string  false  "排序方向 (asc, desc)，預設 asc"
+// @Success      200  {object}  endpoint.GetPetTimelineResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.GetPetTimelineResponse
+// @Failure      401  {object}  endpoint.GetPetTimelineResponse
+// @Failure      403  {object}  endpoint.GetPetTimelineResponse
+// @Failure      404  {object}  endpoint.GetPetTimelineResponse
+// @Failure      500  {object}  endpoint.GetPetTimelineResponse
+// @Security     BearerAuth
+// @Router       /api/v1/timeline [get]
+func GetPetTimeline(e endpoint.TimelineEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetPetTimelineEndpoint,
+		decodeGetPetTimelineRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeGetPetTimelineRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+
+	startDate, err := parseDateQuery(ginctx, "start_date")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseDateQuery(ginctx, "end_date")
+	if err != nil {
+		return nil, err
+	}
+
+	var types []model.TimelineItemType
+	for _, t := range strings.Split(ginctx.Query("type"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, model.TimelineItemType(t))
+		}
+	}
+
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoint.GetPetTimelineRequest{
+		PetID:     ginctx.Query("pet_id"),
+		StartDate: startDate,
+		EndDate:   endDate,
+		Types:     types,
+		Page:      page,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterTrashRoutes registers trash-related routes on the given Gin engine.
+func RegisterTrashRoutes(r *gin.Engine, cfg config.Config, e endpoint.TrashEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	trashRoutes := v1.Group("/trash")
+	trashRoutes.Use(EnsureValidToken(cfg))
+	{
+		trashRoutes.GET("", ListTrash(e, opts...))
+		trashRoutes.POST("/:type/:id/restore", RestoreTrashItem(e, opts...))
+		trashRoutes.DELETE("/:type/:id", PurgeTrashItem(e, opts...))
+	}
+}
+
+// ListTrash godoc
+// @Summary      查詢垃圾桶
+// @Description  列出可檢視的封存寵物與已刪除的健康日誌、醫療記錄與費用，預設依刪除時間由新到舊排序
+// @Tags         trash
+// @Accept       json
+// @Produce      json
+// @Param        type    query     string  false  "項目種類（pet、health_log、medical_record、expense）"
+// @Param        pet_id  query     string  false  "寵物ID"
+// @Param        limit   query     int     false  "每頁筆數 (1-200)，未指定時回傳全部，帶 cursor 時預設 50"
+// @Param        offset  query     int     false  "略過的筆數，不可與 cursor 同時使用"
+// @Param        cursor  query     string  false  "上一頁回傳的 next_cursor，為編碼後的位移，翻頁期間資料異動時可能重複或遺漏"
+// @Param        sort    query     string  false  "排序欄位 (deleted_at, type)，預設 deleted_at"
+// @Param        order   query     string  false  "排序方向 (asc, desc)，預設 desc"
+// @Success      200  {object}  endpoint.ListTrashResponse
+// @Header       200  {integer}  X-Total-Count  "符合條件的總筆數"
+// @Failure      400  {object}  endpoint.ListTrashResponse
+// @Failure      401  {object}  endpoint.ListTrashResponse
+// @Failure      500  {object}  endpoint.ListTrashResponse
+// @Security     BearerAuth
+// @Router       /api/v1/trash [get]
+func ListTrash(e endpoint.TrashEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.ListTrashEndpoint,
+		decodeListTrashRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// RestoreTrashItem godoc
+// @Summary      還原垃圾桶項目
+// @Description  在保留期限內還原項目；還原寵物會一併還原與其一同封存的紀錄，還原紀錄前所屬寵物須未被刪除
+// @Tags         trash
+// @Accept       json
+// @Produce      json
+// @Param        type  path      string  true  "項目種類（pet、health_log、medical_record、expense）"
+// @Param        id    path      string  true  "項目ID"
+// @Success      200  {object}  endpoint.TrashItemResponse
+// @Failure      400  {object}  endpoint.TrashItemResponse
+// @Failure      401  {object}  endpoint.TrashItemResponse
+// @Failure      403  {object}  endpoint.TrashItemResponse
+// @Failure      404  {object}  endpoint.TrashItemResponse
+// @Failure      500  {object}  endpoint.TrashItemResponse
+// @Security     BearerAuth
+// @Router       /api/v1/trash/{type}/{id}/restore [post]
+func RestoreTrashItem(e endpoint.TrashEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.RestoreTrashItemEndpoint,
+		decodeTrashItemRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// PurgeTrashItem godoc
+// @Summary      永久清除垃圾桶項目
+// @Description  永久刪除垃圾桶中的項目，清除寵物會一併刪除其所有關聯紀錄，無法復原
+// @Tags         trash
+// @Accept       json
+// @Produce      json
+// @Param        type  path      string  true  "項目種類（pet、health_log、medical_record、expense）"
+// @Param        id    path      string  true  "項目ID"
+// @Success      200  {object}  endpoint.TrashItemResponse
+// @Failure      400  {object}  endpoint.TrashItemResponse
+// @Failure      401  {object}  endpoint.TrashItemResponse
+// @Failure      403  {object}  endpoint.TrashItemResponse
+// @Failure      404  {object}  endpoint.TrashItemResponse
+// @Failure      500  {object}  endpoint.TrashItemResponse
+// @Security     BearerAuth
+// @Router       /api/v1/trash/{type}/{id} [delete]
+func PurgeTrashItem(e endpoint.TrashEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.PurgeTrashItemEndpoint,
+		decodeTrashItemRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeListTrashRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	page, err := parsePageQuery(r.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+	return endpoint.ListTrashRequest{
+		Type:  model.TrashItemType(ginctx.Query("type")),
+		PetID: ginctx.Query("pet_id"),
+		Page:  page,
+	}, nil
+}
+
+func decodeTrashItemRequest(c context.Context, r *http.Request) (request interface{}, err error) {
+	ginctx, _ := c.Value(ginContextKey).(*gin.Context)
+	return endpoint.TrashItemRequest{
+		Type: model.TrashItemType(ginctx.Param("type")),
+		ID:   ginctx.Param("id"),
+	}, nil
+}
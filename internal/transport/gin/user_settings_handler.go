@@ -0,0 +1,81 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/endpoint"
+	"github.com/gin-gonic/gin"
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// RegisterUserSettingsRoutes registers user-settings routes on the given Gin engine.
+func RegisterUserSettingsRoutes(r *gin.Engine, cfg config.Config, e endpoint.UserSettingsEndpoints, options ...httptransport.ServerOption) {
+	// Error handler
+	opts := []httptransport.ServerOption{
+		httptransport.ServerErrorHandler(NewContextualLogErrorHandler()),
+		httptransport.ServerErrorEncoder(encodeError),
+	}
+	opts = append(opts, options...)
+
+	// Private endpoints
+	v1 := r.Group("/api/v1")
+	settingsRoutes := v1.Group("/user-settings")
+	settingsRoutes.Use(EnsureValidToken(cfg))
+	{
+		settingsRoutes.GET("", GetUserSettings(e, opts...))
+		settingsRoutes.PUT("", UpdateUserSettings(e, opts...))
+	}
+}
+
+// GetUserSettings godoc
+// @Summary      取得使用者設定
+// @Description  取得目前使用者的基準貨幣等設定，尚未設定時回傳系統預設值
+// @Tags         user-settings
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  endpoint.UserSettingsResponse
+// @Failure      401  {object}  endpoint.UserSettingsResponse
+// @Failure      500  {object}  endpoint.UserSettingsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/user-settings [get]
+func GetUserSettings(e endpoint.UserSettingsEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.GetSettingsEndpoint,
+		httptransport.NopRequestDecoder,
+		encodeResponse,
+		options...,
+	))
+}
+
+// UpdateUserSettings godoc
+// @Summary      更新使用者設定
+// @Description  設定目前使用者的基準貨幣，費用摘要與預算將換算為此貨幣
+// @Tags         user-settings
+// @Accept       json
+// @Produce      json
+// @Param        data  body      endpoint.UpdateUserSettingsRequest  true  "使用者設定"
+// @Success      200  {object}  endpoint.UserSettingsResponse
+// @Failure      400  {object}  endpoint.UserSettingsResponse
+// @Failure      401  {object}  endpoint.UserSettingsResponse
+// @Failure      500  {object}  endpoint.UserSettingsResponse
+// @Security     BearerAuth
+// @Router       /api/v1/user-settings [put]
+func UpdateUserSettings(e endpoint.UserSettingsEndpoints, options ...httptransport.ServerOption) gin.HandlerFunc {
+	return gin.WrapH(httptransport.NewServer(
+		e.UpdateSettingsEndpoint,
+		decodeUpdateUserSettingsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+func decodeUpdateUserSettingsRequest(_ context.Context, r *http.Request) (request interface{}, err error) {
+	var req endpoint.UpdateUserSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,142 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ProvideJobs 組合排程器要執行的工作，順序即為執行順序
+func ProvideJobs(
+	generate *command.GenerateRemindersHandler,
+	dispatch *command.DispatchRemindersHandler,
+	retry *command.RetryNotificationsHandler,
+	purge *command.PurgeExpiredTrashHandler,
+	recurring *command.GenerateRecurringExpensesHandler,
+	exports *command.ProcessExportJobsHandler,
+	missedDoses *command.DetectMissedDosesHandler,
+) []Job {
+	return []Job{
+		&generateRemindersJob{handler: generate},
+		&detectMissedDosesJob{handler: missedDoses},
+		&dispatchRemindersJob{handler: dispatch},
+		&retryNotificationsJob{handler: retry},
+		&purgeExpiredTrashJob{handler: purge},
+		&generateRecurringExpensesJob{handler: recurring},
+		&processExportJobsJob{handler: exports},
+	}
+}
+
+// generateRemindersJob 掃描醫療記錄的下次到期日並建立提醒
+type generateRemindersJob struct {
+	handler *command.GenerateRemindersHandler
+}
+
+func (j *generateRemindersJob) Name() string { return "generate_reminders" }
+
+func (j *generateRemindersJob) Run(c context.Context, now time.Time) error {
+	created, err := j.handler.Handle(c, command.GenerateRemindersCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("提醒產生完成", "created", created)
+	return nil
+}
+
+// dispatchRemindersJob 發送到期的提醒
+type dispatchRemindersJob struct {
+	handler *command.DispatchRemindersHandler
+}
+
+func (j *dispatchRemindersJob) Name() string { return "dispatch_reminders" }
+
+func (j *dispatchRemindersJob) Run(c context.Context, now time.Time) error {
+	sent, err := j.handler.Handle(c, command.DispatchRemindersCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("提醒發送完成", "sent", sent)
+	return nil
+}
+
+// retryNotificationsJob 以指數退避重送失敗的通知
+type retryNotificationsJob struct {
+	handler *command.RetryNotificationsHandler
+}
+
+func (j *retryNotificationsJob) Name() string { return "retry_notifications" }
+
+func (j *retryNotificationsJob) Run(c context.Context, now time.Time) error {
+	succeeded, err := j.handler.Handle(c, command.RetryNotificationsCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("通知重送完成", "succeeded", succeeded)
+	return nil
+}
+
+// purgeExpiredTrashJob 永久清除垃圾桶中超過保留期限的項目
+type purgeExpiredTrashJob struct {
+	handler *command.PurgeExpiredTrashHandler
+}
+
+func (j *purgeExpiredTrashJob) Name() string { return "purge_expired_trash" }
+
+func (j *purgeExpiredTrashJob) Run(c context.Context, now time.Time) error {
+	purged, err := j.handler.Handle(c, command.PurgeExpiredTrashCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("垃圾桶逾期項目清除完成", "purged", purged)
+	return nil
+}
+
+// generateRecurringExpensesJob 依週期性費用的排程產生到期的費用
+type generateRecurringExpensesJob struct {
+	handler *command.GenerateRecurringExpensesHandler
+}
+
+func (j *generateRecurringExpensesJob) Name() string { return "generate_recurring_expenses" }
+
+func (j *generateRecurringExpensesJob) Run(c context.Context, now time.Time) error {
+	created, err := j.handler.Handle(c, command.GenerateRecurringExpensesCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("週期性費用產生完成", "created", created)
+	return nil
+}
+
+// processExportJobsJob 產生背景匯出檔並刪除逾期的匯出檔
+type processExportJobsJob struct {
+	handler *command.ProcessExportJobsHandler
+}
+
+func (j *processExportJobsJob) Name() string { return "process_export_jobs" }
+
+func (j *processExportJobsJob) Run(c context.Context, now time.Time) error {
+	processed, err := j.handler.Handle(c, command.ProcessExportJobsCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("匯出工作處理完成", "processed", processed)
+	return nil
+}
+
+// detectMissedDosesJob 偵測用藥療程的漏服並建立提醒
+type detectMissedDosesJob struct {
+	handler *command.DetectMissedDosesHandler
+}
+
+func (j *detectMissedDosesJob) Name() string { return "detect_missed_doses" }
+
+func (j *detectMissedDosesJob) Run(c context.Context, now time.Time) error {
+	created, err := j.handler.Handle(c, command.DetectMissedDosesCommand{Now: now})
+	if err != nil {
+		return err
+	}
+	contextx.WithContext(c).Info("漏服偵測完成", "created", created)
+	return nil
+}
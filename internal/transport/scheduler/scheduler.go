@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// Job 定義排程器每次 tick 要執行的工作
+type Job interface {
+	// Name 回傳工作名稱，用於日誌
+	Name() string
+
+	// Run 執行一次工作
+	Run(c context.Context, now time.Time) error
+}
+
+// Scheduler 週期性執行背景工作，可在長駐伺服器中以 ticker 執行，
+// 也可由 Lambda 排程事件觸發單次 Tick。
+type Scheduler struct {
+	interval time.Duration
+	jobs     []Job
+}
+
+// NewScheduler 建立排程器
+func NewScheduler(cfg config.Config, jobs []Job) *Scheduler {
+	interval := cfg.Reminder.ScanInterval
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	return &Scheduler{
+		interval: interval,
+		jobs:     jobs,
+	}
+}
+
+// Tick 依序執行所有工作一次，單一工作失敗不影響其他工作
+func (s *Scheduler) Tick(c context.Context) error {
+	ctx := contextx.WithContext(c)
+	now := time.Now()
+
+	var errs []error
+	for _, job := range s.jobs {
+		if err := job.Run(ctx, now); err != nil {
+			ctx.Error("排程工作執行失敗", "job", job.Name(), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", job.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Start 以固定間隔執行 Tick，直到 context 結束
+func (s *Scheduler) Start(c context.Context) {
+	ctx := contextx.WithContext(c)
+	ctx.Info("排程器啟動", "interval", s.interval, "jobs", len(s.jobs))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	_ = s.Tick(ctx)
+	for {
+		select {
+		case <-c.Done():
+			ctx.Info("排程器停止")
+			return
+		case <-ticker.C:
+			_ = s.Tick(ctx)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package behavior
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+const (
+	// maxAttachmentFileNameLength 附件檔名的字元數上限
+	maxAttachmentFileNameLength = 255
+	// defaultAttachmentFileName 未提供檔名時使用的名稱
+	defaultAttachmentFileName = "attachment"
+)
+
+// AllowedAttachmentContentTypes 允許上傳的附件類型，以檔案內容判斷而非用戶端宣告的類型
+var AllowedAttachmentContentTypes = []string{"image/jpeg", "image/png", "image/webp", "image/gif", "application/pdf"}
+
+var (
+	ErrAttachmentOwnerInvalid   = errors.New("附件必須指定所屬的費用或醫療記錄")
+	ErrAttachmentEmpty          = errors.New("附件內容不可為空")
+	ErrAttachmentTooLarge       = errors.New("附件超過大小上限")
+	ErrAttachmentTypeNotAllowed = errors.New("附件僅支援 JPEG、PNG、WebP、GIF 圖片與 PDF")
+)
+
+// ValidateAttachmentOwner 檢查附件所屬的資料種類與 ID
+func ValidateAttachmentOwner(ownerType model.AttachmentOwnerType, ownerID string) error {
+	if !ownerType.IsValid() || strings.TrimSpace(ownerID) == "" {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAttachmentOwnerInvalid)
+	}
+	return nil
+}
+
+// DetectAttachmentContentType 依檔案內容判斷類型並檢查大小，maxSize 為 0 時不限制大小
+func DetectAttachmentContentType(content []byte, maxSize int64) (string, error) {
+	if len(content) == 0 {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAttachmentEmpty)
+	}
+	if maxSize > 0 && int64(len(content)) > maxSize {
+		return "", fmt.Errorf("%w: %w（上限 %d 位元組）", domain.ErrInvalidParameter, ErrAttachmentTooLarge, maxSize)
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil || !slices.Contains(AllowedAttachmentContentTypes, contentType) {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAttachmentTypeNotAllowed)
+	}
+	return contentType, nil
+}
+
+// NormalizeAttachmentFileName 去除路徑並限制長度，未提供時使用預設名稱
+func NormalizeAttachmentFileName(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return defaultAttachmentFileName
+	}
+	if utf8.RuneCountInString(name) > maxAttachmentFileNameLength {
+		ext := filepath.Ext(name)
+		if utf8.RuneCountInString(ext) >= maxAttachmentFileNameLength {
+			ext = ""
+		}
+		runes := []rune(strings.TrimSuffix(name, ext))
+		name = string(runes[:maxAttachmentFileNameLength-utf8.RuneCountInString(ext)]) + ext
+	}
+	return name
+}
+
+// NewAttachmentStorageKey 產生附件於 BlobStore 中的隨機鍵值 attachments/<petID>/<random>
+func NewAttachmentStorageKey(petID string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("產生附件鍵值失敗: %w", err)
+	}
+	return "attachments/" + petID + "/" + hex.EncodeToString(b), nil
+}
+
+// RemoveAttachmentFiles 刪除已清除中繼資料的附件檔案，中繼資料已不存在，失敗只記錄日誌
+func RemoveAttachmentFiles(c context.Context, store service.BlobStore, attachments []*model.Attachment) {
+	ctx := contextx.WithContext(c)
+	for _, attachment := range attachments {
+		if err := store.Delete(ctx, attachment.StorageKey); err != nil {
+			ctx.Error("刪除附件檔案失敗", "error", err, "attachment_id", attachment.ID, "key", attachment.StorageKey)
+		}
+	}
+}
+
+// AttachmentOwnerResolver 查詢附件所屬的費用或醫療記錄，並檢查使用者對其寵物的存取權
+type AttachmentOwnerResolver struct {
+	expenseRepo repository.ExpenseRepository
+	medicalRepo repository.MedicalRecordRepository
+	guard       *PetAccessGuard
+}
+
+// NewAttachmentOwnerResolver 建立新的 AttachmentOwnerResolver
+func NewAttachmentOwnerResolver(expenseRepo repository.ExpenseRepository, medicalRepo repository.MedicalRecordRepository, guard *PetAccessGuard) *AttachmentOwnerResolver {
+	if expenseRepo == nil || medicalRepo == nil || guard == nil {
+		panic("expenseRepo, medicalRepo and guard are required")
+	}
+	return &AttachmentOwnerResolver{expenseRepo: expenseRepo, medicalRepo: medicalRepo, guard: guard}
+}
+
+// Authorize 確認所屬資料存在且使用者具備指定的存取層級，通過時回傳所屬寵物的 ID
+// 已移至垃圾桶的資料視為不存在
+func (r *AttachmentOwnerResolver) Authorize(c context.Context, ownerType model.AttachmentOwnerType, ownerID string, perm model.Permission) (string, error) {
+	if err := ValidateAttachmentOwner(ownerType, ownerID); err != nil {
+		return "", err
+	}
+
+	var petID string
+	switch ownerType {
+	case model.AttachmentOwnerExpense:
+		expense, err := r.expenseRepo.FindByID(c, ownerID)
+		if err != nil {
+			return "", fmt.Errorf("查找費用失敗: %w", err)
+		}
+		petID = expense.PetID
+	case model.AttachmentOwnerMedicalRecord:
+		record, err := r.medicalRepo.FindByID(c, ownerID)
+		if err != nil {
+			return "", fmt.Errorf("查找醫療記錄失敗: %w", err)
+		}
+		petID = record.PetID
+	}
+
+	if _, err := r.guard.Authorize(c, petID, perm); err != nil {
+		return "", err
+	}
+	return petID, nil
+}
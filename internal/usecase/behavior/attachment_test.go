@@ -0,0 +1,105 @@
+package behavior
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidateAttachmentOwner(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownerType model.AttachmentOwnerType
+		ownerID   string
+		wantErr   bool
+	}{
+		{"費用附件", model.AttachmentOwnerExpense, "exp-1", false},
+		{"醫療記錄附件", model.AttachmentOwnerMedicalRecord, "rec-1", false},
+		{"無效的所屬種類", "pet", "pet-1", true},
+		{"缺少所屬 ID", model.AttachmentOwnerExpense, " ", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttachmentOwner(tt.ownerType, tt.ownerID)
+			if tt.wantErr != errors.Is(err, ErrAttachmentOwnerInvalid) {
+				t.Errorf("預期錯誤 %v，實際為 %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDetectAttachmentContentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	tests := []struct {
+		name    string
+		content []byte
+		maxSize int64
+		want    string
+		wantErr error
+	}{
+		{"PDF 文件", []byte("%PDF-1.7\n"), 0, "application/pdf", nil},
+		{"PNG 圖片", png, 1024, "image/png", nil},
+		{"JPEG 圖片", []byte("\xff\xd8\xff\xe0\x00\x10JFIF"), 0, "image/jpeg", nil},
+		{"HTML 偽裝成圖片", []byte("<html><script>alert(1)</script></html>"), 0, "", ErrAttachmentTypeNotAllowed},
+		{"純文字", []byte("hello"), 0, "", ErrAttachmentTypeNotAllowed},
+		{"空檔案", nil, 0, "", ErrAttachmentEmpty},
+		{"超過大小上限", png, 4, "", ErrAttachmentTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectAttachmentContentType(tt.content, tt.maxSize)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Errorf("預期 %v，實際為 %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("預期 %s，實際為 %s（錯誤：%v）", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeAttachmentFileName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"一般檔名", "收據.pdf", "收據.pdf"},
+		{"去除路徑", "../../etc/passwd", "passwd"},
+		{"去除 Windows 路徑", `C:\Users\me\invoice.png`, "invoice.png"},
+		{"空檔名", "  ", "attachment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeAttachmentFileName(tt.in); got != tt.want {
+				t.Errorf("預期 %s，實際為 %s", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("過長的檔名保留副檔名", func(t *testing.T) {
+		got := NormalizeAttachmentFileName(strings.Repeat("發", 300) + ".pdf")
+		if utf8.RuneCountInString(got) != maxAttachmentFileNameLength || !strings.HasSuffix(got, ".pdf") {
+			t.Errorf("截斷結果錯誤，長度 %d: %s", utf8.RuneCountInString(got), got)
+		}
+	})
+}
+
+func TestNewAttachmentStorageKey(t *testing.T) {
+	a, err := NewAttachmentStorageKey("pet-1")
+	if err != nil {
+		t.Fatalf("預期無錯誤，實際為 %v", err)
+	}
+	b, _ := NewAttachmentStorageKey("pet-1")
+	if !strings.HasPrefix(a, "attachments/pet-1/") || a == b {
+		t.Errorf("鍵值應以寵物區分且不重複: %s, %s", a, b)
+	}
+}
@@ -0,0 +1,120 @@
+package behavior
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// auditIgnoredFields 由系統維護、不列入欄位差異的欄位
+var auditIgnoredFields = map[string]struct{}{
+	"id":         {},
+	"version":    {},
+	"created_at": {},
+	"updated_at": {},
+	"deleted_at": {},
+}
+
+// DiffFields 比較兩個同型別實體的 JSON 欄位，回傳有異動的欄位
+// before 為 nil 時視為建立，after 為 nil 時視為刪除；欄位名稱採 json 標籤
+func DiffFields(before, after any) []model.FieldChange {
+	beforeFields := auditFields(before)
+	afterFields := auditFields(after)
+
+	var names []string
+	seen := make(map[string]struct{})
+	for _, fields := range []*auditFieldSet{beforeFields, afterFields} {
+		if fields == nil {
+			continue
+		}
+		for _, name := range fields.order {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+
+	changes := make([]model.FieldChange, 0)
+	for _, name := range names {
+		b := beforeFields.get(name)
+		a := afterFields.get(name)
+		if bytes.Equal(b, a) {
+			continue
+		}
+		changes = append(changes, model.FieldChange{Field: name, Before: b, After: a})
+	}
+
+	return changes
+}
+
+type auditFieldSet struct {
+	order  []string
+	values map[string]json.RawMessage
+}
+
+func (s *auditFieldSet) get(name string) json.RawMessage {
+	if s == nil {
+		return nil
+	}
+	return s.values[name]
+}
+
+// auditFields 依 json 標籤取出結構的欄位值，零值欄位視為未設定
+func auditFields(v any) *auditFieldSet {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	set := &auditFieldSet{values: make(map[string]json.RawMessage)}
+	collectAuditFields(rv, set)
+	return set
+}
+
+// collectAuditFields 收集結構的欄位值，未命名的內嵌結構會展開為上層欄位，與 JSON 編碼一致
+func collectAuditFields(rv reflect.Value, set *auditFieldSet) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" && field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectAuditFields(rv.Field(i), set)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if _, ignored := auditIgnoredFields[name]; ignored {
+			continue
+		}
+
+		set.order = append(set.order, name)
+		value := rv.Field(i)
+		if value.IsZero() {
+			continue
+		}
+		raw, err := json.Marshal(value.Interface())
+		if err != nil {
+			continue
+		}
+		set.values[name] = raw
+	}
+}
@@ -0,0 +1,60 @@
+package behavior
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestDiffFields(t *testing.T) {
+	date := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("建立時列出所有已設定的欄位", func(t *testing.T) {
+		changes := DiffFields(nil, &model.HealthLog{ID: "log-1", PetID: "pet-1", Date: date, WeightKg: 4.2})
+		if len(changes) != 3 {
+			t.Fatalf("預期 3 個欄位異動，實際為 %+v", changes)
+		}
+		if changes[0].Field != "pet_id" || changes[0].Before != nil || string(changes[0].After) != `"pet-1"` {
+			t.Errorf("預期 pet_id 由空值變為 pet-1，實際為 %+v", changes[0])
+		}
+	})
+
+	t.Run("更新時只列出異動的欄位並忽略系統欄位", func(t *testing.T) {
+		before := &model.HealthLog{ID: "log-1", PetID: "pet-1", Date: date, WeightKg: 4.2, Version: 1}
+		after := &model.HealthLog{ID: "log-1", PetID: "pet-1", Date: date, WeightKg: 4.5, Version: 2}
+
+		changes := DiffFields(before, after)
+		if len(changes) != 1 {
+			t.Fatalf("預期 1 個欄位異動，實際為 %+v", changes)
+		}
+		if changes[0].Field != "weight_kg" || string(changes[0].Before) != "4.2" || string(changes[0].After) != "4.5" {
+			t.Errorf("預期 weight_kg 由 4.2 變為 4.5，實際為 %+v", changes[0])
+		}
+	})
+
+	t.Run("刪除時列出所有原有欄位", func(t *testing.T) {
+		dosage := &model.MedicalRecord{PetID: "pet-1", Dosage: "5mg"}
+		changes := DiffFields(dosage, nil)
+		if len(changes) != 2 || changes[1].Field != "dosage" || changes[1].After != nil {
+			t.Errorf("預期 pet_id 與 dosage 被移除，實際為 %+v", changes)
+		}
+	})
+
+	t.Run("內嵌的結構化觀察以個別欄位比較", func(t *testing.T) {
+		before := &model.HealthLog{PetID: "pet-1", Date: date}
+		after := &model.HealthLog{PetID: "pet-1", Date: date, HealthObservations: model.HealthObservations{VomitingCount: 2}}
+
+		changes := DiffFields(before, after)
+		if len(changes) != 1 || changes[0].Field != "vomiting_count" || string(changes[0].After) != "2" {
+			t.Errorf("預期 vomiting_count 由空值變為 2，實際為 %+v", changes)
+		}
+	})
+
+	t.Run("內容相同時沒有異動", func(t *testing.T) {
+		pet := &model.Pet{Name: "Mochi", DOB: date}
+		if changes := DiffFields(pet, pet); len(changes) != 0 {
+			t.Errorf("預期沒有異動，實際為 %+v", changes)
+		}
+	})
+}
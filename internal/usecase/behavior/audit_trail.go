@@ -0,0 +1,60 @@
+package behavior
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+var ErrAuditEntityTypeInvalid = errors.New("無效的稽核資料種類")
+
+// ValidateAuditEntityType 檢查稽核資料種類，空值代表所有種類
+func ValidateAuditEntityType(entityType model.AuditEntityType) error {
+	if entityType != "" && !entityType.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAuditEntityTypeInvalid)
+	}
+	return nil
+}
+
+// AuditTrail 記錄寵物相關資料的異動歷程，供所有寫入用例共用
+// 稽核紀錄為盡力而為：寫入失敗只記錄日誌，不影響原本的操作結果
+type AuditTrail struct {
+	auditRepo repository.AuditRepository
+}
+
+// NewAuditTrail 建立新的 AuditTrail
+func NewAuditTrail(auditRepo repository.AuditRepository) *AuditTrail {
+	if auditRepo == nil {
+		panic("auditRepo is required")
+	}
+	return &AuditTrail{auditRepo: auditRepo}
+}
+
+// Record 比較異動前後的實體並寫入一筆稽核紀錄
+// 建立時 before 傳 nil，刪除時 after 傳 nil；context 中沒有使用者時記錄為系統操作
+func (a *AuditTrail) Record(c context.Context, action model.AuditAction, entityType model.AuditEntityType, petID, entityID string, before, after any) {
+	ctx := contextx.WithContext(c)
+
+	actorID, err := contextx.GetUserID(c)
+	if err != nil {
+		actorID = model.AuditSystemActor
+	}
+
+	entry := &model.AuditEntry{
+		PetID:      petID,
+		ActorID:    actorID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    DiffFields(before, after),
+	}
+
+	if err := a.auditRepo.Create(ctx, entry); err != nil {
+		ctx.Error("寫入稽核紀錄失敗", "error", err, "entity_type", entityType, "entity_id", entityID, "action", action)
+	}
+}
@@ -0,0 +1,308 @@
+package behavior
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // 註冊 GIF 解碼器
+	"image/jpeg"
+	_ "image/png" // 註冊 PNG 解碼器
+	"mime"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // 註冊 WebP 解碼器
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+const (
+	// maxAvatarPixels 頭像原圖的像素上限，於解碼前檢查以避免解壓縮炸彈
+	maxAvatarPixels = 40_000_000
+	// avatarJPEGQuality 縮圖的 JPEG 品質
+	avatarJPEGQuality = 85
+	// avatarRoutePath 頭像公開網址的路由前綴
+	avatarRoutePath = "/api/v1/avatars/"
+	// avatarKeyPrefix 頭像於 BlobStore 中的鍵值前綴
+	avatarKeyPrefix = "avatars/"
+)
+
+// AvatarSizes 頭像縮圖的邊長（像素），由小到大排列，最大尺寸作為寵物的 AvatarURL
+var AvatarSizes = []int{64, 256, 512}
+
+// AllowedAvatarContentTypes 允許上傳的頭像類型
+var AllowedAvatarContentTypes = []string{"image/jpeg", "image/png", "image/webp", "image/gif"}
+
+var (
+	ErrAvatarEmpty          = errors.New("頭像內容不可為空")
+	ErrAvatarTooLarge       = errors.New("頭像超過大小上限")
+	ErrAvatarTypeNotAllowed = errors.New("頭像僅支援 JPEG、PNG、WebP 與 GIF 圖片")
+	ErrAvatarInvalid        = errors.New("無法解析頭像圖片")
+)
+
+// avatarPathPattern 頭像公開網址中的路徑：<petID>/<隨機值>/<尺寸>.jpg
+var avatarPathPattern = regexp.MustCompile(`^[0-9A-Za-z-]+/[0-9a-f]{32}/[0-9]+\.jpg$`)
+
+// AvatarImage 處理完成的單一尺寸頭像
+type AvatarImage struct {
+	Size int
+	Data []byte
+}
+
+// ProcessAvatar 驗證頭像類型與大小後產生各尺寸的正方形 JPEG 縮圖
+// 依 EXIF 方向轉正後置中裁切；重新編碼會移除 EXIF 等所有中繼資料，透明區域以白色填滿
+func ProcessAvatar(content []byte, maxSize int64) ([]AvatarImage, error) {
+	if len(content) == 0 {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAvatarEmpty)
+	}
+	if maxSize > 0 && int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("%w: %w（上限 %d 位元組）", domain.ErrInvalidParameter, ErrAvatarTooLarge, maxSize)
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil || !slices.Contains(AllowedAvatarContentTypes, contentType) {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrAvatarTypeNotAllowed)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrAvatarInvalid, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxAvatarPixels {
+		return nil, fmt.Errorf("%w: %w（像素上限 %d）", domain.ErrInvalidParameter, ErrAvatarTooLarge, maxAvatarPixels)
+	}
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrAvatarInvalid, err)
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(content)
+	}
+
+	// 正方形置中裁切與縮放不受旋轉影響，先縮小再轉正可避免處理整張原圖
+	crop := centerSquare(src.Bounds())
+	images := make([]AvatarImage, len(AvatarSizes))
+	var larger image.Image = src
+	largerRect := crop
+	for i := len(AvatarSizes) - 1; i >= 0; i-- {
+		size := AvatarSizes[i]
+		dst := image.NewRGBA(image.Rect(0, 0, size, size))
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		xdraw.CatmullRom.Scale(dst, dst.Bounds(), larger, largerRect, draw.Over, nil)
+
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, orient(dst, orientation), &jpeg.Options{Quality: avatarJPEGQuality}); err != nil {
+			return nil, fmt.Errorf("編碼頭像失敗: %w", err)
+		}
+		images[i] = AvatarImage{Size: size, Data: buf.Bytes()}
+
+		// 較小的尺寸由已縮小的圖產生
+		larger, largerRect = dst, dst.Bounds()
+	}
+	return images, nil
+}
+
+// centerSquare 回傳置中的最大正方形範圍
+func centerSquare(r image.Rectangle) image.Rectangle {
+	side := min(r.Dx(), r.Dy())
+	x := r.Min.X + (r.Dx()-side)/2
+	y := r.Min.Y + (r.Dy()-side)/2
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// orient 依 EXIF 方向（1~8）旋轉或翻轉圖片
+func orient(img *image.RGBA, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.SetRGBA(x, y, img.RGBAAt(sx, sy))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation 自 JPEG 的 EXIF APP1 區段讀取方向標籤（0x0112），找不到時回傳 1
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 { // 影像資料開始或結束
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation 自 TIFF 結構的第一個 IFD 讀取方向標籤
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			if v := int(order.Uint16(tiff[entry+8:])); v >= 1 && v <= 8 {
+				return v
+			}
+			return 1
+		}
+	}
+	return 1
+}
+
+// PetAvatarStore 將頭像縮圖保存至 BlobStore，並產生不會過期的公開網址
+// 公開網址由 API 轉址至限時下載連結，鍵值含隨機值而無法猜測
+type PetAvatarStore struct {
+	store     service.BlobStore
+	baseURL   string
+	urlExpiry time.Duration
+}
+
+// NewPetAvatarStore 建立新的 PetAvatarStore
+func NewPetAvatarStore(cfg config.Config, store service.BlobStore) *PetAvatarStore {
+	if store == nil {
+		panic("store is required")
+	}
+	return &PetAvatarStore{
+		store:     store,
+		baseURL:   strings.TrimRight(cfg.Storage.PublicBaseURL, "/"),
+		urlExpiry: cfg.Storage.URLExpiry,
+	}
+}
+
+// Save 寫入所有尺寸的縮圖；任一尺寸失敗時移除已寫入的檔案
+func (s *PetAvatarStore) Save(c context.Context, petID string, images []AvatarImage) (*model.PetAvatar, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("產生頭像鍵值失敗: %w", err)
+	}
+	avatar := &model.PetAvatar{
+		Key:        avatarKeyPrefix + petID + "/" + hex.EncodeToString(b),
+		UploadedAt: time.Now(),
+	}
+
+	for _, img := range images {
+		obj := service.BlobObject{Key: avatarThumbnailKey(avatar.Key, img.Size), ContentType: "image/jpeg"}
+		if err := s.store.Put(c, obj, bytes.NewReader(img.Data), int64(len(img.Data))); err != nil {
+			s.Remove(c, avatar)
+			return nil, fmt.Errorf("寫入頭像失敗: %w", err)
+		}
+		avatar.Thumbnails = append(avatar.Thumbnails, model.AvatarThumbnail{
+			Size: img.Size,
+			URL:  s.baseURL + avatarRoutePath + strings.TrimPrefix(obj.Key, avatarKeyPrefix),
+		})
+	}
+	return avatar, nil
+}
+
+// Remove 刪除頭像的所有縮圖，失敗時只記錄日誌
+func (s *PetAvatarStore) Remove(c context.Context, avatar *model.PetAvatar) {
+	if avatar == nil {
+		return
+	}
+	ctx := contextx.WithContext(c)
+	for _, size := range AvatarSizes {
+		key := avatarThumbnailKey(avatar.Key, size)
+		if err := s.store.Delete(ctx, key); err != nil {
+			ctx.Error("刪除頭像檔案失敗", "error", err, "key", key)
+		}
+	}
+}
+
+// SignedURL 將頭像公開網址中的路徑轉換為限時下載連結
+func (s *PetAvatarStore) SignedURL(c context.Context, path string) (string, error) {
+	if !avatarPathPattern.MatchString(path) {
+		return "", fmt.Errorf("%w: avatar %s", domain.ErrNotFound, path)
+	}
+	obj := service.BlobObject{Key: avatarKeyPrefix + path, ContentType: "image/jpeg"}
+	return s.store.SignedURL(c, obj, time.Now().Add(s.urlExpiry))
+}
+
+// LargestAvatarURL 回傳最大尺寸縮圖的網址
+func LargestAvatarURL(avatar *model.PetAvatar) string {
+	if avatar == nil || len(avatar.Thumbnails) == 0 {
+		return ""
+	}
+	return slices.MaxFunc(avatar.Thumbnails, func(a, b model.AvatarThumbnail) int {
+		return a.Size - b.Size
+	}).URL
+}
+
+func avatarThumbnailKey(key string, size int) string {
+	return key + "/" + strconv.Itoa(size) + ".jpg"
+}
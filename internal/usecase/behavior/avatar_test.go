@@ -0,0 +1,180 @@
+package behavior
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+// quadrantImage 產生左上角為紅色、其餘為白色的圖片
+func quadrantImage(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			if x < w/2 && y < h/2 {
+				c = color.RGBA{R: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// withOrientation 在 JPEG 的 SOI 之後插入只含方向標籤的 EXIF 區段
+func withOrientation(t *testing.T, data []byte, orientation uint16) []byte {
+	t.Helper()
+
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(8))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(1))
+	_ = binary.Write(&tiff, binary.BigEndian, []uint16{0x0112, 3})
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(1))
+	_ = binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(0))
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var out bytes.Buffer
+	out.Write(data[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	_ = binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(data[2:])
+	return out.Bytes()
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func TestProcessAvatar(t *testing.T) {
+	t.Run("產生各尺寸的正方形 JPEG", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, quadrantImage(120, 60)); err != nil {
+			t.Fatal(err)
+		}
+
+		images, err := ProcessAvatar(buf.Bytes(), 0)
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if len(images) != len(AvatarSizes) {
+			t.Fatalf("預期 %d 個尺寸，實際為 %d", len(AvatarSizes), len(images))
+		}
+		for i, img := range images {
+			if img.Size != AvatarSizes[i] {
+				t.Errorf("預期尺寸 %d，實際為 %d", AvatarSizes[i], img.Size)
+			}
+			cfg, format, err := image.DecodeConfig(bytes.NewReader(img.Data))
+			if err != nil || format != "jpeg" {
+				t.Fatalf("預期 JPEG，實際為 %q (%v)", format, err)
+			}
+			if cfg.Width != img.Size || cfg.Height != img.Size {
+				t.Errorf("預期 %dx%d，實際為 %dx%d", img.Size, img.Size, cfg.Width, cfg.Height)
+			}
+		}
+	})
+
+	t.Run("依 EXIF 方向轉正並移除 EXIF", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, quadrantImage(64, 64), &jpeg.Options{Quality: 100}); err != nil {
+			t.Fatal(err)
+		}
+		content := withOrientation(t, buf.Bytes(), 6)
+		if got := jpegOrientation(content); got != 6 {
+			t.Fatalf("預期方向 6，實際為 %d", got)
+		}
+
+		images, err := ProcessAvatar(content, 0)
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		small := images[0]
+		if bytes.Contains(small.Data, []byte("Exif")) {
+			t.Error("預期輸出不含 EXIF")
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(small.Data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 順時針旋轉 90 度後，紅色由左上角移至右上角
+		size := small.Size
+		if !isRed(img.At(size*3/4, size/4)) || isRed(img.At(size/4, size/4)) {
+			t.Error("預期紅色區塊位於右上角")
+		}
+	})
+
+	t.Run("沒有 EXIF 時視為正常方向", func(t *testing.T) {
+		if got := jpegOrientation([]byte("\xff\xd8\xff\xdb\x00\x02")); got != 1 {
+			t.Errorf("預期方向 1，實際為 %d", got)
+		}
+	})
+
+	t.Run("拒絕無效內容", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, quadrantImage(8, 8)); err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			name    string
+			content []byte
+			maxSize int64
+			wantErr error
+		}{
+			{"空檔案", nil, 0, ErrAvatarEmpty},
+			{"超過大小上限", buf.Bytes(), 4, ErrAvatarTooLarge},
+			{"PDF 文件", []byte("%PDF-1.7\n"), 0, ErrAvatarTypeNotAllowed},
+			{"損毀的 PNG", buf.Bytes()[:20], 0, ErrAvatarInvalid},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := ProcessAvatar(tt.content, tt.maxSize)
+				if !errors.Is(err, tt.wantErr) || !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Errorf("預期 %v，實際為 %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+}
+
+func TestOrient(t *testing.T) {
+	// 2x1 的圖片：左紅右白
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.SetRGBA(0, 0, color.RGBA{R: 255, A: 255})
+	src.SetRGBA(1, 0, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	tests := []struct {
+		name        string
+		orientation int
+		w, h        int
+		redX, redY  int
+	}{
+		{"正常", 1, 2, 1, 0, 0},
+		{"水平翻轉", 2, 2, 1, 1, 0},
+		{"旋轉 180 度", 3, 2, 1, 1, 0},
+		{"順時針 90 度", 6, 1, 2, 0, 0},
+		{"逆時針 90 度", 8, 1, 2, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := orient(src, tt.orientation)
+			if b := got.Bounds(); b.Dx() != tt.w || b.Dy() != tt.h {
+				t.Fatalf("預期 %dx%d，實際為 %dx%d", tt.w, tt.h, b.Dx(), b.Dy())
+			}
+			if !isRed(got.At(tt.redX, tt.redY)) {
+				t.Errorf("預期 (%d,%d) 為紅色", tt.redX, tt.redY)
+			}
+		})
+	}
+}
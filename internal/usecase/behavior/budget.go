@@ -0,0 +1,287 @@
+package behavior
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// maxBudgetThresholdPct 門檻百分比上限，允許設定超支警示（例如 150%）
+const maxBudgetThresholdPct = 1000
+
+var (
+	ErrBudgetPeriodInvalid    = errors.New("無效的預算週期")
+	ErrBudgetLimitInvalid     = errors.New("預算金額必須大於 0")
+	ErrBudgetThresholdInvalid = errors.New("預算門檻必須介於 1 到 1000 之間且不可重複")
+)
+
+// NormalizeBudget 驗證預算設定並整理門檻：未指定時套用預設值，並由小到大排序
+func NormalizeBudget(b *model.Budget) error {
+	if !b.Period.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrBudgetPeriodInvalid)
+	}
+	if b.Limit <= 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrBudgetLimitInvalid)
+	}
+
+	if len(b.Thresholds) == 0 {
+		b.Thresholds = slices.Clone(model.DefaultBudgetThresholds)
+	}
+	thresholds := slices.Clone(b.Thresholds)
+	slices.Sort(thresholds)
+	for i, pct := range thresholds {
+		if pct <= 0 || pct > maxBudgetThresholdPct || (i > 0 && thresholds[i-1] == pct) {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrBudgetThresholdInvalid)
+		}
+	}
+	b.Thresholds = thresholds
+	b.Category = strings.TrimSpace(b.Category)
+
+	return nil
+}
+
+// AuthorizeBudget 檢查預算是否由使用者設定，預算僅供設定者檢視與管理
+func AuthorizeBudget(b *model.Budget, userID string) error {
+	if b.OwnerID != userID {
+		return fmt.Errorf("budget %s: %w", b.ID, domain.ErrForbidden)
+	}
+	return nil
+}
+
+// BudgetPeriodRange 回傳 now 所在預算週期的起訖時間（UTC），end 為下個週期的起點
+func BudgetPeriodRange(period model.BudgetPeriod, now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	if period == model.BudgetPeriodYearly {
+		start = time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0)
+	}
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// BuildBudgetStatus 依週期花費與本月花費計算預算狀態
+// 月底推估以本月至今的平均每日花費估算剩餘天數，再加上週期累計花費
+func BuildBudgetStatus(b *model.Budget, spent, monthSpent int, now time.Time) *model.BudgetStatus {
+	start, end := BudgetPeriodRange(b.Period, now)
+	now = now.UTC()
+
+	daysInMonth := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	elapsed := now.Day()
+	projected := spent + int(math.Round(float64(monthSpent)/float64(elapsed)*float64(daysInMonth-elapsed)))
+
+	status := &model.BudgetStatus{
+		Budget:            b,
+		PeriodStart:       start,
+		PeriodEnd:         end,
+		Spent:             spent,
+		Remaining:         b.Limit - spent,
+		ProjectedMonthEnd: projected,
+		ProjectedOverrun:  projected > b.Limit,
+	}
+	if b.Limit > 0 {
+		status.UsedPct = roundTo(float64(spent)/float64(b.Limit)*100, 2)
+	}
+	return status
+}
+
+// CrossedBudgetThreshold 回傳本週期花費已達到、且尚未發出警示的最高門檻
+func CrossedBudgetThreshold(b *model.Budget, spent int, periodStart time.Time) (int, bool) {
+	if b.Limit <= 0 {
+		return 0, false
+	}
+	alerted := b.AlertedIn(periodStart)
+	crossed := 0
+	for _, pct := range b.Thresholds {
+		if pct > alerted && spent*100 >= pct*b.Limit {
+			crossed = pct
+		}
+	}
+	return crossed, crossed > 0
+}
+
+// BudgetTracker 計算預算的花費狀況，並在費用寫入後檢查是否突破警示門檻
+// 門檻檢查為盡力而為：失敗只記錄日誌，不影響費用本身的寫入結果
+type BudgetTracker struct {
+	budgetRepo  repository.BudgetRepository
+	expenseRepo repository.ExpenseRepository
+	petRepo     repository.PetRepository
+	publisher   service.EventPublisher
+	currencies  *CurrencyConverter
+}
+
+// NewBudgetTracker 建立新的 BudgetTracker
+func NewBudgetTracker(
+	budgetRepo repository.BudgetRepository,
+	expenseRepo repository.ExpenseRepository,
+	petRepo repository.PetRepository,
+	publisher service.EventPublisher,
+	currencies *CurrencyConverter,
+) *BudgetTracker {
+	if budgetRepo == nil || expenseRepo == nil || petRepo == nil || publisher == nil || currencies == nil {
+		panic("budgetRepo, expenseRepo, petRepo, publisher and currencies are required")
+	}
+	return &BudgetTracker{
+		budgetRepo:  budgetRepo,
+		expenseRepo: expenseRepo,
+		petRepo:     petRepo,
+		publisher:   publisher,
+		currencies:  currencies,
+	}
+}
+
+// Status 計算預算在 now 所在週期的執行狀況，金額以預算擁有者的基準貨幣表示
+func (t *BudgetTracker) Status(c context.Context, b *model.Budget, now time.Time) (*model.BudgetStatus, error) {
+	conversion, err := t.conversion(c, b)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := BudgetPeriodRange(b.Period, now)
+	spent, unconverted, err := t.spent(c, b, conversion, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	monthSpent := spent
+	if b.Period != model.BudgetPeriodMonthly {
+		monthStart, monthEnd := BudgetPeriodRange(model.BudgetPeriodMonthly, now)
+		if monthSpent, _, err = t.spent(c, b, conversion, monthStart, monthEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	status := BuildBudgetStatus(b, spent, monthSpent, now)
+	status.Currency = conversion.Base()
+	status.Unconverted = unconverted
+	return status, nil
+}
+
+// Check 在費用新增或修改後，檢查涵蓋該費用的預算是否突破門檻並發布事件
+func (t *BudgetTracker) Check(c context.Context, pet *model.Pet, expense *model.Expense) {
+	ctx := contextx.WithContext(c)
+	now := time.Now()
+
+	budgets, err := t.budgetRepo.FindApplicable(ctx, pet.OwnerID, pet.ID)
+	if err != nil {
+		ctx.Error("查詢適用預算失敗", "error", err, "pet_id", pet.ID)
+		return
+	}
+
+	for _, b := range budgets {
+		if !b.Covers(expense, pet.OwnerID) {
+			continue
+		}
+		// 只有計入目前週期的費用才可能觸發警示
+		start, end := BudgetPeriodRange(b.Period, now)
+		if expense.Date.Before(start) || !expense.Date.Before(end) {
+			continue
+		}
+
+		if err := t.checkBudget(ctx, b, start, end, now); err != nil {
+			ctx.Error("檢查預算門檻失敗", "error", err, "budget_id", b.ID)
+		}
+	}
+}
+
+func (t *BudgetTracker) checkBudget(ctx *contextx.Contextx, b *model.Budget, start, end, now time.Time) error {
+	conversion, err := t.conversion(ctx, b)
+	if err != nil {
+		return err
+	}
+	spent, unconverted, err := t.spent(ctx, b, conversion, start, end)
+	if err != nil {
+		return err
+	}
+	if len(unconverted) > 0 {
+		ctx.Warn("部分費用缺少匯率，未計入預算花費", "budget_id", b.ID, "unconverted", len(unconverted))
+	}
+
+	pct, crossed := CrossedBudgetThreshold(b, spent, start)
+	if !crossed {
+		return nil
+	}
+
+	// 先記錄警示狀態再發布事件；版本衝突代表有其他請求同時處理，交由對方發布
+	b.AlertedPeriodStart = &start
+	b.AlertedPct = pct
+	if err := t.budgetRepo.Update(ctx, b); err != nil {
+		if domain.IsUpdateConflict(err) {
+			ctx.Warn("預算警示狀態已被更新，略過本次通知", "budget_id", b.ID)
+			return nil
+		}
+		return err
+	}
+
+	ctx.Info("預算突破門檻", "budget_id", b.ID, "threshold_pct", pct, "spent", spent, "limit", b.Limit)
+
+	return t.publisher.Publish(ctx, model.BudgetThresholdCrossed{
+		BudgetID:     b.ID,
+		OwnerID:      b.OwnerID,
+		PetID:        b.PetID,
+		Category:     b.Category,
+		Period:       b.Period,
+		PeriodStart:  start,
+		ThresholdPct: pct,
+		Spent:        spent,
+		Limit:        b.Limit,
+		At:           now,
+		Currency:     conversion.Base(),
+	})
+}
+
+// conversion 建立換算為預算擁有者基準貨幣的工作階段
+func (t *BudgetTracker) conversion(c context.Context, b *model.Budget) (*CurrencyConversion, error) {
+	base, err := t.currencies.BaseCurrency(c, b.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	return t.currencies.NewConversion(base), nil
+}
+
+// spent 加總預算在 [start, end) 期間涵蓋的費用，依各筆消費日期的匯率換算
+// 缺少匯率的費用不計入花費，改依幣別合計後另行回傳
+func (t *BudgetTracker) spent(c context.Context, b *model.Budget, conversion *CurrencyConversion, start, end time.Time) (int, []*model.UnconvertedExpense, error) {
+	opts := []repository.ExpenseQueryOption{repository.WithDateRange(start, end.Add(-time.Nanosecond))}
+	if b.PetID != "" {
+		opts = append(opts, repository.WithPetID(b.PetID))
+	} else {
+		petIDs, err := t.petRepo.FindIDsByOwnerID(c, b.OwnerID)
+		if err != nil {
+			return 0, nil, fmt.Errorf("查詢飼主寵物失敗: %w", err)
+		}
+		opts = append(opts, repository.WithPetIDs(petIDs))
+	}
+	if b.Category != "" {
+		opts = append(opts, repository.WithCategory(b.Category))
+	}
+
+	expenses, _, err := t.expenseRepo.FindAll(c, opts...)
+	if err != nil {
+		return 0, nil, fmt.Errorf("查詢預算期間費用失敗: %w", err)
+	}
+
+	total := 0
+	var unconverted []*model.UnconvertedExpense
+	for _, expense := range expenses {
+		amount, err := conversion.Convert(c, expense.Amount, expense.Currency, expense.Date)
+		if errors.Is(err, ErrExchangeRateNotFound) {
+			unconverted = addUnconvertedExpense(unconverted, expense.Currency, expense.Amount, 1)
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		total += amount
+	}
+	return total, unconverted, nil
+}
@@ -0,0 +1,131 @@
+package behavior
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestNormalizeBudget(t *testing.T) {
+	t.Run("未指定門檻時套用預設值", func(t *testing.T) {
+		b := &model.Budget{Period: model.BudgetPeriodMonthly, Limit: 1000}
+		if err := NormalizeBudget(b); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if !slices.Equal(b.Thresholds, model.DefaultBudgetThresholds) {
+			t.Errorf("預期門檻 %v，實際為 %v", model.DefaultBudgetThresholds, b.Thresholds)
+		}
+	})
+
+	t.Run("門檻會由小到大排序", func(t *testing.T) {
+		b := &model.Budget{Period: model.BudgetPeriodYearly, Limit: 1000, Thresholds: []int{100, 50, 120}}
+		if err := NormalizeBudget(b); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if !slices.Equal(b.Thresholds, []int{50, 100, 120}) {
+			t.Errorf("預期門檻已排序，實際為 %v", b.Thresholds)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		budget *model.Budget
+		want   error
+	}{
+		{"無效週期", &model.Budget{Period: "weekly", Limit: 1000}, ErrBudgetPeriodInvalid},
+		{"金額為 0", &model.Budget{Period: model.BudgetPeriodMonthly}, ErrBudgetLimitInvalid},
+		{"門檻為 0", &model.Budget{Period: model.BudgetPeriodMonthly, Limit: 1000, Thresholds: []int{0}}, ErrBudgetThresholdInvalid},
+		{"門檻重複", &model.Budget{Period: model.BudgetPeriodMonthly, Limit: 1000, Thresholds: []int{80, 80}}, ErrBudgetThresholdInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NormalizeBudget(tt.budget)
+			if !errors.Is(err, tt.want) || !errors.Is(err, domain.ErrInvalidParameter) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestBudgetPeriodRange(t *testing.T) {
+	now := time.Date(2024, time.February, 15, 10, 0, 0, 0, time.UTC)
+
+	start, end := BudgetPeriodRange(model.BudgetPeriodMonthly, now)
+	if !start.Equal(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("月週期預期為 2 月，實際為 %v ~ %v", start, end)
+	}
+
+	start, end = BudgetPeriodRange(model.BudgetPeriodYearly, now)
+	if !start.Equal(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("年週期預期為 2024 年，實際為 %v ~ %v", start, end)
+	}
+}
+
+func TestBuildBudgetStatus(t *testing.T) {
+	// 2024 年 4 月共 30 天，10 日時已花費 1000，平均每日 100
+	now := time.Date(2024, time.April, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("月預算依平均每日花費推估月底", func(t *testing.T) {
+		b := &model.Budget{Period: model.BudgetPeriodMonthly, Limit: 2500}
+		status := BuildBudgetStatus(b, 1000, 1000, now)
+		if status.ProjectedMonthEnd != 3000 {
+			t.Errorf("預期月底推估 3000，實際為 %d", status.ProjectedMonthEnd)
+		}
+		if !status.ProjectedOverrun {
+			t.Error("預期推估超支")
+		}
+		if status.Remaining != 1500 || status.UsedPct != 40 {
+			t.Errorf("預期剩餘 1500、使用 40%%，實際為 %d、%v", status.Remaining, status.UsedPct)
+		}
+	})
+
+	t.Run("年預算以年度累計加上本月剩餘天數推估", func(t *testing.T) {
+		b := &model.Budget{Period: model.BudgetPeriodYearly, Limit: 12000}
+		status := BuildBudgetStatus(b, 5000, 1000, now)
+		if status.ProjectedMonthEnd != 7000 {
+			t.Errorf("預期月底推估 7000，實際為 %d", status.ProjectedMonthEnd)
+		}
+		if status.ProjectedOverrun {
+			t.Error("預期未超支")
+		}
+	})
+}
+
+func TestCrossedBudgetThreshold(t *testing.T) {
+	periodStart := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+	lastPeriod := periodStart.AddDate(0, -1, 0)
+
+	tests := []struct {
+		name          string
+		spent         int
+		alertedPeriod *time.Time
+		alertedPct    int
+		want          int
+		wantCrossed   bool
+	}{
+		{"未達門檻", 790, nil, 0, 0, false},
+		{"達到第一個門檻", 800, nil, 0, 80, true},
+		{"同時跨越多個門檻回傳最高者", 1200, nil, 0, 100, true},
+		{"本期已警示過的門檻不重複", 900, &periodStart, 80, 0, false},
+		{"本期已警示後跨越更高門檻", 1000, &periodStart, 80, 100, true},
+		{"上期的警示不影響本期", 850, &lastPeriod, 100, 80, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &model.Budget{
+				Limit:              1000,
+				Thresholds:         []int{80, 100},
+				AlertedPeriodStart: tt.alertedPeriod,
+				AlertedPct:         tt.alertedPct,
+			}
+			got, crossed := CrossedBudgetThreshold(b, tt.spent, periodStart)
+			if got != tt.want || crossed != tt.wantCrossed {
+				t.Errorf("預期 (%d, %v)，實際為 (%d, %v)", tt.want, tt.wantCrossed, got, crossed)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package behavior
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+const (
+	// CalendarFeedContentType 行事曆訂閱的 MIME 類型
+	CalendarFeedContentType = "text/calendar; charset=utf-8"
+	// CalendarFeedPastWindow 已過期的到期事件仍保留在訂閱中的期間，讓逾期未處理的事件不會立即消失
+	CalendarFeedPastWindow = 90 * 24 * time.Hour
+	// calendarRefreshInterval 建議行事曆用戶端重新讀取訂閱的間隔
+	calendarRefreshInterval = "PT6H"
+	// icsLineLimit 每行內容的位元組上限，超過時需折行
+	icsLineLimit = 75
+)
+
+// NewCalendarFeedToken 產生隨機的行事曆訂閱 token，回傳明文與其雜湊值
+func NewCalendarFeedToken() (token string, tokenHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("產生行事曆訂閱 token 失敗: %w", err)
+	}
+	token = hex.EncodeToString(buf)
+	return token, HashCalendarFeedToken(token), nil
+}
+
+// HashCalendarFeedToken 計算行事曆訂閱 token 的雜湊值，資料庫只保存雜湊值
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CalendarFeedRecords 挑出要發佈到行事曆的醫療記錄，依到期日由近到遠排列
+// 同一寵物、種類與描述的紀錄只保留最新一筆，舊紀錄的到期日已由新的紀錄取代
+// 到期日早於 now 減去 CalendarFeedPastWindow 的紀錄不發佈
+func CalendarFeedRecords(records []*model.MedicalRecord, now time.Time) []*model.MedicalRecord {
+	latest := latestMedicalRecords(records)
+
+	cutoff := now.Add(-CalendarFeedPastWindow)
+	out := make([]*model.MedicalRecord, 0, len(latest))
+	for _, record := range latest {
+		if record.NextDueDate != nil && !record.NextDueDate.Before(cutoff) {
+			out = append(out, record)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].NextDueDate.Equal(*out[j].NextDueDate) {
+			return out[i].NextDueDate.Before(*out[j].NextDueDate)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// medicalRecordKey 同一寵物、種類與描述的醫療記錄視為同一照護事項
+func medicalRecordKey(record *model.MedicalRecord) string {
+	return strings.Join([]string{record.PetID, string(record.Type), strings.ToLower(strings.TrimSpace(record.Description))}, "\x00")
+}
+
+// latestMedicalRecords 依照護事項分組，只保留日期最新的一筆
+func latestMedicalRecords(records []*model.MedicalRecord) map[string]*model.MedicalRecord {
+	latest := make(map[string]*model.MedicalRecord, len(records))
+	for _, record := range records {
+		key := medicalRecordKey(record)
+		if current, ok := latest[key]; !ok || record.Date.After(current.Date) {
+			latest[key] = record
+		}
+	}
+	return latest
+}
+
+// CalendarEventUID 回傳醫療記錄到期事件的 UID，以記錄 ID 產生，到期日變更時用戶端會更新同一事件
+func CalendarEventUID(recordID string) string {
+	return fmt.Sprintf("medical-record-%s@petlog", recordID)
+}
+
+// BuildCalendarFeed 將醫療記錄的到期日輸出為 iCalendar (RFC 5545) 內容
+// 每筆到期事件為全天事件，並附上到期日前 leadDays 天的提醒
+func BuildCalendarFeed(pets []*model.Pet, records []*model.MedicalRecord, leadDays int, now time.Time) []byte {
+	petNames := make(map[string]string, len(pets))
+	for _, pet := range pets {
+		petNames[pet.ID] = pet.Name
+	}
+
+	trigger := "PT0S"
+	if leadDays > 0 {
+		trigger = fmt.Sprintf("-P%dD", leadDays)
+	}
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	var buf bytes.Buffer
+	w := icsWriter{buf: &buf}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//PetLog//Pet Care Calendar//ZH")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + escapeICSText("PetLog 寵物照護"))
+	w.line("REFRESH-INTERVAL;VALUE=DURATION:" + calendarRefreshInterval)
+	w.line("X-PUBLISHED-TTL:" + calendarRefreshInterval)
+
+	for _, record := range CalendarFeedRecords(records, now) {
+		due := record.NextDueDate.UTC()
+		title := ReminderTitle(record)
+		if name := petNames[record.PetID]; name != "" {
+			title = fmt.Sprintf("%s - %s", name, title)
+		}
+
+		description := []string{"上次紀錄：" + record.Date.UTC().Format(time.DateOnly)}
+		if record.Dosage != "" {
+			description = append(description, "劑量："+record.Dosage)
+		}
+
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + CalendarEventUID(record.ID))
+		w.line("DTSTAMP:" + stamp)
+		w.line("SEQUENCE:" + fmt.Sprint(record.Version))
+		w.line("DTSTART;VALUE=DATE:" + due.Format("20060102"))
+		w.line("DTEND;VALUE=DATE:" + due.AddDate(0, 0, 1).Format("20060102"))
+		w.line("SUMMARY:" + escapeICSText(title))
+		w.line("DESCRIPTION:" + escapeICSText(strings.Join(description, "\n")))
+		w.line("CATEGORIES:" + escapeICSText(string(record.Type)))
+		w.line("TRANSP:TRANSPARENT")
+		w.line("BEGIN:VALARM")
+		w.line("ACTION:DISPLAY")
+		w.line("DESCRIPTION:" + escapeICSText(title))
+		w.line("TRIGGER:" + trigger)
+		w.line("END:VALARM")
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// icsWriter 依 RFC 5545 以 CRLF 結尾並將過長的內容行折行
+type icsWriter struct {
+	buf *bytes.Buffer
+}
+
+// line 寫入一行內容，超過 75 位元組時於字元邊界折行，續行以空白開頭
+func (w icsWriter) line(content string) {
+	limit := icsLineLimit
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		// 續行開頭的空白也計入長度
+		limit = icsLineLimit - 1
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+// escapeICSText 跳脫 TEXT 值中的反斜線、分號、逗號與換行
+func escapeICSText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
@@ -0,0 +1,103 @@
+package behavior
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestCalendarFeedToken(t *testing.T) {
+	token, hash, err := NewCalendarFeedToken()
+	if err != nil {
+		t.Fatalf("產生 token 失敗: %v", err)
+	}
+	if token == hash || HashCalendarFeedToken(token) != hash {
+		t.Error("雜湊值應由明文 token 計算且不等於明文")
+	}
+
+	other, _, _ := NewCalendarFeedToken()
+	if other == token {
+		t.Error("每次產生的 token 應不同")
+	}
+}
+
+func TestCalendarFeedRecords(t *testing.T) {
+	now := utcDate(2025, 6, 1)
+	record := func(id, petID string, recordType model.MedicalRecordType, description string, date, nextDue time.Time) *model.MedicalRecord {
+		return &model.MedicalRecord{ID: id, PetID: petID, Type: recordType, Description: description, Date: date, NextDueDate: &nextDue}
+	}
+
+	records := []*model.MedicalRecord{
+		record("old", "pet-1", model.RecordTypeVaccination, "Rabies", utcDate(2024, 7, 1), utcDate(2025, 7, 1)),
+		record("new", "pet-1", model.RecordTypeVaccination, "rabies ", utcDate(2025, 5, 30), utcDate(2026, 6, 1)),
+		record("pet-2", "pet-2", model.RecordTypeVaccination, "Rabies", utcDate(2024, 7, 1), utcDate(2025, 7, 1)),
+		record("overdue", "pet-1", model.RecordTypeDeworming, "", utcDate(2025, 2, 1), utcDate(2025, 5, 1)),
+		record("expired", "pet-1", model.RecordTypeMedication, "", utcDate(2024, 12, 1), utcDate(2025, 1, 1)),
+		{ID: "visit", PetID: "pet-1", Type: model.RecordTypeVetVisit, Date: utcDate(2025, 5, 1)},
+	}
+
+	got := CalendarFeedRecords(records, now)
+
+	var ids []string
+	for _, r := range got {
+		ids = append(ids, r.ID)
+	}
+	if want := []string{"overdue", "pet-2", "new"}; !slices.Equal(ids, want) {
+		t.Errorf("預期 %v，實際為 %v", want, ids)
+	}
+}
+
+func TestBuildCalendarFeed(t *testing.T) {
+	now := utcDate(2025, 6, 1)
+	next := utcDate(2025, 9, 15)
+	pets := []*model.Pet{{ID: "pet-1", Name: "Mochi"}}
+	records := []*model.MedicalRecord{{
+		ID:          "rec-1",
+		PetID:       "pet-1",
+		Type:        model.RecordTypeMedication,
+		Description: "Heartworm, monthly; " + strings.Repeat("長", 40),
+		Dosage:      "1 tablet",
+		Date:        utcDate(2025, 8, 15),
+		NextDueDate: &next,
+		Version:     3,
+	}}
+
+	feed := string(BuildCalendarFeed(pets, records, 7, now))
+
+	if !strings.HasPrefix(feed, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(feed, "END:VCALENDAR\r\n") {
+		t.Fatalf("行事曆開頭或結尾不正確:\n%s", feed)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(feed, "\r\n"), "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Errorf("內容行超過 %d 位元組: %q", icsLineLimit, line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(feed, "\r\n ", "")
+	for _, want := range []string{
+		"UID:medical-record-rec-1@petlog\r\n",
+		"SEQUENCE:3\r\n",
+		"DTSTART;VALUE=DATE:20250915\r\n",
+		"DTEND;VALUE=DATE:20250916\r\n",
+		`SUMMARY:Mochi - 用藥：Heartworm\, monthly\; `,
+		`DESCRIPTION:上次紀錄：2025-08-15\n劑量：1 tablet` + "\r\n",
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\n",
+		"TRIGGER:-P7D\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("行事曆缺少 %q", want)
+		}
+	}
+
+	t.Run("UID 不隨到期日或產生時間改變", func(t *testing.T) {
+		later := utcDate(2025, 10, 15)
+		records[0].NextDueDate = &later
+		again := strings.ReplaceAll(string(BuildCalendarFeed(pets, records, 0, now.AddDate(0, 0, 1))), "\r\n ", "")
+		if !strings.Contains(again, "UID:medical-record-rec-1@petlog\r\n") || !strings.Contains(again, "TRIGGER:PT0S\r\n") {
+			t.Errorf("UID 或提醒不正確:\n%s", again)
+		}
+	})
+}
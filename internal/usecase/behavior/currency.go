@@ -0,0 +1,248 @@
+package behavior
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+)
+
+// exchangeRateDateLayout 匯率檔案的日期格式
+const exchangeRateDateLayout = "2006-01-02"
+
+var (
+	ErrCurrencyInvalid      = errors.New("幣別必須為 3 碼英文字母的 ISO 4217 代碼")
+	ErrExchangeRateInvalid  = errors.New("匯率必須指定幣別與日期，且數值大於 0；參考貨幣的匯率恆為 1 不需設定")
+	ErrExchangeRateNotFound = errors.New("找不到幣別的匯率")
+	ErrAdminRequired        = errors.New("此操作僅限管理者")
+)
+
+// NormalizeCurrency 驗證幣別代碼並轉為大寫
+func NormalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrCurrencyInvalid)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return "", fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrCurrencyInvalid)
+		}
+	}
+	return code, nil
+}
+
+// NormalizeExchangeRates 驗證匯率並將日期正規化為 UTC 當日零時
+func NormalizeExchangeRates(rates []*model.ExchangeRate, reference string) error {
+	for _, rate := range rates {
+		currency, err := NormalizeCurrency(rate.Currency)
+		if err != nil {
+			return err
+		}
+		if currency == reference || rate.Date.IsZero() || rate.Rate <= 0 || math.IsInf(rate.Rate, 0) || math.IsNaN(rate.Rate) {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrExchangeRateInvalid)
+		}
+		rate.Currency = currency
+		rate.Date = StartOfUTCDay(rate.Date)
+	}
+	return nil
+}
+
+// ParseExchangeRatesCSV 解析匯率 CSV，每列為 date,currency,rate，日期格式為 2006-01-02
+// 第一列為 date 開頭的標題列時略過
+func ParseExchangeRatesCSV(r io.Reader) ([]*model.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: 匯率檔案格式錯誤: %w", domain.ErrInvalidParameter, err)
+	}
+
+	rates := make([]*model.ExchangeRate, 0, len(records))
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+		date, err := time.Parse(exchangeRateDateLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: 第 %d 列日期格式錯誤: %w", domain.ErrInvalidParameter, i+1, err)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: 第 %d 列匯率格式錯誤: %w", domain.ErrInvalidParameter, i+1, err)
+		}
+		rates = append(rates, &model.ExchangeRate{Currency: record[1], Date: date, Rate: rate})
+	}
+	return rates, nil
+}
+
+// FindExchangeRate 自依日期排序的匯率表中取得 on 當日適用的匯率：
+// 取當日或之前最近的一筆；早於所有資料時取最早的一筆
+func FindExchangeRate(rates []*model.ExchangeRate, on time.Time) (float64, bool) {
+	if len(rates) == 0 {
+		return 0, false
+	}
+	day := StartOfUTCDay(on)
+	i, found := slices.BinarySearchFunc(rates, day, func(rate *model.ExchangeRate, t time.Time) int {
+		return rate.Date.Compare(t)
+	})
+	switch {
+	case found:
+		return rates[i].Rate, true
+	case i == 0:
+		return rates[0].Rate, true
+	default:
+		return rates[i-1].Rate, true
+	}
+}
+
+// ConvertAmount 透過參考貨幣換算金額，四捨五入至整數
+func ConvertAmount(amount int, fromRate, toRate float64) int {
+	return int(math.Round(float64(amount) * toRate / fromRate))
+}
+
+// AuthorizeAdmin 檢查使用者是否為設定中的管理者
+func AuthorizeAdmin(adminIDs []string, userID string) error {
+	if userID == "" || !slices.Contains(adminIDs, userID) {
+		return fmt.Errorf("%w: %w", domain.ErrForbidden, ErrAdminRequired)
+	}
+	return nil
+}
+
+// CurrencyConverter 依使用者的基準貨幣與匯率表換算費用金額
+type CurrencyConverter struct {
+	rateRepo     repository.ExchangeRateRepository
+	settingsRepo repository.UserSettingsRepository
+	defaultBase  string
+	reference    string
+}
+
+// NewCurrencyConverter 建立新的 CurrencyConverter
+func NewCurrencyConverter(cfg config.Config, rateRepo repository.ExchangeRateRepository, settingsRepo repository.UserSettingsRepository) *CurrencyConverter {
+	if rateRepo == nil || settingsRepo == nil {
+		panic("rateRepo and settingsRepo are required")
+	}
+	return &CurrencyConverter{
+		rateRepo:     rateRepo,
+		settingsRepo: settingsRepo,
+		defaultBase:  strings.ToUpper(cfg.Currency.DefaultBase),
+		reference:    strings.ToUpper(cfg.Currency.Reference),
+	}
+}
+
+// DefaultCurrency 回傳系統預設貨幣
+func (cv *CurrencyConverter) DefaultCurrency() string {
+	return cv.defaultBase
+}
+
+// ReferenceCurrency 回傳匯率表的參考貨幣
+func (cv *CurrencyConverter) ReferenceCurrency() string {
+	return cv.reference
+}
+
+// BaseCurrency 回傳使用者的基準貨幣，未設定時為系統預設貨幣
+func (cv *CurrencyConverter) BaseCurrency(c context.Context, userID string) (string, error) {
+	settings, err := cv.settingsRepo.FindByUserID(c, userID)
+	if err != nil {
+		if domain.IsNotFound(err) {
+			return cv.defaultBase, nil
+		}
+		return "", fmt.Errorf("查詢使用者設定失敗: %w", err)
+	}
+	if settings.BaseCurrency == "" {
+		return cv.defaultBase, nil
+	}
+	return settings.BaseCurrency, nil
+}
+
+// EnsureConvertible 確認幣別可經由參考貨幣換算為 base，避免寫入無法納入統計的費用
+// 兩者相同時不需匯率；否則各自須為參考貨幣或已有匯率資料
+func (cv *CurrencyConverter) EnsureConvertible(c context.Context, currency, base string) error {
+	if currency == base {
+		return nil
+	}
+	for _, code := range []string{currency, base} {
+		if code == cv.reference {
+			continue
+		}
+		table, err := cv.rateRepo.FindByCurrency(c, code)
+		if err != nil {
+			return fmt.Errorf("查詢匯率失敗: %w", err)
+		}
+		if len(table) == 0 {
+			return fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrExchangeRateNotFound, code)
+		}
+	}
+	return nil
+}
+
+// NewConversion 建立換算為 base 的工作階段，同一工作階段內每個幣別的匯率表只查詢一次
+func (cv *CurrencyConverter) NewConversion(base string) *CurrencyConversion {
+	return &CurrencyConversion{cv: cv, base: base, tables: make(map[string][]*model.ExchangeRate)}
+}
+
+// CurrencyConversion 換算為單一基準貨幣的工作階段，不可跨 goroutine 共用
+type CurrencyConversion struct {
+	cv     *CurrencyConverter
+	base   string
+	tables map[string][]*model.ExchangeRate
+}
+
+// Base 回傳換算的目標貨幣
+func (s *CurrencyConversion) Base() string {
+	return s.base
+}
+
+// Convert 以 on 當日的匯率將金額換算為基準貨幣，空的幣別視為系統預設貨幣
+func (s *CurrencyConversion) Convert(c context.Context, amount int, currency string, on time.Time) (int, error) {
+	if currency == "" {
+		currency = s.cv.defaultBase
+	}
+	if currency == s.base {
+		return amount, nil
+	}
+
+	fromRate, err := s.rate(c, currency, on)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := s.rate(c, s.base, on)
+	if err != nil {
+		return 0, err
+	}
+	return ConvertAmount(amount, fromRate, toRate), nil
+}
+
+func (s *CurrencyConversion) rate(c context.Context, currency string, on time.Time) (float64, error) {
+	if currency == s.cv.reference {
+		return 1, nil
+	}
+
+	table, ok := s.tables[currency]
+	if !ok {
+		var err error
+		if table, err = s.cv.rateRepo.FindByCurrency(c, currency); err != nil {
+			return 0, fmt.Errorf("查詢匯率失敗: %w", err)
+		}
+		s.tables[currency] = table
+	}
+
+	rate, ok := FindExchangeRate(table, on)
+	if !ok {
+		return 0, fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrExchangeRateNotFound, currency)
+	}
+	return rate, nil
+}
@@ -0,0 +1,211 @@
+package behavior
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNormalizeCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{"大寫代碼", "TWD", "TWD", false},
+		{"小寫代碼轉大寫", " usd ", "USD", false},
+		{"長度不符", "US", "", true},
+		{"包含數字", "U5D", "", true},
+		{"空字串", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeCurrency(tt.code)
+			if tt.wantErr {
+				if !errors.Is(err, ErrCurrencyInvalid) || !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Errorf("預期幣別無效錯誤，實際為 %v", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("預期 %s，實際為 %s（錯誤：%v）", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeExchangeRates(t *testing.T) {
+	t.Run("正規化幣別與日期", func(t *testing.T) {
+		rates := []*model.ExchangeRate{{Currency: "twd", Date: time.Date(2024, time.March, 5, 15, 30, 0, 0, time.UTC), Rate: 31.5}}
+		if err := NormalizeExchangeRates(rates, "USD"); err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if rates[0].Currency != "TWD" || !rates[0].Date.Equal(utcDate(2024, time.March, 5)) {
+			t.Errorf("正規化結果錯誤: %+v", rates[0])
+		}
+	})
+
+	tests := []struct {
+		name string
+		rate *model.ExchangeRate
+	}{
+		{"參考貨幣不需設定", &model.ExchangeRate{Currency: "USD", Date: utcDate(2024, time.March, 5), Rate: 1}},
+		{"匯率必須大於 0", &model.ExchangeRate{Currency: "TWD", Date: utcDate(2024, time.March, 5), Rate: 0}},
+		{"日期為必填", &model.ExchangeRate{Currency: "TWD", Rate: 31.5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NormalizeExchangeRates([]*model.ExchangeRate{tt.rate}, "USD")
+			if !errors.Is(err, ErrExchangeRateInvalid) {
+				t.Errorf("預期匯率無效錯誤，實際為 %v", err)
+			}
+		})
+	}
+}
+
+func TestParseExchangeRatesCSV(t *testing.T) {
+	t.Run("略過標題列與註解", func(t *testing.T) {
+		input := "date,currency,rate\n# 2024 年第一季\n2024-01-01,TWD,31.2\n2024-01-01, JPY, 141.5\n"
+		rates, err := ParseExchangeRatesCSV(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if len(rates) != 2 {
+			t.Fatalf("預期 2 筆匯率，實際為 %d", len(rates))
+		}
+		if rates[1].Currency != "JPY" || rates[1].Rate != 141.5 || !rates[1].Date.Equal(utcDate(2024, time.January, 1)) {
+			t.Errorf("解析結果錯誤: %+v", rates[1])
+		}
+	})
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"日期格式錯誤", "2024/01/01,TWD,31.2\n"},
+		{"匯率格式錯誤", "2024-01-01,TWD,abc\n"},
+		{"欄位數不符", "2024-01-01,TWD\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExchangeRatesCSV(strings.NewReader(tt.input))
+			if !errors.Is(err, domain.ErrInvalidParameter) {
+				t.Errorf("預期參數錯誤，實際為 %v", err)
+			}
+		})
+	}
+}
+
+func TestFindExchangeRate(t *testing.T) {
+	rates := []*model.ExchangeRate{
+		{Currency: "TWD", Date: utcDate(2024, time.January, 1), Rate: 31},
+		{Currency: "TWD", Date: utcDate(2024, time.February, 1), Rate: 32},
+	}
+
+	tests := []struct {
+		name string
+		on   time.Time
+		want float64
+	}{
+		{"當日匯率", time.Date(2024, time.February, 1, 18, 0, 0, 0, time.UTC), 32},
+		{"取之前最近的匯率", utcDate(2024, time.January, 20), 31},
+		{"晚於所有資料取最後一筆", utcDate(2024, time.June, 1), 32},
+		{"早於所有資料取最早一筆", utcDate(2023, time.December, 1), 31},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FindExchangeRate(rates, tt.on)
+			if !ok || got != tt.want {
+				t.Errorf("預期 %v，實際為 %v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("沒有匯率資料", func(t *testing.T) {
+		if _, ok := FindExchangeRate(nil, utcDate(2024, time.January, 1)); ok {
+			t.Error("預期找不到匯率")
+		}
+	})
+}
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int
+		fromRate float64
+		toRate   float64
+		want     int
+	}{
+		{"美元換算台幣", 10, 1, 31.5, 315},
+		{"台幣換算日圓", 1000, 31.5, 141.75, 4500},
+		{"四捨五入", 100, 3, 1, 33},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertAmount(tt.amount, tt.fromRate, tt.toRate); got != tt.want {
+				t.Errorf("預期 %d，實際為 %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAuthorizeAdmin(t *testing.T) {
+	admins := []string{"admin-1"}
+
+	if err := AuthorizeAdmin(admins, "admin-1"); err != nil {
+		t.Errorf("預期管理者通過，實際為 %v", err)
+	}
+	for _, userID := range []string{"user-1", ""} {
+		if err := AuthorizeAdmin(admins, userID); !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("預期 %q 被拒絕，實際為 %v", userID, err)
+		}
+	}
+}
+
+func TestCurrencyConverter_EnsureConvertible(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	rateRepo := repository.NewMockExchangeRateRepository(ctrl)
+	cfg := config.Config{Currency: config.CurrencyConfig{DefaultBase: "TWD", Reference: "USD"}}
+	cv := NewCurrencyConverter(cfg, rateRepo, repository.NewMockUserSettingsRepository(ctrl))
+
+	rates := map[string][]*model.ExchangeRate{
+		"TWD": {{Currency: "TWD", Date: utcDate(2024, time.January, 1), Rate: 31.5}},
+		"JPY": {{Currency: "JPY", Date: utcDate(2024, time.January, 1), Rate: 141.75}},
+	}
+	rateRepo.EXPECT().FindByCurrency(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, currency string) ([]*model.ExchangeRate, error) {
+		return rates[currency], nil
+	}).AnyTimes()
+
+	tests := []struct {
+		name     string
+		currency string
+		base     string
+		wantErr  bool
+	}{
+		{"與基準貨幣相同", "EUR", "EUR", false},
+		{"參考貨幣換算為基準貨幣", "USD", "TWD", false},
+		{"兩者皆有匯率", "JPY", "TWD", false},
+		{"幣別缺少匯率", "EUR", "TWD", true},
+		{"基準貨幣缺少匯率", "JPY", "EUR", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cv.EnsureConvertible(context.Background(), tt.currency, tt.base)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("預期錯誤為 %v，實際為 %v", tt.wantErr, err)
+			}
+			if tt.wantErr && (!errors.Is(err, ErrExchangeRateNotFound) || !errors.Is(err, domain.ErrInvalidParameter)) {
+				t.Errorf("預期找不到匯率的參數錯誤，實際為 %v", err)
+			}
+		})
+	}
+}
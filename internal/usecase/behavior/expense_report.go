@@ -0,0 +1,180 @@
+package behavior
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+const (
+	// DefaultExpenseGroupBy 未指定時費用報表的分組方式
+	DefaultExpenseGroupBy = model.ExpenseGroupByMonth
+	// DefaultRecentExpenses 費用摘要附帶的最近紀錄筆數
+	DefaultRecentExpenses = 5
+	// maxExpenseSeriesPeriods 時間分組補齊空白區間時允許的最大區間數
+	maxExpenseSeriesPeriods = 600
+)
+
+var (
+	ErrExpenseGroupByInvalid   = errors.New("無效的費用分組方式")
+	ErrExpenseDateRangeInvalid = errors.New("費用報表的開始日期不可晚於結束日期，且區間數不可超過 600")
+)
+
+// ValidateExpenseReportOptions 檢查費用報表的分組方式與日期範圍，零值日期代表不限制
+func ValidateExpenseReportOptions(groupBy model.ExpenseGroupBy, startDate, endDate time.Time) error {
+	if !groupBy.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrExpenseGroupByInvalid)
+	}
+	if startDate.IsZero() || endDate.IsZero() {
+		return nil
+	}
+	if startDate.After(endDate) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrExpenseDateRangeInvalid)
+	}
+	if groupBy.IsTimeBased() && countExpensePeriods(groupBy, startDate, endDate) > maxExpenseSeriesPeriods {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrExpenseDateRangeInvalid)
+	}
+	return nil
+}
+
+// ExpensePeriodStart 回傳時間點所屬的月、季或年起點（UTC）
+func ExpensePeriodStart(t time.Time, groupBy model.ExpenseGroupBy) time.Time {
+	t = t.UTC()
+	switch groupBy {
+	case model.ExpenseGroupByQuarter:
+		month := time.Month((int(t.Month())-1)/3*3 + 1)
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+	case model.ExpenseGroupByYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// nextExpensePeriod 回傳下一個區間的起點
+func nextExpensePeriod(start time.Time, groupBy model.ExpenseGroupBy) time.Time {
+	switch groupBy {
+	case model.ExpenseGroupByQuarter:
+		return start.AddDate(0, 3, 0)
+	case model.ExpenseGroupByYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+func countExpensePeriods(groupBy model.ExpenseGroupBy, startDate, endDate time.Time) int {
+	months := (endDate.Year()-startDate.Year())*12 + int(endDate.Month()) - int(startDate.Month()) + 1
+	switch groupBy {
+	case model.ExpenseGroupByQuarter:
+		return months/3 + 1
+	case model.ExpenseGroupByYear:
+		return endDate.Year() - startDate.Year() + 1
+	default:
+		return months
+	}
+}
+
+// ExpenseAmountConverter 將原始幣別的金額依指定日期換算為基準貨幣
+type ExpenseAmountConverter func(amount int, currency string, on time.Time) (int, error)
+
+// MergeExpenseBuckets 將依幣別與日期拆分的彙總結果換算為基準貨幣後合併
+// 時間分組依區間先後排序，其他分組依金額由高到低排序
+// 缺少匯率的部分不計入分組，改依幣別合計後另行回傳
+func MergeExpenseBuckets(parts []*model.ExpenseBucketPart, groupBy model.ExpenseGroupBy, convert ExpenseAmountConverter) ([]*model.ExpenseBucket, []*model.UnconvertedExpense, error) {
+	byKey := make(map[string]*model.ExpenseBucket, len(parts))
+	buckets := make([]*model.ExpenseBucket, 0, len(parts))
+	var unconverted []*model.UnconvertedExpense
+	for _, part := range parts {
+		total, err := convert(part.Total, part.Currency, part.Date)
+		if errors.Is(err, ErrExchangeRateNotFound) {
+			unconverted = addUnconvertedExpense(unconverted, part.Currency, part.Total, part.Count)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bucket, ok := byKey[part.Key]
+		if !ok {
+			bucket = &model.ExpenseBucket{Key: part.Key, PeriodStart: part.PeriodStart}
+			byKey[part.Key] = bucket
+			buckets = append(buckets, bucket)
+		}
+		bucket.Total += total
+		bucket.Count += part.Count
+	}
+
+	if groupBy.IsTimeBased() {
+		slices.SortFunc(buckets, func(a, b *model.ExpenseBucket) int {
+			return cmp.Compare(a.Key, b.Key)
+		})
+	} else {
+		slices.SortFunc(buckets, func(a, b *model.ExpenseBucket) int {
+			return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.Key, b.Key))
+		})
+	}
+	return buckets, unconverted, nil
+}
+
+// addUnconvertedExpense 將無法換算的金額累加至同幣別的合計
+func addUnconvertedExpense(list []*model.UnconvertedExpense, currency string, total, count int) []*model.UnconvertedExpense {
+	for _, item := range list {
+		if item.Currency == currency {
+			item.Total += total
+			item.Count += count
+			return list
+		}
+	}
+	return append(list, &model.UnconvertedExpense{Currency: currency, Total: total, Count: count})
+}
+
+// FillExpenseSeries 為時間分組補上沒有費用的區間（金額為 0），讓圖表的時間軸連續
+// 範圍以指定的日期為準，未指定時以彙總結果的第一個與最後一個區間為界；非時間分組原樣回傳
+func FillExpenseSeries(buckets []*model.ExpenseBucket, groupBy model.ExpenseGroupBy, startDate, endDate time.Time) []*model.ExpenseBucket {
+	if !groupBy.IsTimeBased() {
+		return buckets
+	}
+
+	byStart := make(map[time.Time]*model.ExpenseBucket, len(buckets))
+	var first, last time.Time
+	for _, b := range buckets {
+		if b.PeriodStart == nil {
+			continue
+		}
+		start := b.PeriodStart.UTC()
+		byStart[start] = b
+		if first.IsZero() || start.Before(first) {
+			first = start
+		}
+		if start.After(last) {
+			last = start
+		}
+	}
+
+	if !startDate.IsZero() {
+		first = ExpensePeriodStart(startDate, groupBy)
+	}
+	if !endDate.IsZero() {
+		last = ExpensePeriodStart(endDate, groupBy)
+	}
+	if first.IsZero() || last.IsZero() || first.After(last) {
+		return buckets
+	}
+
+	series := make([]*model.ExpenseBucket, 0, len(buckets))
+	for start := first; !start.After(last) && len(series) < maxExpenseSeriesPeriods; start = nextExpensePeriod(start, groupBy) {
+		if b, ok := byStart[start]; ok {
+			series = append(series, b)
+			continue
+		}
+		periodStart := start
+		series = append(series, &model.ExpenseBucket{Key: groupBy.PeriodKey(start), PeriodStart: &periodStart})
+	}
+	return series
+}
@@ -0,0 +1,178 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidateExpenseReportOptions(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		groupBy   model.ExpenseGroupBy
+		startDate time.Time
+		endDate   time.Time
+		want      error
+	}{
+		{"有效的月份分組", model.ExpenseGroupByMonth, start, end, nil},
+		{"未指定日期範圍", model.ExpenseGroupByPet, time.Time{}, time.Time{}, nil},
+		{"無效的分組方式", "week", start, end, ErrExpenseGroupByInvalid},
+		{"開始日期晚於結束日期", model.ExpenseGroupByMonth, end, start, ErrExpenseDateRangeInvalid},
+		{"月份區間數過多", model.ExpenseGroupByMonth, start.AddDate(-60, 0, 0), end, ErrExpenseDateRangeInvalid},
+		{"年度分組允許較長範圍", model.ExpenseGroupByYear, start.AddDate(-60, 0, 0), end, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExpenseReportOptions(tt.groupBy, tt.startDate, tt.endDate)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestExpensePeriodStart(t *testing.T) {
+	ts := time.Date(2024, time.August, 15, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		groupBy model.ExpenseGroupBy
+		want    time.Time
+		key     string
+	}{
+		{model.ExpenseGroupByMonth, time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC), "2024-08"},
+		{model.ExpenseGroupByQuarter, time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), "2024-Q3"},
+		{model.ExpenseGroupByYear, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), "2024"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.groupBy), func(t *testing.T) {
+			got := ExpensePeriodStart(ts, tt.groupBy)
+			if !got.Equal(tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, got)
+			}
+			if key := tt.groupBy.PeriodKey(got); key != tt.key {
+				t.Errorf("預期鍵 %s，實際為 %s", tt.key, key)
+			}
+		})
+	}
+}
+
+func TestFillExpenseSeries(t *testing.T) {
+	bucket := func(month time.Month, total int) *model.ExpenseBucket {
+		start := time.Date(2024, month, 1, 0, 0, 0, 0, time.UTC)
+		return &model.ExpenseBucket{Key: model.ExpenseGroupByMonth.PeriodKey(start), PeriodStart: &start, Total: total, Count: 1}
+	}
+
+	t.Run("補齊指定範圍內沒有費用的月份", func(t *testing.T) {
+		buckets := []*model.ExpenseBucket{bucket(time.February, 100), bucket(time.April, 300)}
+		start := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.UTC)
+		end := time.Date(2024, time.May, 20, 0, 0, 0, 0, time.UTC)
+
+		series := FillExpenseSeries(buckets, model.ExpenseGroupByMonth, start, end)
+
+		wantKeys := []string{"2024-01", "2024-02", "2024-03", "2024-04", "2024-05"}
+		wantTotals := []int{0, 100, 0, 300, 0}
+		if len(series) != len(wantKeys) {
+			t.Fatalf("預期 %d 個區間，實際為 %d", len(wantKeys), len(series))
+		}
+		for i, b := range series {
+			if b.Key != wantKeys[i] || b.Total != wantTotals[i] {
+				t.Errorf("第 %d 個區間預期 %s=%d，實際為 %s=%d", i, wantKeys[i], wantTotals[i], b.Key, b.Total)
+			}
+		}
+	})
+
+	t.Run("未指定範圍時以第一個與最後一個區間為界", func(t *testing.T) {
+		buckets := []*model.ExpenseBucket{bucket(time.March, 100), bucket(time.May, 200)}
+		series := FillExpenseSeries(buckets, model.ExpenseGroupByMonth, time.Time{}, time.Time{})
+		if len(series) != 3 || series[1].Key != "2024-04" || series[1].Total != 0 {
+			t.Errorf("預期補上 2024-04，實際為 %+v", series)
+		}
+	})
+
+	t.Run("非時間分組原樣回傳", func(t *testing.T) {
+		buckets := []*model.ExpenseBucket{{Key: "飼料", Total: 500}}
+		series := FillExpenseSeries(buckets, model.ExpenseGroupByCategory, time.Time{}, time.Time{})
+		if len(series) != 1 || series[0].Key != "飼料" {
+			t.Errorf("預期原樣回傳，實際為 %+v", series)
+		}
+	})
+}
+
+func TestMergeExpenseBuckets(t *testing.T) {
+	march := utcDate(2024, time.March, 1)
+	rates := map[string]int{"TWD": 1, "USD": 30}
+	convert := func(amount int, currency string, _ time.Time) (int, error) {
+		rate, ok := rates[currency]
+		if !ok {
+			return 0, ErrExchangeRateNotFound
+		}
+		return amount * rate, nil
+	}
+
+	t.Run("不同幣別換算後合併", func(t *testing.T) {
+		parts := []*model.ExpenseBucketPart{
+			{Key: "2024-03", PeriodStart: &march, Currency: "TWD", Date: march, Total: 500, Count: 2},
+			{Key: "2024-03", PeriodStart: &march, Currency: "USD", Date: march, Total: 10, Count: 1},
+			{Key: "2024-02", Currency: "TWD", Date: march, Total: 100, Count: 1},
+		}
+		buckets, unconverted, err := MergeExpenseBuckets(parts, model.ExpenseGroupByMonth, convert)
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if len(unconverted) != 0 {
+			t.Errorf("預期全部換算成功，實際為 %+v", unconverted)
+		}
+		if len(buckets) != 2 || buckets[0].Key != "2024-02" {
+			t.Fatalf("預期依區間排序的 2 個分組，實際為 %+v", buckets)
+		}
+		if buckets[1].Total != 800 || buckets[1].Count != 3 {
+			t.Errorf("預期金額 800、筆數 3，實際為 %d、%d", buckets[1].Total, buckets[1].Count)
+		}
+	})
+
+	t.Run("非時間分組依金額排序", func(t *testing.T) {
+		parts := []*model.ExpenseBucketPart{
+			{Key: "飼料", Currency: "TWD", Date: march, Total: 500, Count: 1},
+			{Key: "醫療", Currency: "USD", Date: march, Total: 20, Count: 1},
+		}
+		buckets, _, err := MergeExpenseBuckets(parts, model.ExpenseGroupByCategory, convert)
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if buckets[0].Key != "醫療" || buckets[0].Total != 600 {
+			t.Errorf("預期醫療 600 排第一，實際為 %+v", buckets[0])
+		}
+	})
+
+	t.Run("缺少匯率的費用不計入分組並依幣別合計", func(t *testing.T) {
+		parts := []*model.ExpenseBucketPart{
+			{Key: "飼料", Currency: "EUR", Date: march, Total: 5, Count: 1},
+			{Key: "飼料", Currency: "TWD", Date: march, Total: 300, Count: 1},
+			{Key: "醫療", Currency: "EUR", Date: march, Total: 20, Count: 2},
+		}
+		buckets, unconverted, err := MergeExpenseBuckets(parts, model.ExpenseGroupByCategory, convert)
+		if err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+		if len(buckets) != 1 || buckets[0].Key != "飼料" || buckets[0].Total != 300 || buckets[0].Count != 1 {
+			t.Errorf("預期只計入台幣的飼料 300，實際為 %+v", buckets)
+		}
+		if len(unconverted) != 1 || unconverted[0].Currency != "EUR" || unconverted[0].Total != 25 || unconverted[0].Count != 3 {
+			t.Errorf("預期 EUR 25 共 3 筆未換算，實際為 %+v", unconverted)
+		}
+	})
+
+	t.Run("其他換算錯誤時回傳錯誤", func(t *testing.T) {
+		failure := errors.New("查詢匯率失敗")
+		parts := []*model.ExpenseBucketPart{{Key: "飼料", Currency: "USD", Date: march, Total: 5, Count: 1}}
+		failing := func(int, string, time.Time) (int, error) { return 0, failure }
+		if _, _, err := MergeExpenseBuckets(parts, model.ExpenseGroupByCategory, failing); !errors.Is(err, failure) {
+			t.Errorf("預期回傳查詢錯誤，實際為 %v", err)
+		}
+	})
+}
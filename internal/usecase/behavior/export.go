@@ -0,0 +1,480 @@
+package behavior
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// exportDateFormat CSV 中日期欄位的格式
+const exportDateFormat = "2006-01-02"
+
+// exportAllTime 查詢健康日誌時涵蓋所有日期的區間上限
+var exportAllTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// utf8BOM 讓試算表軟體以 UTF-8 開啟含中文的 CSV
+const utf8BOM = "\ufeff"
+
+var ErrExportFormatInvalid = errors.New("匯出格式僅支援 json、csv 與 zip")
+
+// ValidateExportFormat 檢查匯出格式
+func ValidateExportFormat(format model.ExportFormat) error {
+	if !format.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrExportFormatInvalid)
+	}
+	return nil
+}
+
+// ExportFileName 回傳匯出檔的下載檔名
+func ExportFileName(format model.ExportFormat, now time.Time) string {
+	ext := "zip"
+	if format == model.ExportFormatJSON {
+		ext = "json"
+	}
+	return fmt.Sprintf("petlog-export-%s.%s", now.Format("20060102"), ext)
+}
+
+// ExportContentType 回傳匯出檔的 MIME 類型
+func ExportContentType(format model.ExportFormat) string {
+	if format == model.ExportFormatJSON {
+		return "application/json"
+	}
+	return "application/zip"
+}
+
+// DataExporter 讀取使用者擁有的寵物與其健康日誌、醫療記錄、費用及附件，並產生匯出檔
+type DataExporter struct {
+	jobRepo        repository.ExportJobRepository
+	petRepo        repository.PetRepository
+	healthLogRepo  repository.HealthLogRepository
+	medicalRepo    repository.MedicalRecordRepository
+	expenseRepo    repository.ExpenseRepository
+	attachmentRepo repository.AttachmentRepository
+	store          service.BlobStore
+	retention      time.Duration
+}
+
+// NewDataExporter 建立新的 DataExporter
+func NewDataExporter(
+	cfg config.Config,
+	jobRepo repository.ExportJobRepository,
+	petRepo repository.PetRepository,
+	healthLogRepo repository.HealthLogRepository,
+	medicalRepo repository.MedicalRecordRepository,
+	expenseRepo repository.ExpenseRepository,
+	attachmentRepo repository.AttachmentRepository,
+	store service.BlobStore,
+) *DataExporter {
+	if jobRepo == nil || petRepo == nil || healthLogRepo == nil || medicalRepo == nil || expenseRepo == nil || attachmentRepo == nil || store == nil {
+		panic("jobRepo, petRepo, healthLogRepo, medicalRepo, expenseRepo, attachmentRepo and store are required")
+	}
+	return &DataExporter{
+		jobRepo:        jobRepo,
+		petRepo:        petRepo,
+		healthLogRepo:  healthLogRepo,
+		medicalRepo:    medicalRepo,
+		expenseRepo:    expenseRepo,
+		attachmentRepo: attachmentRepo,
+		store:          store,
+		retention:      cfg.Export.Retention,
+	}
+}
+
+// CountRecords 估算匯出的資料量（寵物、健康日誌與費用筆數），用於判斷是否改於背景處理
+func (e *DataExporter) CountRecords(c context.Context, ownerID string) (int, error) {
+	petIDs, err := e.petRepo.FindIDsByOwnerID(c, ownerID)
+	if err != nil {
+		return 0, fmt.Errorf("查找寵物失敗: %w", err)
+	}
+	if len(petIDs) == 0 {
+		return 0, nil
+	}
+
+	logs, err := e.healthLogRepo.CountByPetIDs(c, petIDs)
+	if err != nil {
+		return 0, fmt.Errorf("計算健康日誌筆數失敗: %w", err)
+	}
+	_, expenses, err := e.expenseRepo.FindAll(c, repository.WithPetIDs(petIDs))
+	if err != nil {
+		return 0, fmt.Errorf("計算費用筆數失敗: %w", err)
+	}
+	return len(petIDs) + logs + expenses, nil
+}
+
+// Collect 讀取使用者擁有的所有寵物及其紀錄，已封存的資料不包含在內
+func (e *DataExporter) Collect(c context.Context, ownerID string, now time.Time) (*model.ExportDocument, error) {
+	pets, err := e.petRepo.FindByOwnerID(c, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("查找寵物失敗: %w", err)
+	}
+
+	doc := &model.ExportDocument{
+		Version:        model.ExportSchemaVersion,
+		ExportedAt:     now,
+		OwnerID:        ownerID,
+		Pets:           pets,
+		HealthLogs:     make([]*model.HealthLog, 0),
+		MedicalRecords: make([]*model.MedicalRecord, 0),
+		Expenses:       make([]*model.Expense, 0),
+		Attachments:    make([]*model.Attachment, 0),
+	}
+	if doc.Pets == nil {
+		doc.Pets = make([]*model.Pet, 0)
+	}
+
+	petIDs := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		petIDs = append(petIDs, pet.ID)
+
+		logs, err := e.healthLogRepo.FindByPetID(c, pet.ID, time.Time{}, exportAllTime, model.HealthLogFilter{})
+		if err != nil {
+			return nil, fmt.Errorf("查找健康日誌失敗: %w", err)
+		}
+		doc.HealthLogs = append(doc.HealthLogs, logs...)
+
+		records, err := e.medicalRepo.FindByPetID(c, pet.ID, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, fmt.Errorf("查找醫療記錄失敗: %w", err)
+		}
+		doc.MedicalRecords = append(doc.MedicalRecords, records...)
+
+		attachments, err := e.attachmentRepo.FindByPetID(c, pet.ID)
+		if err != nil {
+			return nil, fmt.Errorf("查找附件失敗: %w", err)
+		}
+		doc.Attachments = append(doc.Attachments, attachments...)
+	}
+
+	if len(petIDs) > 0 {
+		expenses, _, err := e.expenseRepo.FindAll(c, repository.WithPetIDs(petIDs))
+		if err != nil {
+			return nil, fmt.Errorf("查找費用失敗: %w", err)
+		}
+		doc.Expenses = append(doc.Expenses, expenses...)
+	}
+
+	sortExportDocument(doc)
+	return doc, nil
+}
+
+// Write 依格式將匯出資料寫入 w
+//   - json: 單一 JSON 檔
+//   - csv: ZIP 內含 pets.csv、health_logs.csv、medical_records.csv、expenses.csv 與 attachments.csv
+//   - zip: ZIP 內含 export.json、csv/ 目錄下的各 CSV 以及 attachments/<附件ID>/<檔名> 的附件檔案
+func (e *DataExporter) Write(c context.Context, w io.Writer, format model.ExportFormat, doc *model.ExportDocument) error {
+	switch format {
+	case model.ExportFormatJSON:
+		return WriteExportJSON(w, doc)
+	case model.ExportFormatCSV:
+		zw := zip.NewWriter(w)
+		if err := writeExportCSVFiles(zw, "", doc); err != nil {
+			return err
+		}
+		return zw.Close()
+	case model.ExportFormatZIP:
+		zw := zip.NewWriter(w)
+		f, err := zw.Create("export.json")
+		if err != nil {
+			return err
+		}
+		if err := WriteExportJSON(f, doc); err != nil {
+			return err
+		}
+		if err := writeExportCSVFiles(zw, "csv/", doc); err != nil {
+			return err
+		}
+		if err := e.writeAttachmentFiles(c, zw, doc.Attachments); err != nil {
+			return err
+		}
+		return zw.Close()
+	}
+	return ValidateExportFormat(format)
+}
+
+// Run 產生匯出檔並寫入 BlobStore，依結果將工作標記為完成或失敗後保存
+func (e *DataExporter) Run(c context.Context, job *model.ExportJob, now time.Time) error {
+	ctx := contextx.WithContext(c)
+
+	if err := e.generate(ctx, job, now); err != nil {
+		ctx.Error("產生匯出檔失敗", "error", err, "export_id", job.ID)
+		job.MarkFailed(err, time.Now(), e.retention)
+		if updateErr := e.jobRepo.Update(ctx, job); updateErr != nil {
+			ctx.Error("更新匯出工作失敗", "error", updateErr, "export_id", job.ID)
+		}
+		return err
+	}
+
+	if err := e.jobRepo.Update(ctx, job); err != nil {
+		if delErr := e.store.Delete(ctx, job.StorageKey); delErr != nil {
+			ctx.Error("移除未記錄的匯出檔失敗", "error", delErr, "key", job.StorageKey)
+		}
+		return fmt.Errorf("更新匯出工作失敗: %w", err)
+	}
+
+	ctx.Info("匯出檔產生完成", "export_id", job.ID, "format", job.Format, "size", job.Size)
+	return nil
+}
+
+// generate 先寫入暫存檔取得大小後再上傳，避免將整個匯出檔保留在記憶體中
+func (e *DataExporter) generate(ctx *contextx.Contextx, job *model.ExportJob, now time.Time) error {
+	doc, err := e.Collect(ctx, job.OwnerID, now)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "petlog-export-*")
+	if err != nil {
+		return fmt.Errorf("建立暫存檔失敗: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	buf := bufio.NewWriter(tmp)
+	if err := e.Write(ctx, buf, job.Format, doc); err != nil {
+		return fmt.Errorf("寫入匯出檔失敗: %w", err)
+	}
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("寫入匯出檔失敗: %w", err)
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("讀取匯出檔大小失敗: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("讀取匯出檔失敗: %w", err)
+	}
+
+	fileName := ExportFileName(job.Format, now)
+	key := "exports/" + job.OwnerID + "/" + job.ID + "/" + fileName
+	obj := service.BlobObject{Key: key, ContentType: ExportContentType(job.Format), FileName: fileName}
+	if err := e.store.Put(ctx, obj, tmp, size); err != nil {
+		return fmt.Errorf("寫入匯出檔失敗: %w", err)
+	}
+
+	job.MarkCompleted(key, fileName, size, time.Now(), e.retention)
+	return nil
+}
+
+// writeAttachmentFiles 將附件檔案寫入 ZIP，已不存在的檔案略過
+func (e *DataExporter) writeAttachmentFiles(c context.Context, zw *zip.Writer, attachments []*model.Attachment) error {
+	ctx := contextx.WithContext(c)
+	for _, a := range attachments {
+		rc, err := e.store.Open(ctx, a.StorageKey)
+		if domain.IsNotFound(err) {
+			ctx.Warn("附件檔案不存在，匯出時略過", "attachment_id", a.ID, "key", a.StorageKey)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("讀取附件 %s 失敗: %w", a.ID, err)
+		}
+
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     ExportAttachmentPath(a),
+			Method:   zip.Deflate,
+			Modified: a.CreatedAt,
+		})
+		if err == nil {
+			_, err = io.Copy(f, rc)
+		}
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("寫入附件 %s 失敗: %w", a.ID, err)
+		}
+	}
+	return nil
+}
+
+// ExportAttachmentPath 回傳附件於 ZIP 匯出檔中的路徑
+func ExportAttachmentPath(a *model.Attachment) string {
+	name := strings.NewReplacer("/", "_", "\\", "_").Replace(a.FileName)
+	if name == "" || name == "." || name == ".." {
+		name = "file"
+	}
+	return path.Join("attachments", a.ID, name)
+}
+
+// WriteExportJSON 以縮排的 JSON 寫入匯出資料
+func WriteExportJSON(w io.Writer, doc *model.ExportDocument) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// writeExportCSVFiles 將每種資料各寫成一個 CSV 檔
+func writeExportCSVFiles(zw *zip.Writer, dir string, doc *model.ExportDocument) error {
+	tables := []struct {
+		name  string
+		write func(*csv.Writer, *model.ExportDocument) error
+	}{
+		{"pets.csv", writePetsCSV},
+		{"health_logs.csv", writeHealthLogsCSV},
+		{"medical_records.csv", writeMedicalRecordsCSV},
+		{"expenses.csv", writeExpensesCSV},
+		{"attachments.csv", writeAttachmentsCSV},
+	}
+	for _, t := range tables {
+		f, err := zw.Create(dir + t.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, utf8BOM); err != nil {
+			return err
+		}
+		cw := csv.NewWriter(f)
+		if err := t.write(cw, doc); err != nil {
+			return fmt.Errorf("寫入 %s 失敗: %w", t.name, err)
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return fmt.Errorf("寫入 %s 失敗: %w", t.name, err)
+		}
+	}
+	return nil
+}
+
+func writePetsCSV(w *csv.Writer, doc *model.ExportDocument) error {
+	if err := w.Write([]string{"id", "name", "breed", "dob", "microchip_id", "household_id", "avatar_url", "created_at", "updated_at"}); err != nil {
+		return err
+	}
+	for _, p := range doc.Pets {
+		if err := w.Write([]string{
+			p.ID, p.Name, p.Breed, formatExportDate(p.DOB), p.MicrochipID, p.HouseholdID, p.AvatarURL,
+			formatExportTime(p.CreatedAt), formatExportTime(p.UpdatedAt),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHealthLogsCSV(w *csv.Writer, doc *model.ExportDocument) error {
+	if err := w.Write([]string{
+		"id", "pet_id", "date", "weight_kg", "food_gram", "litter_notes", "behaviour_notes",
+		"urine_clumps", "stool_consistency", "vomiting_count", "activity_level", "mood_tags",
+	}); err != nil {
+		return err
+	}
+	for _, l := range doc.HealthLogs {
+		moods := make([]string, 0, len(l.MoodTags))
+		for _, m := range l.MoodTags {
+			moods = append(moods, string(m))
+		}
+		if err := w.Write([]string{
+			l.ID, l.PetID, formatExportDate(l.Date),
+			strconv.FormatFloat(l.WeightKg, 'f', -1, 64), formatExportOptionalInt(l.FoodGram),
+			l.LitterNotes, l.BehaviourNotes,
+			formatExportOptionalInt(l.UrineClumps), formatExportOptionalInt(l.StoolConsistency),
+			strconv.Itoa(l.VomitingCount), string(l.ActivityLevel), strings.Join(moods, ";"),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMedicalRecordsCSV(w *csv.Writer, doc *model.ExportDocument) error {
+	if err := w.Write([]string{"id", "pet_id", "type", "description", "date", "next_due_date", "dosage"}); err != nil {
+		return err
+	}
+	for _, r := range doc.MedicalRecords {
+		nextDue := ""
+		if r.NextDueDate != nil {
+			nextDue = formatExportDate(*r.NextDueDate)
+		}
+		if err := w.Write([]string{
+			r.ID, r.PetID, string(r.Type), r.Description, formatExportDate(r.Date), nextDue, r.Dosage,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeExpensesCSV(w *csv.Writer, doc *model.ExportDocument) error {
+	if err := w.Write([]string{"id", "pet_id", "date", "category", "amount", "currency", "description", "recurring_expense_id"}); err != nil {
+		return err
+	}
+	for _, e := range doc.Expenses {
+		if err := w.Write([]string{
+			e.ID, e.PetID, formatExportDate(e.Date), e.Category, strconv.Itoa(e.Amount), e.Currency,
+			e.Description, e.RecurringExpenseID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeAttachmentsCSV(w *csv.Writer, doc *model.ExportDocument) error {
+	if err := w.Write([]string{"id", "pet_id", "owner_type", "owner_id", "file_name", "content_type", "size", "created_at"}); err != nil {
+		return err
+	}
+	for _, a := range doc.Attachments {
+		if err := w.Write([]string{
+			a.ID, a.PetID, string(a.OwnerType), a.OwnerID, a.FileName, a.ContentType,
+			strconv.FormatInt(a.Size, 10), formatExportTime(a.CreatedAt),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortExportDocument 讓匯出結果依寵物與日期排序，相同資料重複匯出時內容一致
+func sortExportDocument(doc *model.ExportDocument) {
+	slices.SortStableFunc(doc.HealthLogs, func(a, b *model.HealthLog) int {
+		return cmpExportRecord(a.PetID, b.PetID, a.Date, b.Date)
+	})
+	slices.SortStableFunc(doc.MedicalRecords, func(a, b *model.MedicalRecord) int {
+		return cmpExportRecord(a.PetID, b.PetID, a.Date, b.Date)
+	})
+	slices.SortStableFunc(doc.Expenses, func(a, b *model.Expense) int {
+		return cmpExportRecord(a.PetID, b.PetID, a.Date, b.Date)
+	})
+}
+
+func cmpExportRecord(petA, petB string, dateA, dateB time.Time) int {
+	if c := strings.Compare(petA, petB); c != 0 {
+		return c
+	}
+	return dateA.Compare(dateB)
+}
+
+func formatExportDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(exportDateFormat)
+}
+
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatExportOptionalInt(v *int) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(*v)
+}
@@ -0,0 +1,205 @@
+package behavior
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestExportDocument() *model.ExportDocument {
+	stool := 4
+	food := 60
+	return &model.ExportDocument{
+		Version:    model.ExportSchemaVersion,
+		ExportedAt: utcDate(2026, 10, 18),
+		OwnerID:    "owner",
+		Pets:       []*model.Pet{{ID: "pet-1", OwnerID: "owner", Name: "麻糬", Breed: "米克斯", DOB: utcDate(2020, 5, 1)}},
+		HealthLogs: []*model.HealthLog{{
+			ID: "log-1", PetID: "pet-1", Date: utcDate(2026, 10, 1), WeightKg: 4.25, FoodGram: &food,
+			HealthObservations: model.HealthObservations{
+				StoolConsistency: &stool,
+				MoodTags:         []model.MoodTag{"playful", "sleepy"},
+			},
+		}},
+		MedicalRecords: []*model.MedicalRecord{{ID: "rec-1", PetID: "pet-1", Type: model.RecordTypeVaccination, Description: "三合一", Date: utcDate(2026, 3, 1)}},
+		Expenses:       []*model.Expense{{ID: "exp-1", PetID: "pet-1", Category: "food", Amount: 1200, Currency: "TWD", Date: utcDate(2026, 9, 30), Description: "飼料, 罐頭"}},
+		Attachments: []*model.Attachment{
+			{ID: "att-1", PetID: "pet-1", OwnerType: model.AttachmentOwnerExpense, OwnerID: "exp-1", FileName: "收據.pdf", StorageKey: "attachments/pet-1/a"},
+			{ID: "att-2", PetID: "pet-1", OwnerType: model.AttachmentOwnerExpense, OwnerID: "exp-1", FileName: "../遺失.png", StorageKey: "attachments/pet-1/b"},
+		},
+	}
+}
+
+func newTestDataExporter(t *testing.T, store service.BlobStore) *DataExporter {
+	ctrl := gomock.NewController(t)
+	return NewDataExporter(
+		config.Config{},
+		repository.NewMockExportJobRepository(ctrl),
+		repository.NewMockPetRepository(ctrl),
+		repository.NewMockHealthLogRepository(ctrl),
+		repository.NewMockMedicalRecordRepository(ctrl),
+		repository.NewMockExpenseRepository(ctrl),
+		repository.NewMockAttachmentRepository(ctrl),
+		store,
+	)
+}
+
+// readZip 回傳 ZIP 中各檔案的內容
+func readZip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("解析 ZIP 失敗: %v", err)
+	}
+	files := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("開啟 %s 失敗: %v", f.Name, err)
+		}
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	return files
+}
+
+func readCSV(t *testing.T, content string) [][]string {
+	t.Helper()
+	if !strings.HasPrefix(content, utf8BOM) {
+		t.Error("預期 CSV 以 UTF-8 BOM 開頭")
+	}
+	rows, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(content, utf8BOM))).ReadAll()
+	if err != nil {
+		t.Fatalf("解析 CSV 失敗: %v", err)
+	}
+	return rows
+}
+
+func TestDataExporter_Write(t *testing.T) {
+	ctx := context.Background()
+	doc := newTestExportDocument()
+
+	t.Run("JSON 包含格式版本與所有資料", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := newTestDataExporter(t, service.NewMockBlobStore(gomock.NewController(t))).Write(ctx, &buf, model.ExportFormatJSON, doc); err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+
+		var got model.ExportDocument
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("解析 JSON 失敗: %v", err)
+		}
+		if got.Version != model.ExportSchemaVersion || got.RecordCount() != doc.RecordCount() {
+			t.Errorf("預期版本 %d 與 %d 筆資料，實際為 %d 與 %d 筆", model.ExportSchemaVersion, doc.RecordCount(), got.Version, got.RecordCount())
+		}
+		if strings.Contains(buf.String(), "storage_key") {
+			t.Error("預期不輸出附件的儲存鍵值")
+		}
+	})
+
+	t.Run("CSV 每種資料各一個檔案", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := newTestDataExporter(t, service.NewMockBlobStore(gomock.NewController(t))).Write(ctx, &buf, model.ExportFormatCSV, doc); err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+
+		files := readZip(t, buf.Bytes())
+		for _, name := range []string{"pets.csv", "health_logs.csv", "medical_records.csv", "expenses.csv", "attachments.csv"} {
+			if _, ok := files[name]; !ok {
+				t.Errorf("預期包含 %s", name)
+			}
+		}
+		if len(files) != 5 {
+			t.Errorf("預期 5 個檔案，實際為 %d", len(files))
+		}
+
+		logs := readCSV(t, files["health_logs.csv"])
+		if len(logs) != 2 {
+			t.Fatalf("預期標題列加 1 筆資料，實際為 %d 列", len(logs))
+		}
+		want := []string{"log-1", "pet-1", "2026-10-01", "4.25", "60", "", "", "", "4", "0", "", "playful;sleepy"}
+		if strings.Join(logs[1], "|") != strings.Join(want, "|") {
+			t.Errorf("預期 %v，實際為 %v", want, logs[1])
+		}
+
+		expenses := readCSV(t, files["expenses.csv"])
+		if expenses[1][6] != "飼料, 罐頭" {
+			t.Errorf("含逗號的欄位內容不符: %q", expenses[1][6])
+		}
+	})
+
+	t.Run("ZIP 包含 JSON、CSV 與附件檔案並略過遺失的檔案", func(t *testing.T) {
+		store := service.NewMockBlobStore(gomock.NewController(t))
+		store.EXPECT().Open(gomock.Any(), "attachments/pet-1/a").Return(io.NopCloser(strings.NewReader("%PDF")), nil)
+		store.EXPECT().Open(gomock.Any(), "attachments/pet-1/b").Return(nil, domain.ErrNotFound)
+
+		var buf bytes.Buffer
+		if err := newTestDataExporter(t, store).Write(ctx, &buf, model.ExportFormatZIP, doc); err != nil {
+			t.Fatalf("預期成功，實際為 %v", err)
+		}
+
+		files := readZip(t, buf.Bytes())
+		if _, ok := files["export.json"]; !ok {
+			t.Error("預期包含 export.json")
+		}
+		if _, ok := files["csv/pets.csv"]; !ok {
+			t.Error("預期包含 csv/pets.csv")
+		}
+		if got := files["attachments/att-1/收據.pdf"]; got != "%PDF" {
+			t.Errorf("附件內容不符: %q", got)
+		}
+		if len(files) != 7 {
+			t.Errorf("預期 7 個檔案，實際為 %d", len(files))
+		}
+	})
+
+	t.Run("無效的格式", func(t *testing.T) {
+		err := newTestDataExporter(t, service.NewMockBlobStore(gomock.NewController(t))).Write(ctx, io.Discard, "xlsx", doc)
+		if !domain.IsInvalidParameter(err) {
+			t.Errorf("預期 ErrInvalidParameter，實際為 %v", err)
+		}
+	})
+}
+
+func TestExportAttachmentPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		want     string
+	}{
+		{"一般檔名", "收據.pdf", "attachments/att-1/收據.pdf"},
+		{"移除路徑分隔字元", "../../etc/passwd", "attachments/att-1/.._.._etc_passwd"},
+		{"空白檔名", "", "attachments/att-1/file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExportAttachmentPath(&model.Attachment{ID: "att-1", FileName: tt.fileName}); got != tt.want {
+				t.Errorf("預期 %s，實際為 %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestExportFileName(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	if got := ExportFileName(model.ExportFormatJSON, now); got != "petlog-export-20261018.json" {
+		t.Errorf("JSON 檔名不符: %s", got)
+	}
+	if got := ExportFileName(model.ExportFormatCSV, now); got != "petlog-export-20261018.zip" {
+		t.Errorf("CSV 檔名不符: %s", got)
+	}
+}
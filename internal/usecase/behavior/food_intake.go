@@ -0,0 +1,112 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrFoodBaselineDaysInvalid = errors.New("進食量基準天數必須介於 1 到 90")
+	ErrFoodThresholdInvalid    = errors.New("進食量門檻百分比必須大於 0 且不超過 100")
+	ErrFoodAlertDaysInvalid    = errors.New("連續天數必須介於 1 到 90")
+)
+
+// ValidateFoodIntakeOptions 檢查進食量分析的基準天數、門檻百分比與連續天數
+func ValidateFoodIntakeOptions(baselineDays int, thresholdPct float64, alertDays int) error {
+	if baselineDays < 1 || baselineDays > maxTrendWindow {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrFoodBaselineDaysInvalid)
+	}
+	if thresholdPct <= 0 || thresholdPct > 100 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrFoodThresholdInvalid)
+	}
+	if alertDays < 1 || alertDays > maxTrendWindow {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrFoodAlertDaysInvalid)
+	}
+	return nil
+}
+
+// BuildFoodIntakeTrend 比較每日進食量與前 baselineDays 天的滾動基準，並找出連續 alertDays 天以上低於門檻的期間
+// 基準只採用未低於門檻的日子，避免食慾持續下降時基準跟著下滑；基準天數內有紀錄的日子不足一半時不計算基準
+// 沒有紀錄的日子不視為未進食，但會中斷連續天數的計算；記錄為 0 公克的日子代表完全未進食，佔基準的 0%
+// 連續期間內基準天數已沒有足夠的正常日子時沿用連續期間的基準，讓長期未進食持續計入同一筆警示
+func BuildFoodIntakeTrend(
+	daily []*model.DailyFoodIntake,
+	baselineDays int,
+	thresholdPct float64,
+	alertDays int,
+) ([]model.FoodIntakePoint, []model.AppetiteAlert) {
+	minSamples := (baselineDays + 1) / 2
+
+	points := make([]model.FoodIntakePoint, 0, len(daily))
+	alerts := make([]model.AppetiteAlert, 0)
+
+	// normal 保存未低於門檻的日子，作為後續基準
+	normal := make([]*model.DailyFoodIntake, 0, len(daily))
+	var streak []model.FoodIntakePoint
+
+	closeStreak := func() {
+		if len(streak) >= alertDays {
+			var ratioSum float64
+			for _, p := range streak {
+				ratioSum += *p.RatioPct
+			}
+			alerts = append(alerts, model.AppetiteAlert{
+				StartDate:       streak[0].Date,
+				EndDate:         streak[len(streak)-1].Date,
+				Days:            len(streak),
+				AverageRatioPct: roundTo(ratioSum/float64(len(streak)), 2),
+			})
+		}
+		streak = nil
+	}
+
+	for _, day := range daily {
+		point := model.FoodIntakePoint{Date: day.Date, FoodGram: day.FoodGram}
+
+		windowStart := day.Date.AddDate(0, 0, -baselineDays)
+		var sum, count int
+		for _, prev := range normal {
+			if !prev.Date.Before(windowStart) && prev.Date.Before(day.Date) {
+				sum += prev.FoodGram
+				count++
+			}
+		}
+
+		var baseline *float64
+		if count >= minSamples && sum > 0 {
+			avg := roundTo(float64(sum)/float64(count), 2)
+			baseline = &avg
+		} else if len(streak) > 0 && isNextDay(streak[len(streak)-1].Date, day.Date) {
+			// 長時間食慾不振（例如完全未進食）時基準天數內已沒有正常的日子，沿用連續期間的基準
+			baseline = streak[len(streak)-1].BaselineGram
+		}
+		if baseline != nil {
+			ratio := roundTo(float64(day.FoodGram) / *baseline * 100, 2)
+			point.BaselineGram = baseline
+			point.RatioPct = &ratio
+			point.BelowThreshold = ratio < thresholdPct
+		}
+
+		if len(streak) > 0 && (!point.BelowThreshold || !isNextDay(streak[len(streak)-1].Date, day.Date)) {
+			closeStreak()
+		}
+		if point.BelowThreshold {
+			streak = append(streak, point)
+		} else {
+			normal = append(normal, day)
+		}
+
+		points = append(points, point)
+	}
+	closeStreak()
+
+	return points, alerts
+}
+
+func isNextDay(prev, next time.Time) bool {
+	return prev.AddDate(0, 0, 1).Equal(next)
+}
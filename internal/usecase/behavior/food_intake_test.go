@@ -0,0 +1,87 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestBuildFoodIntakeTrend(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 5, d, 0, 0, 0, 0, time.UTC) }
+	intakes := func(grams map[int]int) []*model.DailyFoodIntake {
+		result := make([]*model.DailyFoodIntake, 0, len(grams))
+		for d := 1; d <= 31; d++ {
+			if g, ok := grams[d]; ok {
+				result = append(result, &model.DailyFoodIntake{Date: day(d), FoodGram: g})
+			}
+		}
+		return result
+	}
+
+	t.Run("歷史資料不足時不計算基準", func(t *testing.T) {
+		points, alerts := BuildFoodIntakeTrend(intakes(map[int]int{1: 60, 2: 60}), 4, 70, 2)
+		if points[1].BaselineGram != nil {
+			t.Errorf("僅一天歷史資料不應計算基準，實際為 %v", *points[1].BaselineGram)
+		}
+		if len(alerts) != 0 {
+			t.Errorf("預期沒有警示，實際為 %+v", alerts)
+		}
+	})
+
+	t.Run("連續多日低於基準時發出警示且基準不受影響", func(t *testing.T) {
+		points, alerts := BuildFoodIntakeTrend(intakes(map[int]int{1: 60, 2: 60, 3: 60, 4: 30, 5: 20, 6: 60}), 3, 70, 2)
+
+		if !points[3].BelowThreshold || *points[3].RatioPct != 50 {
+			t.Errorf("預期第 4 天低於門檻且為基準的 50%%，實際為 %+v", points[3])
+		}
+		if *points[4].BaselineGram != 60 {
+			t.Errorf("低於門檻的日子不應列入基準，實際基準為 %v", *points[4].BaselineGram)
+		}
+		if len(alerts) != 1 {
+			t.Fatalf("預期 1 筆警示，實際為 %+v", alerts)
+		}
+		alert := alerts[0]
+		if !alert.StartDate.Equal(day(4)) || !alert.EndDate.Equal(day(5)) || alert.Days != 2 {
+			t.Errorf("預期警示涵蓋第 4 到 5 天，實際為 %+v", alert)
+		}
+		if alert.AverageRatioPct != 41.67 {
+			t.Errorf("預期平均比例 41.67%%，實際為 %v", alert.AverageRatioPct)
+		}
+	})
+
+	t.Run("連續記錄 0 公克時視為基準的 0% 並發出警示", func(t *testing.T) {
+		points, alerts := BuildFoodIntakeTrend(intakes(map[int]int{1: 60, 2: 60, 3: 60, 4: 0, 5: 0, 6: 0}), 3, 70, 2)
+
+		if !points[3].BelowThreshold || points[3].RatioPct == nil || *points[3].RatioPct != 0 {
+			t.Errorf("預期第 4 天為基準的 0%%，實際為 %+v", points[3])
+		}
+		if len(alerts) != 1 {
+			t.Fatalf("預期 1 筆警示，實際為 %+v", alerts)
+		}
+		alert := alerts[0]
+		if !alert.StartDate.Equal(day(4)) || !alert.EndDate.Equal(day(6)) || alert.Days != 3 || alert.AverageRatioPct != 0 {
+			t.Errorf("預期警示涵蓋第 4 到 6 天且平均 0%%，實際為 %+v", alert)
+		}
+	})
+
+	t.Run("未記錄的日子會中斷連續天數", func(t *testing.T) {
+		_, alerts := BuildFoodIntakeTrend(intakes(map[int]int{1: 60, 2: 60, 3: 60, 4: 30, 6: 30}), 5, 70, 2)
+		if len(alerts) != 0 {
+			t.Errorf("中間缺少紀錄時不應發出警示，實際為 %+v", alerts)
+		}
+	})
+}
+
+func TestValidateFoodIntakeOptions(t *testing.T) {
+	if err := ValidateFoodIntakeOptions(0, 70, 2); !errors.Is(err, ErrFoodBaselineDaysInvalid) {
+		t.Errorf("預期 ErrFoodBaselineDaysInvalid，實際為 %v", err)
+	}
+	if err := ValidateFoodIntakeOptions(7, 120, 2); !errors.Is(err, ErrFoodThresholdInvalid) {
+		t.Errorf("預期 ErrFoodThresholdInvalid，實際為 %v", err)
+	}
+	if err := ValidateFoodIntakeOptions(7, 70, 2); err != nil {
+		t.Errorf("預期無錯誤，實際為 %v", err)
+	}
+}
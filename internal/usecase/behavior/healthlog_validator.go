@@ -2,16 +2,29 @@ package behavior
 
 import (
 	"errors"
+	"fmt"
 	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
 )
 
 var (
 	ErrPetIDRequired   = errors.New("pet_id 為必填欄位")
 	ErrDateInFuture    = errors.New("日期不可為未來")
 	ErrWeightInvalid   = errors.New("體重必須大於 0 且小於 1000 公斤")
-	ErrFoodGramInvalid = errors.New("食物量必須大於 0 且小於 10000 公克")
+	ErrFoodGramInvalid = errors.New("食物量必須介於 0 到 10000 公克")
+
+	ErrUrineClumpsInvalid      = errors.New("尿塊數必須介於 0 到 50")
+	ErrStoolConsistencyInvalid = errors.New("糞便型態必須介於 1 到 7")
+	ErrVomitingCountInvalid    = errors.New("嘔吐次數必須介於 0 到 50")
+	ErrActivityLevelInvalid    = errors.New("無效的活動量")
+	ErrMoodTagInvalid          = errors.New("無效或重複的情緒標籤")
 )
 
+// maxObservationCount 尿塊數與嘔吐次數的合理上限
+const maxObservationCount = 50
+
 // HealthLogValidator 負責健康日誌欄位驗證
 type HealthLogValidator struct{}
 
@@ -30,20 +43,67 @@ func (v *HealthLogValidator) ValidateDate(date time.Time) error {
 	return nil
 }
 
-func (v *HealthLogValidator) ValidateNumericFields(weightKg float64, foodGram int) error {
+// ValidateNumericFields 檢查體重與進食量，未記錄進食量時 foodGram 為 nil，記錄 0 公克代表未進食
+func (v *HealthLogValidator) ValidateNumericFields(weightKg float64, foodGram *int) error {
 	if weightKg < 0 || weightKg > 1000 {
 		return ErrWeightInvalid
 	}
-	if foodGram < 0 || foodGram > 10000 {
+	if foodGram != nil && (*foodGram < 0 || *foodGram > 10000) {
 		return ErrFoodGramInvalid
 	}
 	return nil
 }
 
 // ValidateCreate 統一驗證建立健康日誌所有欄位
-func (v *HealthLogValidator) ValidateCreate(petID string, date time.Time, weightKg float64, foodGram int) error {
+func (v *HealthLogValidator) ValidateCreate(petID string, date time.Time, weightKg float64, foodGram *int) error {
 	if err := v.ValidatePetID(petID); err != nil {
 		return err
 	}
 	return nil
 }
+
+// ValidateObservations 檢查結構化的排泄與行為觀察
+func (v *HealthLogValidator) ValidateObservations(obs model.HealthObservations) error {
+	if obs.UrineClumps != nil && (*obs.UrineClumps < 0 || *obs.UrineClumps > maxObservationCount) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrUrineClumpsInvalid)
+	}
+	if obs.StoolConsistency != nil && !validStoolConsistency(*obs.StoolConsistency) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrStoolConsistencyInvalid)
+	}
+	if obs.VomitingCount < 0 || obs.VomitingCount > maxObservationCount {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrVomitingCountInvalid)
+	}
+	if obs.ActivityLevel != "" && !obs.ActivityLevel.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrActivityLevelInvalid)
+	}
+
+	seen := make(map[model.MoodTag]struct{}, len(obs.MoodTags))
+	for _, tag := range obs.MoodTags {
+		if _, dup := seen[tag]; dup || !tag.IsValid() {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMoodTagInvalid)
+		}
+		seen[tag] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateFilter 檢查結構化觀察的篩選條件
+func (v *HealthLogValidator) ValidateFilter(f model.HealthLogFilter) error {
+	if f.StoolConsistencyMin != 0 && !validStoolConsistency(f.StoolConsistencyMin) ||
+		f.StoolConsistencyMax != 0 && !validStoolConsistency(f.StoolConsistencyMax) ||
+		f.StoolConsistencyMax != 0 && f.StoolConsistencyMin > f.StoolConsistencyMax {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrStoolConsistencyInvalid)
+	}
+	if f.ActivityLevel != "" && !f.ActivityLevel.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrActivityLevelInvalid)
+	}
+	if f.MoodTag != "" && !f.MoodTag.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMoodTagInvalid)
+	}
+	return nil
+}
+
+func validStoolConsistency(v int) bool {
+	return v >= model.StoolConsistencyMin && v <= model.StoolConsistencyMax
+}
@@ -1,7 +1,10 @@
 package behavior
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
 )
 
 func TestValidatePetID(t *testing.T) {
@@ -21,3 +24,59 @@ func TestValidatePetID(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateObservations(t *testing.T) {
+	validator := &HealthLogValidator{}
+	intPtr := func(v int) *int { return &v }
+
+	t.Run("完整且合法的觀察應通過驗證", func(t *testing.T) {
+		obs := model.HealthObservations{
+			UrineClumps:      intPtr(0),
+			StoolConsistency: intPtr(4),
+			VomitingCount:    1,
+			ActivityLevel:    model.ActivityLevelLow,
+			MoodTags:         []model.MoodTag{model.MoodLethargic, model.MoodHiding},
+		}
+		if err := validator.ValidateObservations(obs); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+
+	t.Run("糞便型態超出量表應回傳 ErrStoolConsistencyInvalid", func(t *testing.T) {
+		err := validator.ValidateObservations(model.HealthObservations{StoolConsistency: intPtr(8)})
+		if !errors.Is(err, ErrStoolConsistencyInvalid) {
+			t.Errorf("預期 ErrStoolConsistencyInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("重複的情緒標籤應回傳 ErrMoodTagInvalid", func(t *testing.T) {
+		err := validator.ValidateObservations(model.HealthObservations{MoodTags: []model.MoodTag{model.MoodCalm, model.MoodCalm}})
+		if !errors.Is(err, ErrMoodTagInvalid) {
+			t.Errorf("預期 ErrMoodTagInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("未知的活動量應回傳 ErrActivityLevelInvalid", func(t *testing.T) {
+		err := validator.ValidateObservations(model.HealthObservations{ActivityLevel: "sleepy"})
+		if !errors.Is(err, ErrActivityLevelInvalid) {
+			t.Errorf("預期 ErrActivityLevelInvalid，實際為 %v", err)
+		}
+	})
+}
+
+func TestValidateFilter(t *testing.T) {
+	validator := &HealthLogValidator{}
+
+	t.Run("糞便型態範圍顛倒應回傳 ErrStoolConsistencyInvalid", func(t *testing.T) {
+		err := validator.ValidateFilter(model.HealthLogFilter{StoolConsistencyMin: 6, StoolConsistencyMax: 2})
+		if !errors.Is(err, ErrStoolConsistencyInvalid) {
+			t.Errorf("預期 ErrStoolConsistencyInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("只指定下限應通過驗證", func(t *testing.T) {
+		if err := validator.ValidateFilter(model.HealthLogFilter{HasVomiting: true, StoolConsistencyMin: 6}); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package behavior
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+// DefaultInvitationTTL 邀請 token 的預設有效期限
+const DefaultInvitationTTL = 7 * 24 * time.Hour
+
+var (
+	ErrHouseholdNameRequired   = errors.New("家庭名稱為必填欄位")
+	ErrHouseholdRoleInvalid    = errors.New("無效的家庭成員角色")
+	ErrSitterExpiryRequired    = errors.New("sitter 角色必須設定到期日")
+	ErrMemberExpiryInPast      = errors.New("成員到期日不可早於目前時間")
+	ErrHouseholdOwnerRequired  = errors.New("家庭至少需保留一位 owner")
+	ErrInvitationTokenRequired = errors.New("邀請 token 為必填欄位")
+)
+
+// HouseholdValidator 負責家庭與成員欄位驗證
+type HouseholdValidator struct{}
+
+// Validate 驗證家庭資料的完整性與合法性
+func (v *HouseholdValidator) Validate(household *model.Household) error {
+	if strings.TrimSpace(household.Name) == "" {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrHouseholdNameRequired)
+	}
+	return nil
+}
+
+// ValidateMembership 驗證角色與到期日的組合，sitter 必須設定未來的到期日
+func (v *HouseholdValidator) ValidateMembership(role model.HouseholdRole, expiresAt *time.Time, now time.Time) error {
+	if !role.IsValid() {
+		return fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrHouseholdRoleInvalid, role)
+	}
+	if role == model.HouseholdRoleSitter && expiresAt == nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrSitterExpiryRequired)
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMemberExpiryInPast)
+	}
+	return nil
+}
+
+// ValidateOwners 確認家庭仍保有至少一位有效的 owner
+func (v *HouseholdValidator) ValidateOwners(household *model.Household, now time.Time) error {
+	if household.OwnerCount(now) == 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrHouseholdOwnerRequired)
+	}
+	return nil
+}
+
+// NewInvitationToken 產生隨機的邀請 token，回傳明文與其雜湊值
+func NewInvitationToken() (token string, tokenHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("產生邀請 token 失敗: %w", err)
+	}
+	token = hex.EncodeToString(buf)
+	return token, HashInvitationToken(token), nil
+}
+
+// HashInvitationToken 計算邀請 token 的雜湊值，資料庫只保存雜湊值
+func HashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
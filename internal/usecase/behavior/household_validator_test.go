@@ -0,0 +1,56 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestHouseholdValidator_ValidateMembership(t *testing.T) {
+	validator := &HouseholdValidator{}
+	now := time.Now()
+	future := now.Add(24 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	t.Run("sitter 未設定到期日應回傳 ErrSitterExpiryRequired", func(t *testing.T) {
+		err := validator.ValidateMembership(model.HouseholdRoleSitter, nil, now)
+		if !errors.Is(err, ErrSitterExpiryRequired) {
+			t.Errorf("預期 ErrSitterExpiryRequired，實際為 %v", err)
+		}
+	})
+
+	t.Run("到期日已過應回傳 ErrMemberExpiryInPast", func(t *testing.T) {
+		err := validator.ValidateMembership(model.HouseholdRoleSitter, &past, now)
+		if !errors.Is(err, ErrMemberExpiryInPast) {
+			t.Errorf("預期 ErrMemberExpiryInPast，實際為 %v", err)
+		}
+	})
+
+	t.Run("未知角色應回傳 ErrHouseholdRoleInvalid", func(t *testing.T) {
+		err := validator.ValidateMembership(model.HouseholdRole("admin"), nil, now)
+		if !errors.Is(err, ErrHouseholdRoleInvalid) {
+			t.Errorf("預期 ErrHouseholdRoleInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("合法組合應通過驗證", func(t *testing.T) {
+		if err := validator.ValidateMembership(model.HouseholdRoleSitter, &future, now); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+		if err := validator.ValidateMembership(model.HouseholdRoleEditor, nil, now); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
+
+func TestNewInvitationToken(t *testing.T) {
+	token, hash, err := NewInvitationToken()
+	if err != nil {
+		t.Fatalf("預期無錯誤，實際為 %v", err)
+	}
+	if token == hash || HashInvitationToken(token) != hash {
+		t.Errorf("雜湊值應由明文 token 計算而來")
+	}
+}
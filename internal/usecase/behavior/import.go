@@ -0,0 +1,494 @@
+package behavior
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrImportEntityInvalid  = errors.New("無效的匯入資料種類")
+	ErrImportFormatInvalid  = errors.New("無效的匯入檔格式，僅支援 csv 與 xlsx")
+	ErrImportFileInvalid    = errors.New("無法解析匯入檔")
+	ErrImportEmpty          = errors.New("匯入檔沒有標題列或資料列")
+	ErrImportTooManyRows    = errors.New("匯入檔的資料列超過上限")
+	ErrImportFieldUnknown   = errors.New("欄位對應包含未知的欄位")
+	ErrImportColumnNotFound = errors.New("欄位對應的欄位名稱不存在於標題列")
+	ErrImportColumnRequired = errors.New("缺少必要欄位")
+)
+
+// ImportDedupeKeyField 匯入檔中可選的去重鍵欄位，通常為來源系統的資料 ID
+// 未提供時以該列正規化後的內容計算，同一檔案重複匯入時不會產生重複資料
+const ImportDedupeKeyField = "dedupe_key"
+
+// importUnzipSizeLimit 解壓縮 XLSX 的大小上限，避免惡意檔案耗盡記憶體
+const importUnzipSizeLimit = 128 << 20
+
+// healthLogImportFields 健康日誌可匯入的欄位，名稱與匯出的 CSV 標題一致
+var healthLogImportFields = []string{
+	"pet_id", "date", "weight_kg", "food_gram", "litter_notes", "behaviour_notes",
+	"urine_clumps", "stool_consistency", "vomiting_count", "activity_level", "mood_tags",
+	ImportDedupeKeyField,
+}
+
+// expenseImportFields 費用可匯入的欄位，名稱與匯出的 CSV 標題一致
+var expenseImportFields = []string{
+	"pet_id", "date", "category", "amount", "currency", "description",
+	ImportDedupeKeyField,
+}
+
+// importDateLayouts 可接受的日期格式，未含時區者視為 UTC
+var importDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006/01/02",
+	"2006-01-02 15:04:05",
+	"2006/01/02 15:04:05",
+	"2006/1/2",
+	"2006-1-2",
+}
+
+// ImportFields 回傳指定資料種類可匯入的欄位
+func ImportFields(entity model.ImportEntity) []string {
+	switch entity {
+	case model.ImportEntityHealthLogs:
+		return healthLogImportFields
+	case model.ImportEntityExpenses:
+		return expenseImportFields
+	}
+	return nil
+}
+
+// importRequiredFields 回傳指定資料種類的必要欄位，未指定預設寵物時 pet_id 亦為必要欄位
+func importRequiredFields(entity model.ImportEntity, hasDefaultPet bool) []string {
+	required := []string{"date"}
+	if entity == model.ImportEntityExpenses {
+		required = append(required, "category", "amount")
+	}
+	if !hasDefaultPet {
+		required = append([]string{"pet_id"}, required...)
+	}
+	return required
+}
+
+// ValidateImportOptions 檢查匯入資料種類與檔案格式
+func ValidateImportOptions(entity model.ImportEntity, format model.ImportFormat) error {
+	if !entity.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportEntityInvalid)
+	}
+	if !format.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportFormatInvalid)
+	}
+	return nil
+}
+
+// DetectImportFormat 依副檔名判斷匯入檔格式，無法判斷時回傳空值
+func DetectImportFormat(fileName string) model.ImportFormat {
+	format := model.ImportFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), "."))
+	if !format.IsValid() {
+		return ""
+	}
+	return format
+}
+
+// ImportTable 匯入檔解析後的標題列與資料列
+type ImportTable struct {
+	Header []string
+	Rows   []ImportRow
+}
+
+// ImportRow 匯入檔中的一列資料，Line 為原始檔案中的列號（第 1 列為標題列）
+type ImportRow struct {
+	Line   int
+	Values []string
+}
+
+// ReadImportTable 讀取 CSV 或 XLSX（第一個工作表），第一個非空白列為標題列，空白列會略過
+// 資料列超過 maxRows 時回傳錯誤，maxRows 為 0 表示不限制
+func ReadImportTable(r io.Reader, format model.ImportFormat, maxRows int) (*ImportTable, error) {
+	var (
+		table *ImportTable
+		err   error
+	)
+	switch format {
+	case model.ImportFormatCSV:
+		table, err = readImportCSV(r, maxRows)
+	case model.ImportFormatXLSX:
+		table, err = readImportXLSX(r, maxRows)
+	default:
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportFormatInvalid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if table.Header == nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportEmpty)
+	}
+	return table, nil
+}
+
+// add 加入一列資料，第一個非空白列作為標題列
+func (t *ImportTable) add(line int, values []string, maxRows int) error {
+	if isBlankImportRow(values) {
+		return nil
+	}
+	if t.Header == nil {
+		t.Header = make([]string, len(values))
+		for i, v := range values {
+			t.Header[i] = strings.TrimSpace(strings.TrimPrefix(v, utf8BOM))
+		}
+		return nil
+	}
+	if maxRows > 0 && len(t.Rows) >= maxRows {
+		return fmt.Errorf("%w: %w（上限 %d 列）", domain.ErrInvalidParameter, ErrImportTooManyRows, maxRows)
+	}
+	t.Rows = append(t.Rows, ImportRow{Line: line, Values: values})
+	return nil
+}
+
+func readImportCSV(r io.Reader, maxRows int) (*ImportTable, error) {
+	br := bufio.NewReader(r)
+	// 略過試算表軟體輸出的 UTF-8 BOM
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte(utf8BOM)) {
+		_, _ = br.Discard(3)
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	table := &ImportTable{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrImportFileInvalid, err)
+		}
+		line, _ := reader.FieldPos(0)
+		if err := table.add(line, record, maxRows); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+func readImportXLSX(r io.Reader, maxRows int) (*ImportTable, error) {
+	f, err := excelize.OpenReader(r, excelize.Options{UnzipSizeLimit: importUnzipSizeLimit})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrImportFileInvalid, err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportEmpty)
+	}
+	rows, err := f.Rows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrImportFileInvalid, err)
+	}
+	defer rows.Close()
+
+	table := &ImportTable{}
+	for line := 1; rows.Next(); line++ {
+		// 取得未套用數值格式的原始值，日期儲存格為 Excel 序列值，於解析欄位時轉換
+		values, err := rows.Columns(excelize.Options{RawCellValue: true})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrImportFileInvalid, err)
+		}
+		if err := table.add(line, values, maxRows); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Error(); err != nil {
+		return nil, fmt.Errorf("%w: %w: %w", domain.ErrInvalidParameter, ErrImportFileInvalid, err)
+	}
+	return table, nil
+}
+
+func isBlankImportRow(values []string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ImportColumns 匯入欄位對應到標題列中的欄位索引
+type ImportColumns map[string]int
+
+// ResolveImportColumns 依欄位對應（欄位 → 標題名稱）決定每個欄位的來源
+// 未指定對應的欄位以同名標題（不分大小寫）為來源；hasDefaultPet 表示已指定預設寵物，pet_id 欄位可省略
+func ResolveImportColumns(entity model.ImportEntity, header []string, mapping map[string]string, hasDefaultPet bool) (ImportColumns, error) {
+	fields := ImportFields(entity)
+	if fields == nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrImportEntityInvalid)
+	}
+
+	indexOf := func(name string) int {
+		name = strings.TrimSpace(name)
+		for i, h := range header {
+			if strings.EqualFold(h, name) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	cols := make(ImportColumns, len(fields))
+	for field, name := range mapping {
+		if !slices.Contains(fields, field) {
+			return nil, fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrImportFieldUnknown, field)
+		}
+		i := indexOf(name)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrImportColumnNotFound, name)
+		}
+		cols[field] = i
+	}
+	for _, field := range fields {
+		if _, ok := cols[field]; ok {
+			continue
+		}
+		if i := indexOf(field); i >= 0 {
+			cols[field] = i
+		}
+	}
+
+	for _, field := range importRequiredFields(entity, hasDefaultPet) {
+		if _, ok := cols[field]; !ok {
+			return nil, fmt.Errorf("%w: %w: %s", domain.ErrInvalidParameter, ErrImportColumnRequired, field)
+		}
+	}
+	return cols, nil
+}
+
+// value 取得欄位在該列的值，未對應或該列欄位不足時為空字串
+func (c ImportColumns) value(row ImportRow, field string) string {
+	i, ok := c[field]
+	if !ok || i >= len(row.Values) {
+		return ""
+	}
+	return strings.TrimSpace(row.Values[i])
+}
+
+// ImportRecord 一筆解析並驗證通過的匯入資料，依資料種類僅設定 HealthLog 或 Expense 其中之一
+// 兩者的 ImportKey 皆已設為 DedupeKey
+type ImportRecord struct {
+	PetID     string
+	DedupeKey string
+	HealthLog *model.HealthLog
+	Expense   *model.Expense
+}
+
+// ParseImportRow 將一列資料轉換為領域模型，並以建立資料時相同的驗證器檢查
+// 回傳該列所有欄位的錯誤訊息，有錯誤時不回傳資料
+// 欄位空白時使用 defaultPetID；費用未指定幣別時 Currency 為空，由呼叫端補上使用者的基準貨幣
+func ParseImportRow(entity model.ImportEntity, cols ImportColumns, row ImportRow, format model.ImportFormat, defaultPetID string) (*ImportRecord, []string) {
+	p := &importRowParser{cols: cols, row: row, format: format}
+
+	petID := p.text("pet_id")
+	if petID == "" {
+		petID = defaultPetID
+	}
+
+	record := &ImportRecord{PetID: petID}
+	var content any
+	switch entity {
+	case model.ImportEntityHealthLogs:
+		record.HealthLog = p.healthLog(petID)
+		content = record.HealthLog
+	case model.ImportEntityExpenses:
+		record.Expense = p.expense(petID)
+		content = record.Expense
+	default:
+		return nil, []string{ErrImportEntityInvalid.Error()}
+	}
+	if len(p.errs) > 0 {
+		return nil, p.errs
+	}
+
+	record.DedupeKey = ImportDedupeKey(entity, petID, p.text(ImportDedupeKeyField), content)
+	if record.HealthLog != nil {
+		record.HealthLog.ImportKey = record.DedupeKey
+	}
+	if record.Expense != nil {
+		record.Expense.ImportKey = record.DedupeKey
+	}
+	return record, nil
+}
+
+// ImportDedupeKey 計算去重鍵：有來源鍵值時以其為準，否則以正規化後的資料內容計算
+// 去重鍵包含資料種類與寵物，不同寵物使用相同來源鍵值時不會互相影響
+func ImportDedupeKey(entity model.ImportEntity, petID, sourceKey string, content any) string {
+	h := sha256.New()
+	h.Write([]byte(string(entity) + "\x00" + petID + "\x00"))
+	if sourceKey != "" {
+		h.Write([]byte("key\x00" + sourceKey))
+	} else {
+		data, _ := json.Marshal(content)
+		h.Write([]byte("content\x00"))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// importRowParser 逐欄解析一列資料並累積錯誤訊息
+type importRowParser struct {
+	cols   ImportColumns
+	row    ImportRow
+	format model.ImportFormat
+	errs   []string
+}
+
+func (p *importRowParser) text(field string) string {
+	return p.cols.value(p.row, field)
+}
+
+func (p *importRowParser) fail(field string, err error) {
+	msg := strings.TrimPrefix(err.Error(), domain.ErrInvalidParameter.Error()+": ")
+	if field != "" {
+		msg = field + ": " + msg
+	}
+	p.errs = append(p.errs, msg)
+}
+
+func (p *importRowParser) date(field string) time.Time {
+	value := p.text(field)
+	if value == "" {
+		p.fail(field, errors.New("為必填欄位"))
+		return time.Time{}
+	}
+	for _, layout := range importDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC()
+		}
+	}
+	// XLSX 日期儲存格的原始值為 Excel 序列值
+	if p.format == model.ImportFormatXLSX {
+		if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+			if t, err := excelize.ExcelDateToTime(serial, false); err == nil {
+				return t.UTC()
+			}
+		}
+	}
+	p.fail(field, fmt.Errorf("無法解析日期 %q", value))
+	return time.Time{}
+}
+
+func (p *importRowParser) float(field string) float64 {
+	value := strings.ReplaceAll(p.text(field), ",", "")
+	if value == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		p.fail(field, fmt.Errorf("無法解析數值 %q", value))
+		return 0
+	}
+	return f
+}
+
+// optionalInt 解析整數欄位，空白時回傳 nil；XLSX 的整數可能以 12.0 的形式儲存
+func (p *importRowParser) optionalInt(field string) *int {
+	value := strings.ReplaceAll(p.text(field), ",", "")
+	if value == "" {
+		return nil
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return &n
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && f == float64(int(f)) {
+		n := int(f)
+		return &n
+	}
+	p.fail(field, fmt.Errorf("無法解析整數 %q", value))
+	return nil
+}
+
+func (p *importRowParser) int(field string) int {
+	if n := p.optionalInt(field); n != nil {
+		return *n
+	}
+	return 0
+}
+
+func (p *importRowParser) check(err error) {
+	if err != nil {
+		p.fail("", err)
+	}
+}
+
+func (p *importRowParser) healthLog(petID string) *model.HealthLog {
+	log := &model.HealthLog{
+		PetID:          petID,
+		Date:           p.date("date"),
+		WeightKg:       p.float("weight_kg"),
+		FoodGram:       p.optionalInt("food_gram"),
+		LitterNotes:    p.text("litter_notes"),
+		BehaviourNotes: p.text("behaviour_notes"),
+		HealthObservations: model.HealthObservations{
+			UrineClumps:      p.optionalInt("urine_clumps"),
+			StoolConsistency: p.optionalInt("stool_consistency"),
+			VomitingCount:    p.int("vomiting_count"),
+			ActivityLevel:    model.ActivityLevel(strings.ToLower(p.text("activity_level"))),
+		},
+	}
+	for _, tag := range strings.FieldsFunc(p.text("mood_tags"), func(r rune) bool { return r == ';' || r == ',' }) {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			log.MoodTags = append(log.MoodTags, model.MoodTag(tag))
+		}
+	}
+	if len(p.errs) > 0 {
+		return log
+	}
+
+	validator := &HealthLogValidator{}
+	p.check(validator.ValidateCreate(log.PetID, log.Date, log.WeightKg, log.FoodGram))
+	p.check(validator.ValidateDate(log.Date))
+	p.check(validator.ValidateNumericFields(log.WeightKg, log.FoodGram))
+	p.check(validator.ValidateObservations(log.HealthObservations))
+	return log
+}
+
+func (p *importRowParser) expense(petID string) *model.Expense {
+	exp := &model.Expense{
+		PetID:       petID,
+		Category:    p.text("category"),
+		Amount:      p.int("amount"),
+		Description: p.text("description"),
+		Date:        p.date("date"),
+	}
+	if currency := p.text("currency"); currency != "" {
+		normalized, err := NormalizeCurrency(currency)
+		if err != nil {
+			p.fail("currency", err)
+		}
+		exp.Currency = normalized
+	}
+	if len(p.errs) > 0 {
+		return exp
+	}
+
+	p.check(NewExpenseValidator().ValidateCreateExpense(exp))
+	return exp
+}
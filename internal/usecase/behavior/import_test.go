@@ -0,0 +1,269 @@
+package behavior
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestDetectImportFormat(t *testing.T) {
+	cases := map[string]model.ImportFormat{
+		"logs.csv":     model.ImportFormatCSV,
+		"費用.XLSX":      model.ImportFormatXLSX,
+		"logs.json":    "",
+		"no-extension": "",
+	}
+	for name, want := range cases {
+		if got := DetectImportFormat(name); got != want {
+			t.Errorf("DetectImportFormat(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestReadImportTable(t *testing.T) {
+	t.Run("CSV 略過 BOM 與空白列並保留原始列號", func(t *testing.T) {
+		content := "\ufeffdate,weight_kg\n2026-10-01,4.2\n\n,\n2026-10-02,4.3\n"
+		table, err := ReadImportTable(strings.NewReader(content), model.ImportFormatCSV, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if table.Header[0] != "date" || table.Header[1] != "weight_kg" {
+			t.Fatalf("unexpected header: %v", table.Header)
+		}
+		if len(table.Rows) != 2 || table.Rows[0].Line != 2 || table.Rows[1].Line != 5 {
+			t.Fatalf("unexpected rows: %+v", table.Rows)
+		}
+	})
+
+	t.Run("資料列超過上限", func(t *testing.T) {
+		content := "date\n2026-10-01\n2026-10-02\n2026-10-03\n"
+		_, err := ReadImportTable(strings.NewReader(content), model.ImportFormatCSV, 2)
+		if !errors.Is(err, ErrImportTooManyRows) || !errors.Is(err, domain.ErrInvalidParameter) {
+			t.Fatalf("expected ErrImportTooManyRows, got %v", err)
+		}
+	})
+
+	t.Run("空檔案", func(t *testing.T) {
+		_, err := ReadImportTable(strings.NewReader("\n\n"), model.ImportFormatCSV, 0)
+		if !errors.Is(err, ErrImportEmpty) {
+			t.Fatalf("expected ErrImportEmpty, got %v", err)
+		}
+	})
+
+	t.Run("XLSX 讀取第一個工作表的原始值", func(t *testing.T) {
+		f := excelize.NewFile()
+		sheet := f.GetSheetName(0)
+		_ = f.SetSheetRow(sheet, "A1", &[]any{"日期", "體重"})
+		_ = f.SetSheetRow(sheet, "A3", &[]any{utcDate(2026, 10, 1), 4.25})
+		var buf bytes.Buffer
+		if err := f.Write(&buf); err != nil {
+			t.Fatalf("建立 XLSX 失敗: %v", err)
+		}
+
+		table, err := ReadImportTable(&buf, model.ImportFormatXLSX, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(table.Rows) != 1 || table.Rows[0].Line != 3 {
+			t.Fatalf("unexpected rows: %+v", table.Rows)
+		}
+
+		cols, err := ResolveImportColumns(model.ImportEntityHealthLogs, table.Header, map[string]string{"date": "日期", "weight_kg": "體重"}, true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		record, errs := ParseImportRow(model.ImportEntityHealthLogs, cols, table.Rows[0], model.ImportFormatXLSX, "pet-1")
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if !record.HealthLog.Date.Equal(utcDate(2026, 10, 1)) || record.HealthLog.WeightKg != 4.25 {
+			t.Fatalf("unexpected log: %+v", record.HealthLog)
+		}
+	})
+
+	t.Run("無效的 XLSX", func(t *testing.T) {
+		_, err := ReadImportTable(strings.NewReader("not a zip"), model.ImportFormatXLSX, 0)
+		if !errors.Is(err, ErrImportFileInvalid) {
+			t.Fatalf("expected ErrImportFileInvalid, got %v", err)
+		}
+	})
+}
+
+func TestResolveImportColumns(t *testing.T) {
+	header := []string{"Date", "體重", "pet_id"}
+
+	t.Run("同名欄位不分大小寫並套用對應", func(t *testing.T) {
+		cols, err := ResolveImportColumns(model.ImportEntityHealthLogs, header, map[string]string{"weight_kg": "體重"}, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cols["date"] != 0 || cols["weight_kg"] != 1 || cols["pet_id"] != 2 {
+			t.Fatalf("unexpected columns: %v", cols)
+		}
+	})
+
+	t.Run("未知的欄位", func(t *testing.T) {
+		_, err := ResolveImportColumns(model.ImportEntityHealthLogs, header, map[string]string{"height": "體重"}, false)
+		if !errors.Is(err, ErrImportFieldUnknown) {
+			t.Fatalf("expected ErrImportFieldUnknown, got %v", err)
+		}
+	})
+
+	t.Run("對應的標題不存在", func(t *testing.T) {
+		_, err := ResolveImportColumns(model.ImportEntityHealthLogs, header, map[string]string{"weight_kg": "重量"}, false)
+		if !errors.Is(err, ErrImportColumnNotFound) {
+			t.Fatalf("expected ErrImportColumnNotFound, got %v", err)
+		}
+	})
+
+	t.Run("缺少必要欄位", func(t *testing.T) {
+		_, err := ResolveImportColumns(model.ImportEntityExpenses, header, nil, false)
+		if !errors.Is(err, ErrImportColumnRequired) {
+			t.Fatalf("expected ErrImportColumnRequired, got %v", err)
+		}
+		_, err = ResolveImportColumns(model.ImportEntityHealthLogs, []string{"date"}, nil, false)
+		if !errors.Is(err, ErrImportColumnRequired) {
+			t.Fatalf("未指定預設寵物時 pet_id 應為必要欄位, got %v", err)
+		}
+		if _, err := ResolveImportColumns(model.ImportEntityHealthLogs, []string{"date"}, nil, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
+
+// parseTestRow 以 CSV 內容解析第一筆資料列
+func parseTestRow(t *testing.T, entity model.ImportEntity, content, defaultPetID string) (*ImportRecord, []string) {
+	t.Helper()
+	table, err := ReadImportTable(strings.NewReader(content), model.ImportFormatCSV, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cols, err := ResolveImportColumns(entity, table.Header, nil, defaultPetID != "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return ParseImportRow(entity, cols, table.Rows[0], model.ImportFormatCSV, defaultPetID)
+}
+
+func TestParseImportRow(t *testing.T) {
+	t.Run("健康日誌", func(t *testing.T) {
+		content := "pet_id,date,weight_kg,food_gram,stool_consistency,activity_level,mood_tags\n" +
+			",2026-10-01,4.2,60,4,Normal,calm; playful\n"
+		record, errs := parseTestRow(t, model.ImportEntityHealthLogs, content, "pet-1")
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		log := record.HealthLog
+		if record.PetID != "pet-1" || log.PetID != "pet-1" || log.FoodGram == nil || *log.FoodGram != 60 || *log.StoolConsistency != 4 {
+			t.Fatalf("unexpected log: %+v", log)
+		}
+		if log.ActivityLevel != model.ActivityLevelNormal || len(log.MoodTags) != 2 || log.MoodTags[1] != model.MoodPlayful {
+			t.Fatalf("unexpected observations: %+v", log.HealthObservations)
+		}
+		if log.UrineClumps != nil {
+			t.Fatalf("未記錄的觀察應為 nil")
+		}
+		if record.DedupeKey == "" || log.ImportKey != record.DedupeKey {
+			t.Fatalf("去重鍵未設定: %q", log.ImportKey)
+		}
+	})
+
+	t.Run("收集所有欄位的格式錯誤", func(t *testing.T) {
+		content := "date,weight_kg,food_gram\n10/01/2026,abc,1.5\n"
+		_, errs := parseTestRow(t, model.ImportEntityHealthLogs, content, "pet-1")
+		if len(errs) != 3 {
+			t.Fatalf("expected 3 errors, got %v", errs)
+		}
+		if !strings.HasPrefix(errs[0], "date: ") || !strings.HasPrefix(errs[1], "weight_kg: ") {
+			t.Fatalf("錯誤訊息應標示欄位: %v", errs)
+		}
+	})
+
+	t.Run("套用健康日誌驗證規則", func(t *testing.T) {
+		content := "date,stool_consistency\n2026-10-01,9\n"
+		_, errs := parseTestRow(t, model.ImportEntityHealthLogs, content, "pet-1")
+		if len(errs) != 1 || errs[0] != ErrStoolConsistencyInvalid.Error() {
+			t.Fatalf("expected stool consistency error, got %v", errs)
+		}
+
+		content = "date\n2999-01-01\n"
+		_, errs = parseTestRow(t, model.ImportEntityHealthLogs, content, "pet-1")
+		if len(errs) != 1 || errs[0] != ErrDateInFuture.Error() {
+			t.Fatalf("expected future date error, got %v", errs)
+		}
+	})
+
+	t.Run("費用", func(t *testing.T) {
+		content := "pet_id,date,category,amount,currency,description\npet-2,2026/9/30,飼料,\"1,200\",usd,罐頭\n"
+		record, errs := parseTestRow(t, model.ImportEntityExpenses, content, "")
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		exp := record.Expense
+		if exp.PetID != "pet-2" || exp.Amount != 1200 || exp.Currency != "USD" || !exp.Date.Equal(utcDate(2026, 9, 30)) {
+			t.Fatalf("unexpected expense: %+v", exp)
+		}
+	})
+
+	t.Run("套用費用驗證規則", func(t *testing.T) {
+		content := "date,category,amount,currency\n2026-09-30,飼料,0,\n"
+		_, errs := parseTestRow(t, model.ImportEntityExpenses, content, "pet-1")
+		if len(errs) != 1 || errs[0] != "金額必須大於 0" {
+			t.Fatalf("expected amount error, got %v", errs)
+		}
+
+		content = "date,category,amount,currency\n2026-09-30,飼料,100,dollar\n"
+		_, errs = parseTestRow(t, model.ImportEntityExpenses, content, "pet-1")
+		if len(errs) != 1 || !strings.HasPrefix(errs[0], "currency: ") {
+			t.Fatalf("expected currency error, got %v", errs)
+		}
+	})
+}
+
+func TestImportDedupeKey(t *testing.T) {
+	key := func(content, petID string) string {
+		record, errs := parseTestRow(t, model.ImportEntityHealthLogs, content, petID)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		return record.DedupeKey
+	}
+
+	t.Run("以正規化後的內容計算", func(t *testing.T) {
+		a := key("date,weight_kg\n2026-10-01,4.2\n", "pet-1")
+		b := key("weight_kg,date\n4.20,2026/10/01\n", "pet-1")
+		if a != b {
+			t.Fatalf("相同內容應產生相同去重鍵")
+		}
+		if a == key("date,weight_kg\n2026-10-01,4.3\n", "pet-1") {
+			t.Fatalf("不同內容應產生不同去重鍵")
+		}
+		if a == key("date,weight_kg\n2026-10-01,4.2\n", "pet-2") {
+			t.Fatalf("不同寵物應產生不同去重鍵")
+		}
+	})
+
+	t.Run("有來源鍵值時以其為準", func(t *testing.T) {
+		a := key("date,weight_kg,dedupe_key\n2026-10-01,4.2,src-1\n", "pet-1")
+		b := key("date,weight_kg,dedupe_key\n2026-10-01,4.5,src-1\n", "pet-1")
+		if a != b {
+			t.Fatalf("相同來源鍵值應產生相同去重鍵")
+		}
+	})
+
+	t.Run("匯出的 CSV 標題可直接匯入", func(t *testing.T) {
+		cols, err := ResolveImportColumns(model.ImportEntityExpenses, []string{"id", "pet_id", "date", "category", "amount", "currency", "description", "recurring_expense_id"}, nil, false)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := cols["currency"]; !ok {
+			t.Fatalf("unexpected columns: %v", cols)
+		}
+	})
+}
@@ -0,0 +1,349 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+const (
+	// maxDoseInterval 給藥間隔上限
+	maxDoseInterval = 30
+	// maxDoseTimesPerDay 每個給藥日可設定的時間數上限
+	maxDoseTimesPerDay = 12
+	// maxDoseOccurrences 單次計算允許展開的給藥次數上限
+	maxDoseOccurrences = 5000
+	// maxDoseRange 給藥紀錄與服藥率查詢期間的上限
+	maxDoseRange = 366 * 24 * time.Hour
+	// MissedDoseLookback 漏服偵測最多往前檢查的期間，避免新建立或長時間未檢查的療程一次產生大量提醒
+	MissedDoseLookback = 48 * time.Hour
+)
+
+var (
+	ErrMedicationRequired         = errors.New("寵物 ID、藥品與單位為必填欄位，且劑量必須大於 0")
+	ErrDoseFrequencyInvalid       = errors.New("無效的給藥頻率")
+	ErrDoseIntervalInvalid        = errors.New("給藥間隔必須介於 1 到 30 之間")
+	ErrDoseTimesInvalid           = errors.New("給藥時間必須為 HH:MM 格式，且每天 1 到 12 次")
+	ErrMedicationTimezoneInvalid  = errors.New("無效的時區")
+	ErrMedicationDateRangeInvalid = errors.New("開始日期為必填欄位，且結束日期不可早於開始日期")
+	ErrMedicationHospitalNotFound = errors.New("開立處方的醫院不存在")
+	ErrMedicationRecordMismatch   = errors.New("醫療記錄不存在或不屬於同一隻寵物")
+	ErrDoseNotScheduled           = errors.New("指定時間不是此療程排定的給藥時間")
+	ErrDoseStatusInvalid          = errors.New("給藥狀態必須為 taken 或 skipped")
+	ErrDoseRangeInvalid           = errors.New("結束時間必須晚於開始時間，且期間不可超過 1 年")
+)
+
+// NormalizeMedicationRegimen 驗證用藥療程並整理排程設定：
+// 未指定間隔時為 1，未指定時區時為 UTC，給藥時間去除重複並排序，起訖日期正規化為療程時區的當日零時
+func NormalizeMedicationRegimen(r *model.MedicationRegimen) error {
+	r.Drug = strings.TrimSpace(r.Drug)
+	r.Unit = strings.TrimSpace(r.Unit)
+	r.Notes = strings.TrimSpace(r.Notes)
+	r.HospitalID = strings.TrimSpace(r.HospitalID)
+	r.MedicalRecordID = strings.TrimSpace(r.MedicalRecordID)
+	if strings.TrimSpace(r.PetID) == "" || r.Drug == "" || r.Unit == "" || r.Dose <= 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMedicationRequired)
+	}
+	if !r.Schedule.Frequency.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseFrequencyInvalid)
+	}
+	if r.Schedule.Interval == 0 {
+		r.Schedule.Interval = 1
+	}
+	if r.Schedule.Interval < 0 || r.Schedule.Interval > maxDoseInterval {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseIntervalInvalid)
+	}
+
+	times := make([]string, 0, len(r.Schedule.Times))
+	for _, value := range r.Schedule.Times {
+		clock, err := time.Parse("15:04", strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseTimesInvalid)
+		}
+		times = append(times, clock.Format("15:04"))
+	}
+	slices.Sort(times)
+	times = slices.Compact(times)
+	if len(times) == 0 || len(times) > maxDoseTimesPerDay {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseTimesInvalid)
+	}
+	r.Schedule.Times = times
+
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMedicationTimezoneInvalid)
+	}
+
+	if r.StartDate.IsZero() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMedicationDateRangeInvalid)
+	}
+	r.StartDate = startOfDayIn(r.StartDate, loc)
+	if r.EndDate != nil {
+		end := startOfDayIn(*r.EndDate, loc)
+		if end.Before(r.StartDate) {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrMedicationDateRangeInvalid)
+		}
+		r.EndDate = &end
+	}
+
+	return nil
+}
+
+// ValidateDoseRange 檢查給藥紀錄與服藥率的查詢期間
+func ValidateDoseRange(start, end time.Time) error {
+	if !end.After(start) || end.Sub(start) > maxDoseRange {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseRangeInvalid)
+	}
+	return nil
+}
+
+// RegimenLocation 回傳療程的時區，時區無效時為 UTC
+func RegimenLocation(r *model.MedicationRegimen) *time.Location {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// DoseOccurrences 回傳療程在 [from, to) 之間排定的給藥時間（UTC），依時間由早到晚排列
+// 每日排程自開始日期起每 Interval 天一次，每週排程自開始日期起每 Interval 週一次，給藥日的各時間以療程時區解讀
+func DoseOccurrences(r *model.MedicationRegimen, from, to time.Time) []time.Time {
+	if r.Schedule.Interval <= 0 || !to.After(from) {
+		return nil
+	}
+
+	step := r.Schedule.Interval
+	switch r.Schedule.Frequency {
+	case model.DoseDaily:
+	case model.DoseWeekly:
+		step *= 7
+	default:
+		return nil
+	}
+
+	clocks := make([]time.Time, 0, len(r.Schedule.Times))
+	for _, value := range r.Schedule.Times {
+		if clock, err := time.Parse("15:04", value); err == nil {
+			clocks = append(clocks, clock)
+		}
+	}
+	if len(clocks) == 0 {
+		return nil
+	}
+
+	loc := RegimenLocation(r)
+	start := r.StartDate.In(loc)
+	first := 0
+	// 往前多看一個給藥日，避免時區差使前一天的給藥時間落在 from 之後
+	if days := calendarDaysBetween(start, from.In(loc)) - 1; days > 0 {
+		first = days / step
+	}
+
+	var occurrences []time.Time
+	for i := first; ; i++ {
+		day := start.AddDate(0, 0, i*step)
+		if !day.Before(to) || (r.EndDate != nil && calendarDaysBetween(r.EndDate.In(loc), day) > 0) {
+			break
+		}
+		for _, clock := range clocks {
+			at := time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+			if at.Before(from) || !at.Before(to) {
+				continue
+			}
+			occurrences = append(occurrences, at.UTC())
+			if len(occurrences) >= maxDoseOccurrences {
+				return occurrences
+			}
+		}
+	}
+	return occurrences
+}
+
+// ValidateDoseEvent 檢查照護者勾選的給藥：狀態必須為 taken 或 skipped，且時間為療程排定的給藥時間
+// taken 未指定實際給藥時間時以排定時間記錄，skipped 不記錄實際給藥時間
+func ValidateDoseEvent(r *model.MedicationRegimen, event *model.DoseEvent) error {
+	if !event.Status.IsRecordable() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseStatusInvalid)
+	}
+	scheduledAt := event.ScheduledAt.UTC()
+	if occurrences := DoseOccurrences(r, scheduledAt, scheduledAt.Add(time.Minute)); len(occurrences) == 0 || !occurrences[0].Equal(scheduledAt) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrDoseNotScheduled)
+	}
+
+	event.ScheduledAt = scheduledAt
+	event.RegimenID = r.ID
+	event.PetID = r.PetID
+	event.Note = strings.TrimSpace(event.Note)
+	switch event.Status {
+	case model.DoseStatusTaken:
+		if event.TakenAt == nil {
+			event.TakenAt = &scheduledAt
+		}
+	case model.DoseStatusSkipped:
+		event.TakenAt = nil
+	}
+	return nil
+}
+
+// BuildDoseEvents 合併療程在 [from, to) 之間排定的給藥與照護者的勾選紀錄，依排定時間排序
+// 未勾選的給藥在排定時間加上 grace 之前為 pending，之後為 missed；
+// 排程變更前已勾選、但已不在排程中的紀錄仍會列出
+func BuildDoseEvents(r *model.MedicationRegimen, recorded []*model.DoseEvent, from, to time.Time, grace time.Duration, now time.Time) []*model.DoseEvent {
+	byTime := make(map[int64]*model.DoseEvent, len(recorded))
+	for _, event := range recorded {
+		if !event.ScheduledAt.Before(from) && event.ScheduledAt.Before(to) {
+			byTime[event.ScheduledAt.UnixNano()] = event
+		}
+	}
+
+	events := make([]*model.DoseEvent, 0, len(recorded))
+	for _, at := range DoseOccurrences(r, from, to) {
+		if event, ok := byTime[at.UnixNano()]; ok {
+			events = append(events, event)
+			delete(byTime, at.UnixNano())
+			continue
+		}
+		status := model.DoseStatusPending
+		if !at.Add(grace).After(now) {
+			status = model.DoseStatusMissed
+		}
+		events = append(events, &model.DoseEvent{RegimenID: r.ID, PetID: r.PetID, ScheduledAt: at, Status: status})
+	}
+	for _, event := range byTime {
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ScheduledAt.Before(events[j].ScheduledAt)
+	})
+	return events
+}
+
+// MissedDoses 從合併後的給藥中挑出漏服的給藥
+func MissedDoses(events []*model.DoseEvent) []*model.DoseEvent {
+	var missed []*model.DoseEvent
+	for _, event := range events {
+		if event.Status == model.DoseStatusMissed {
+			missed = append(missed, event)
+		}
+	}
+	return missed
+}
+
+// MissedDoseReminderTitle 產生漏服提醒的標題
+func MissedDoseReminderTitle(r *model.MedicationRegimen) string {
+	return fmt.Sprintf("漏服：%s %s %s", r.Drug, formatDose(r.Dose), r.Unit)
+}
+
+// BuildMedicationAdherence 依區間統計給藥狀態與服藥率，期間內沒有給藥的區間也會列出
+// 區間以療程時區的日期分組，period_start 以該日期的 UTC 零時表示
+func BuildMedicationAdherence(
+	r *model.MedicationRegimen,
+	events []*model.DoseEvent,
+	start, end time.Time,
+	interval model.TrendInterval,
+) *model.MedicationAdherence {
+	loc := RegimenLocation(r)
+	periodOf := func(t time.Time) time.Time {
+		local := t.In(loc)
+		return TrendPeriodStart(time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC), interval)
+	}
+
+	result := &model.MedicationAdherence{
+		RegimenID: r.ID,
+		Interval:  interval,
+		StartDate: start,
+		EndDate:   end,
+		Periods:   []*model.DoseAdherence{},
+	}
+
+	index := make(map[time.Time]*model.DoseAdherence)
+	last := periodOf(end.Add(-time.Nanosecond))
+	for period := periodOf(start); !period.After(last); period = nextTrendPeriod(period, interval) {
+		bucket := &model.DoseAdherence{PeriodStart: period}
+		index[period] = bucket
+		result.Periods = append(result.Periods, bucket)
+	}
+
+	result.Overall.PeriodStart = periodOf(start)
+	for _, event := range events {
+		bucket, ok := index[periodOf(event.ScheduledAt)]
+		if !ok {
+			continue
+		}
+		countDose(bucket, event.Status)
+		countDose(&result.Overall, event.Status)
+	}
+
+	for _, bucket := range result.Periods {
+		bucket.AdherencePct = adherencePct(bucket)
+	}
+	result.Overall.AdherencePct = adherencePct(&result.Overall)
+	return result
+}
+
+// countDose 依給藥狀態累加統計
+func countDose(bucket *model.DoseAdherence, status model.DoseStatus) {
+	bucket.Scheduled++
+	switch status {
+	case model.DoseStatusTaken:
+		bucket.Taken++
+	case model.DoseStatusSkipped:
+		bucket.Skipped++
+	case model.DoseStatusMissed:
+		bucket.Missed++
+	default:
+		bucket.Pending++
+	}
+}
+
+// adherencePct 計算已到期的給藥中 taken 所佔的百分比，略過的給藥不計入
+func adherencePct(bucket *model.DoseAdherence) *float64 {
+	due := bucket.Taken + bucket.Missed
+	if due == 0 {
+		return nil
+	}
+	pct := roundTo(float64(bucket.Taken)/float64(due)*100, 1)
+	return &pct
+}
+
+// nextTrendPeriod 回傳下一個區間的起始時間
+func nextTrendPeriod(period time.Time, interval model.TrendInterval) time.Time {
+	switch interval {
+	case model.TrendIntervalWeek:
+		return period.AddDate(0, 0, 7)
+	case model.TrendIntervalMonth:
+		return period.AddDate(0, 1, 0)
+	default:
+		return period.AddDate(0, 0, 1)
+	}
+}
+
+// startOfDayIn 回傳時間點在指定時區的當日零時
+func startOfDayIn(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// calendarDaysBetween 回傳兩個時間點在各自時區的日期相差的天數，不受日光節約時間影響
+func calendarDaysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// formatDose 以最精簡的小數格式輸出劑量
+func formatDose(dose float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.3f", dose), "0"), ".")
+}
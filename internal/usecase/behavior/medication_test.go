@@ -0,0 +1,190 @@
+package behavior
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestNormalizeMedicationRegimen(t *testing.T) {
+	t.Run("整理給藥時間並將起訖日期正規化為療程時區的零時", func(t *testing.T) {
+		end := time.Date(2025, time.March, 31, 20, 0, 0, 0, time.UTC)
+		r := &model.MedicationRegimen{
+			PetID:     "pet-1",
+			Drug:      " Methimazole ",
+			Dose:      2.5,
+			Unit:      "mg",
+			Schedule:  model.DoseSchedule{Frequency: model.DoseDaily, Times: []string{"20:00", "08:00", "8:00"}},
+			Timezone:  "Asia/Taipei",
+			StartDate: time.Date(2025, time.February, 28, 17, 0, 0, 0, time.UTC),
+			EndDate:   &end,
+		}
+		if err := NormalizeMedicationRegimen(r); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+
+		taipei, _ := time.LoadLocation("Asia/Taipei")
+		if r.Drug != "Methimazole" || r.Schedule.Interval != 1 || !slices.Equal(r.Schedule.Times, []string{"08:00", "20:00"}) {
+			t.Errorf("排程未正規化: %q %+v", r.Drug, r.Schedule)
+		}
+		if !r.StartDate.Equal(time.Date(2025, time.March, 1, 0, 0, 0, 0, taipei)) || !r.EndDate.Equal(time.Date(2025, time.April, 1, 0, 0, 0, 0, taipei)) {
+			t.Errorf("起訖日期未正規化: %v ~ %v", r.StartDate, r.EndDate)
+		}
+	})
+
+	end := utcDate(2024, time.December, 31)
+	valid := func() model.MedicationRegimen {
+		return model.MedicationRegimen{
+			PetID:     "pet-1",
+			Drug:      "Benazepril",
+			Dose:      0.5,
+			Unit:      "tablet",
+			Schedule:  model.DoseSchedule{Frequency: model.DoseDaily, Times: []string{"08:00"}},
+			StartDate: utcDate(2025, time.January, 1),
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(r *model.MedicationRegimen)
+		want   error
+	}{
+		{"劑量為 0", func(r *model.MedicationRegimen) { r.Dose = 0 }, ErrMedicationRequired},
+		{"未指定單位", func(r *model.MedicationRegimen) { r.Unit = " " }, ErrMedicationRequired},
+		{"無效頻率", func(r *model.MedicationRegimen) { r.Schedule.Frequency = "monthly" }, ErrDoseFrequencyInvalid},
+		{"間隔超過上限", func(r *model.MedicationRegimen) { r.Schedule.Interval = 31 }, ErrDoseIntervalInvalid},
+		{"未指定給藥時間", func(r *model.MedicationRegimen) { r.Schedule.Times = nil }, ErrDoseTimesInvalid},
+		{"給藥時間格式錯誤", func(r *model.MedicationRegimen) { r.Schedule.Times = []string{"25:00"} }, ErrDoseTimesInvalid},
+		{"無效時區", func(r *model.MedicationRegimen) { r.Timezone = "Mars/Base" }, ErrMedicationTimezoneInvalid},
+		{"結束日期早於開始日期", func(r *model.MedicationRegimen) { r.EndDate = &end }, ErrMedicationDateRangeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.mutate(&r)
+			err := NormalizeMedicationRegimen(&r)
+			if !errors.Is(err, tt.want) || !domain.IsInvalidParameter(err) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestDoseOccurrences(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, time.March, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("每日兩次並於結束日期後停止", func(t *testing.T) {
+		end := utcDate(2025, time.March, 3)
+		r := &model.MedicationRegimen{
+			Schedule:  model.DoseSchedule{Frequency: model.DoseDaily, Interval: 1, Times: []string{"08:00", "20:00"}},
+			Timezone:  "UTC",
+			StartDate: utcDate(2025, time.March, 1),
+			EndDate:   &end,
+		}
+		got := DoseOccurrences(r, at(1, 12), at(10, 0))
+		want := []time.Time{at(1, 20), at(2, 8), at(2, 20), at(3, 8), at(3, 20)}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("預期 %v，實際為 %v", want, got)
+		}
+	})
+
+	t.Run("每兩週一次且給藥時間以療程時區解讀", func(t *testing.T) {
+		r := &model.MedicationRegimen{
+			Schedule:  model.DoseSchedule{Frequency: model.DoseWeekly, Interval: 2, Times: []string{"07:00"}},
+			Timezone:  "Asia/Taipei",
+			StartDate: utcDate(2025, time.February, 28).Add(-8 * time.Hour),
+		}
+		got := DoseOccurrences(r, at(14, 0), at(31, 0))
+		// 台北 3/14、3/28 07:00 為 UTC 前一天 23:00
+		want := []time.Time{at(27, 23)}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("預期 %v，實際為 %v", want, got)
+		}
+	})
+}
+
+func TestBuildDoseEvents(t *testing.T) {
+	r := &model.MedicationRegimen{
+		ID:        "reg-1",
+		PetID:     "pet-1",
+		Schedule:  model.DoseSchedule{Frequency: model.DoseDaily, Interval: 1, Times: []string{"08:00", "20:00"}},
+		Timezone:  "UTC",
+		StartDate: utcDate(2025, time.March, 1),
+	}
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, time.March, day, hour, 0, 0, 0, time.UTC)
+	}
+	recorded := []*model.DoseEvent{
+		{RegimenID: "reg-1", ScheduledAt: at(1, 8), Status: model.DoseStatusTaken},
+		{RegimenID: "reg-1", ScheduledAt: at(2, 8), Status: model.DoseStatusSkipped},
+		// 排程變更前的紀錄
+		{RegimenID: "reg-1", ScheduledAt: at(2, 12), Status: model.DoseStatusTaken},
+	}
+	now := at(2, 21)
+
+	events := BuildDoseEvents(r, recorded, at(1, 0), at(3, 0), 2*time.Hour, now)
+
+	var statuses []model.DoseStatus
+	for _, event := range events {
+		statuses = append(statuses, event.Status)
+	}
+	want := []model.DoseStatus{model.DoseStatusTaken, model.DoseStatusMissed, model.DoseStatusSkipped, model.DoseStatusTaken, model.DoseStatusPending}
+	if !slices.Equal(statuses, want) {
+		t.Fatalf("預期 %v，實際為 %v", want, statuses)
+	}
+
+	missed := MissedDoses(events)
+	if len(missed) != 1 || !missed[0].ScheduledAt.Equal(at(1, 20)) || missed[0].RegimenID != "reg-1" {
+		t.Errorf("漏服的給藥不正確: %+v", missed)
+	}
+
+	t.Run("統計各區間服藥率", func(t *testing.T) {
+		adherence := BuildMedicationAdherence(r, events, at(1, 0), at(4, 0), model.TrendIntervalDay)
+		if len(adherence.Periods) != 3 {
+			t.Fatalf("預期 3 個區間，實際為 %d", len(adherence.Periods))
+		}
+
+		first := adherence.Periods[0]
+		if first.Scheduled != 2 || first.Taken != 1 || first.Missed != 1 || first.AdherencePct == nil || *first.AdherencePct != 50 {
+			t.Errorf("第一天統計不正確: %+v", first)
+		}
+		if last := adherence.Periods[2]; last.Scheduled != 0 || last.AdherencePct != nil {
+			t.Errorf("沒有給藥的區間服藥率應為 nil: %+v", last)
+		}
+		overall := adherence.Overall
+		if overall.Scheduled != 5 || overall.Skipped != 1 || overall.Pending != 1 || overall.AdherencePct == nil || *overall.AdherencePct != 66.7 {
+			t.Errorf("整體統計不正確: %+v", overall)
+		}
+	})
+}
+
+func TestValidateDoseEvent(t *testing.T) {
+	r := &model.MedicationRegimen{
+		ID:        "reg-1",
+		PetID:     "pet-1",
+		Schedule:  model.DoseSchedule{Frequency: model.DoseDaily, Interval: 1, Times: []string{"08:00"}},
+		Timezone:  "UTC",
+		StartDate: utcDate(2025, time.March, 1),
+	}
+	scheduled := time.Date(2025, time.March, 2, 8, 0, 0, 0, time.UTC)
+
+	event := &model.DoseEvent{ScheduledAt: scheduled, Status: model.DoseStatusTaken}
+	if err := ValidateDoseEvent(r, event); err != nil {
+		t.Fatalf("預期無錯誤，實際為 %v", err)
+	}
+	if event.RegimenID != "reg-1" || event.PetID != "pet-1" || event.TakenAt == nil || !event.TakenAt.Equal(scheduled) {
+		t.Errorf("給藥紀錄未補齊: %+v", event)
+	}
+
+	if err := ValidateDoseEvent(r, &model.DoseEvent{ScheduledAt: scheduled.Add(time.Hour), Status: model.DoseStatusTaken}); !errors.Is(err, ErrDoseNotScheduled) {
+		t.Errorf("預期 ErrDoseNotScheduled，實際為 %v", err)
+	}
+	if err := ValidateDoseEvent(r, &model.DoseEvent{ScheduledAt: scheduled, Status: model.DoseStatusMissed}); !errors.Is(err, ErrDoseStatusInvalid) {
+		t.Errorf("預期 ErrDoseStatusInvalid，實際為 %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrNotificationChannelInvalid   = errors.New("不支援的通知管道")
+	ErrNotificationChannelDuplicate = errors.New("通知管道不可重複設定")
+	ErrNotificationTargetRequired   = errors.New("啟用的通知管道必須設定目標")
+	ErrNotificationEmailInvalid     = errors.New("Email 格式不正確")
+	ErrNotificationWebhookInvalid   = errors.New("Webhook URL 必須為 https 或 http 絕對路徑")
+)
+
+// NotificationPreferenceValidator 負責通知偏好設定的驗證
+type NotificationPreferenceValidator struct{}
+
+// Validate 驗證每個管道設定的合法性
+func (v *NotificationPreferenceValidator) Validate(pref *model.NotificationPreference) error {
+	seen := make(map[model.NotificationChannel]bool, len(pref.Channels))
+	for _, ch := range pref.Channels {
+		if !ch.Channel.IsValid() {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrNotificationChannelInvalid)
+		}
+		if seen[ch.Channel] {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrNotificationChannelDuplicate)
+		}
+		seen[ch.Channel] = true
+
+		target := strings.TrimSpace(ch.Target)
+		if target == "" {
+			if ch.Enabled {
+				return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrNotificationTargetRequired)
+			}
+			continue
+		}
+
+		switch ch.Channel {
+		case model.NotificationChannelEmail:
+			if _, err := mail.ParseAddress(target); err != nil {
+				return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrNotificationEmailInvalid)
+			}
+		case model.NotificationChannelWebhook:
+			u, err := url.Parse(target)
+			if err != nil || !u.IsAbs() || (u.Scheme != "https" && u.Scheme != "http") {
+				return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrNotificationWebhookInvalid)
+			}
+		}
+	}
+	return nil
+}
+
+// NextRetryAt 依指數退避計算下次重試時間；已達嘗試上限時回傳 nil
+// attempts 為已嘗試次數，第 n 次失敗後等待 baseDelay * 2^(n-1)
+func NextRetryAt(now time.Time, attempts, maxAttempts int, baseDelay time.Duration) *time.Time {
+	if attempts >= maxAttempts {
+		return nil
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+	next := now.Add(baseDelay << (attempts - 1))
+	return &next
+}
@@ -0,0 +1,61 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestNextRetryAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("應以指數倍增等待時間", func(t *testing.T) {
+		cases := map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 3: 4 * time.Minute}
+		for attempts, want := range cases {
+			next := NextRetryAt(now, attempts, 5, time.Minute)
+			if next == nil || next.Sub(now) != want {
+				t.Errorf("第 %d 次失敗後預期等待 %v，實際為 %v", attempts, want, next)
+			}
+		}
+	})
+
+	t.Run("達到上限時不再重試", func(t *testing.T) {
+		if next := NextRetryAt(now, 5, 5, time.Minute); next != nil {
+			t.Errorf("預期 nil，實際為 %v", next)
+		}
+	})
+}
+
+func TestNotificationPreferenceValidator(t *testing.T) {
+	validator := &NotificationPreferenceValidator{}
+
+	t.Run("啟用但未設定目標應失敗", func(t *testing.T) {
+		pref := &model.NotificationPreference{Channels: []model.NotificationChannelSetting{
+			{Channel: model.NotificationChannelLine, Enabled: true},
+		}}
+		if err := validator.Validate(pref); !errors.Is(err, ErrNotificationTargetRequired) {
+			t.Errorf("預期 ErrNotificationTargetRequired，實際為 %v", err)
+		}
+	})
+
+	t.Run("無效的 Email 應失敗", func(t *testing.T) {
+		pref := &model.NotificationPreference{Channels: []model.NotificationChannelSetting{
+			{Channel: model.NotificationChannelEmail, Target: "not-an-email", Enabled: true},
+		}}
+		if err := validator.Validate(pref); !errors.Is(err, ErrNotificationEmailInvalid) {
+			t.Errorf("預期 ErrNotificationEmailInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("合法設定應通過驗證", func(t *testing.T) {
+		pref := &model.NotificationPreference{Channels: []model.NotificationChannelSetting{
+			{Channel: model.NotificationChannelEmail, Target: "owner@example.com", Enabled: true},
+			{Channel: model.NotificationChannelWebhook, Target: "https://example.com/hook", Enabled: true},
+		}}
+		if err := validator.Validate(pref); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package behavior
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// PetAccessGuard 依飼主身分與家庭成員資格判斷目前使用者能否存取寵物，供所有寵物相關資料的用例共用
+type PetAccessGuard struct {
+	petRepo       repository.PetRepository
+	householdRepo repository.HouseholdRepository
+}
+
+// NewPetAccessGuard 建立新的 PetAccessGuard
+func NewPetAccessGuard(petRepo repository.PetRepository, householdRepo repository.HouseholdRepository) *PetAccessGuard {
+	if petRepo == nil || householdRepo == nil {
+		panic("petRepo and householdRepo are required")
+	}
+	return &PetAccessGuard{petRepo: petRepo, householdRepo: householdRepo}
+}
+
+// Authorize 檢查 context 中的使用者對寵物是否具備指定的存取層級，通過時回傳該寵物
+// 寵物建立者永遠具備完整權限；其餘使用者依寵物所屬家庭中的角色判斷
+// 寵物不存在時回傳 domain.ErrNotFound，權限不足時回傳 domain.ErrForbidden
+func (g *PetAccessGuard) Authorize(c context.Context, petID string, perm model.Permission) (*model.Pet, error) {
+	userID, err := contextx.GetUserID(c)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	pet, err := g.petRepo.FindByID(c, petID)
+	if err != nil {
+		return nil, fmt.Errorf("查找寵物失敗: %w", err)
+	}
+
+	if err := g.authorizePet(c, userID, pet, perm); err != nil {
+		return nil, err
+	}
+
+	return pet, nil
+}
+
+// AuthorizePet 檢查 context 中的使用者對已取得的寵物是否具備指定的存取層級
+// 用於一般查詢不會回傳的寵物，例如已封存的寵物
+func (g *PetAccessGuard) AuthorizePet(c context.Context, pet *model.Pet, perm model.Permission) error {
+	userID, err := contextx.GetUserID(c)
+	if err != nil {
+		return fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	return g.authorizePet(c, userID, pet, perm)
+}
+
+func (g *PetAccessGuard) authorizePet(c context.Context, userID string, pet *model.Pet, perm model.Permission) error {
+	if pet.OwnerID == userID {
+		return nil
+	}
+
+	if pet.HouseholdID != "" {
+		household, err := g.householdRepo.FindByID(c, pet.HouseholdID)
+		if err != nil && !domain.IsNotFound(err) {
+			return fmt.Errorf("查找家庭失敗: %w", err)
+		}
+		if household != nil && household.Allows(userID, perm, time.Now()) {
+			return nil
+		}
+	}
+
+	contextx.WithContext(c).Warn("使用者無權存取寵物", "user_id", userID, "pet_id", pet.ID, "permission", perm)
+	return fmt.Errorf("%w: user %s is not authorized to access pet %s", domain.ErrForbidden, userID, pet.ID)
+}
+
+// AuthorizeHousehold 檢查 context 中的使用者在家庭中是否具備指定的存取層級，通過時回傳該家庭
+func (g *PetAccessGuard) AuthorizeHousehold(c context.Context, householdID string, perm model.Permission) (*model.Household, error) {
+	userID, err := contextx.GetUserID(c)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	household, err := g.householdRepo.FindByID(c, householdID)
+	if err != nil {
+		return nil, fmt.Errorf("查找家庭失敗: %w", err)
+	}
+
+	if !household.Allows(userID, perm, time.Now()) {
+		contextx.WithContext(c).Warn("使用者無權存取家庭", "user_id", userID, "household_id", householdID, "permission", perm)
+		return nil, fmt.Errorf("%w: user %s is not authorized to access household %s", domain.ErrForbidden, userID, householdID)
+	}
+
+	return household, nil
+}
+
+// AccessScope 回傳目前使用者 ID 與其具備檢視權限的家庭 ID
+func (g *PetAccessGuard) AccessScope(c context.Context) (string, []string, error) {
+	userID, err := contextx.GetUserID(c)
+	if err != nil {
+		return "", nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	households, err := g.householdRepo.FindByMemberUserID(c, userID)
+	if err != nil {
+		return "", nil, fmt.Errorf("查找使用者家庭失敗: %w", err)
+	}
+
+	now := time.Now()
+	householdIDs := make([]string, 0, len(households))
+	for _, h := range households {
+		if h.Allows(userID, model.PermissionRead, now) {
+			householdIDs = append(householdIDs, h.ID)
+		}
+	}
+
+	return userID, householdIDs, nil
+}
+
+// AccessiblePets 回傳目前使用者可檢視的所有寵物，包含自己建立的與所屬家庭的寵物
+func (g *PetAccessGuard) AccessiblePets(c context.Context) ([]*model.Pet, error) {
+	userID, householdIDs, err := g.AccessScope(c)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := g.petRepo.FindByOwnerID(c, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查找使用者寵物失敗: %w", err)
+	}
+
+	shared, err := g.petRepo.FindByHouseholdIDs(c, householdIDs)
+	if err != nil {
+		return nil, fmt.Errorf("查找家庭寵物失敗: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(owned)+len(shared))
+	pets := make([]*model.Pet, 0, len(owned)+len(shared))
+	for _, pet := range append(owned, shared...) {
+		if _, ok := seen[pet.ID]; ok {
+			continue
+		}
+		seen[pet.ID] = struct{}{}
+		pets = append(pets, pet)
+	}
+
+	return pets, nil
+}
+
+// AccessiblePetIDs 回傳目前使用者可檢視的所有寵物 ID
+func (g *PetAccessGuard) AccessiblePetIDs(c context.Context) ([]string, error) {
+	pets, err := g.AccessiblePets(c)
+	if err != nil {
+		return nil, err
+	}
+
+	petIDs := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		petIDs = append(petIDs, pet.ID)
+	}
+
+	return petIDs, nil
+}
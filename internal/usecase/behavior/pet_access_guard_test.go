@@ -0,0 +1,106 @@
+package behavior
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPetAccessGuard_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	householdRepo := repository.NewMockHouseholdRepository(ctrl)
+	guard := NewPetAccessGuard(petRepo, householdRepo)
+
+	expired := time.Now().Add(-time.Hour)
+	petRepo.EXPECT().FindByID(gomock.Any(), "pet-1").
+		Return(&model.Pet{ID: "pet-1", OwnerID: "owner", HouseholdID: "hh-1"}, nil).AnyTimes()
+	householdRepo.EXPECT().FindByID(gomock.Any(), "hh-1").
+		Return(&model.Household{ID: "hh-1", Members: []model.HouseholdMember{
+			{UserID: "owner", Role: model.HouseholdRoleOwner},
+			{UserID: "partner", Role: model.HouseholdRoleEditor},
+			{UserID: "grandma", Role: model.HouseholdRoleViewer},
+			{UserID: "sitter", Role: model.HouseholdRoleSitter, ExpiresAt: &expired},
+		}}, nil).AnyTimes()
+
+	t.Run("飼主應通過檢查", func(t *testing.T) {
+		c := contextx.WithUserID(context.Background(), "owner")
+		pet, err := guard.Authorize(c, "pet-1", model.PermissionManage)
+		if err != nil || pet.ID != "pet-1" {
+			t.Errorf("預期通過檢查，實際為 %v", err)
+		}
+	})
+
+	t.Run("家庭 editor 可寫入", func(t *testing.T) {
+		c := contextx.WithUserID(context.Background(), "partner")
+		if _, err := guard.Authorize(c, "pet-1", model.PermissionWrite); err != nil {
+			t.Errorf("預期通過檢查，實際為 %v", err)
+		}
+	})
+
+	t.Run("家庭 viewer 不可寫入", func(t *testing.T) {
+		c := contextx.WithUserID(context.Background(), "grandma")
+		if _, err := guard.Authorize(c, "pet-1", model.PermissionRead); err != nil {
+			t.Errorf("預期可檢視，實際為 %v", err)
+		}
+		if _, err := guard.Authorize(c, "pet-1", model.PermissionWrite); !domain.IsForbidden(err) {
+			t.Errorf("預期 ErrForbidden，實際為 %v", err)
+		}
+	})
+
+	t.Run("已到期的 sitter 應回傳 ErrForbidden", func(t *testing.T) {
+		c := contextx.WithUserID(context.Background(), "sitter")
+		if _, err := guard.Authorize(c, "pet-1", model.PermissionRead); !domain.IsForbidden(err) {
+			t.Errorf("預期 ErrForbidden，實際為 %v", err)
+		}
+	})
+
+	t.Run("非家庭成員應回傳 ErrForbidden", func(t *testing.T) {
+		c := contextx.WithUserID(context.Background(), "stranger")
+		if _, err := guard.Authorize(c, "pet-1", model.PermissionRead); !domain.IsForbidden(err) {
+			t.Errorf("預期 ErrForbidden，實際為 %v", err)
+		}
+	})
+
+	t.Run("寵物不存在應回傳 ErrNotFound", func(t *testing.T) {
+		petRepo.EXPECT().FindByID(gomock.Any(), "missing").Return(nil, domain.ErrNotFound)
+		c := contextx.WithUserID(context.Background(), "owner")
+		if _, err := guard.Authorize(c, "missing", model.PermissionRead); !domain.IsNotFound(err) {
+			t.Errorf("預期 ErrNotFound，實際為 %v", err)
+		}
+	})
+}
+
+func TestPetAccessGuard_AccessiblePetIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	householdRepo := repository.NewMockHouseholdRepository(ctrl)
+	guard := NewPetAccessGuard(petRepo, householdRepo)
+
+	petRepo.EXPECT().FindByOwnerID(gomock.Any(), "partner").
+		Return([]*model.Pet{{ID: "pet-2", OwnerID: "partner", HouseholdID: "hh-1"}}, nil)
+	householdRepo.EXPECT().FindByMemberUserID(gomock.Any(), "partner").
+		Return([]*model.Household{{ID: "hh-1", Members: []model.HouseholdMember{
+			{UserID: "partner", Role: model.HouseholdRoleEditor},
+		}}}, nil)
+	petRepo.EXPECT().FindByHouseholdIDs(gomock.Any(), []string{"hh-1"}).
+		Return([]*model.Pet{
+			{ID: "pet-1", OwnerID: "owner", HouseholdID: "hh-1"},
+			{ID: "pet-2", OwnerID: "partner", HouseholdID: "hh-1"},
+		}, nil)
+
+	c := contextx.WithUserID(context.Background(), "partner")
+	petIDs, err := guard.AccessiblePetIDs(c)
+	if err != nil {
+		t.Fatalf("預期成功，實際為 %v", err)
+	}
+	if len(petIDs) != 2 || petIDs[0] != "pet-2" || petIDs[1] != "pet-1" {
+		t.Errorf("預期去重後的 [pet-2 pet-1]，實際為 %v", petIDs)
+	}
+}
@@ -0,0 +1,137 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrReportRangeInvalid  = errors.New("報告的結束日期必須晚於開始日期")
+	ErrReportRangeTooLarge = errors.New("報告期間不可超過 5 年")
+)
+
+const (
+	// PetReportContentType 寵物健康報告的 MIME 類型
+	PetReportContentType = "application/pdf"
+	// maxReportRange 報告期間的上限
+	maxReportRange = 5 * 365 * 24 * time.Hour
+)
+
+// concerningMoods 需要告知獸醫的情緒標籤
+var concerningMoods = []model.MoodTag{model.MoodAnxious, model.MoodAggressive, model.MoodLethargic, model.MoodHiding}
+
+// ValidateReportRange 檢查報告期間
+func ValidateReportRange(start, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrReportRangeInvalid)
+	}
+	if end.Sub(start) > maxReportRange {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrReportRangeTooLarge)
+	}
+	return nil
+}
+
+// ReportWeightInterval 依報告期間長短決定體重圖表的分組：三個月內以日、兩年內以週，其餘以月分組
+func ReportWeightInterval(start, end time.Time) model.TrendInterval {
+	switch span := end.Sub(start); {
+	case span <= 92*24*time.Hour:
+		return model.TrendIntervalDay
+	case span <= 2*366*24*time.Hour:
+		return model.TrendIntervalWeek
+	default:
+		return model.TrendIntervalMonth
+	}
+}
+
+// PetReportFileName 回傳寵物健康報告的下載檔名
+func PetReportFileName(petID string, end time.Time) string {
+	return fmt.Sprintf("petlog-report-%s-%s.pdf", petID, end.UTC().Format("20060102"))
+}
+
+// NotableFlags 回傳健康日誌中值得告知獸醫的觀察，沒有時回傳 nil
+func NotableFlags(log *model.HealthLog) []model.ObservationFlag {
+	var flags []model.ObservationFlag
+	if log.VomitingCount > 0 {
+		flags = append(flags, model.ObservationVomiting)
+	}
+	if s := log.StoolConsistency; s != nil && (*s <= 2 || *s >= 6) {
+		flags = append(flags, model.ObservationAbnormalStool)
+	}
+	if log.UrineClumps != nil && *log.UrineClumps == 0 {
+		flags = append(flags, model.ObservationNoUrine)
+	}
+	if log.ActivityLevel == model.ActivityLevelLow {
+		flags = append(flags, model.ObservationLowActivity)
+	}
+	for _, tag := range log.MoodTags {
+		if slices.Contains(concerningMoods, tag) {
+			flags = append(flags, model.ObservationConcerningMood)
+			break
+		}
+	}
+	return flags
+}
+
+// BuildPetHealthReport 彙整寵物健康報告的內容
+// logs 為期間內的健康日誌；records 為截至報告結束日的醫療紀錄，疫苗列出完整接種史，用藥只列出期間內的紀錄
+func BuildPetHealthReport(
+	pet *model.Pet,
+	logs []*model.HealthLog,
+	records []*model.MedicalRecord,
+	start, end time.Time,
+	threshold model.WeightAlertThreshold,
+	now time.Time,
+) *model.PetHealthReport {
+	report := &model.PetHealthReport{
+		Pet:            pet,
+		StartDate:      start,
+		EndDate:        end,
+		GeneratedAt:    now,
+		WeightInterval: ReportWeightInterval(start, end),
+		HealthLogCount: len(logs),
+	}
+
+	sorted := slices.Clone(logs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	report.Weights = BuildWeightTrend(sorted, report.WeightInterval, DefaultTrendWindow, DefaultChangeWindow, threshold)
+
+	var first, latest float64
+	for _, log := range sorted {
+		if log.WeightKg > 0 {
+			if first == 0 {
+				first = log.WeightKg
+			}
+			latest = log.WeightKg
+		}
+		if flags := NotableFlags(log); len(flags) > 0 {
+			report.Observations = append(report.Observations, model.NotableObservation{Log: log, Flags: flags})
+		}
+	}
+	if latest > 0 {
+		change := roundTo((latest-first)/first*100, 2)
+		report.LatestWeightKg = &latest
+		report.WeightChangePct = &change
+	}
+
+	for _, record := range records {
+		switch {
+		case record.Date.After(end):
+		case record.Type == model.RecordTypeVaccination:
+			report.Vaccinations = append(report.Vaccinations, record)
+		case record.Type == model.RecordTypeMedication && !record.Date.Before(start):
+			report.Medications = append(report.Medications, record)
+		}
+	}
+	newestFirst := func(a, b *model.MedicalRecord) int { return b.Date.Compare(a.Date) }
+	slices.SortStableFunc(report.Vaccinations, newestFirst)
+	slices.SortStableFunc(report.Medications, newestFirst)
+
+	return report
+}
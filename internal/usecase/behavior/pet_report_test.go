@@ -0,0 +1,141 @@
+package behavior
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidateReportRange(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("結束日期早於開始日期", func(t *testing.T) {
+		err := ValidateReportRange(start, start.AddDate(0, 0, -1))
+		if !errors.Is(err, ErrReportRangeInvalid) || !domain.IsInvalidParameter(err) {
+			t.Errorf("預期 ErrReportRangeInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("期間超過上限", func(t *testing.T) {
+		if err := ValidateReportRange(start, start.AddDate(6, 0, 0)); !errors.Is(err, ErrReportRangeTooLarge) {
+			t.Errorf("預期 ErrReportRangeTooLarge，實際為 %v", err)
+		}
+	})
+
+	t.Run("合法期間", func(t *testing.T) {
+		if err := ValidateReportRange(start, start.AddDate(1, 0, 0)); err != nil {
+			t.Errorf("不應有錯誤，實際為 %v", err)
+		}
+	})
+}
+
+func TestReportWeightInterval(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[time.Time]model.TrendInterval{
+		start.AddDate(0, 1, 0): model.TrendIntervalDay,
+		start.AddDate(1, 0, 0): model.TrendIntervalWeek,
+		start.AddDate(3, 0, 0): model.TrendIntervalMonth,
+	}
+	for end, want := range cases {
+		if got := ReportWeightInterval(start, end); got != want {
+			t.Errorf("%v: 預期 %s，實際為 %s", end, want, got)
+		}
+	}
+}
+
+func TestNotableFlags(t *testing.T) {
+	zero, soft, normal := 0, 6, 4
+
+	t.Run("正常觀察不標示", func(t *testing.T) {
+		log := &model.HealthLog{HealthObservations: model.HealthObservations{
+			StoolConsistency: &normal,
+			ActivityLevel:    model.ActivityLevelNormal,
+			MoodTags:         []model.MoodTag{model.MoodPlayful},
+		}}
+		if flags := NotableFlags(log); flags != nil {
+			t.Errorf("預期沒有標示，實際為 %v", flags)
+		}
+	})
+
+	t.Run("標示所有值得注意的觀察", func(t *testing.T) {
+		log := &model.HealthLog{HealthObservations: model.HealthObservations{
+			UrineClumps:      &zero,
+			StoolConsistency: &soft,
+			VomitingCount:    2,
+			ActivityLevel:    model.ActivityLevelLow,
+			MoodTags:         []model.MoodTag{model.MoodLethargic, model.MoodHiding},
+		}}
+		want := []model.ObservationFlag{
+			model.ObservationVomiting,
+			model.ObservationAbnormalStool,
+			model.ObservationNoUrine,
+			model.ObservationLowActivity,
+			model.ObservationConcerningMood,
+		}
+		if got := NotableFlags(log); !slices.Equal(got, want) {
+			t.Errorf("預期 %v，實際為 %v", want, got)
+		}
+	})
+}
+
+func TestBuildPetHealthReport(t *testing.T) {
+	start, end := utcDate(2025, 1, 1), utcDate(2025, 3, 31)
+	now := utcDate(2025, 4, 1)
+	threshold := model.WeightAlertThreshold{LossPct: 5, GainPct: 5}
+	pet := &model.Pet{ID: "pet-1", Name: "Mochi"}
+
+	logs := []*model.HealthLog{
+		{ID: "l3", Date: utcDate(2025, 3, 1), WeightKg: 4.4},
+		{ID: "l1", Date: utcDate(2025, 1, 5), WeightKg: 4.0},
+		{ID: "l2", Date: utcDate(2025, 2, 1), HealthObservations: model.HealthObservations{VomitingCount: 1}},
+	}
+	records := []*model.MedicalRecord{
+		{ID: "v-old", Type: model.RecordTypeVaccination, Date: utcDate(2023, 6, 1)},
+		{ID: "v-new", Type: model.RecordTypeVaccination, Date: utcDate(2025, 2, 1)},
+		{ID: "m-before", Type: model.RecordTypeMedication, Date: utcDate(2024, 12, 1)},
+		{ID: "m-in", Type: model.RecordTypeMedication, Date: utcDate(2025, 1, 10)},
+		{ID: "m-after", Type: model.RecordTypeMedication, Date: utcDate(2025, 4, 10)},
+		{ID: "visit", Type: model.RecordTypeVetVisit, Date: utcDate(2025, 1, 20)},
+	}
+
+	report := BuildPetHealthReport(pet, logs, records, start, end, threshold, now)
+
+	if report.WeightInterval != model.TrendIntervalDay {
+		t.Errorf("預期以日分組，實際為 %s", report.WeightInterval)
+	}
+	if len(report.Weights) != 2 {
+		t.Errorf("預期 2 個體重區間，實際為 %d", len(report.Weights))
+	}
+	if report.LatestWeightKg == nil || *report.LatestWeightKg != 4.4 {
+		t.Errorf("預期最新體重為 4.4，實際為 %v", report.LatestWeightKg)
+	}
+	if report.WeightChangePct == nil || *report.WeightChangePct != 10 {
+		t.Errorf("預期體重變化 10%%，實際為 %v", report.WeightChangePct)
+	}
+	if report.HealthLogCount != 3 {
+		t.Errorf("預期 3 筆健康日誌，實際為 %d", report.HealthLogCount)
+	}
+
+	ids := func(records []*model.MedicalRecord) []string {
+		var out []string
+		for _, r := range records {
+			out = append(out, r.ID)
+		}
+		return out
+	}
+	if got := ids(report.Vaccinations); !slices.Equal(got, []string{"v-new", "v-old"}) {
+		t.Errorf("疫苗應列出完整接種史並由新到舊排序，實際為 %v", got)
+	}
+	if got := ids(report.Medications); !slices.Equal(got, []string{"m-in"}) {
+		t.Errorf("用藥只列出期間內的紀錄，實際為 %v", got)
+	}
+
+	if len(report.Observations) != 1 || report.Observations[0].Log.ID != "l2" {
+		t.Errorf("預期只有 l2 有值得注意的觀察，實際為 %+v", report.Observations)
+	}
+}
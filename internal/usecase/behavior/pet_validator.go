@@ -2,11 +2,18 @@ package behavior
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/blackhorseya/petlog/internal/domain"
 	"github.com/blackhorseya/petlog/internal/domain/model"
 )
 
+var (
+	ErrPetNotArchived        = errors.New("寵物尚未封存")
+	ErrArchiveRestoreExpired = errors.New("已超過封存還原期限")
+)
+
 // ValidatePet checks if the pet model has the required fields.
 func ValidatePet(pet *model.Pet) error {
 	if pet.Name == "" {
@@ -24,3 +31,14 @@ func ValidatePet(pet *model.Pet) error {
 
 	return nil
 }
+
+// ValidatePetRestore checks that an archived pet is still within the restore window.
+func ValidatePetRestore(pet *model.Pet, window time.Duration, now time.Time) error {
+	if pet.DeletedAt == nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrPetNotArchived)
+	}
+	if now.After(pet.DeletedAt.Add(window)) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrArchiveRestoreExpired)
+	}
+	return nil
+}
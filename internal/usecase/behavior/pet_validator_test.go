@@ -0,0 +1,36 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidatePetRestore(t *testing.T) {
+	now := time.Now()
+	window := 30 * 24 * time.Hour
+
+	t.Run("未封存的寵物應回傳 ErrPetNotArchived", func(t *testing.T) {
+		err := ValidatePetRestore(&model.Pet{}, window, now)
+		if !errors.Is(err, ErrPetNotArchived) {
+			t.Errorf("預期 ErrPetNotArchived，實際為 %v", err)
+		}
+	})
+
+	t.Run("超過還原期限應回傳 ErrArchiveRestoreExpired", func(t *testing.T) {
+		archivedAt := now.Add(-window - time.Hour)
+		err := ValidatePetRestore(&model.Pet{DeletedAt: &archivedAt}, window, now)
+		if !errors.Is(err, ErrArchiveRestoreExpired) {
+			t.Errorf("預期 ErrArchiveRestoreExpired，實際為 %v", err)
+		}
+	})
+
+	t.Run("期限內應通過驗證", func(t *testing.T) {
+		archivedAt := now.Add(-time.Hour)
+		if err := ValidatePetRestore(&model.Pet{DeletedAt: &archivedAt}, window, now); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
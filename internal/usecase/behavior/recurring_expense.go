@@ -0,0 +1,163 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+const (
+	// maxRecurringInterval 重複間隔上限
+	maxRecurringInterval = 12
+	// maxRecurringOccurrences 單次計算允許展開的期別數上限，避免開始日期過早時一次產生過多費用
+	maxRecurringOccurrences = 1000
+)
+
+var (
+	ErrRecurringExpenseRequired   = errors.New("寵物 ID 與分類為必填欄位，且金額必須大於 0")
+	ErrRecurringFrequencyInvalid  = errors.New("無效的重複頻率")
+	ErrRecurringIntervalInvalid   = errors.New("重複間隔必須介於 1 到 12 之間")
+	ErrRecurringDayOfMonthInvalid = errors.New("每月日期必須介於 1 到 31 之間")
+	ErrRecurringDateRangeInvalid  = errors.New("開始日期為必填欄位，且結束日期不可早於開始日期")
+	ErrRecurringOccurrenceInvalid = errors.New("指定日期不是此週期性費用的期別")
+	ErrRecurringOccurrenceExists  = errors.New("指定期別的費用已產生，請直接刪除該筆費用")
+)
+
+// StartOfUTCDay 將時間正規化為 UTC 當日零時，週期性費用的期別與匯率日期一律以此表示
+func StartOfUTCDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// NormalizeRecurringExpense 驗證週期性費用並整理排程設定：
+// 未指定間隔時為 1，每月排程未指定日期時沿用開始日期的日，起訖日期正規化為 UTC 當日零時
+func NormalizeRecurringExpense(r *model.RecurringExpense) error {
+	r.Category = strings.TrimSpace(r.Category)
+	if strings.TrimSpace(r.PetID) == "" || r.Category == "" || r.Amount <= 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringExpenseRequired)
+	}
+	if !r.Schedule.Frequency.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringFrequencyInvalid)
+	}
+	if r.Schedule.Interval == 0 {
+		r.Schedule.Interval = 1
+	}
+	if r.Schedule.Interval < 0 || r.Schedule.Interval > maxRecurringInterval {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringIntervalInvalid)
+	}
+	if r.StartDate.IsZero() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringDateRangeInvalid)
+	}
+	r.StartDate = StartOfUTCDay(r.StartDate)
+	if r.EndDate != nil {
+		end := StartOfUTCDay(*r.EndDate)
+		if end.Before(r.StartDate) {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringDateRangeInvalid)
+		}
+		r.EndDate = &end
+	}
+
+	switch r.Schedule.Frequency {
+	case model.RecurrenceMonthly:
+		if r.Schedule.DayOfMonth == 0 {
+			r.Schedule.DayOfMonth = r.StartDate.Day()
+		}
+		if r.Schedule.DayOfMonth < 1 || r.Schedule.DayOfMonth > 31 {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringDayOfMonthInvalid)
+		}
+	case model.RecurrenceWeekly:
+		r.Schedule.DayOfMonth = 0
+	}
+
+	return nil
+}
+
+// RecurringOccurrences 回傳排程在 (from, to] 之間的期別，依日期由早到晚排列
+// 每月排程自開始月份起每 Interval 個月一次，當月沒有指定日期時取月底；每週排程自開始日期起每 Interval 週一次
+func RecurringOccurrences(r *model.RecurringExpense, from, to time.Time) []time.Time {
+	if r.Schedule.Interval <= 0 || !to.After(from) {
+		return nil
+	}
+	if r.EndDate != nil && to.After(*r.EndDate) {
+		to = *r.EndDate
+	}
+
+	var next func(i int) time.Time
+	first := 0
+	switch r.Schedule.Frequency {
+	case model.RecurrenceMonthly:
+		base := time.Date(r.StartDate.Year(), r.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+		next = func(i int) time.Time {
+			month := base.AddDate(0, i*r.Schedule.Interval, 0)
+			lastDay := month.AddDate(0, 1, -1).Day()
+			return time.Date(month.Year(), month.Month(), min(r.Schedule.DayOfMonth, lastDay), 0, 0, 0, 0, time.UTC)
+		}
+		if from.After(base) {
+			from := from.UTC()
+			months := (from.Year()-base.Year())*12 + int(from.Month()) - int(base.Month())
+			first = months / r.Schedule.Interval
+		}
+	case model.RecurrenceWeekly:
+		days := 7 * r.Schedule.Interval
+		next = func(i int) time.Time {
+			return r.StartDate.AddDate(0, 0, i*days)
+		}
+		if from.After(r.StartDate) {
+			first = int(from.Sub(r.StartDate).Hours()/24) / days
+		}
+	default:
+		return nil
+	}
+
+	var occurrences []time.Time
+	for i := first; len(occurrences) < maxRecurringOccurrences; i++ {
+		occurrence := next(i)
+		if occurrence.After(to) {
+			break
+		}
+		if occurrence.Before(r.StartDate) || !occurrence.After(from) {
+			continue
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences
+}
+
+// PendingRecurringOccurrences 回傳截至 now 尚未產生費用、且未被略過的期別
+func PendingRecurringOccurrences(r *model.RecurringExpense, now time.Time) []time.Time {
+	if r.Paused {
+		return nil
+	}
+	from := r.StartDate.Add(-time.Nanosecond)
+	if r.GeneratedThrough != nil && r.GeneratedThrough.After(from) {
+		from = *r.GeneratedThrough
+	}
+
+	var pending []time.Time
+	for _, occurrence := range RecurringOccurrences(r, from, now) {
+		if !r.IsSkipped(occurrence) {
+			pending = append(pending, occurrence)
+		}
+	}
+	return pending
+}
+
+// SkipRecurringOccurrence 將指定期別加入略過清單；期別必須符合排程且尚未產生費用
+func SkipRecurringOccurrence(r *model.RecurringExpense, date time.Time) error {
+	occurrence := StartOfUTCDay(date)
+	matches := RecurringOccurrences(r, occurrence.Add(-time.Nanosecond), occurrence)
+	if len(matches) == 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringOccurrenceInvalid)
+	}
+	if r.GeneratedThrough != nil && !occurrence.After(*r.GeneratedThrough) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrRecurringOccurrenceExists)
+	}
+	if !r.IsSkipped(occurrence) {
+		r.SkippedDates = append(r.SkippedDates, occurrence)
+	}
+	return nil
+}
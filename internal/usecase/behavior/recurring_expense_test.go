@@ -0,0 +1,195 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNormalizeRecurringExpense(t *testing.T) {
+	t.Run("每月排程未指定日期時沿用開始日期", func(t *testing.T) {
+		r := &model.RecurringExpense{
+			PetID:     "pet-1",
+			Category:  " 飼料 ",
+			Amount:    1200,
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceMonthly},
+			StartDate: time.Date(2024, time.January, 15, 9, 30, 0, 0, time.UTC),
+		}
+		if err := NormalizeRecurringExpense(r); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if r.Schedule.Interval != 1 || r.Schedule.DayOfMonth != 15 {
+			t.Errorf("預期間隔 1、日期 15，實際為 %+v", r.Schedule)
+		}
+		if !r.StartDate.Equal(utcDate(2024, time.January, 15)) || r.Category != "飼料" {
+			t.Errorf("預期開始日期與分類已正規化，實際為 %v、%q", r.StartDate, r.Category)
+		}
+	})
+
+	end := utcDate(2023, time.December, 31)
+	valid := func() model.RecurringExpense {
+		return model.RecurringExpense{
+			PetID:     "pet-1",
+			Category:  "保險",
+			Amount:    500,
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceWeekly},
+			StartDate: utcDate(2024, time.January, 1),
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(r *model.RecurringExpense)
+		want   error
+	}{
+		{"金額為 0", func(r *model.RecurringExpense) { r.Amount = 0 }, ErrRecurringExpenseRequired},
+		{"無效頻率", func(r *model.RecurringExpense) { r.Schedule.Frequency = "daily" }, ErrRecurringFrequencyInvalid},
+		{"間隔為負數", func(r *model.RecurringExpense) { r.Schedule.Interval = -1 }, ErrRecurringIntervalInvalid},
+		{"每月日期超過 31", func(r *model.RecurringExpense) {
+			r.Schedule = model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, DayOfMonth: 32}
+		}, ErrRecurringDayOfMonthInvalid},
+		{"未指定開始日期", func(r *model.RecurringExpense) { r.StartDate = time.Time{} }, ErrRecurringDateRangeInvalid},
+		{"結束日期早於開始日期", func(r *model.RecurringExpense) { r.EndDate = &end }, ErrRecurringDateRangeInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.mutate(&r)
+			err := NormalizeRecurringExpense(&r)
+			if !errors.Is(err, tt.want) || !errors.Is(err, domain.ErrInvalidParameter) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestRecurringOccurrences(t *testing.T) {
+	t.Run("每月 31 日遇到小月時取月底", func(t *testing.T) {
+		r := &model.RecurringExpense{
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, Interval: 1, DayOfMonth: 31},
+			StartDate: utcDate(2024, time.January, 1),
+		}
+		got := RecurringOccurrences(r, utcDate(2024, time.January, 1), utcDate(2024, time.April, 30))
+		want := []time.Time{utcDate(2024, time.January, 31), utcDate(2024, time.February, 29), utcDate(2024, time.March, 31), utcDate(2024, time.April, 30)}
+		assertOccurrences(t, got, want)
+	})
+
+	t.Run("每兩個月以開始月份為基準", func(t *testing.T) {
+		r := &model.RecurringExpense{
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, Interval: 2, DayOfMonth: 5},
+			StartDate: utcDate(2024, time.January, 10),
+		}
+		got := RecurringOccurrences(r, utcDate(2024, time.April, 1), utcDate(2024, time.September, 30))
+		want := []time.Time{utcDate(2024, time.May, 5), utcDate(2024, time.July, 5), utcDate(2024, time.September, 5)}
+		assertOccurrences(t, got, want)
+	})
+
+	t.Run("開始當月早於開始日期的期別不計入", func(t *testing.T) {
+		r := &model.RecurringExpense{
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, Interval: 1, DayOfMonth: 5},
+			StartDate: utcDate(2024, time.January, 10),
+		}
+		got := RecurringOccurrences(r, r.StartDate.Add(-time.Nanosecond), utcDate(2024, time.February, 10))
+		assertOccurrences(t, got, []time.Time{utcDate(2024, time.February, 5)})
+	})
+
+	t.Run("每兩週並遵守結束日期", func(t *testing.T) {
+		end := utcDate(2024, time.February, 12)
+		r := &model.RecurringExpense{
+			Schedule:  model.ExpenseSchedule{Frequency: model.RecurrenceWeekly, Interval: 2},
+			StartDate: utcDate(2024, time.January, 1),
+			EndDate:   &end,
+		}
+		got := RecurringOccurrences(r, utcDate(2024, time.January, 10), utcDate(2024, time.March, 31))
+		want := []time.Time{utcDate(2024, time.January, 15), utcDate(2024, time.January, 29), utcDate(2024, time.February, 12)}
+		assertOccurrences(t, got, want)
+	})
+}
+
+func TestPendingRecurringOccurrences(t *testing.T) {
+	generated := time.Date(2024, time.March, 1, 6, 0, 0, 0, time.UTC)
+	r := &model.RecurringExpense{
+		Schedule:         model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, Interval: 1, DayOfMonth: 1},
+		StartDate:        utcDate(2024, time.January, 1),
+		GeneratedThrough: &generated,
+		SkippedDates:     []time.Time{utcDate(2024, time.April, 1)},
+	}
+
+	t.Run("只回傳上次產生後且未略過的期別", func(t *testing.T) {
+		got := PendingRecurringOccurrences(r, utcDate(2024, time.May, 15))
+		assertOccurrences(t, got, []time.Time{utcDate(2024, time.May, 1)})
+	})
+
+	t.Run("暫停時不產生", func(t *testing.T) {
+		paused := *r
+		paused.Paused = true
+		if got := PendingRecurringOccurrences(&paused, utcDate(2024, time.May, 15)); len(got) != 0 {
+			t.Errorf("預期沒有期別，實際為 %v", got)
+		}
+	})
+
+	t.Run("尚未產生過時包含開始日期", func(t *testing.T) {
+		fresh := *r
+		fresh.GeneratedThrough = nil
+		fresh.SkippedDates = nil
+		got := PendingRecurringOccurrences(&fresh, utcDate(2024, time.February, 1))
+		assertOccurrences(t, got, []time.Time{utcDate(2024, time.January, 1), utcDate(2024, time.February, 1)})
+	})
+}
+
+func TestSkipRecurringOccurrence(t *testing.T) {
+	generated := utcDate(2024, time.February, 1)
+	newRecurring := func() *model.RecurringExpense {
+		return &model.RecurringExpense{
+			Schedule:         model.ExpenseSchedule{Frequency: model.RecurrenceMonthly, Interval: 1, DayOfMonth: 1},
+			StartDate:        utcDate(2024, time.January, 1),
+			GeneratedThrough: &generated,
+		}
+	}
+
+	t.Run("略過未來的期別", func(t *testing.T) {
+		r := newRecurring()
+		if err := SkipRecurringOccurrence(r, time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if err := SkipRecurringOccurrence(r, utcDate(2024, time.March, 1)); err != nil {
+			t.Fatalf("重複略過預期無錯誤，實際為 %v", err)
+		}
+		assertOccurrences(t, r.SkippedDates, []time.Time{utcDate(2024, time.March, 1)})
+	})
+
+	tests := []struct {
+		name string
+		date time.Time
+		want error
+	}{
+		{"不是排程期別", utcDate(2024, time.March, 2), ErrRecurringOccurrenceInvalid},
+		{"期別已產生費用", utcDate(2024, time.February, 1), ErrRecurringOccurrenceExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := SkipRecurringOccurrence(newRecurring(), tt.date)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func assertOccurrences(t *testing.T, got, want []time.Time) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("預期 %v，實際為 %v", want, got)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("第 %d 個期別預期 %v，實際為 %v", i, want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrReminderTitleRequired   = errors.New("提醒標題為必填欄位")
+	ErrReminderDueDateRequired = errors.New("提醒到期日為必填欄位")
+	ErrReminderRemindAtInvalid = errors.New("提醒時間不可晚於到期日")
+)
+
+// ReminderValidator 負責提醒欄位驗證
+type ReminderValidator struct{}
+
+// Validate 驗證提醒資料的完整性與合法性
+func (v *ReminderValidator) Validate(reminder *model.Reminder) error {
+	if strings.TrimSpace(reminder.PetID) == "" {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrPetIDRequired)
+	}
+	if strings.TrimSpace(reminder.Title) == "" {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrReminderTitleRequired)
+	}
+	if reminder.DueDate.IsZero() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrReminderDueDateRequired)
+	}
+	if reminder.RemindAt.After(reminder.DueDate) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrReminderRemindAtInvalid)
+	}
+	return nil
+}
+
+// DefaultRemindAt 依提前天數計算預設的提醒時間
+func DefaultRemindAt(dueDate time.Time, leadDays int) time.Time {
+	return dueDate.AddDate(0, 0, -leadDays)
+}
+
+// ReminderTitle 依醫療記錄產生提醒標題
+func ReminderTitle(record *model.MedicalRecord) string {
+	label := map[model.MedicalRecordType]string{
+		model.RecordTypeVaccination: "疫苗接種",
+		model.RecordTypeDeworming:   "驅蟲",
+		model.RecordTypeMedication:  "用藥",
+		model.RecordTypeVetVisit:    "回診",
+		model.RecordTypeOther:       "照護事項",
+	}[record.Type]
+	if label == "" {
+		label = "照護事項"
+	}
+	if record.Description == "" {
+		return label
+	}
+	return fmt.Sprintf("%s：%s", label, record.Description)
+}
@@ -0,0 +1,29 @@
+package behavior
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultRemindAt(t *testing.T) {
+	due := time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		dueDate  time.Time
+		leadDays int
+		want     time.Time
+	}{
+		{"提前 7 天", due, 7, time.Date(2024, time.March, 3, 9, 30, 0, 0, time.UTC)},
+		{"不提前時為到期當下", due, 0, due},
+		{"跨月與閏年", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 1, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"跨年", time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), 3, time.Date(2023, time.December, 30, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultRemindAt(tt.dueDate, tt.leadDays); !got.Equal(tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, got)
+			}
+		})
+	}
+}
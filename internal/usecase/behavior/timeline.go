@@ -0,0 +1,148 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrTimelineRangeInvalid    = errors.New("時間軸的結束日期必須晚於開始日期")
+	ErrTimelineRangeTooLarge   = errors.New("時間軸期間不可超過 1 年")
+	ErrTimelineItemTypeInvalid = errors.New("無效的時間軸項目種類")
+)
+
+// maxTimelineRange 時間軸期間的上限
+const maxTimelineRange = 366 * 24 * time.Hour
+
+// ValidateTimelineRange 檢查時間軸期間
+func ValidateTimelineRange(start, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTimelineRangeInvalid)
+	}
+	if end.Sub(start) > maxTimelineRange {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTimelineRangeTooLarge)
+	}
+	return nil
+}
+
+// ValidateTimelineTypes 檢查時間軸項目種類，空值代表所有種類
+func ValidateTimelineTypes(types []model.TimelineItemType) error {
+	for _, t := range types {
+		if !t.IsValid() {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTimelineItemTypeInvalid)
+		}
+	}
+	return nil
+}
+
+// IncludesTimelineType 檢查時間軸是否要列出指定種類，types 為空時列出所有種類
+func IncludesTimelineType(types []model.TimelineItemType, t model.TimelineItemType) bool {
+	return len(types) == 0 || slices.Contains(types, t)
+}
+
+// TimelineDueRecords 挑出要在時間軸產生到期事件的醫療記錄
+// due 為下次到期日落在期間內的紀錄，later 為同一寵物在其後的紀錄；
+// 與行事曆訂閱相同，同一照護事項只保留最新一筆，舊紀錄的到期日已由新的紀錄取代
+func TimelineDueRecords(due, later []*model.MedicalRecord) []*model.MedicalRecord {
+	latest := latestMedicalRecords(append(slices.Clone(due), later...))
+	out := make([]*model.MedicalRecord, 0, len(due))
+	for _, record := range due {
+		if current := latest[medicalRecordKey(record)]; current.ID == record.ID {
+			out = append(out, record)
+		}
+	}
+	return out
+}
+
+// BuildTimeline 將健康日誌、醫療記錄、到期事件與費用合併為時間軸項目，只保留日期在期間內的事件
+// dueRecords 為 TimelineDueRecords 挑出的紀錄，NextDueDate 落在期間內時產生到期事件
+func BuildTimeline(
+	logs []*model.HealthLog,
+	records []*model.MedicalRecord,
+	dueRecords []*model.MedicalRecord,
+	expenses []*model.Expense,
+	start, end time.Time,
+	types []model.TimelineItemType,
+) []*model.TimelineItem {
+	inRange := func(date time.Time) bool {
+		return !date.Before(start) && !date.After(end)
+	}
+
+	items := make([]*model.TimelineItem, 0, len(logs)+len(records)+len(dueRecords)+len(expenses))
+
+	if IncludesTimelineType(types, model.TimelineItemHealthLog) {
+		for _, log := range logs {
+			if !inRange(log.Date) {
+				continue
+			}
+			summary := "健康日誌"
+			if log.WeightKg > 0 {
+				summary = fmt.Sprintf("%s 體重 %.2f kg", summary, log.WeightKg)
+			}
+			items = append(items, &model.TimelineItem{
+				Type:      model.TimelineItemHealthLog,
+				ID:        log.ID,
+				PetID:     log.PetID,
+				Date:      log.Date,
+				Summary:   summary,
+				HealthLog: log,
+			})
+		}
+	}
+
+	if IncludesTimelineType(types, model.TimelineItemMedicalRecord) {
+		for _, record := range records {
+			if !inRange(record.Date) {
+				continue
+			}
+			items = append(items, &model.TimelineItem{
+				Type:          model.TimelineItemMedicalRecord,
+				ID:            record.ID,
+				PetID:         record.PetID,
+				Date:          record.Date,
+				Summary:       strings.TrimSpace(fmt.Sprintf("%s %s", record.Type, record.Description)),
+				MedicalRecord: record,
+			})
+		}
+	}
+
+	if IncludesTimelineType(types, model.TimelineItemDue) {
+		for _, record := range dueRecords {
+			if record.NextDueDate == nil || !inRange(*record.NextDueDate) {
+				continue
+			}
+			items = append(items, &model.TimelineItem{
+				Type:          model.TimelineItemDue,
+				ID:            record.ID,
+				PetID:         record.PetID,
+				Date:          *record.NextDueDate,
+				Summary:       strings.TrimSpace(fmt.Sprintf("%s 到期 %s", record.Type, record.Description)),
+				MedicalRecord: record,
+			})
+		}
+	}
+
+	if IncludesTimelineType(types, model.TimelineItemExpense) {
+		for _, expense := range expenses {
+			if !inRange(expense.Date) {
+				continue
+			}
+			items = append(items, &model.TimelineItem{
+				Type:    model.TimelineItemExpense,
+				ID:      expense.ID,
+				PetID:   expense.PetID,
+				Date:    expense.Date,
+				Summary: strings.TrimSpace(fmt.Sprintf("%s %d %s", expense.Category, expense.Amount, expense.Description)),
+				Expense: expense,
+			})
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,125 @@
+package behavior
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidateTimelineRange(t *testing.T) {
+	start := utcDate(2025, 1, 1)
+
+	t.Run("結束日期早於開始日期", func(t *testing.T) {
+		err := ValidateTimelineRange(start, start)
+		if !errors.Is(err, ErrTimelineRangeInvalid) || !domain.IsInvalidParameter(err) {
+			t.Errorf("預期 ErrTimelineRangeInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("期間超過上限", func(t *testing.T) {
+		if err := ValidateTimelineRange(start, start.AddDate(1, 1, 0)); !errors.Is(err, ErrTimelineRangeTooLarge) {
+			t.Errorf("預期 ErrTimelineRangeTooLarge，實際為 %v", err)
+		}
+	})
+
+	t.Run("無效的種類", func(t *testing.T) {
+		err := ValidateTimelineTypes([]model.TimelineItemType{model.TimelineItemExpense, "weather"})
+		if !errors.Is(err, ErrTimelineItemTypeInvalid) {
+			t.Errorf("預期 ErrTimelineItemTypeInvalid，實際為 %v", err)
+		}
+	})
+}
+
+func TestBuildTimeline(t *testing.T) {
+	start, end := utcDate(2025, 3, 1), utcDate(2025, 3, 31)
+	due := utcDate(2025, 3, 15)
+	later := utcDate(2025, 6, 1)
+
+	logs := []*model.HealthLog{
+		{ID: "l-in", PetID: "pet-1", Date: utcDate(2025, 3, 2), WeightKg: 4.2},
+		{ID: "l-out", PetID: "pet-1", Date: utcDate(2025, 4, 2)},
+	}
+	records := []*model.MedicalRecord{
+		{ID: "v-old", PetID: "pet-1", Type: model.RecordTypeVaccination, Date: utcDate(2024, 3, 15), NextDueDate: &due},
+		{ID: "v-new", PetID: "pet-1", Type: model.RecordTypeVetVisit, Date: utcDate(2025, 3, 14), NextDueDate: &later},
+	}
+	expenses := []*model.Expense{
+		{ID: "e-in", PetID: "pet-2", Category: "food", Amount: 300, Date: utcDate(2025, 3, 31)},
+	}
+
+	byType := func(items []*model.TimelineItem) map[model.TimelineItemType][]string {
+		out := make(map[model.TimelineItemType][]string)
+		for _, item := range items {
+			out[item.Type] = append(out[item.Type], item.ID)
+		}
+		return out
+	}
+
+	t.Run("合併所有種類並只保留期間內的事件", func(t *testing.T) {
+		got := byType(BuildTimeline(logs, records, records, expenses, start, end, nil))
+		if ids := got[model.TimelineItemHealthLog]; len(ids) != 1 || ids[0] != "l-in" {
+			t.Errorf("健康日誌應只有 l-in，實際為 %v", ids)
+		}
+		if ids := got[model.TimelineItemMedicalRecord]; len(ids) != 1 || ids[0] != "v-new" {
+			t.Errorf("醫療記錄應只有 v-new，實際為 %v", ids)
+		}
+		if ids := got[model.TimelineItemDue]; len(ids) != 1 || ids[0] != "v-old" {
+			t.Errorf("到期事件應來自期間前的 v-old，實際為 %v", ids)
+		}
+		if ids := got[model.TimelineItemExpense]; len(ids) != 1 || ids[0] != "e-in" {
+			t.Errorf("費用應包含期間最後一天的 e-in，實際為 %v", ids)
+		}
+	})
+
+	t.Run("到期事件以下次到期日為日期並帶有來源記錄", func(t *testing.T) {
+		for _, item := range BuildTimeline(nil, nil, records, nil, start, end, []model.TimelineItemType{model.TimelineItemDue}) {
+			if !item.Date.Equal(due) || item.MedicalRecord == nil || item.MedicalRecord.ID != "v-old" {
+				t.Errorf("到期事件不正確: %+v", item)
+			}
+		}
+	})
+
+	t.Run("只列出指定種類", func(t *testing.T) {
+		items := BuildTimeline(logs, records, records, expenses, start, end, []model.TimelineItemType{model.TimelineItemExpense})
+		if len(items) != 1 || items[0].Type != model.TimelineItemExpense || items[0].Summary != "food 300" {
+			t.Errorf("預期只有一筆費用，實際為 %+v", items)
+		}
+	})
+}
+
+func TestTimelineDueRecords(t *testing.T) {
+	due := utcDate(2025, 3, 15)
+	next := utcDate(2026, 3, 14)
+
+	oldVaccine := &model.MedicalRecord{ID: "v-old", PetID: "pet-1", Type: model.RecordTypeVaccination, Description: "三合一", Date: utcDate(2024, 3, 15), NextDueDate: &due}
+	newVaccine := &model.MedicalRecord{ID: "v-new", PetID: "pet-1", Type: model.RecordTypeVaccination, Description: " 三合一 ", Date: utcDate(2025, 3, 14), NextDueDate: &next}
+	deworming := &model.MedicalRecord{ID: "d-1", PetID: "pet-1", Type: model.RecordTypeDeworming, Date: utcDate(2025, 2, 15), NextDueDate: &due}
+	otherPet := &model.MedicalRecord{ID: "v-other", PetID: "pet-2", Type: model.RecordTypeVaccination, Description: "三合一", Date: utcDate(2024, 3, 15), NextDueDate: &due}
+
+	tests := []struct {
+		name  string
+		due   []*model.MedicalRecord
+		later []*model.MedicalRecord
+		want  []string
+	}{
+		{"沒有較新紀錄時保留", []*model.MedicalRecord{oldVaccine, deworming}, nil, []string{"v-old", "d-1"}},
+		{"已被同一照護事項的新紀錄取代", []*model.MedicalRecord{oldVaccine, deworming}, []*model.MedicalRecord{newVaccine}, []string{"d-1"}},
+		{"其他寵物的紀錄不互相取代", []*model.MedicalRecord{oldVaccine, otherPet}, []*model.MedicalRecord{newVaccine}, []string{"v-other"}},
+		{"較新紀錄同時出現在兩份清單時不重複", []*model.MedicalRecord{deworming}, []*model.MedicalRecord{deworming}, []string{"d-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TimelineDueRecords(tt.due, tt.later)
+			ids := make([]string, 0, len(got))
+			for _, record := range got {
+				ids = append(ids, record.ID)
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("預期 %v，實際為 %v", tt.want, ids)
+			}
+		})
+	}
+}
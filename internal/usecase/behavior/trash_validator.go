@@ -0,0 +1,34 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+var (
+	ErrTrashItemTypeInvalid  = errors.New("無效的垃圾桶項目種類")
+	ErrTrashRetentionExpired = errors.New("已超過垃圾桶保留期限")
+)
+
+// ValidateTrashItemType 檢查垃圾桶項目種類，allowEmpty 為 true 時允許空值（代表所有種類）
+func ValidateTrashItemType(itemType model.TrashItemType, allowEmpty bool) error {
+	if itemType == "" && allowEmpty {
+		return nil
+	}
+	if !itemType.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTrashItemTypeInvalid)
+	}
+	return nil
+}
+
+// ValidateTrashRestore 檢查垃圾桶項目是否仍在保留期限內
+func ValidateTrashRestore(item *model.TrashItem, retention time.Duration, now time.Time) error {
+	if now.After(item.DeletedAt.Add(retention)) {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTrashRetentionExpired)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestValidateTrashItemType(t *testing.T) {
+	t.Run("允許空值時應通過驗證", func(t *testing.T) {
+		if err := ValidateTrashItemType("", true); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+
+	t.Run("不允許空值時應回傳 ErrTrashItemTypeInvalid", func(t *testing.T) {
+		if err := ValidateTrashItemType("", false); !errors.Is(err, ErrTrashItemTypeInvalid) {
+			t.Errorf("預期 ErrTrashItemTypeInvalid，實際為 %v", err)
+		}
+	})
+
+	t.Run("未知種類應回傳 ErrTrashItemTypeInvalid", func(t *testing.T) {
+		if err := ValidateTrashItemType("reminder", true); !errors.Is(err, ErrTrashItemTypeInvalid) {
+			t.Errorf("預期 ErrTrashItemTypeInvalid，實際為 %v", err)
+		}
+	})
+}
+
+func TestValidateTrashRestore(t *testing.T) {
+	now := time.Now()
+	retention := 30 * 24 * time.Hour
+
+	t.Run("超過保留期限應回傳 ErrTrashRetentionExpired", func(t *testing.T) {
+		item := &model.TrashItem{DeletedAt: now.Add(-retention - time.Hour)}
+		if err := ValidateTrashRestore(item, retention, now); !errors.Is(err, ErrTrashRetentionExpired) {
+			t.Errorf("預期 ErrTrashRetentionExpired，實際為 %v", err)
+		}
+	})
+
+	t.Run("期限內應通過驗證", func(t *testing.T) {
+		item := &model.TrashItem{DeletedAt: now.Add(-time.Hour)}
+		if err := ValidateTrashRestore(item, retention, now); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package behavior
+
+import (
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+// ValidateExpectedVersion 檢查用戶端預期的版本（例如 If-Match）是否與目前版本相同
+// expected 為 nil 時表示用戶端未指定，不做檢查
+func ValidateExpectedVersion(expected *int, current int) error {
+	if expected == nil || *expected == current {
+		return nil
+	}
+	return fmt.Errorf("%w: expected version %d but current version is %d", domain.ErrPreconditionFailed, *expected, current)
+}
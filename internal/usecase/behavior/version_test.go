@@ -0,0 +1,29 @@
+package behavior
+
+import (
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+)
+
+func TestValidateExpectedVersion(t *testing.T) {
+	t.Run("未指定版本應通過驗證", func(t *testing.T) {
+		if err := ValidateExpectedVersion(nil, 3); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+
+	t.Run("版本相同應通過驗證", func(t *testing.T) {
+		expected := 3
+		if err := ValidateExpectedVersion(&expected, 3); err != nil {
+			t.Errorf("預期無錯誤，實際為 %v", err)
+		}
+	})
+
+	t.Run("版本不同應回傳 ErrPreconditionFailed", func(t *testing.T) {
+		expected := 2
+		if err := ValidateExpectedVersion(&expected, 3); !domain.IsPreconditionFailed(err) {
+			t.Errorf("預期 ErrPreconditionFailed，實際為 %v", err)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package behavior
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+const (
+	// DefaultTrendWindow 未指定時移動平均涵蓋的區間數
+	DefaultTrendWindow = 3
+	// DefaultChangeWindow 未指定時變化百分比比較的區間數
+	DefaultChangeWindow = 1
+	// maxTrendWindow 移動平均與變化比較可設定的最大區間數
+	maxTrendWindow = 90
+)
+
+var (
+	ErrTrendIntervalInvalid        = errors.New("無效的趨勢分組單位")
+	ErrTrendWindowInvalid          = errors.New("趨勢區間數必須介於 1 到 90")
+	ErrWeightAlertThresholdInvalid = errors.New("體重警示門檻必須大於 0 且不超過 100")
+)
+
+// ValidateTrendOptions 檢查趨勢分析的分組單位與區間數
+func ValidateTrendOptions(interval model.TrendInterval, window, changeWindow int) error {
+	if !interval.IsValid() {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTrendIntervalInvalid)
+	}
+	if window < 1 || window > maxTrendWindow || changeWindow < 1 || changeWindow > maxTrendWindow {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrTrendWindowInvalid)
+	}
+	return nil
+}
+
+// ValidateWeightAlertThreshold 檢查寵物自訂的體重警示門檻，nil 代表使用系統預設值
+func ValidateWeightAlertThreshold(threshold *model.WeightAlertThreshold) error {
+	if threshold == nil {
+		return nil
+	}
+	if threshold.LossPct <= 0 || threshold.LossPct > 100 || threshold.GainPct <= 0 || threshold.GainPct > 100 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, ErrWeightAlertThresholdInvalid)
+	}
+	return nil
+}
+
+// TrendPeriodStart 回傳時間點所屬區間的起始時間（UTC），週以星期一為起始
+func TrendPeriodStart(t time.Time, interval model.TrendInterval) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case model.TrendIntervalWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case model.TrendIntervalMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// BuildWeightTrend 將健康日誌依區間分組並計算平均體重、移動平均、變化百分比與異常標示
+// 沒有體重紀錄的日誌與區間會略過，移動平均與變化比較以有資料的區間計算
+func BuildWeightTrend(
+	logs []*model.HealthLog,
+	interval model.TrendInterval,
+	window, changeWindow int,
+	threshold model.WeightAlertThreshold,
+) []model.WeightTrendPoint {
+	type bucket struct {
+		sum   float64
+		count int
+	}
+
+	buckets := make(map[time.Time]*bucket)
+	for _, log := range logs {
+		if log == nil || log.WeightKg <= 0 {
+			continue
+		}
+		start := TrendPeriodStart(log.Date, interval)
+		b, ok := buckets[start]
+		if !ok {
+			b = &bucket{}
+			buckets[start] = b
+		}
+		b.sum += log.WeightKg
+		b.count++
+	}
+
+	starts := make([]time.Time, 0, len(buckets))
+	for start := range buckets {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	points := make([]model.WeightTrendPoint, 0, len(starts))
+	for i, start := range starts {
+		b := buckets[start]
+		point := model.WeightTrendPoint{
+			PeriodStart: start,
+			WeightKg:    roundTo(b.sum/float64(b.count), 3),
+			SampleCount: b.count,
+		}
+
+		from := max(0, i-window+1)
+		var sum float64
+		for _, p := range points[from:] {
+			sum += p.WeightKg
+		}
+		sum += point.WeightKg
+		point.MovingAverageKg = roundTo(sum/float64(i-from+1), 3)
+
+		if i >= changeWindow {
+			base := points[i-changeWindow].WeightKg
+			change := roundTo((point.WeightKg-base)/base*100, 2)
+			point.ChangePct = &change
+
+			switch {
+			case threshold.LossPct > 0 && change <= -threshold.LossPct:
+				point.Alert = model.WeightAlertRapidLoss
+			case threshold.GainPct > 0 && change >= threshold.GainPct:
+				point.Alert = model.WeightAlertRapidGain
+			}
+		}
+
+		points = append(points, point)
+	}
+
+	return points
+}
+
+func roundTo(v float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+	return math.Round(v*scale) / scale
+}
@@ -0,0 +1,105 @@
+package behavior
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+)
+
+func TestTrendPeriodStart(t *testing.T) {
+	// 2025-01-15 為星期三
+	ts := time.Date(2025, 1, 15, 18, 30, 0, 0, time.UTC)
+
+	cases := map[model.TrendInterval]time.Time{
+		model.TrendIntervalDay:   time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+		model.TrendIntervalWeek:  time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		model.TrendIntervalMonth: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for interval, want := range cases {
+		if got := TrendPeriodStart(ts, interval); !got.Equal(want) {
+			t.Errorf("%s: 預期 %v，實際為 %v", interval, want, got)
+		}
+	}
+}
+
+func TestBuildWeightTrend(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 8, 0, 0, 0, time.UTC) }
+	threshold := model.WeightAlertThreshold{LossPct: 5, GainPct: 5}
+
+	t.Run("同一區間取平均並略過沒有體重的紀錄", func(t *testing.T) {
+		food := 80
+		logs := []*model.HealthLog{
+			{Date: day(3), WeightKg: 4.0},
+			{Date: day(4), WeightKg: 4.2},
+			{Date: day(5), FoodGram: &food},
+		}
+
+		points := BuildWeightTrend(logs, model.TrendIntervalWeek, 3, 1, threshold)
+		if len(points) != 1 {
+			t.Fatalf("預期 1 個區間，實際為 %d", len(points))
+		}
+		if points[0].WeightKg != 4.1 || points[0].SampleCount != 2 {
+			t.Errorf("預期平均 4.1 且 2 筆樣本，實際為 %+v", points[0])
+		}
+		if points[0].ChangePct != nil {
+			t.Errorf("第一個區間不應有變化百分比，實際為 %v", *points[0].ChangePct)
+		}
+	})
+
+	t.Run("計算移動平均與變化百分比並標示快速減重", func(t *testing.T) {
+		logs := []*model.HealthLog{
+			{Date: day(3), WeightKg: 5.0},
+			{Date: day(1), WeightKg: 5.0},
+			{Date: day(2), WeightKg: 5.0},
+			{Date: day(4), WeightKg: 4.5},
+		}
+
+		points := BuildWeightTrend(logs, model.TrendIntervalDay, 2, 1, threshold)
+		if len(points) != 4 {
+			t.Fatalf("預期 4 個區間，實際為 %d", len(points))
+		}
+		last := points[3]
+		if last.MovingAverageKg != 4.75 {
+			t.Errorf("預期移動平均 4.75，實際為 %v", last.MovingAverageKg)
+		}
+		if last.ChangePct == nil || *last.ChangePct != -10 {
+			t.Errorf("預期變化 -10%%，實際為 %v", last.ChangePct)
+		}
+		if last.Alert != model.WeightAlertRapidLoss {
+			t.Errorf("預期標示快速減重，實際為 %q", last.Alert)
+		}
+		if points[1].Alert != "" {
+			t.Errorf("體重未變化不應標示，實際為 %q", points[1].Alert)
+		}
+	})
+
+	t.Run("依變化區間數比較並標示快速增重", func(t *testing.T) {
+		logs := []*model.HealthLog{
+			{Date: day(1), WeightKg: 4.0},
+			{Date: day(2), WeightKg: 4.1},
+			{Date: day(3), WeightKg: 4.4},
+		}
+
+		points := BuildWeightTrend(logs, model.TrendIntervalDay, 1, 2, threshold)
+		if points[1].ChangePct != nil {
+			t.Errorf("資料不足時不應有變化百分比")
+		}
+		if points[2].ChangePct == nil || *points[2].ChangePct != 10 || points[2].Alert != model.WeightAlertRapidGain {
+			t.Errorf("預期變化 10%% 並標示快速增重，實際為 %+v", points[2])
+		}
+	})
+}
+
+func TestValidateTrendOptions(t *testing.T) {
+	if err := ValidateTrendOptions(model.TrendInterval("year"), 3, 1); !errors.Is(err, ErrTrendIntervalInvalid) {
+		t.Errorf("預期 ErrTrendIntervalInvalid，實際為 %v", err)
+	}
+	if err := ValidateTrendOptions(model.TrendIntervalDay, 0, 1); !errors.Is(err, ErrTrendWindowInvalid) {
+		t.Errorf("預期 ErrTrendWindowInvalid，實際為 %v", err)
+	}
+	if err := ValidateTrendOptions(model.TrendIntervalWeek, 4, 1); err != nil {
+		t.Errorf("預期無錯誤，實際為 %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// AcceptHouseholdInvitationCommand 表示接受家庭邀請的命令請求
+type AcceptHouseholdInvitationCommand struct {
+	Token string `json:"token"`
+}
+
+// AcceptHouseholdInvitationHandler 處理接受家庭邀請的命令
+type AcceptHouseholdInvitationHandler struct {
+	householdRepo repository.HouseholdRepository
+	audit         *behavior.AuditTrail
+}
+
+// NewAcceptHouseholdInvitationHandler 建立新的 AcceptHouseholdInvitationHandler
+func NewAcceptHouseholdInvitationHandler(householdRepo repository.HouseholdRepository, audit *behavior.AuditTrail) *AcceptHouseholdInvitationHandler {
+	if householdRepo == nil || audit == nil {
+		panic("householdRepo and audit are required")
+	}
+	return &AcceptHouseholdInvitationHandler{householdRepo: householdRepo, audit: audit}
+}
+
+// Handle 執行接受家庭邀請的命令，邀請使用後即失效
+// 已是成員的使用者接受邀請時保留原本的角色
+func (h *AcceptHouseholdInvitationHandler) Handle(c context.Context, cmd AcceptHouseholdInvitationCommand) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	if strings.TrimSpace(cmd.Token) == "" {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidParameter, behavior.ErrInvitationTokenRequired)
+	}
+
+	tokenHash := behavior.HashInvitationToken(cmd.Token)
+	household, err := h.householdRepo.FindByInvitationTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, fmt.Errorf("查找邀請失敗: %w", err)
+	}
+
+	now := time.Now()
+	before := household.Clone()
+	invitation := household.TakeInvitation(tokenHash, now)
+	if invitation != nil && household.Member(userID) == nil {
+		household.UpsertMember(model.HouseholdMember{
+			UserID:    userID,
+			Role:      invitation.Role,
+			ExpiresAt: invitation.MemberExpiresAt,
+			JoinedAt:  now,
+		})
+	}
+
+	if err := h.householdRepo.Update(ctx, household); err != nil {
+		ctx.Error("更新家庭成員失敗", "error", err, "household_id", household.ID)
+		return nil, fmt.Errorf("接受邀請失敗: %w", err)
+	}
+
+	if invitation == nil {
+		ctx.Warn("邀請已過期", "household_id", household.ID, "user_id", userID)
+		return nil, fmt.Errorf("%w: invitation has expired", domain.ErrNotFound)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityHousehold, "", household.ID, before, household)
+
+	ctx.Info("成功加入家庭", "household_id", household.ID, "user_id", userID, "role", invitation.Role)
+	return household, nil
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CompleteReminderCommand 表示完成提醒的命令請求
+type CompleteReminderCommand struct {
+	ID string `json:"id"`
+}
+
+// CompleteReminderHandler 處理完成提醒的命令
+type CompleteReminderHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewCompleteReminderHandler 建立新的 CompleteReminderHandler
+func NewCompleteReminderHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CompleteReminderHandler {
+	if reminderRepo == nil || guard == nil || audit == nil {
+		panic("reminderRepo, guard and audit are required")
+	}
+	return &CompleteReminderHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行完成提醒的命令
+func (h *CompleteReminderHandler) Handle(c context.Context, cmd CompleteReminderCommand) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理完成提醒請求", "user_id", userID, "reminder_id", cmd.ID)
+
+	reminder, err := h.reminderRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找提醒失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, reminder.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	before := *reminder
+
+	reminder.Complete(time.Now())
+
+	if err := h.reminderRepo.Update(ctx, reminder); err != nil {
+		ctx.Error("在倉儲中更新提醒失敗", "error", err, "reminder_id", cmd.ID)
+		return nil, fmt.Errorf("完成提醒失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityReminder, reminder.PetID, reminder.ID, &before, reminder)
+
+	ctx.Info("成功完成提醒", "reminder_id", reminder.ID)
+
+	return reminder, nil
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CreateBudgetCommand 封裝建立預算的請求參數
+// PetID 為空表示涵蓋飼主名下所有寵物；Category 為空表示涵蓋所有分類
+type CreateBudgetCommand struct {
+	PetID      string
+	Category   string
+	Period     model.BudgetPeriod
+	Limit      int
+	Thresholds []int
+}
+
+// CreateBudgetHandler 處理建立預算的業務邏輯
+type CreateBudgetHandler struct {
+	budgetRepo repository.BudgetRepository
+	guard      *behavior.PetAccessGuard
+	audit      *behavior.AuditTrail
+}
+
+// NewCreateBudgetHandler 建立新的 handler 實例
+func NewCreateBudgetHandler(budgetRepo repository.BudgetRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CreateBudgetHandler {
+	if budgetRepo == nil || guard == nil || audit == nil {
+		panic("budgetRepo, guard and audit are required")
+	}
+	return &CreateBudgetHandler{budgetRepo: budgetRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行建立預算的流程
+func (h *CreateBudgetHandler) Handle(c context.Context, cmd CreateBudgetCommand) (*model.Budget, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	// 限定寵物的預算需可檢視該寵物的費用
+	if cmd.PetID != "" {
+		if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionRead); err != nil {
+			ctx.Warn("寵物存取檢查失敗", "pet_id", cmd.PetID, "error", err)
+			return nil, err
+		}
+	}
+
+	budget := &model.Budget{
+		OwnerID:    userID,
+		PetID:      cmd.PetID,
+		Category:   cmd.Category,
+		Period:     cmd.Period,
+		Limit:      cmd.Limit,
+		Thresholds: cmd.Thresholds,
+	}
+	if err := behavior.NormalizeBudget(budget); err != nil {
+		ctx.Warn("預算驗證失敗", "error", err)
+		return nil, err
+	}
+
+	if err := h.budgetRepo.Create(ctx, budget); err != nil {
+		ctx.Error("建立預算失敗", "error", err)
+		return nil, fmt.Errorf("建立預算失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityBudget, budget.PetID, budget.ID, nil, budget)
+
+	ctx.Info("成功建立預算", "budget_id", budget.ID, "user_id", userID)
+	return budget, nil
+}
@@ -18,31 +18,42 @@ type CreateExpenseCommand struct {
 	Amount      int       `json:"amount"`
 	Description string    `json:"description,omitempty"`
 	Date        time.Time `json:"date"`
+
+	// Currency 為 ISO 4217 幣別代碼，未指定時使用建立者的基準貨幣
+	Currency string `json:"currency,omitempty"`
 }
 
 // CreateExpenseHandler 處理建立費用的業務邏輯
 type CreateExpenseHandler struct {
 	expenseRepo repository.ExpenseRepository
-	petRepo     repository.PetRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+	budgets     *behavior.BudgetTracker
+	currencies  *behavior.CurrencyConverter
 }
 
 // NewCreateExpenseHandler 建立新的 handler 實例
-func NewCreateExpenseHandler(expenseRepo repository.ExpenseRepository, petRepo repository.PetRepository) *CreateExpenseHandler {
-	if expenseRepo == nil || petRepo == nil {
-		panic("expenseRepo and petRepo are required")
+func NewCreateExpenseHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail, budgets *behavior.BudgetTracker, currencies *behavior.CurrencyConverter) *CreateExpenseHandler {
+	if expenseRepo == nil || guard == nil || audit == nil || budgets == nil || currencies == nil {
+		panic("expenseRepo, guard, audit, budgets and currencies are required")
 	}
-	return &CreateExpenseHandler{expenseRepo: expenseRepo, petRepo: petRepo}
+	return &CreateExpenseHandler{expenseRepo: expenseRepo, guard: guard, audit: audit, budgets: budgets, currencies: currencies}
 }
 
 // Handle 執行建立費用的流程
 func (h *CreateExpenseHandler) Handle(c context.Context, cmd CreateExpenseCommand) (*model.Expense, error) {
 	ctx := contextx.WithContext(c)
 
-	// 檢查 Pet 是否存在
-	_, err := h.petRepo.FindByID(ctx, cmd.PetID)
+	// 檢查 Pet 是否存在且屬於目前使用者
+	pet, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite)
+	if err != nil {
+		ctx.Warn("寵物存取檢查失敗", "pet_id", cmd.PetID, "error", err)
+		return nil, err
+	}
+
+	currency, err := resolveCurrency(ctx, h.currencies, cmd.Currency)
 	if err != nil {
-		ctx.Warn("查無此寵物", "pet_id", cmd.PetID)
-		return nil, fmt.Errorf("查無此寵物，請確認寵物 ID 是否正確")
+		return nil, err
 	}
 
 	exp := &model.Expense{
@@ -51,6 +62,7 @@ func (h *CreateExpenseHandler) Handle(c context.Context, cmd CreateExpenseComman
 		Amount:      cmd.Amount,
 		Description: cmd.Description,
 		Date:        cmd.Date,
+		Currency:    currency,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -66,6 +78,30 @@ func (h *CreateExpenseHandler) Handle(c context.Context, cmd CreateExpenseComman
 		return nil, fmt.Errorf("failed to create expense: %w", err)
 	}
 
-	ctx.Info("expense created successfully", "pet_id", exp.PetID, "amount", exp.Amount)
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityExpense, exp.PetID, exp.ID, nil, exp)
+	h.budgets.Check(ctx, pet, exp)
+
+	ctx.Info("expense created successfully", "pet_id", exp.PetID, "amount", exp.Amount, "currency", exp.Currency)
 	return exp, nil
 }
+
+// resolveCurrency 驗證指定的幣別可換算為目前使用者的基準貨幣，未指定時使用基準貨幣
+func resolveCurrency(ctx *contextx.Contextx, currencies *behavior.CurrencyConverter, currency string) (string, error) {
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("user ID not found in context: %w", err)
+	}
+	base, err := currencies.BaseCurrency(ctx, userID)
+	if err != nil || currency == "" {
+		return base, err
+	}
+
+	if currency, err = behavior.NormalizeCurrency(currency); err != nil {
+		return "", err
+	}
+	if err := currencies.EnsureConvertible(ctx, currency, base); err != nil {
+		ctx.Warn("幣別無法換算為基準貨幣", "error", err, "currency", currency, "base", base)
+		return "", err
+	}
+	return currency, nil
+}
@@ -16,23 +16,28 @@ type CreateHealthLogCommand struct {
 	PetID          string    `json:"pet_id"`
 	Date           time.Time `json:"date"`
 	WeightKg       float64   `json:"weight_kg,omitempty"`
-	FoodGram       int       `json:"food_gram,omitempty"`
+	FoodGram       *int      `json:"food_gram,omitempty"`
 	LitterNotes    string    `json:"litter_notes,omitempty"`
 	BehaviourNotes string    `json:"behaviour_notes,omitempty"`
+	model.HealthObservations
 }
 
 // CreateHealthLogHandler handles the health log creation command.
 type CreateHealthLogHandler struct {
 	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
 }
 
 // NewCreateHealthLogHandler creates a new CreateHealthLogHandler.
-func NewCreateHealthLogHandler(healthLogRepo repository.HealthLogRepository) *CreateHealthLogHandler {
-	if healthLogRepo == nil {
-		panic("healthLogRepo is required")
+func NewCreateHealthLogHandler(healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CreateHealthLogHandler {
+	if healthLogRepo == nil || guard == nil || audit == nil {
+		panic("healthLogRepo, guard and audit are required")
 	}
 	return &CreateHealthLogHandler{
 		healthLogRepo: healthLogRepo,
+		guard:         guard,
+		audit:         audit,
 	}
 }
 
@@ -47,21 +52,28 @@ func (h *CreateHealthLogHandler) Handle(c context.Context, cmd CreateHealthLogCo
 
 	ctx.Info("handling create health log request", "user_id", userID, "pet_id", cmd.PetID)
 
-	// Here you might want to add a validation step to ensure the user owns the pet.
-	// This would involve the PetRepository. For now, we'll skip this to keep it simple.
 	validator := &behavior.HealthLogValidator{}
 	if err := validator.ValidateCreate(cmd.PetID, cmd.Date, cmd.WeightKg, cmd.FoodGram); err != nil {
 		ctx.Warn("health log validation failed", "error", err)
 		return nil, err
 	}
+	if err := validator.ValidateObservations(cmd.HealthObservations); err != nil {
+		ctx.Warn("health log observations validation failed", "error", err)
+		return nil, err
+	}
+
+	if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
 
 	log := &model.HealthLog{
-		PetID:          cmd.PetID,
-		Date:           cmd.Date,
-		WeightKg:       cmd.WeightKg,
-		FoodGram:       cmd.FoodGram,
-		LitterNotes:    cmd.LitterNotes,
-		BehaviourNotes: cmd.BehaviourNotes,
+		PetID:              cmd.PetID,
+		Date:               cmd.Date,
+		WeightKg:           cmd.WeightKg,
+		FoodGram:           cmd.FoodGram,
+		LitterNotes:        cmd.LitterNotes,
+		BehaviourNotes:     cmd.BehaviourNotes,
+		HealthObservations: cmd.HealthObservations,
 	}
 
 	if err := h.healthLogRepo.Create(ctx, log); err != nil {
@@ -69,6 +81,8 @@ func (h *CreateHealthLogHandler) Handle(c context.Context, cmd CreateHealthLogCo
 		return nil, fmt.Errorf("failed to create health log: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityHealthLog, log.PetID, log.ID, nil, log)
+
 	ctx.Info("health log created successfully", "log_id", log.ID, "pet_id", log.PetID)
 
 	return log, nil
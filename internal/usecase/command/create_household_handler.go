@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CreateHouseholdCommand 表示建立家庭的命令請求
+type CreateHouseholdCommand struct {
+	Name string `json:"name"`
+}
+
+// CreateHouseholdHandler 處理建立家庭的命令，建立者自動成為 owner
+type CreateHouseholdHandler struct {
+	householdRepo repository.HouseholdRepository
+	audit         *behavior.AuditTrail
+}
+
+// NewCreateHouseholdHandler 建立新的 CreateHouseholdHandler
+func NewCreateHouseholdHandler(householdRepo repository.HouseholdRepository, audit *behavior.AuditTrail) *CreateHouseholdHandler {
+	if householdRepo == nil || audit == nil {
+		panic("householdRepo and audit are required")
+	}
+	return &CreateHouseholdHandler{householdRepo: householdRepo, audit: audit}
+}
+
+// Handle 執行建立家庭的命令
+func (h *CreateHouseholdHandler) Handle(c context.Context, cmd CreateHouseholdCommand) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	household := &model.Household{
+		Name: cmd.Name,
+		Members: []model.HouseholdMember{
+			{UserID: userID, Role: model.HouseholdRoleOwner, JoinedAt: time.Now()},
+		},
+		Invitations: []model.HouseholdInvitation{},
+	}
+
+	validator := &behavior.HouseholdValidator{}
+	if err := validator.Validate(household); err != nil {
+		ctx.Warn("家庭驗證失敗", "error", err)
+		return nil, err
+	}
+
+	if err := h.householdRepo.Create(ctx, household); err != nil {
+		ctx.Error("建立家庭失敗", "error", err)
+		return nil, fmt.Errorf("建立家庭失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityHousehold, "", household.ID, nil, household)
+
+	ctx.Info("成功建立家庭", "household_id", household.ID, "user_id", userID)
+	return household, nil
+}
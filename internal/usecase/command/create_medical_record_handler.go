@@ -5,20 +5,30 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // CreateMedicalRecordHandler 負責建立新的醫療記錄
 // 依照專案規範，所有錯誤皆需標準化處理
 type CreateMedicalRecordHandler struct {
-	repo repository.MedicalRecordRepository
+	repo  repository.MedicalRecordRepository
+	guard *behavior.PetAccessGuard
+	audit *behavior.AuditTrail
 }
 
 // NewCreateMedicalRecordHandler 建立 handler 實例
-func NewCreateMedicalRecordHandler(repo repository.MedicalRecordRepository) *CreateMedicalRecordHandler {
-	return &CreateMedicalRecordHandler{repo: repo}
+func NewCreateMedicalRecordHandler(repo repository.MedicalRecordRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CreateMedicalRecordHandler {
+	return &CreateMedicalRecordHandler{repo: repo, guard: guard, audit: audit}
 }
 
 // Handle 執行建立醫療記錄邏輯
 func (h *CreateMedicalRecordHandler) Handle(c context.Context, record *model.MedicalRecord) error {
-	return h.repo.Create(c, record)
+	if _, err := h.guard.Authorize(c, record.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+	if err := h.repo.Create(c, record); err != nil {
+		return err
+	}
+	h.audit.Record(c, model.AuditActionCreate, model.AuditEntityMedicalRecord, record.PetID, record.ID, nil, record)
+	return nil
 }
@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CreateMedicationRegimenCommand 封裝建立用藥療程的請求參數
+type CreateMedicationRegimenCommand struct {
+	PetID           string
+	Drug            string
+	Dose            float64
+	Unit            string
+	Schedule        model.DoseSchedule
+	Timezone        string
+	StartDate       time.Time
+	EndDate         *time.Time
+	HospitalID      string
+	MedicalRecordID string
+	Notes           string
+}
+
+// CreateMedicationRegimenHandler 處理建立用藥療程的業務邏輯
+type CreateMedicationRegimenHandler struct {
+	regimenRepo  repository.MedicationRegimenRepository
+	hospitalRepo repository.HospitalRepository
+	recordRepo   repository.MedicalRecordRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewCreateMedicationRegimenHandler 建立新的 handler 實例
+func NewCreateMedicationRegimenHandler(
+	regimenRepo repository.MedicationRegimenRepository,
+	hospitalRepo repository.HospitalRepository,
+	recordRepo repository.MedicalRecordRepository,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *CreateMedicationRegimenHandler {
+	if regimenRepo == nil || hospitalRepo == nil || recordRepo == nil || guard == nil || audit == nil {
+		panic("regimenRepo, hospitalRepo, recordRepo, guard and audit are required")
+	}
+	return &CreateMedicationRegimenHandler{
+		regimenRepo:  regimenRepo,
+		hospitalRepo: hospitalRepo,
+		recordRepo:   recordRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行建立用藥療程的流程
+func (h *CreateMedicationRegimenHandler) Handle(c context.Context, cmd CreateMedicationRegimenCommand) (*model.MedicationRegimen, error) {
+	ctx := contextx.WithContext(c)
+
+	if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+		ctx.Warn("寵物存取檢查失敗", "pet_id", cmd.PetID, "error", err)
+		return nil, err
+	}
+
+	regimen := &model.MedicationRegimen{
+		PetID:           cmd.PetID,
+		Drug:            cmd.Drug,
+		Dose:            cmd.Dose,
+		Unit:            cmd.Unit,
+		Schedule:        cmd.Schedule,
+		Timezone:        cmd.Timezone,
+		StartDate:       cmd.StartDate,
+		EndDate:         cmd.EndDate,
+		HospitalID:      cmd.HospitalID,
+		MedicalRecordID: cmd.MedicalRecordID,
+		Notes:           cmd.Notes,
+	}
+	if err := behavior.NormalizeMedicationRegimen(regimen); err != nil {
+		ctx.Warn("用藥療程驗證失敗", "error", err, "pet_id", cmd.PetID)
+		return nil, err
+	}
+	if err := validateRegimenReferences(ctx, h.hospitalRepo, h.recordRepo, regimen); err != nil {
+		return nil, err
+	}
+
+	if err := h.regimenRepo.Create(ctx, regimen); err != nil {
+		ctx.Error("建立用藥療程失敗", "error", err)
+		return nil, fmt.Errorf("建立用藥療程失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityMedicationRegimen, regimen.PetID, regimen.ID, nil, regimen)
+
+	ctx.Info("成功建立用藥療程", "regimen_id", regimen.ID, "pet_id", regimen.PetID)
+	return regimen, nil
+}
+
+// validateRegimenReferences 確認開立處方的醫院存在，且關聯的醫療記錄屬於同一隻寵物
+func validateRegimenReferences(
+	ctx *contextx.Contextx,
+	hospitalRepo repository.HospitalRepository,
+	recordRepo repository.MedicalRecordRepository,
+	regimen *model.MedicationRegimen,
+) error {
+	if regimen.HospitalID != "" {
+		if _, err := hospitalRepo.GetByID(ctx, regimen.HospitalID); err != nil {
+			if domain.IsNotFound(err) || domain.IsInvalidID(err) {
+				return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, behavior.ErrMedicationHospitalNotFound)
+			}
+			ctx.Error("查找醫院失敗", "error", err, "hospital_id", regimen.HospitalID)
+			return fmt.Errorf("查找醫院失敗: %w", err)
+		}
+	}
+
+	if regimen.MedicalRecordID != "" {
+		record, err := recordRepo.FindByID(ctx, regimen.MedicalRecordID)
+		if err != nil && !domain.IsNotFound(err) && !domain.IsInvalidID(err) {
+			ctx.Error("查找醫療記錄失敗", "error", err, "medical_record_id", regimen.MedicalRecordID)
+			return fmt.Errorf("查找醫療記錄失敗: %w", err)
+		}
+		if record == nil || record.PetID != regimen.PetID {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidParameter, behavior.ErrMedicationRecordMismatch)
+		}
+	}
+
+	return nil
+}
@@ -18,20 +18,28 @@ type CreatePetCommand struct {
 	DOB         time.Time `json:"dob"`
 	Breed       string    `json:"breed"`
 	MicrochipID string    `json:"microchip_id"`
+	HouseholdID string    `json:"household_id"`
+
+	// WeightAlert overrides the default weight change thresholds; nil uses the defaults.
+	WeightAlert *model.WeightAlertThreshold `json:"weight_alert"`
 }
 
 // CreatePetHandler handles the pet creation command.
 type CreatePetHandler struct {
 	petRepo repository.PetRepository
+	guard   *behavior.PetAccessGuard
+	audit   *behavior.AuditTrail
 }
 
 // NewCreatePetHandler creates a new CreatePetHandler.
-func NewCreatePetHandler(petRepo repository.PetRepository) *CreatePetHandler {
-	if petRepo == nil {
-		panic("petRepo is required")
+func NewCreatePetHandler(petRepo repository.PetRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CreatePetHandler {
+	if petRepo == nil || guard == nil || audit == nil {
+		panic("petRepo, guard and audit are required")
 	}
 	return &CreatePetHandler{
 		petRepo: petRepo,
+		guard:   guard,
+		audit:   audit,
 	}
 }
 
@@ -46,25 +54,39 @@ func (h *CreatePetHandler) Handle(c context.Context, cmd CreatePetCommand) (*mod
 
 	ctx.Info("handling create pet request", "user_id", userID, "pet_name", cmd.Name)
 
+	// Adding a pet to a household requires write access to that household
+	if cmd.HouseholdID != "" {
+		if _, err := h.guard.AuthorizeHousehold(ctx, cmd.HouseholdID, model.PermissionWrite); err != nil {
+			return nil, fmt.Errorf("failed to create pet in household %s: %w", cmd.HouseholdID, err)
+		}
+	}
+
 	pet := &model.Pet{
 		OwnerID:     userID,
+		HouseholdID: cmd.HouseholdID,
 		Name:        cmd.Name,
 		AvatarURL:   cmd.AvatarURL,
 		DOB:         cmd.DOB,
 		Breed:       cmd.Breed,
 		MicrochipID: cmd.MicrochipID,
+		WeightAlert: cmd.WeightAlert,
 	}
 
 	if err := behavior.ValidatePet(pet); err != nil {
 		ctx.Warn("pet validation failed", "error", err, "pet_name", pet.Name)
 		return nil, fmt.Errorf("pet validation failed: %w", err)
 	}
+	if err := behavior.ValidateWeightAlertThreshold(pet.WeightAlert); err != nil {
+		return nil, err
+	}
 
 	if err := h.petRepo.Create(ctx, pet); err != nil {
 		ctx.Error("failed to create pet in repository", "error", err)
 		return nil, fmt.Errorf("failed to create pet: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityPet, pet.ID, pet.ID, nil, pet)
+
 	ctx.Info("pet created successfully", "pet_id", pet.ID, "user_id", userID)
 
 	return pet, nil
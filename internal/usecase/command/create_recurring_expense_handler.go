@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CreateRecurringExpenseCommand 封裝建立週期性費用的請求參數
+type CreateRecurringExpenseCommand struct {
+	PetID       string
+	Category    string
+	Amount      int
+	Description string
+	Schedule    model.ExpenseSchedule
+	StartDate   time.Time
+	EndDate     *time.Time
+
+	// Currency 為 ISO 4217 幣別代碼，未指定時使用建立者的基準貨幣
+	Currency string
+}
+
+// CreateRecurringExpenseHandler 處理建立週期性費用的業務邏輯
+type CreateRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+	currencies    *behavior.CurrencyConverter
+}
+
+// NewCreateRecurringExpenseHandler 建立新的 handler 實例
+func NewCreateRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail, currencies *behavior.CurrencyConverter) *CreateRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil || audit == nil || currencies == nil {
+		panic("recurringRepo, guard, audit and currencies are required")
+	}
+	return &CreateRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard, audit: audit, currencies: currencies}
+}
+
+// Handle 執行建立週期性費用的流程，費用由排程器依期別產生
+func (h *CreateRecurringExpenseHandler) Handle(c context.Context, cmd CreateRecurringExpenseCommand) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+		ctx.Warn("寵物存取檢查失敗", "pet_id", cmd.PetID, "error", err)
+		return nil, err
+	}
+
+	currency, err := resolveCurrency(ctx, h.currencies, cmd.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring := &model.RecurringExpense{
+		PetID:       cmd.PetID,
+		Category:    cmd.Category,
+		Amount:      cmd.Amount,
+		Description: cmd.Description,
+		Schedule:    cmd.Schedule,
+		StartDate:   cmd.StartDate,
+		EndDate:     cmd.EndDate,
+		Currency:    currency,
+	}
+	if err := behavior.NormalizeRecurringExpense(recurring); err != nil {
+		ctx.Warn("週期性費用驗證失敗", "error", err, "pet_id", cmd.PetID)
+		return nil, err
+	}
+
+	if err := h.recurringRepo.Create(ctx, recurring); err != nil {
+		ctx.Error("建立週期性費用失敗", "error", err)
+		return nil, fmt.Errorf("建立週期性費用失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityRecurringExpense, recurring.PetID, recurring.ID, nil, recurring)
+
+	ctx.Info("成功建立週期性費用", "recurring_expense_id", recurring.ID, "pet_id", recurring.PetID)
+	return recurring, nil
+}
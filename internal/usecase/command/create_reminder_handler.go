@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// CreateReminderCommand 表示手動建立提醒的命令請求
+type CreateReminderCommand struct {
+	PetID    string                  `json:"pet_id"`
+	Type     model.MedicalRecordType `json:"type"`
+	Title    string                  `json:"title"`
+	DueDate  time.Time               `json:"due_date"`
+	RemindAt *time.Time              `json:"remind_at,omitempty"`
+}
+
+// CreateReminderHandler 處理建立提醒的命令
+type CreateReminderHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+	leadDays     int
+}
+
+// NewCreateReminderHandler 建立新的 CreateReminderHandler
+func NewCreateReminderHandler(cfg config.Config, reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *CreateReminderHandler {
+	if reminderRepo == nil || guard == nil || audit == nil {
+		panic("reminderRepo, guard and audit are required")
+	}
+	return &CreateReminderHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+		audit:        audit,
+		leadDays:     cfg.Reminder.LeadDays,
+	}
+}
+
+// Handle 執行建立提醒的命令
+func (h *CreateReminderHandler) Handle(c context.Context, cmd CreateReminderCommand) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理建立提醒請求", "user_id", userID, "pet_id", cmd.PetID)
+
+	if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+
+	reminder := &model.Reminder{
+		PetID:    cmd.PetID,
+		Type:     cmd.Type,
+		Title:    cmd.Title,
+		DueDate:  cmd.DueDate,
+		RemindAt: behavior.DefaultRemindAt(cmd.DueDate, h.leadDays),
+		Status:   model.ReminderStatusPending,
+	}
+	if reminder.Type == "" {
+		reminder.Type = model.RecordTypeOther
+	}
+	if cmd.RemindAt != nil {
+		reminder.RemindAt = *cmd.RemindAt
+	}
+
+	validator := &behavior.ReminderValidator{}
+	if err := validator.Validate(reminder); err != nil {
+		ctx.Warn("提醒驗證失敗", "error", err)
+		return nil, err
+	}
+
+	if err := h.reminderRepo.Create(ctx, reminder); err != nil {
+		ctx.Error("在倉儲中建立提醒失敗", "error", err)
+		return nil, fmt.Errorf("建立提醒失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityReminder, reminder.PetID, reminder.ID, nil, reminder)
+
+	ctx.Info("成功建立提醒", "reminder_id", reminder.ID, "pet_id", reminder.PetID)
+
+	return reminder, nil
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DeleteAttachmentCommand 表示刪除附件的命令請求
+type DeleteAttachmentCommand struct {
+	ID string
+}
+
+// DeleteAttachmentHandler 處理刪除附件的命令，中繼資料與檔案一併刪除
+type DeleteAttachmentHandler struct {
+	attachmentRepo repository.AttachmentRepository
+	store          service.BlobStore
+	guard          *behavior.PetAccessGuard
+	audit          *behavior.AuditTrail
+}
+
+// NewDeleteAttachmentHandler 建立新的 DeleteAttachmentHandler
+func NewDeleteAttachmentHandler(
+	attachmentRepo repository.AttachmentRepository,
+	store service.BlobStore,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *DeleteAttachmentHandler {
+	if attachmentRepo == nil || store == nil || guard == nil || audit == nil {
+		panic("attachmentRepo, store, guard and audit are required")
+	}
+	return &DeleteAttachmentHandler{
+		attachmentRepo: attachmentRepo,
+		store:          store,
+		guard:          guard,
+		audit:          audit,
+	}
+}
+
+// Handle 執行刪除附件的命令
+func (h *DeleteAttachmentHandler) Handle(c context.Context, cmd DeleteAttachmentCommand) error {
+	ctx := contextx.WithContext(c)
+
+	attachment, err := h.attachmentRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("查找附件失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, attachment.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+
+	if err := h.attachmentRepo.Delete(ctx, cmd.ID); err != nil {
+		return fmt.Errorf("刪除附件失敗: %w", err)
+	}
+
+	// 中繼資料已刪除，檔案刪除失敗只記錄日誌
+	if err := h.store.Delete(ctx, attachment.StorageKey); err != nil {
+		ctx.Error("刪除附件檔案失敗", "error", err, "attachment_id", cmd.ID, "key", attachment.StorageKey)
+	}
+
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityAttachment, attachment.PetID, cmd.ID, attachment, nil)
+
+	ctx.Info("成功刪除附件", "attachment_id", cmd.ID)
+	return nil
+}
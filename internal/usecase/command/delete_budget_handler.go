@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DeleteBudgetCommand 封裝刪除預算的請求參數
+type DeleteBudgetCommand struct {
+	ID string
+}
+
+// DeleteBudgetHandler 處理刪除預算的業務邏輯
+type DeleteBudgetHandler struct {
+	budgetRepo repository.BudgetRepository
+	audit      *behavior.AuditTrail
+}
+
+// NewDeleteBudgetHandler 建立新的 handler 實例
+func NewDeleteBudgetHandler(budgetRepo repository.BudgetRepository, audit *behavior.AuditTrail) *DeleteBudgetHandler {
+	if budgetRepo == nil || audit == nil {
+		panic("budgetRepo and audit are required")
+	}
+	return &DeleteBudgetHandler{budgetRepo: budgetRepo, audit: audit}
+}
+
+// Handle 執行刪除預算的流程
+func (h *DeleteBudgetHandler) Handle(c context.Context, cmd DeleteBudgetCommand) error {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	budget, err := h.budgetRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("查找預算失敗: %w", err)
+	}
+	if err := behavior.AuthorizeBudget(budget, userID); err != nil {
+		return err
+	}
+
+	if err := h.budgetRepo.Delete(ctx, cmd.ID); err != nil {
+		ctx.Error("刪除預算失敗", "error", err, "budget_id", cmd.ID)
+		return fmt.Errorf("刪除預算失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityBudget, budget.PetID, budget.ID, budget, nil)
+
+	ctx.Info("成功刪除預算", "budget_id", cmd.ID)
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -16,12 +18,16 @@ type DeleteExpenseCommand struct {
 // DeleteExpenseHandler 處理刪除費用的業務邏輯
 type DeleteExpenseHandler struct {
 	expenseRepo repository.ExpenseRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
 }
 
 // NewDeleteExpenseHandler 建立新的 handler 實例
-func NewDeleteExpenseHandler(expenseRepo repository.ExpenseRepository) *DeleteExpenseHandler {
+func NewDeleteExpenseHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteExpenseHandler {
 	return &DeleteExpenseHandler{
 		expenseRepo: expenseRepo,
+		guard:       guard,
+		audit:       audit,
 	}
 }
 
@@ -38,12 +44,17 @@ func (h *DeleteExpenseHandler) Handle(c context.Context, cmd DeleteExpenseComman
 	}
 
 	// 先檢查費用是否存在
-	_, err := h.expenseRepo.FindByID(ctx, cmd.ID)
+	existing, err := h.expenseRepo.FindByID(ctx, cmd.ID)
 	if err != nil {
 		ctx.Error("查詢費用失敗", "expense_id", cmd.ID, "error", err)
 		return fmt.Errorf("查詢費用失敗: %w", err)
 	}
 
+	// 確認使用者可存取該費用所屬的寵物
+	if _, err := h.guard.Authorize(ctx, existing.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+
 	// 執行刪除
 	err = h.expenseRepo.Delete(ctx, cmd.ID)
 	if err != nil {
@@ -51,6 +62,8 @@ func (h *DeleteExpenseHandler) Handle(c context.Context, cmd DeleteExpenseComman
 		return fmt.Errorf("刪除費用失敗: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityExpense, existing.PetID, cmd.ID, existing, nil)
+
 	ctx.Info("成功刪除費用", "expense_id", cmd.ID)
 	return nil
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -16,15 +18,19 @@ type DeleteHealthLogCommand struct {
 // DeleteHealthLogHandler 處理刪除健康日誌的命令
 type DeleteHealthLogHandler struct {
 	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
 }
 
 // NewDeleteHealthLogHandler 建立新的 DeleteHealthLogHandler
-func NewDeleteHealthLogHandler(healthLogRepo repository.HealthLogRepository) *DeleteHealthLogHandler {
-	if healthLogRepo == nil {
-		panic("healthLogRepo is required")
+func NewDeleteHealthLogHandler(healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteHealthLogHandler {
+	if healthLogRepo == nil || guard == nil || audit == nil {
+		panic("healthLogRepo, guard and audit are required")
 	}
 	return &DeleteHealthLogHandler{
 		healthLogRepo: healthLogRepo,
+		guard:         guard,
+		audit:         audit,
 	}
 }
 
@@ -46,15 +52,19 @@ func (h *DeleteHealthLogHandler) Handle(c context.Context, cmd DeleteHealthLogCo
 		return fmt.Errorf("查找健康日誌失敗: %w", err)
 	}
 
-	// TODO: 這裡可能需要新增驗證邏輯，確保使用者擁有該寵物的健康日誌存取權限
-	// 這需要透過 PetRepository 來驗證寵物的擁有者。目前為了簡化先跳過此驗證
+	// 確認使用者可存取該健康日誌所屬的寵物
+	if _, err := h.guard.Authorize(ctx, existingLog.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
 
 	if err := h.healthLogRepo.Delete(ctx, cmd.ID); err != nil {
 		ctx.Error("在倉儲中刪除健康日誌失敗", "error", err, "log_id", cmd.ID)
 		return fmt.Errorf("刪除健康日誌失敗: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityHealthLog, existingLog.PetID, cmd.ID, existingLog, nil)
+
 	ctx.Info("成功刪除健康日誌", "log_id", cmd.ID, "pet_id", existingLog.PetID)
 
 	return nil
-}
\ No newline at end of file
+}
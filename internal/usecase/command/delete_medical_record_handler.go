@@ -3,20 +3,35 @@ package command
 import (
 	"context"
 
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // DeleteMedicalRecordHandler 負責刪除醫療記錄
 type DeleteMedicalRecordHandler struct {
-	repo repository.MedicalRecordRepository
+	repo  repository.MedicalRecordRepository
+	guard *behavior.PetAccessGuard
+	audit *behavior.AuditTrail
 }
 
 // NewDeleteMedicalRecordHandler 建立 handler 實例
-func NewDeleteMedicalRecordHandler(repo repository.MedicalRecordRepository) *DeleteMedicalRecordHandler {
-	return &DeleteMedicalRecordHandler{repo: repo}
+func NewDeleteMedicalRecordHandler(repo repository.MedicalRecordRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteMedicalRecordHandler {
+	return &DeleteMedicalRecordHandler{repo: repo, guard: guard, audit: audit}
 }
 
 // Handle 執行刪除醫療記錄邏輯
 func (h *DeleteMedicalRecordHandler) Handle(c context.Context, id string) error {
-	return h.repo.Delete(c, id)
+	existing, err := h.repo.FindByID(c, id)
+	if err != nil {
+		return err
+	}
+	if _, err := h.guard.Authorize(c, existing.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+	if err := h.repo.Delete(c, id); err != nil {
+		return err
+	}
+	h.audit.Record(c, model.AuditActionDelete, model.AuditEntityMedicalRecord, existing.PetID, id, existing, nil)
+	return nil
 }
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DeleteMedicationRegimenCommand 封裝刪除用藥療程的請求參數
+type DeleteMedicationRegimenCommand struct {
+	ID string
+}
+
+// DeleteMedicationRegimenHandler 處理刪除用藥療程的業務邏輯，療程的給藥紀錄會一併刪除
+type DeleteMedicationRegimenHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	doseRepo    repository.DoseEventRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+}
+
+// NewDeleteMedicationRegimenHandler 建立新的 handler 實例
+func NewDeleteMedicationRegimenHandler(regimenRepo repository.MedicationRegimenRepository, doseRepo repository.DoseEventRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteMedicationRegimenHandler {
+	if regimenRepo == nil || doseRepo == nil || guard == nil || audit == nil {
+		panic("regimenRepo, doseRepo, guard and audit are required")
+	}
+	return &DeleteMedicationRegimenHandler{regimenRepo: regimenRepo, doseRepo: doseRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行刪除用藥療程的流程
+func (h *DeleteMedicationRegimenHandler) Handle(c context.Context, cmd DeleteMedicationRegimenCommand) error {
+	ctx := contextx.WithContext(c)
+
+	regimen, err := findWritableMedicationRegimen(ctx, h.regimenRepo, h.guard, cmd.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := h.regimenRepo.Delete(ctx, cmd.ID); err != nil {
+		ctx.Error("刪除用藥療程失敗", "error", err, "regimen_id", cmd.ID)
+		return fmt.Errorf("刪除用藥療程失敗: %w", err)
+	}
+	// 療程已刪除，給藥紀錄刪除失敗只留下無法查詢的孤兒資料，不影響結果
+	if err := h.doseRepo.DeleteByRegimen(ctx, cmd.ID); err != nil {
+		ctx.Warn("刪除給藥紀錄失敗", "error", err, "regimen_id", cmd.ID)
+	}
+
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityMedicationRegimen, regimen.PetID, regimen.ID, regimen, nil)
+
+	ctx.Info("成功刪除用藥療程", "regimen_id", cmd.ID)
+	return nil
+}
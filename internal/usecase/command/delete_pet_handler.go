@@ -3,28 +3,50 @@ package command
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
 // DeletePetCommand represents the request for deleting a pet.
+// By default the pet and its records are archived and can be restored;
+// Purge permanently removes them instead.
 type DeletePetCommand struct {
-	ID string
+	ID    string
+	Purge bool
 }
 
 // DeletePetHandler handles the pet deletion command.
 type DeletePetHandler struct {
-	petRepo repository.PetRepository
+	archiveRepo repository.PetArchiveRepository
+	store       service.BlobStore
+	avatars     *behavior.PetAvatarStore
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
 }
 
 // NewDeletePetHandler creates a new DeletePetHandler.
-func NewDeletePetHandler(petRepo repository.PetRepository) *DeletePetHandler {
-	if petRepo == nil {
-		panic("petRepo is required")
+func NewDeletePetHandler(
+	archiveRepo repository.PetArchiveRepository,
+	store service.BlobStore,
+	avatars *behavior.PetAvatarStore,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *DeletePetHandler {
+	if archiveRepo == nil || store == nil || avatars == nil || guard == nil || audit == nil {
+		panic("archiveRepo, store, avatars, guard and audit are required")
 	}
 	return &DeletePetHandler{
-		petRepo: petRepo,
+		archiveRepo: archiveRepo,
+		store:       store,
+		avatars:     avatars,
+		guard:       guard,
+		audit:       audit,
 	}
 }
 
@@ -37,25 +59,51 @@ func (h *DeletePetHandler) Handle(c context.Context, cmd DeletePetCommand) error
 		return fmt.Errorf("user ID not found in context: %w", err)
 	}
 
-	ctx.Info("handling delete pet request", "user_id", userID, "pet_id", cmd.ID)
+	ctx.Info("handling delete pet request", "user_id", userID, "pet_id", cmd.ID, "purge", cmd.Purge)
 
-	// Fetch existing pet to check for ownership
-	pet, err := h.petRepo.FindByID(ctx, cmd.ID)
-	if err != nil {
-		return fmt.Errorf("failed to find pet with id %s: %w", cmd.ID, err)
+	// Only the creator or a household owner may delete a pet
+	pet, err := h.guard.Authorize(ctx, cmd.ID, model.PermissionManage)
+	if err != nil && !(cmd.Purge && domain.IsNotFound(err)) {
+		return fmt.Errorf("failed to delete pet with id %s: %w", cmd.ID, err)
 	}
 
-	// Authorization check
-	if pet.OwnerID != userID {
-		return fmt.Errorf("user %s is not authorized to delete pet %s", userID, cmd.ID)
+	if !cmd.Purge {
+		if err := h.archiveRepo.Archive(ctx, cmd.ID, time.Now()); err != nil {
+			ctx.Error("failed to archive pet", "error", err)
+			return fmt.Errorf("failed to archive pet: %w", err)
+		}
+
+		h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityPet, cmd.ID, cmd.ID, pet, nil)
+
+		ctx.Info("pet archived successfully", "pet_id", cmd.ID, "user_id", userID)
+		return nil
 	}
 
-	if err := h.petRepo.Delete(ctx, cmd.ID); err != nil {
-		ctx.Error("failed to delete pet from repository", "error", err)
-		return fmt.Errorf("failed to delete pet: %w", err)
+	// An already archived pet can still be purged explicitly
+	if err != nil {
+		archived, findErr := h.archiveRepo.FindArchivedByID(ctx, cmd.ID)
+		if findErr != nil {
+			return fmt.Errorf("failed to delete pet with id %s: %w", cmd.ID, findErr)
+		}
+		if err := h.guard.AuthorizePet(ctx, archived, model.PermissionManage); err != nil {
+			return fmt.Errorf("failed to delete pet with id %s: %w", cmd.ID, err)
+		}
+		pet = archived
 	}
 
-	ctx.Info("pet deleted successfully", "pet_id", cmd.ID, "user_id", userID)
+	attachments, err := h.archiveRepo.Purge(ctx, cmd.ID)
+	if err != nil {
+		ctx.Error("failed to purge pet", "error", err)
+		return fmt.Errorf("failed to purge pet: %w", err)
+	}
+
+	// Attachment and avatar files can only be removed once the metadata purge has committed
+	behavior.RemoveAttachmentFiles(ctx, h.store, attachments)
+	h.avatars.Remove(ctx, pet.Avatar)
+
+	h.audit.Record(ctx, model.AuditActionPurge, model.AuditEntityPet, cmd.ID, cmd.ID, pet, nil)
+
+	ctx.Info("pet purged successfully", "pet_id", cmd.ID, "user_id", userID)
 
 	return nil
 }
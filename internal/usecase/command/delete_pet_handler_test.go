@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDeletePetHandler_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	archiveRepo := repository.NewMockPetArchiveRepository(ctrl)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	auditRepo := repository.NewMockAuditRepository(ctrl)
+	store := service.NewMockBlobStore(ctrl)
+	handler := NewDeletePetHandler(
+		archiveRepo,
+		store,
+		behavior.NewPetAvatarStore(config.Config{}, store),
+		behavior.NewPetAccessGuard(petRepo, repository.NewMockHouseholdRepository(ctrl)),
+		behavior.NewAuditTrail(auditRepo),
+	)
+
+	avatar := &model.PetAvatar{Key: "avatars/pet-1/abc"}
+	petRepo.EXPECT().FindByID(gomock.Any(), "pet-1").
+		Return(&model.Pet{ID: "pet-1", OwnerID: "owner", Avatar: avatar}, nil)
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	t.Run("清除寵物後刪除附件與頭像檔案", func(t *testing.T) {
+		purged := false
+		archiveRepo.EXPECT().Purge(gomock.Any(), "pet-1").DoAndReturn(func(context.Context, string) ([]*model.Attachment, error) {
+			purged = true
+			return []*model.Attachment{{ID: "att-1", StorageKey: "attachments/pet-1/receipt"}}, nil
+		})
+
+		var deleted []string
+		store.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key string) error {
+			if !purged {
+				t.Errorf("預期資料清除後才刪除檔案，實際先刪除 %s", key)
+			}
+			deleted = append(deleted, key)
+			return nil
+		}).Times(1 + len(behavior.AvatarSizes))
+
+		c := contextx.WithUserID(context.Background(), "owner")
+		if err := handler.Handle(c, DeletePetCommand{ID: "pet-1", Purge: true}); err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+
+		avatars := 0
+		for _, key := range deleted {
+			if strings.HasPrefix(key, avatar.Key+"/") {
+				avatars++
+			}
+		}
+		if avatars != len(behavior.AvatarSizes) {
+			t.Errorf("預期刪除 %d 個頭像縮圖，實際刪除 %v", len(behavior.AvatarSizes), deleted)
+		}
+	})
+}
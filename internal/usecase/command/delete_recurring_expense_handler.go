@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DeleteRecurringExpenseCommand 封裝刪除週期性費用的請求參數
+type DeleteRecurringExpenseCommand struct {
+	ID string
+}
+
+// DeleteRecurringExpenseHandler 處理刪除週期性費用的業務邏輯，已產生的費用不會一併刪除
+type DeleteRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewDeleteRecurringExpenseHandler 建立新的 handler 實例
+func NewDeleteRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil || audit == nil {
+		panic("recurringRepo, guard and audit are required")
+	}
+	return &DeleteRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行刪除週期性費用的流程
+func (h *DeleteRecurringExpenseHandler) Handle(c context.Context, cmd DeleteRecurringExpenseCommand) error {
+	ctx := contextx.WithContext(c)
+
+	recurring, err := findWritableRecurringExpense(ctx, h.recurringRepo, h.guard, cmd.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := h.recurringRepo.Delete(ctx, cmd.ID); err != nil {
+		ctx.Error("刪除週期性費用失敗", "error", err, "recurring_expense_id", cmd.ID)
+		return fmt.Errorf("刪除週期性費用失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityRecurringExpense, recurring.PetID, recurring.ID, recurring, nil)
+
+	ctx.Info("成功刪除週期性費用", "recurring_expense_id", cmd.ID)
+	return nil
+}
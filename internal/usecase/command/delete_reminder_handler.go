@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DeleteReminderCommand 表示刪除提醒的命令請求
+type DeleteReminderCommand struct {
+	ID string `json:"id"`
+}
+
+// DeleteReminderHandler 處理刪除提醒的命令
+type DeleteReminderHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewDeleteReminderHandler 建立新的 DeleteReminderHandler
+func NewDeleteReminderHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *DeleteReminderHandler {
+	if reminderRepo == nil || guard == nil || audit == nil {
+		panic("reminderRepo, guard and audit are required")
+	}
+	return &DeleteReminderHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行刪除提醒的命令
+func (h *DeleteReminderHandler) Handle(c context.Context, cmd DeleteReminderCommand) error {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理刪除提醒請求", "user_id", userID, "reminder_id", cmd.ID)
+
+	reminder, err := h.reminderRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("查找提醒失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, reminder.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+
+	if err := h.reminderRepo.Delete(ctx, cmd.ID); err != nil {
+		ctx.Error("在倉儲中刪除提醒失敗", "error", err, "reminder_id", cmd.ID)
+		return fmt.Errorf("刪除提醒失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionDelete, model.AuditEntityReminder, reminder.PetID, reminder.ID, reminder, nil)
+
+	ctx.Info("成功刪除提醒", "reminder_id", cmd.ID)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DetectMissedDosesCommand 表示偵測漏服並建立提醒的命令
+type DetectMissedDosesCommand struct {
+	Now time.Time
+}
+
+// DetectMissedDosesHandler 找出超過寬限期仍未勾選的給藥，為每次漏服建立一筆提醒
+// 提醒以 (medication_regimen_id, due_date) 唯一索引去重，重複執行不會產生重複提醒
+type DetectMissedDosesHandler struct {
+	regimenRepo  repository.MedicationRegimenRepository
+	doseRepo     repository.DoseEventRepository
+	reminderRepo repository.ReminderRepository
+	audit        *behavior.AuditTrail
+	grace        time.Duration
+}
+
+// NewDetectMissedDosesHandler 建立新的 DetectMissedDosesHandler
+func NewDetectMissedDosesHandler(
+	cfg config.Config,
+	regimenRepo repository.MedicationRegimenRepository,
+	doseRepo repository.DoseEventRepository,
+	reminderRepo repository.ReminderRepository,
+	audit *behavior.AuditTrail,
+) *DetectMissedDosesHandler {
+	if regimenRepo == nil || doseRepo == nil || reminderRepo == nil || audit == nil {
+		panic("regimenRepo, doseRepo, reminderRepo and audit are required")
+	}
+	return &DetectMissedDosesHandler{
+		regimenRepo:  regimenRepo,
+		doseRepo:     doseRepo,
+		reminderRepo: reminderRepo,
+		audit:        audit,
+		grace:        cfg.Reminder.MissedDoseGrace,
+	}
+}
+
+// Handle 執行漏服偵測，回傳本次新建立的提醒數量
+// 單一療程處理失敗只記錄日誌，不影響其他療程
+func (h *DetectMissedDosesHandler) Handle(c context.Context, cmd DetectMissedDosesCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	regimens, err := h.regimenRepo.FindActive(ctx, now.Add(-h.grace))
+	if err != nil {
+		ctx.Error("查找用藥療程失敗", "error", err)
+		return 0, fmt.Errorf("查找用藥療程失敗: %w", err)
+	}
+
+	created := 0
+	for _, regimen := range regimens {
+		n, err := h.detect(ctx, regimen, now)
+		created += n
+		if err != nil {
+			ctx.Error("偵測漏服失敗", "error", err, "regimen_id", regimen.ID)
+		}
+	}
+
+	ctx.Info("完成漏服偵測", "scanned", len(regimens), "created", created)
+
+	return created, nil
+}
+
+func (h *DetectMissedDosesHandler) detect(ctx *contextx.Contextx, regimen *model.MedicationRegimen, now time.Time) (int, error) {
+	// 只檢查寬限期已過的給藥，且不早於上次檢查的時間點與回溯上限
+	to := now.Add(-h.grace)
+	from := to.Add(-behavior.MissedDoseLookback)
+	if regimen.MissedCheckedThrough != nil && regimen.MissedCheckedThrough.After(from) {
+		from = *regimen.MissedCheckedThrough
+	}
+	if !to.After(from) {
+		return 0, nil
+	}
+
+	// 沒有排定的給藥時不更新進度，避免每次排程都遞增版本而使用戶端的 If-Match 失效
+	if len(behavior.DoseOccurrences(regimen, from, to)) == 0 {
+		return 0, nil
+	}
+
+	recorded, err := h.doseRepo.FindByRegimen(ctx, regimen.ID, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("查找給藥紀錄失敗: %w", err)
+	}
+
+	created := 0
+	for _, dose := range behavior.MissedDoses(behavior.BuildDoseEvents(regimen, recorded, from, to, h.grace, now)) {
+		reminder := &model.Reminder{
+			PetID:               regimen.PetID,
+			MedicationRegimenID: regimen.ID,
+			Type:                model.RecordTypeMedication,
+			Title:               behavior.MissedDoseReminderTitle(regimen),
+			DueDate:             dose.ScheduledAt,
+			RemindAt:            now,
+			Status:              model.ReminderStatusPending,
+		}
+		if err := h.reminderRepo.Create(ctx, reminder); err != nil {
+			// 上次執行已建立但未更新進度時，唯一索引會擋下重複的提醒
+			if domain.IsDuplicateEntry(err) {
+				continue
+			}
+			return created, fmt.Errorf("建立漏服提醒失敗: %w", err)
+		}
+		h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityReminder, reminder.PetID, reminder.ID, nil, reminder)
+		created++
+	}
+
+	regimen.MissedCheckedThrough = &to
+	if err := h.regimenRepo.Update(ctx, regimen); err != nil {
+		// 版本衝突代表使用者同時編輯，下次執行會依新的設定繼續檢查
+		if domain.IsUpdateConflict(err) {
+			ctx.Warn("用藥療程已被更新，延後記錄檢查進度", "regimen_id", regimen.ID)
+			return created, nil
+		}
+		return created, fmt.Errorf("更新漏服檢查進度失敗: %w", err)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// DispatchRemindersCommand 表示發送到期提醒的命令
+type DispatchRemindersCommand struct {
+	Now time.Time
+}
+
+// DispatchRemindersHandler 依飼主的通知偏好發送到期（含延後到期）的提醒，並將提醒標記為已發送
+type DispatchRemindersHandler struct {
+	reminderRepo repository.ReminderRepository
+	petRepo      repository.PetRepository
+	prefRepo     repository.NotificationPreferenceRepository
+	deliveryRepo repository.NotificationDeliveryRepository
+	notifier     *notifier
+}
+
+// NewDispatchRemindersHandler 建立新的 DispatchRemindersHandler
+func NewDispatchRemindersHandler(
+	cfg config.Config,
+	reminderRepo repository.ReminderRepository,
+	petRepo repository.PetRepository,
+	prefRepo repository.NotificationPreferenceRepository,
+	deliveryRepo repository.NotificationDeliveryRepository,
+	senders []service.NotificationSender,
+) *DispatchRemindersHandler {
+	if reminderRepo == nil || petRepo == nil || prefRepo == nil || deliveryRepo == nil {
+		panic("reminderRepo, petRepo, prefRepo and deliveryRepo are required")
+	}
+	return &DispatchRemindersHandler{
+		reminderRepo: reminderRepo,
+		petRepo:      petRepo,
+		prefRepo:     prefRepo,
+		deliveryRepo: deliveryRepo,
+		notifier:     newNotifier(cfg, senders, deliveryRepo),
+	}
+}
+
+// Handle 執行發送提醒的命令，回傳本次發送的提醒數量
+// 單一提醒處理失敗時記錄錯誤並繼續處理其他提醒，未標記為已發送的提醒於下一次排程重新處理
+func (h *DispatchRemindersHandler) Handle(c context.Context, cmd DispatchRemindersCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	reminders, err := h.reminderRepo.FindDue(ctx, now)
+	if err != nil {
+		ctx.Error("查找到期提醒失敗", "error", err)
+		return 0, fmt.Errorf("查找到期提醒失敗: %w", err)
+	}
+
+	sent := 0
+	var errs []error
+	for _, reminder := range reminders {
+		if !reminder.IsDue(now) {
+			continue
+		}
+
+		if err := h.notify(ctx, reminder, now); err != nil {
+			ctx.Error("發送提醒失敗", "error", err, "reminder_id", reminder.ID, "pet_id", reminder.PetID)
+			errs = append(errs, fmt.Errorf("發送提醒 %s 失敗: %w", reminder.ID, err))
+			continue
+		}
+
+		if err := reminder.MarkSent(now); err != nil {
+			ctx.Warn("提醒無法標記為已發送", "reminder_id", reminder.ID, "status", reminder.Status)
+			continue
+		}
+
+		if err := h.reminderRepo.Update(ctx, reminder); err != nil {
+			ctx.Error("更新提醒狀態失敗", "error", err, "reminder_id", reminder.ID)
+			errs = append(errs, fmt.Errorf("更新提醒 %s 狀態失敗: %w", reminder.ID, err))
+			continue
+		}
+
+		ctx.Info("提醒已發送", "reminder_id", reminder.ID, "pet_id", reminder.PetID, "due_date", reminder.DueDate)
+		sent++
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// notify 為提醒建立各管道的投遞紀錄並嘗試第一次發送，失敗的投遞交由重試工作處理
+func (h *DispatchRemindersHandler) notify(ctx *contextx.Contextx, reminder *model.Reminder, now time.Time) error {
+	pet, err := h.petRepo.FindByID(ctx, reminder.PetID)
+	if err != nil {
+		return fmt.Errorf("查找寵物失敗: %w", err)
+	}
+
+	pref, err := h.prefRepo.FindByUserID(ctx, pet.OwnerID)
+	if domain.IsNotFound(err) {
+		ctx.Info("飼主未設定通知偏好，略過發送", "user_id", pet.OwnerID, "reminder_id", reminder.ID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("查找通知偏好失敗: %w", err)
+	}
+
+	msg := model.NotificationMessage{
+		Title:      fmt.Sprintf("%s 的提醒：%s", pet.Name, reminder.Title),
+		Body:       fmt.Sprintf("到期日：%s", reminder.DueDate.Format("2006-01-02")),
+		PetID:      pet.ID,
+		ReminderID: reminder.ID,
+		DueDate:    reminder.DueDate,
+	}
+
+	for _, ch := range pref.EnabledChannels() {
+		delivery := &model.NotificationDelivery{
+			UserID:  pet.OwnerID,
+			Channel: ch.Channel,
+			Target:  ch.Target,
+			Message: msg,
+			Status:  model.DeliveryStatusPending,
+		}
+		if err := h.deliveryRepo.Create(ctx, delivery); err != nil {
+			return fmt.Errorf("建立通知投遞紀錄失敗: %w", err)
+		}
+		if _, err := h.notifier.attempt(ctx, delivery, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
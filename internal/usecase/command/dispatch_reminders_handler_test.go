@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatchRemindersHandler_ContinueOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	reminderRepo := repository.NewMockReminderRepository(ctrl)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	prefRepo := repository.NewMockNotificationPreferenceRepository(ctrl)
+	deliveryRepo := repository.NewMockNotificationDeliveryRepository(ctrl)
+	sender := service.NewMockNotificationSender(ctrl)
+	sender.EXPECT().Channel().Return(model.NotificationChannelEmail).AnyTimes()
+	handler := NewDispatchRemindersHandler(config.Config{}, reminderRepo, petRepo, prefRepo, deliveryRepo, []service.NotificationSender{sender})
+
+	now := time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC)
+	due := func(id, petID string) *model.Reminder {
+		return &model.Reminder{ID: id, PetID: petID, Title: "疫苗接種", Status: model.ReminderStatusPending, RemindAt: now.Add(-time.Hour), DueDate: now}
+	}
+	reminders := []*model.Reminder{
+		due("rem-missing-pet", "pet-gone"),
+		due("rem-pref-error", "pet-2"),
+		due("rem-delivery-error", "pet-3"),
+		due("rem-ok", "pet-1"),
+	}
+	reminderRepo.EXPECT().FindDue(gomock.Any(), now).Return(reminders, nil)
+
+	storeFailure := errors.New("資料庫暫時無法使用")
+	petRepo.EXPECT().FindByID(gomock.Any(), "pet-gone").Return(nil, domain.ErrNotFound)
+	for _, id := range []string{"pet-1", "pet-2", "pet-3"} {
+		petRepo.EXPECT().FindByID(gomock.Any(), id).Return(&model.Pet{ID: id, OwnerID: "owner-" + id, Name: "Mochi"}, nil)
+	}
+	pref := &model.NotificationPreference{Channels: []model.NotificationChannelSetting{
+		{Channel: model.NotificationChannelEmail, Target: "owner@example.com", Enabled: true},
+	}}
+	prefRepo.EXPECT().FindByUserID(gomock.Any(), "owner-pet-2").Return(nil, storeFailure)
+	prefRepo.EXPECT().FindByUserID(gomock.Any(), "owner-pet-3").Return(pref, nil)
+	prefRepo.EXPECT().FindByUserID(gomock.Any(), "owner-pet-1").Return(pref, nil)
+
+	deliveryRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d *model.NotificationDelivery) error {
+		if d.UserID == "owner-pet-3" {
+			return storeFailure
+		}
+		return nil
+	}).Times(2)
+	deliveryRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+	sender.EXPECT().Send(gomock.Any(), "owner@example.com", gomock.Any()).Return(nil)
+
+	var updated []string
+	reminderRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *model.Reminder) error {
+		updated = append(updated, r.ID)
+		return nil
+	})
+
+	sent, err := handler.Handle(context.Background(), DispatchRemindersCommand{Now: now})
+	if sent != 1 {
+		t.Errorf("預期發送 1 筆，實際為 %d", sent)
+	}
+	if len(updated) != 1 || updated[0] != "rem-ok" {
+		t.Errorf("預期只有 rem-ok 標記為已發送，實際為 %v", updated)
+	}
+	if !domain.IsNotFound(err) || !errors.Is(err, storeFailure) {
+		t.Errorf("預期回傳各筆失敗的錯誤，實際為 %v", err)
+	}
+	for _, r := range reminders[:3] {
+		if r.Status != model.ReminderStatusPending {
+			t.Errorf("預期失敗的提醒 %s 維持待發送，實際為 %s", r.ID, r.Status)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GenerateRecurringExpensesCommand 表示依週期性費用的排程產生費用的命令
+type GenerateRecurringExpensesCommand struct {
+	Now time.Time
+}
+
+// GenerateRecurringExpensesHandler 為每個到期的期別建立具體的費用
+// 費用以 (recurring_expense_id, occurrence_date) 唯一索引去重，重複執行不會產生重複費用
+type GenerateRecurringExpensesHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	expenseRepo   repository.ExpenseRepository
+	petRepo       repository.PetRepository
+	audit         *behavior.AuditTrail
+	budgets       *behavior.BudgetTracker
+}
+
+// NewGenerateRecurringExpensesHandler 建立新的 GenerateRecurringExpensesHandler
+func NewGenerateRecurringExpensesHandler(
+	recurringRepo repository.RecurringExpenseRepository,
+	expenseRepo repository.ExpenseRepository,
+	petRepo repository.PetRepository,
+	audit *behavior.AuditTrail,
+	budgets *behavior.BudgetTracker,
+) *GenerateRecurringExpensesHandler {
+	if recurringRepo == nil || expenseRepo == nil || petRepo == nil || audit == nil || budgets == nil {
+		panic("recurringRepo, expenseRepo, petRepo, audit and budgets are required")
+	}
+	return &GenerateRecurringExpensesHandler{
+		recurringRepo: recurringRepo,
+		expenseRepo:   expenseRepo,
+		petRepo:       petRepo,
+		audit:         audit,
+		budgets:       budgets,
+	}
+}
+
+// Handle 執行產生費用的命令，回傳本次新建立的費用數量
+// 單一週期性費用處理失敗只記錄日誌，不影響其他週期性費用
+func (h *GenerateRecurringExpensesHandler) Handle(c context.Context, cmd GenerateRecurringExpensesCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	recurrings, err := h.recurringRepo.FindActive(ctx)
+	if err != nil {
+		ctx.Error("查找週期性費用失敗", "error", err)
+		return 0, fmt.Errorf("查找週期性費用失敗: %w", err)
+	}
+
+	created := 0
+	for _, recurring := range recurrings {
+		n, err := h.generate(ctx, recurring, now)
+		created += n
+		if err != nil {
+			ctx.Error("產生週期性費用失敗", "error", err, "recurring_expense_id", recurring.ID)
+		}
+	}
+
+	ctx.Info("完成週期性費用產生", "scanned", len(recurrings), "created", created)
+
+	return created, nil
+}
+
+func (h *GenerateRecurringExpensesHandler) generate(ctx *contextx.Contextx, recurring *model.RecurringExpense, now time.Time) (int, error) {
+	// 沒有到期的期別時不更新進度，避免每次排程都遞增版本而使用戶端的 If-Match 失效
+	occurrences := behavior.PendingRecurringOccurrences(recurring, now)
+	if len(occurrences) == 0 {
+		return 0, nil
+	}
+
+	pet, err := h.petRepo.FindByID(ctx, recurring.PetID)
+	if err != nil {
+		// 定義會隨寵物一同封存與清除，此處僅防範寵物已不存在的不一致資料
+		if domain.IsNotFound(err) {
+			ctx.Warn("週期性費用的寵物不存在，略過", "recurring_expense_id", recurring.ID, "pet_id", recurring.PetID)
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查找寵物失敗: %w", err)
+	}
+
+	created := 0
+	for _, occurrence := range occurrences {
+		expense := recurring.NewExpense(occurrence)
+		expense.CreatedAt = now
+		expense.UpdatedAt = now
+		if err := h.expenseRepo.Create(ctx, expense); err != nil {
+			// 上次執行已建立但未更新進度時，唯一索引會擋下重複的費用
+			if domain.IsDuplicateEntry(err) {
+				continue
+			}
+			return created, fmt.Errorf("建立費用失敗: %w", err)
+		}
+		h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityExpense, expense.PetID, expense.ID, nil, expense)
+		h.budgets.Check(ctx, pet, expense)
+		created++
+	}
+
+	recurring.GeneratedThrough = &now
+	if err := h.recurringRepo.Update(ctx, recurring); err != nil {
+		// 版本衝突代表使用者同時編輯，下次執行會依新的設定繼續產生
+		if domain.IsUpdateConflict(err) {
+			ctx.Warn("週期性費用已被更新，延後記錄產生進度", "recurring_expense_id", recurring.ID)
+			return created, nil
+		}
+		return created, fmt.Errorf("更新產生進度失敗: %w", err)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GenerateRemindersCommand 表示依醫療記錄到期日產生提醒的命令
+type GenerateRemindersCommand struct {
+	Now time.Time
+}
+
+// GenerateRemindersHandler 掃描即將到期的醫療記錄並建立對應的提醒
+type GenerateRemindersHandler struct {
+	medicalRecordRepo repository.MedicalRecordRepository
+	reminderRepo      repository.ReminderRepository
+	audit             *behavior.AuditTrail
+	leadDays          int
+}
+
+// NewGenerateRemindersHandler 建立新的 GenerateRemindersHandler
+func NewGenerateRemindersHandler(cfg config.Config, medicalRecordRepo repository.MedicalRecordRepository, reminderRepo repository.ReminderRepository, audit *behavior.AuditTrail) *GenerateRemindersHandler {
+	if medicalRecordRepo == nil || reminderRepo == nil || audit == nil {
+		panic("medicalRecordRepo, reminderRepo and audit are required")
+	}
+	return &GenerateRemindersHandler{
+		medicalRecordRepo: medicalRecordRepo,
+		reminderRepo:      reminderRepo,
+		audit:             audit,
+		leadDays:          cfg.Reminder.LeadDays,
+	}
+}
+
+// Handle 執行產生提醒的命令，回傳本次新建立的提醒數量
+func (h *GenerateRemindersHandler) Handle(c context.Context, cmd GenerateRemindersCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	// 往前多看一天，避免排程中斷時漏掉剛到期的記錄
+	from := now.Add(-24 * time.Hour)
+	to := now.AddDate(0, 0, h.leadDays)
+
+	records, err := h.medicalRecordRepo.FindUpcomingDue(ctx, from, to)
+	if err != nil {
+		ctx.Error("查找即將到期的醫療記錄失敗", "error", err)
+		return 0, fmt.Errorf("查找即將到期的醫療記錄失敗: %w", err)
+	}
+
+	created := 0
+	for _, record := range records {
+		if record.NextDueDate == nil {
+			continue
+		}
+
+		_, err := h.reminderRepo.FindByMedicalRecord(ctx, record.ID, *record.NextDueDate)
+		if err == nil {
+			continue
+		}
+		if !domain.IsNotFound(err) {
+			ctx.Error("查找既有提醒失敗", "error", err, "medical_record_id", record.ID)
+			return created, fmt.Errorf("查找既有提醒失敗: %w", err)
+		}
+
+		reminder := &model.Reminder{
+			PetID:           record.PetID,
+			MedicalRecordID: record.ID,
+			Type:            record.Type,
+			Title:           behavior.ReminderTitle(record),
+			DueDate:         *record.NextDueDate,
+			RemindAt:        behavior.DefaultRemindAt(*record.NextDueDate, h.leadDays),
+			Status:          model.ReminderStatusPending,
+		}
+
+		if err := h.reminderRepo.Create(ctx, reminder); err != nil {
+			// 多個排程同時執行時，唯一索引會擋下重複的提醒
+			if domain.IsDuplicateEntry(err) {
+				continue
+			}
+			ctx.Error("建立提醒失敗", "error", err, "medical_record_id", record.ID)
+			return created, fmt.Errorf("建立提醒失敗: %w", err)
+		}
+		h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityReminder, reminder.PetID, reminder.ID, nil, reminder)
+		created++
+	}
+
+	ctx.Info("完成提醒產生", "scanned", len(records), "created", created)
+
+	return created, nil
+}
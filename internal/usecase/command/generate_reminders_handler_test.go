@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGenerateRemindersHandler_Idempotent(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		existing    error
+		createErr   error
+		wantCreated int
+		wantCreate  bool
+	}{
+		{"尚無提醒時建立", domain.ErrNotFound, nil, 1, true},
+		{"已有同一到期日的提醒時略過", nil, nil, 0, false},
+		{"並行排程已建立時略過唯一索引衝突", domain.ErrNotFound, domain.ErrDuplicateEntry, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			medicalRepo := repository.NewMockMedicalRecordRepository(ctrl)
+			reminderRepo := repository.NewMockReminderRepository(ctrl)
+			auditRepo := repository.NewMockAuditRepository(ctrl)
+			cfg := config.Config{Reminder: config.ReminderConfig{LeadDays: 7}}
+			handler := NewGenerateRemindersHandler(cfg, medicalRepo, reminderRepo, behavior.NewAuditTrail(auditRepo))
+
+			medicalRepo.EXPECT().FindUpcomingDue(gomock.Any(), now.Add(-24*time.Hour), now.AddDate(0, 0, 7)).
+				Return([]*model.MedicalRecord{
+					{ID: "rec-1", PetID: "pet-1", Type: model.RecordTypeVaccination, NextDueDate: &due},
+					{ID: "rec-2", PetID: "pet-1", Type: model.RecordTypeVetVisit},
+				}, nil)
+			var existing *model.Reminder
+			if tt.existing == nil {
+				existing = &model.Reminder{ID: "rem-1", MedicalRecordID: "rec-1", DueDate: due}
+			}
+			reminderRepo.EXPECT().FindByMedicalRecord(gomock.Any(), "rec-1", due).Return(existing, tt.existing)
+			if tt.wantCreate {
+				reminderRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, r *model.Reminder) error {
+					if r.Status != model.ReminderStatusPending || !r.RemindAt.Equal(behavior.DefaultRemindAt(due, 7)) {
+						t.Errorf("提醒內容不正確: %+v", r)
+					}
+					return tt.createErr
+				})
+			}
+			auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			created, err := handler.Handle(context.Background(), GenerateRemindersCommand{Now: now})
+			if err != nil {
+				t.Fatalf("預期無錯誤，實際為 %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("預期建立 %d 筆，實際為 %d", tt.wantCreated, created)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ImportExchangeRatesCommand 表示匯入匯率的命令請求，同幣別同日期的匯率會被覆寫
+type ImportExchangeRatesCommand struct {
+	Rates []*model.ExchangeRate
+}
+
+// ImportExchangeRatesHandler 維護匯率表，可由管理者 API 或啟動時的本機檔案匯入
+type ImportExchangeRatesHandler struct {
+	rateRepo  repository.ExchangeRateRepository
+	adminIDs  []string
+	reference string
+}
+
+// NewImportExchangeRatesHandler 建立新的 ImportExchangeRatesHandler
+func NewImportExchangeRatesHandler(cfg config.Config, rateRepo repository.ExchangeRateRepository, currencies *behavior.CurrencyConverter) *ImportExchangeRatesHandler {
+	if rateRepo == nil || currencies == nil {
+		panic("rateRepo and currencies are required")
+	}
+	return &ImportExchangeRatesHandler{
+		rateRepo:  rateRepo,
+		adminIDs:  cfg.Admin.UserIDs,
+		reference: currencies.ReferenceCurrency(),
+	}
+}
+
+// Handle 由管理者匯入匯率，回傳匯入筆數
+func (h *ImportExchangeRatesHandler) Handle(c context.Context, cmd ImportExchangeRatesCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("user ID not found in context: %w", err)
+	}
+	if err := behavior.AuthorizeAdmin(h.adminIDs, userID); err != nil {
+		ctx.Warn("非管理者嘗試匯入匯率", "user_id", userID)
+		return 0, err
+	}
+
+	return h.save(ctx, cmd.Rates)
+}
+
+// LoadFile 自本機 CSV 檔匯入匯率，供服務啟動時載入
+func (h *ImportExchangeRatesHandler) LoadFile(c context.Context, path string) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("開啟匯率檔案失敗: %w", err)
+	}
+	defer file.Close()
+
+	rates, err := behavior.ParseExchangeRatesCSV(file)
+	if err != nil {
+		return 0, err
+	}
+
+	return h.save(ctx, rates)
+}
+
+func (h *ImportExchangeRatesHandler) save(ctx *contextx.Contextx, rates []*model.ExchangeRate) (int, error) {
+	if err := behavior.NormalizeExchangeRates(rates, h.reference); err != nil {
+		ctx.Warn("匯率驗證失敗", "error", err)
+		return 0, err
+	}
+
+	if err := h.rateRepo.Upsert(ctx, rates); err != nil {
+		ctx.Error("匯入匯率失敗", "error", err)
+		return 0, fmt.Errorf("匯入匯率失敗: %w", err)
+	}
+
+	ctx.Info("成功匯入匯率", "count", len(rates))
+	return len(rates), nil
+}
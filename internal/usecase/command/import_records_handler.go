@@ -0,0 +1,268 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ImportRecordsCommand 表示批次匯入健康日誌或費用的命令請求
+// - PetID: 預設寵物，檔案未對應 pet_id 欄位或欄位空白時使用；未指定時檔案必須包含 pet_id 欄位
+// - Mapping: 欄位對應（欄位 → 標題名稱），未指定的欄位以同名標題為來源
+// - DryRun: 僅驗證並回傳報告，不寫入任何資料
+type ImportRecordsCommand struct {
+	Entity  model.ImportEntity
+	Format  model.ImportFormat
+	PetID   string
+	Mapping map[string]string
+	DryRun  bool
+	Content io.Reader
+}
+
+// ImportRecordsHandler 處理健康日誌與費用的批次匯入，可由 API 或 import-records 工具呼叫
+// 每筆資料以去重鍵寫入，同一檔案重複匯入時已存在的資料會略過
+type ImportRecordsHandler struct {
+	healthLogRepo repository.HealthLogRepository
+	expenseRepo   repository.ExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+	currencies    *behavior.CurrencyConverter
+	maxRows       int
+}
+
+// NewImportRecordsHandler 建立新的 ImportRecordsHandler
+func NewImportRecordsHandler(
+	cfg config.Config,
+	healthLogRepo repository.HealthLogRepository,
+	expenseRepo repository.ExpenseRepository,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+	currencies *behavior.CurrencyConverter,
+) *ImportRecordsHandler {
+	if healthLogRepo == nil || expenseRepo == nil || guard == nil || audit == nil || currencies == nil {
+		panic("healthLogRepo, expenseRepo, guard, audit and currencies are required")
+	}
+	return &ImportRecordsHandler{
+		healthLogRepo: healthLogRepo,
+		expenseRepo:   expenseRepo,
+		guard:         guard,
+		audit:         audit,
+		currencies:    currencies,
+		maxRows:       cfg.Import.MaxRows,
+	}
+}
+
+// importCandidate 一列資料的匯入結果與驗證通過時待寫入的資料
+type importCandidate struct {
+	result *model.ImportRowResult
+	record *behavior.ImportRecord
+}
+
+// Handle 解析匯入檔並逐列驗證，非試算時寫入驗證通過且尚未匯入過的資料，回傳每列的結果報告
+// 檔案格式或欄位對應錯誤時整批拒絕；個別資料列的錯誤記錄於報告中，不影響其他資料列
+func (h *ImportRecordsHandler) Handle(c context.Context, cmd ImportRecordsCommand) (*model.ImportReport, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	if err := behavior.ValidateImportOptions(cmd.Entity, cmd.Format); err != nil {
+		ctx.Warn("匯入參數驗證失敗", "error", err)
+		return nil, err
+	}
+
+	// 明確指定的預設寵物無權限時整批拒絕，檔案中各列指定的寵物則逐列檢查
+	if cmd.PetID != "" {
+		if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+			ctx.Warn("寵物存取檢查失敗", "pet_id", cmd.PetID, "error", err)
+			return nil, err
+		}
+	}
+
+	table, err := behavior.ReadImportTable(cmd.Content, cmd.Format, h.maxRows)
+	if err != nil {
+		ctx.Warn("讀取匯入檔失敗", "error", err)
+		return nil, err
+	}
+	cols, err := behavior.ResolveImportColumns(cmd.Entity, table.Header, cmd.Mapping, cmd.PetID != "")
+	if err != nil {
+		ctx.Warn("匯入欄位對應失敗", "error", err, "header", table.Header)
+		return nil, err
+	}
+
+	ctx.Info("開始批次匯入", "user_id", userID, "entity", cmd.Entity, "format", cmd.Format, "rows", len(table.Rows), "dry_run", cmd.DryRun)
+
+	candidates := h.parse(ctx, cmd, cols, table)
+	if err := h.markExisting(ctx, cmd.Entity, candidates); err != nil {
+		return nil, err
+	}
+	if !cmd.DryRun {
+		if err := h.write(ctx, cmd.Entity, candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &model.ImportReport{Entity: cmd.Entity, Format: cmd.Format, DryRun: cmd.DryRun, Rows: make([]*model.ImportRowResult, 0, len(candidates))}
+	for _, candidate := range candidates {
+		report.Add(candidate.result)
+	}
+
+	ctx.Info("批次匯入完成", "entity", cmd.Entity, "dry_run", cmd.DryRun, "total", report.Total,
+		"created", report.Created, "valid", report.Valid, "duplicates", report.Duplicates, "invalid", report.Invalid, "failed", report.Failed)
+	return report, nil
+}
+
+// parse 逐列解析與驗證，並檢查寵物權限與檔案內重複的資料
+func (h *ImportRecordsHandler) parse(ctx *contextx.Contextx, cmd ImportRecordsCommand, cols behavior.ImportColumns, table *behavior.ImportTable) []*importCandidate {
+	access := make(map[string]error)
+	seen := make(map[string]int)
+	candidates := make([]*importCandidate, 0, len(table.Rows))
+
+	for _, row := range table.Rows {
+		candidate := &importCandidate{result: &model.ImportRowResult{Row: row.Line}}
+		candidates = append(candidates, candidate)
+
+		record, errs := behavior.ParseImportRow(cmd.Entity, cols, row, cmd.Format, cmd.PetID)
+		if len(errs) > 0 {
+			candidate.result.Status = model.ImportRowInvalid
+			candidate.result.Errors = errs
+			continue
+		}
+		candidate.result.PetID = record.PetID
+		candidate.result.DedupeKey = record.DedupeKey
+
+		err, checked := access[record.PetID]
+		if !checked {
+			_, err = h.guard.Authorize(ctx, record.PetID, model.PermissionWrite)
+			access[record.PetID] = err
+		}
+		if err != nil {
+			candidate.result.Status = model.ImportRowInvalid
+			candidate.result.Errors = []string{importAccessError(err)}
+			continue
+		}
+
+		if line, dup := seen[record.DedupeKey]; dup {
+			candidate.result.Status = model.ImportRowDuplicate
+			candidate.result.Errors = []string{fmt.Sprintf("與第 %d 列重複", line)}
+			continue
+		}
+		seen[record.DedupeKey] = row.Line
+
+		candidate.record = record
+		candidate.result.Status = model.ImportRowValid
+	}
+	return candidates
+}
+
+// markExisting 將先前已匯入過的資料標記為重複，並附上既有資料的 ID
+func (h *ImportRecordsHandler) markExisting(ctx *contextx.Contextx, entity model.ImportEntity, candidates []*importCandidate) error {
+	keysByPet := make(map[string][]string)
+	for _, candidate := range candidates {
+		if candidate.record != nil {
+			keysByPet[candidate.record.PetID] = append(keysByPet[candidate.record.PetID], candidate.record.DedupeKey)
+		}
+	}
+
+	existing := make(map[string]map[string]string, len(keysByPet))
+	for petID, keys := range keysByPet {
+		var (
+			found map[string]string
+			err   error
+		)
+		switch entity {
+		case model.ImportEntityHealthLogs:
+			found, err = h.healthLogRepo.FindImportKeys(ctx, petID, keys)
+		case model.ImportEntityExpenses:
+			found, err = h.expenseRepo.FindImportKeys(ctx, petID, keys)
+		}
+		if err != nil {
+			ctx.Error("查詢已匯入資料失敗", "error", err, "pet_id", petID)
+			return fmt.Errorf("查詢已匯入資料失敗: %w", err)
+		}
+		existing[petID] = found
+	}
+
+	for _, candidate := range candidates {
+		if candidate.record == nil {
+			continue
+		}
+		if id, ok := existing[candidate.record.PetID][candidate.record.DedupeKey]; ok {
+			candidate.result.Status = model.ImportRowDuplicate
+			candidate.result.ID = id
+			candidate.record = nil
+		}
+	}
+	return nil
+}
+
+// write 逐筆建立資料；並行匯入同一檔案時由唯一索引擋下的資料視為重複
+func (h *ImportRecordsHandler) write(ctx *contextx.Contextx, entity model.ImportEntity, candidates []*importCandidate) error {
+	var baseCurrency string
+	for _, candidate := range candidates {
+		record := candidate.record
+		if record == nil {
+			continue
+		}
+
+		var err error
+		switch entity {
+		case model.ImportEntityHealthLogs:
+			if err = h.healthLogRepo.Create(ctx, record.HealthLog); err == nil {
+				candidate.result.ID = record.HealthLog.ID
+				h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityHealthLog, record.PetID, record.HealthLog.ID, nil, record.HealthLog)
+			}
+		case model.ImportEntityExpenses:
+			exp := record.Expense
+			if exp.Currency == "" {
+				if baseCurrency == "" {
+					if baseCurrency, err = resolveCurrency(ctx, h.currencies, ""); err != nil {
+						return err
+					}
+				}
+				exp.Currency = baseCurrency
+			}
+			exp.CreatedAt = time.Now()
+			exp.UpdatedAt = exp.CreatedAt
+			// 匯入的多為歷史費用，不觸發預算門檻提醒
+			if err = h.expenseRepo.Create(ctx, exp); err == nil {
+				candidate.result.ID = exp.ID
+				h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityExpense, record.PetID, exp.ID, nil, exp)
+			}
+		}
+
+		switch {
+		case err == nil:
+			candidate.result.Status = model.ImportRowCreated
+		case errors.Is(err, domain.ErrDuplicateEntry):
+			candidate.result.Status = model.ImportRowDuplicate
+		default:
+			ctx.Error("匯入資料寫入失敗", "error", err, "row", candidate.result.Row)
+			candidate.result.Status = model.ImportRowFailed
+			candidate.result.Errors = []string{err.Error()}
+		}
+	}
+	return nil
+}
+
+// importAccessError 將寵物存取檢查的錯誤轉換為資料列的錯誤訊息
+func importAccessError(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrInvalidID):
+		return "pet_id: 寵物不存在"
+	case errors.Is(err, domain.ErrForbidden):
+		return "pet_id: 無權限寫入此寵物的資料"
+	}
+	return "pet_id: " + err.Error()
+}
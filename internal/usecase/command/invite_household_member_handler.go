@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// InviteHouseholdMemberCommand 表示邀請家庭成員的命令請求
+// - MemberExpiresAt: 接受邀請後成員資格的到期時間，sitter 必填
+type InviteHouseholdMemberCommand struct {
+	HouseholdID     string              `json:"household_id"`
+	Role            model.HouseholdRole `json:"role"`
+	MemberExpiresAt *time.Time          `json:"member_expires_at,omitempty"`
+}
+
+// InviteHouseholdMemberResult 邀請結果，明文 token 只在此回傳一次
+type InviteHouseholdMemberResult struct {
+	Token      string
+	Invitation model.HouseholdInvitation
+}
+
+// InviteHouseholdMemberHandler 處理邀請家庭成員的命令，僅 owner 可發出邀請
+type InviteHouseholdMemberHandler struct {
+	householdRepo repository.HouseholdRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewInviteHouseholdMemberHandler 建立新的 InviteHouseholdMemberHandler
+func NewInviteHouseholdMemberHandler(householdRepo repository.HouseholdRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *InviteHouseholdMemberHandler {
+	if householdRepo == nil || guard == nil || audit == nil {
+		panic("householdRepo, guard and audit are required")
+	}
+	return &InviteHouseholdMemberHandler{
+		householdRepo: householdRepo,
+		guard:         guard,
+		audit:         audit,
+	}
+}
+
+// Handle 執行邀請家庭成員的命令
+func (h *InviteHouseholdMemberHandler) Handle(c context.Context, cmd InviteHouseholdMemberCommand) (*InviteHouseholdMemberResult, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	household, err := h.guard.AuthorizeHousehold(ctx, cmd.HouseholdID, model.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	validator := &behavior.HouseholdValidator{}
+	if err := validator.ValidateMembership(cmd.Role, cmd.MemberExpiresAt, now); err != nil {
+		ctx.Warn("邀請驗證失敗", "error", err)
+		return nil, err
+	}
+
+	token, tokenHash, err := behavior.NewInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := model.HouseholdInvitation{
+		TokenHash:       tokenHash,
+		Role:            cmd.Role,
+		InvitedBy:       userID,
+		ExpiresAt:       now.Add(behavior.DefaultInvitationTTL),
+		MemberExpiresAt: cmd.MemberExpiresAt,
+		CreatedAt:       now,
+	}
+	before := household.Clone()
+	household.PruneExpiredInvitations(now)
+	household.Invitations = append(household.Invitations, invitation)
+
+	if err := h.householdRepo.Update(ctx, household); err != nil {
+		ctx.Error("儲存邀請失敗", "error", err, "household_id", household.ID)
+		return nil, fmt.Errorf("建立邀請失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityHousehold, "", household.ID, before, household)
+
+	ctx.Info("成功建立家庭邀請", "household_id", household.ID, "role", cmd.Role)
+	return &InviteHouseholdMemberResult{Token: token, Invitation: invitation}, nil
+}
@@ -0,0 +1,58 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// notifier 負責對單筆投遞紀錄執行一次發送，並記錄結果與下次重試時間
+type notifier struct {
+	senders      map[model.NotificationChannel]service.NotificationSender
+	deliveryRepo repository.NotificationDeliveryRepository
+	maxAttempts  int
+	baseDelay    time.Duration
+}
+
+func newNotifier(cfg config.Config, senders []service.NotificationSender, deliveryRepo repository.NotificationDeliveryRepository) *notifier {
+	n := &notifier{
+		senders:      make(map[model.NotificationChannel]service.NotificationSender, len(senders)),
+		deliveryRepo: deliveryRepo,
+		maxAttempts:  cfg.Notification.MaxAttempts,
+		baseDelay:    cfg.Notification.RetryBaseDelay,
+	}
+	if n.maxAttempts <= 0 {
+		n.maxAttempts = 1
+	}
+	for _, s := range senders {
+		n.senders[s.Channel()] = s
+	}
+	return n
+}
+
+// attempt 發送一次通知並更新投遞紀錄，回傳是否發送成功
+func (n *notifier) attempt(ctx *contextx.Contextx, delivery *model.NotificationDelivery, now time.Time) (bool, error) {
+	sender, ok := n.senders[delivery.Channel]
+	if !ok {
+		// 管道未設定時重試也不會成功，直接標記為放棄
+		delivery.MarkFailed(fmt.Errorf("通知管道 %s 未啟用", delivery.Channel), now, nil)
+	} else if err := sender.Send(ctx, delivery.Target, &delivery.Message); err != nil {
+		ctx.Warn("通知發送失敗", "error", err, "channel", delivery.Channel, "attempts", delivery.Attempts+1)
+		delivery.MarkFailed(err, now, behavior.NextRetryAt(now, delivery.Attempts+1, n.maxAttempts, n.baseDelay))
+	} else {
+		delivery.MarkSent(now)
+	}
+
+	if err := n.deliveryRepo.Update(ctx, delivery); err != nil {
+		ctx.Error("更新通知投遞紀錄失敗", "error", err, "delivery_id", delivery.ID)
+		return false, fmt.Errorf("更新通知投遞紀錄失敗: %w", err)
+	}
+
+	return delivery.Status == model.DeliveryStatusSent, nil
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// NotifyBudgetAlertHandler 訂閱預算門檻突破事件，依預算擁有者的通知偏好發送警示
+type NotifyBudgetAlertHandler struct {
+	petRepo      repository.PetRepository
+	prefRepo     repository.NotificationPreferenceRepository
+	deliveryRepo repository.NotificationDeliveryRepository
+	notifier     *notifier
+}
+
+// NewNotifyBudgetAlertHandler 建立新的 NotifyBudgetAlertHandler
+func NewNotifyBudgetAlertHandler(
+	cfg config.Config,
+	petRepo repository.PetRepository,
+	prefRepo repository.NotificationPreferenceRepository,
+	deliveryRepo repository.NotificationDeliveryRepository,
+	senders []service.NotificationSender,
+) *NotifyBudgetAlertHandler {
+	if petRepo == nil || prefRepo == nil || deliveryRepo == nil {
+		panic("petRepo, prefRepo and deliveryRepo are required")
+	}
+	return &NotifyBudgetAlertHandler{
+		petRepo:      petRepo,
+		prefRepo:     prefRepo,
+		deliveryRepo: deliveryRepo,
+		notifier:     newNotifier(cfg, senders, deliveryRepo),
+	}
+}
+
+// Handle 為預算警示建立各管道的投遞紀錄並嘗試第一次發送，失敗的投遞交由重試工作處理
+func (h *NotifyBudgetAlertHandler) Handle(c context.Context, evt model.BudgetThresholdCrossed) error {
+	ctx := contextx.WithContext(c)
+
+	pref, err := h.prefRepo.FindByUserID(ctx, evt.OwnerID)
+	if domain.IsNotFound(err) {
+		ctx.Info("使用者未設定通知偏好，略過預算警示", "user_id", evt.OwnerID, "budget_id", evt.BudgetID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("查找通知偏好失敗: %w", err)
+	}
+
+	msg := model.NotificationMessage{
+		Title:    fmt.Sprintf("%s已使用 %d%%", h.budgetLabel(ctx, evt), evt.ThresholdPct),
+		Body:     fmt.Sprintf("本期已花費 %d %s，預算 %d %s", evt.Spent, evt.Currency, evt.Limit, evt.Currency),
+		PetID:    evt.PetID,
+		BudgetID: evt.BudgetID,
+	}
+
+	now := time.Now()
+	for _, ch := range pref.EnabledChannels() {
+		delivery := &model.NotificationDelivery{
+			UserID:  evt.OwnerID,
+			Channel: ch.Channel,
+			Target:  ch.Target,
+			Message: msg,
+			Status:  model.DeliveryStatusPending,
+		}
+		if err := h.deliveryRepo.Create(ctx, delivery); err != nil {
+			return fmt.Errorf("建立通知投遞紀錄失敗: %w", err)
+		}
+		if _, err := h.notifier.attempt(ctx, delivery, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// budgetLabel 組出預算的顯示名稱，例如「Mochi 本月飼料預算」
+func (h *NotifyBudgetAlertHandler) budgetLabel(ctx *contextx.Contextx, evt model.BudgetThresholdCrossed) string {
+	label := "本月"
+	if evt.Period == model.BudgetPeriodYearly {
+		label = "今年"
+	}
+	if evt.Category != "" {
+		label += evt.Category
+	}
+	label += "預算"
+
+	if evt.PetID == "" {
+		return "所有寵物" + label
+	}
+	pet, err := h.petRepo.FindByID(ctx, evt.PetID)
+	if err != nil {
+		ctx.Warn("查找預算寵物失敗", "error", err, "pet_id", evt.PetID)
+		return label
+	}
+	return pet.Name + " " + label
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// NotifyExportReadyHandler 訂閱背景匯出完成事件，依使用者的通知偏好發送下載通知
+type NotifyExportReadyHandler struct {
+	prefRepo     repository.NotificationPreferenceRepository
+	deliveryRepo repository.NotificationDeliveryRepository
+	notifier     *notifier
+	baseURL      string
+	retention    time.Duration
+}
+
+// NewNotifyExportReadyHandler 建立新的 NotifyExportReadyHandler
+func NewNotifyExportReadyHandler(
+	cfg config.Config,
+	prefRepo repository.NotificationPreferenceRepository,
+	deliveryRepo repository.NotificationDeliveryRepository,
+	senders []service.NotificationSender,
+) *NotifyExportReadyHandler {
+	if prefRepo == nil || deliveryRepo == nil {
+		panic("prefRepo and deliveryRepo are required")
+	}
+	return &NotifyExportReadyHandler{
+		prefRepo:     prefRepo,
+		deliveryRepo: deliveryRepo,
+		notifier:     newNotifier(cfg, senders, deliveryRepo),
+		baseURL:      strings.TrimRight(cfg.Storage.PublicBaseURL, "/"),
+		retention:    cfg.Export.Retention,
+	}
+}
+
+// Handle 為匯出結果建立各管道的投遞紀錄並嘗試第一次發送，失敗的投遞交由重試工作處理
+func (h *NotifyExportReadyHandler) Handle(c context.Context, evt model.ExportCompleted) error {
+	ctx := contextx.WithContext(c)
+
+	pref, err := h.prefRepo.FindByUserID(ctx, evt.OwnerID)
+	if domain.IsNotFound(err) {
+		ctx.Info("使用者未設定通知偏好，略過匯出通知", "user_id", evt.OwnerID, "export_id", evt.ExportID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("查找通知偏好失敗: %w", err)
+	}
+
+	msg := model.NotificationMessage{
+		Title:    "資料匯出完成",
+		Body:     fmt.Sprintf("匯出檔已可下載，保留 %d 天：%s/api/v1/exports/%s/download", int(h.retention.Hours()/24), h.baseURL, evt.ExportID),
+		ExportID: evt.ExportID,
+	}
+	if evt.Status != model.ExportStatusCompleted {
+		msg.Title = "資料匯出失敗"
+		msg.Body = "產生匯出檔時發生錯誤，請稍後重新匯出"
+	}
+
+	now := time.Now()
+	for _, ch := range pref.EnabledChannels() {
+		delivery := &model.NotificationDelivery{
+			UserID:  evt.OwnerID,
+			Channel: ch.Channel,
+			Target:  ch.Target,
+			Message: msg,
+			Status:  model.DeliveryStatusPending,
+		}
+		if err := h.deliveryRepo.Create(ctx, delivery); err != nil {
+			return fmt.Errorf("建立通知投遞紀錄失敗: %w", err)
+		}
+		if _, err := h.notifier.attempt(ctx, delivery, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// PauseRecurringExpenseCommand 封裝暫停或恢復週期性費用的請求參數
+type PauseRecurringExpenseCommand struct {
+	ID     string
+	Paused bool
+
+	// ExpectedVersion 為客戶端最後取得的版本（If-Match），nil 表示不檢查
+	ExpectedVersion *int
+}
+
+// PauseRecurringExpenseHandler 處理暫停與恢復週期性費用的業務邏輯
+type PauseRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewPauseRecurringExpenseHandler 建立新的 handler 實例
+func NewPauseRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *PauseRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil || audit == nil {
+		panic("recurringRepo, guard and audit are required")
+	}
+	return &PauseRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行暫停或恢復的流程；恢復時不補產生暫停期間的費用
+func (h *PauseRecurringExpenseHandler) Handle(c context.Context, cmd PauseRecurringExpenseCommand) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	recurring, err := findWritableRecurringExpense(ctx, h.recurringRepo, h.guard, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Paused == cmd.Paused {
+		return recurring, nil
+	}
+
+	before := *recurring
+	recurring.Paused = cmd.Paused
+	if !cmd.Paused {
+		now := time.Now()
+		if recurring.GeneratedThrough == nil || recurring.GeneratedThrough.Before(now) {
+			recurring.GeneratedThrough = &now
+		}
+	}
+
+	return recurring, saveRecurringExpense(ctx, h.recurringRepo, h.audit, &before, recurring)
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// maxExportsPerTick 每次排程最多處理的匯出工作數，其餘留待下一次排程
+const maxExportsPerTick = 5
+
+// ProcessExportJobsCommand 表示處理背景匯出工作的命令請求
+type ProcessExportJobsCommand struct {
+	Now time.Time
+}
+
+// ProcessExportJobsHandler 由排程器觸發，產生待處理的匯出檔並刪除保留期限已過的匯出檔
+type ProcessExportJobsHandler struct {
+	jobRepo    repository.ExportJobRepository
+	exporter   *behavior.DataExporter
+	store      service.BlobStore
+	publisher  service.EventPublisher
+	staleAfter time.Duration
+}
+
+// NewProcessExportJobsHandler 建立新的 ProcessExportJobsHandler
+func NewProcessExportJobsHandler(
+	cfg config.Config,
+	jobRepo repository.ExportJobRepository,
+	exporter *behavior.DataExporter,
+	store service.BlobStore,
+	publisher service.EventPublisher,
+) *ProcessExportJobsHandler {
+	if jobRepo == nil || exporter == nil || store == nil || publisher == nil {
+		panic("jobRepo, exporter, store and publisher are required")
+	}
+	return &ProcessExportJobsHandler{
+		jobRepo:    jobRepo,
+		exporter:   exporter,
+		store:      store,
+		publisher:  publisher,
+		staleAfter: cfg.Export.StaleAfter,
+	}
+}
+
+// Handle 依序領取並執行匯出工作，完成後發布事件通知使用者，回傳處理的工作數
+func (h *ProcessExportJobsHandler) Handle(c context.Context, cmd ProcessExportJobsCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	processed := 0
+	for processed < maxExportsPerTick {
+		job, err := h.jobRepo.ClaimNext(ctx, cmd.Now, cmd.Now.Add(-h.staleAfter))
+		if domain.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return processed, fmt.Errorf("領取匯出工作失敗: %w", err)
+		}
+		processed++
+
+		// 單一工作失敗已記錄於工作狀態，不影響其他工作
+		_ = h.exporter.Run(ctx, job, cmd.Now)
+
+		evt := model.ExportCompleted{
+			ExportID: job.ID,
+			OwnerID:  job.OwnerID,
+			Format:   job.Format,
+			Status:   job.Status,
+			At:       time.Now(),
+		}
+		if err := h.publisher.Publish(ctx, evt); err != nil {
+			ctx.Warn("發布匯出完成事件失敗", "error", err, "export_id", job.ID)
+		}
+	}
+
+	if err := h.purgeExpired(ctx, cmd.Now); err != nil {
+		return processed, err
+	}
+	return processed, nil
+}
+
+// purgeExpired 刪除保留期限已過的匯出檔與工作
+func (h *ProcessExportJobsHandler) purgeExpired(ctx *contextx.Contextx, now time.Time) error {
+	jobs, err := h.jobRepo.FindExpired(ctx, now)
+	if err != nil {
+		return fmt.Errorf("查詢逾期匯出工作失敗: %w", err)
+	}
+
+	for _, job := range jobs {
+		if job.StorageKey != "" {
+			if err := h.store.Delete(ctx, job.StorageKey); err != nil {
+				ctx.Error("刪除逾期匯出檔失敗", "error", err, "export_id", job.ID)
+				continue
+			}
+		}
+		if err := h.jobRepo.Delete(ctx, job.ID); err != nil && !domain.IsNotFound(err) {
+			ctx.Error("刪除逾期匯出工作失敗", "error", err, "export_id", job.ID)
+		}
+	}
+	if len(jobs) > 0 {
+		ctx.Info("已刪除逾期匯出檔", "count", len(jobs))
+	}
+	return nil
+}
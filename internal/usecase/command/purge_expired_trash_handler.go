@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// PurgeExpiredTrashCommand 表示清除垃圾桶中逾期項目的命令請求
+type PurgeExpiredTrashCommand struct {
+	Now time.Time
+}
+
+// PurgeExpiredTrashHandler 永久清除超過保留期限的封存寵物（連同關聯紀錄）與個別刪除的紀錄
+type PurgeExpiredTrashHandler struct {
+	archiveRepo repository.PetArchiveRepository
+	trashRepo   repository.TrashRepository
+	store       service.BlobStore
+	avatars     *behavior.PetAvatarStore
+	retention   time.Duration
+}
+
+// NewPurgeExpiredTrashHandler 建立新的 PurgeExpiredTrashHandler
+func NewPurgeExpiredTrashHandler(
+	cfg config.Config,
+	archiveRepo repository.PetArchiveRepository,
+	trashRepo repository.TrashRepository,
+	store service.BlobStore,
+	avatars *behavior.PetAvatarStore,
+) *PurgeExpiredTrashHandler {
+	if archiveRepo == nil || trashRepo == nil || store == nil || avatars == nil {
+		panic("archiveRepo, trashRepo, store and avatars are required")
+	}
+	return &PurgeExpiredTrashHandler{
+		archiveRepo: archiveRepo,
+		trashRepo:   trashRepo,
+		store:       store,
+		avatars:     avatars,
+		retention:   cfg.Trash.Retention,
+	}
+}
+
+// Handle 執行清除，回傳成功清除的項目數量；單一寵物清除失敗不影響其他項目
+func (h *PurgeExpiredTrashHandler) Handle(c context.Context, cmd PurgeExpiredTrashCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+	cutoff := cmd.Now.Add(-h.retention)
+
+	pets, err := h.archiveRepo.FindArchivedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("查詢逾期封存寵物失敗: %w", err)
+	}
+
+	purged := 0
+	var errs []error
+	for _, pet := range pets {
+		attachments, err := h.archiveRepo.Purge(ctx, pet.ID)
+		if err != nil {
+			ctx.Error("清除封存寵物失敗", "error", err, "pet_id", pet.ID)
+			errs = append(errs, fmt.Errorf("清除寵物 %s 失敗: %w", pet.ID, err))
+			continue
+		}
+		behavior.RemoveAttachmentFiles(ctx, h.store, attachments)
+		h.avatars.Remove(ctx, pet.Avatar)
+		purged++
+	}
+
+	records, attachments, err := h.trashRepo.PurgeDeletedBefore(ctx, cutoff)
+	behavior.RemoveAttachmentFiles(ctx, h.store, attachments)
+	purged += records
+	if err != nil {
+		ctx.Error("清除逾期紀錄失敗", "error", err)
+		errs = append(errs, fmt.Errorf("清除逾期紀錄失敗: %w", err))
+	}
+
+	return purged, errors.Join(errs...)
+}
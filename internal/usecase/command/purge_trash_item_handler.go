@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// PurgeTrashItemCommand 表示永久清除垃圾桶項目的命令請求
+type PurgeTrashItemCommand struct {
+	Type model.TrashItemType
+	ID   string
+}
+
+// PurgeTrashItemHandler 處理永久清除垃圾桶項目的命令，權限要求與還原相同
+type PurgeTrashItemHandler struct {
+	archiveRepo repository.PetArchiveRepository
+	trashRepo   repository.TrashRepository
+	store       service.BlobStore
+	avatars     *behavior.PetAvatarStore
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+}
+
+// NewPurgeTrashItemHandler 建立新的 PurgeTrashItemHandler
+func NewPurgeTrashItemHandler(
+	archiveRepo repository.PetArchiveRepository,
+	trashRepo repository.TrashRepository,
+	store service.BlobStore,
+	avatars *behavior.PetAvatarStore,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *PurgeTrashItemHandler {
+	if archiveRepo == nil || trashRepo == nil || store == nil || avatars == nil || guard == nil || audit == nil {
+		panic("archiveRepo, trashRepo, store, avatars, guard and audit are required")
+	}
+	return &PurgeTrashItemHandler{
+		archiveRepo: archiveRepo,
+		trashRepo:   trashRepo,
+		store:       store,
+		avatars:     avatars,
+		guard:       guard,
+		audit:       audit,
+	}
+}
+
+// Handle 執行永久清除垃圾桶項目的命令
+func (h *PurgeTrashItemHandler) Handle(c context.Context, cmd PurgeTrashItemCommand) error {
+	ctx := contextx.WithContext(c)
+
+	if err := behavior.ValidateTrashItemType(cmd.Type, false); err != nil {
+		return err
+	}
+
+	if cmd.Type == model.TrashItemPet {
+		pet, err := h.archiveRepo.FindArchivedByID(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("查找封存寵物失敗: %w", err)
+		}
+		if err := h.guard.AuthorizePet(ctx, pet, model.PermissionManage); err != nil {
+			return err
+		}
+		attachments, err := h.archiveRepo.Purge(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("永久清除寵物失敗: %w", err)
+		}
+		// 資料清除完成後才刪除附件與頭像檔案
+		behavior.RemoveAttachmentFiles(ctx, h.store, attachments)
+		h.avatars.Remove(ctx, pet.Avatar)
+
+		h.audit.Record(ctx, model.AuditActionPurge, model.AuditEntityPet, pet.ID, pet.ID, pet, nil)
+
+		ctx.Info("成功永久清除垃圾桶中的寵物", "pet_id", cmd.ID)
+		return nil
+	}
+
+	item, err := h.trashRepo.FindByID(ctx, cmd.Type, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("查找垃圾桶紀錄失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, item.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+
+	attachments, err := h.trashRepo.Purge(ctx, cmd.Type, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("永久清除紀錄失敗: %w", err)
+	}
+	behavior.RemoveAttachmentFiles(ctx, h.store, attachments)
+
+	h.audit.Record(ctx, model.AuditActionPurge, model.AuditEntityType(cmd.Type), item.PetID, cmd.ID, nil, nil)
+
+	ctx.Info("成功永久清除垃圾桶紀錄", "type", cmd.Type, "id", cmd.ID, "pet_id", item.PetID)
+	return nil
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RecordDoseCommand 封裝照護者勾選單次給藥的請求參數
+// - Status: taken 或 skipped，重複勾選同一次給藥時覆寫前一次的紀錄
+// - TakenAt: 實際給藥時間，未指定時以排定時間記錄
+type RecordDoseCommand struct {
+	RegimenID   string
+	ScheduledAt time.Time
+	Status      model.DoseStatus
+	TakenAt     *time.Time
+	Note        string
+}
+
+// RecordDoseHandler 處理照護者勾選給藥的業務邏輯
+type RecordDoseHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	doseRepo    repository.DoseEventRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+}
+
+// NewRecordDoseHandler 建立新的 handler 實例
+func NewRecordDoseHandler(regimenRepo repository.MedicationRegimenRepository, doseRepo repository.DoseEventRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *RecordDoseHandler {
+	if regimenRepo == nil || doseRepo == nil || guard == nil || audit == nil {
+		panic("regimenRepo, doseRepo, guard and audit are required")
+	}
+	return &RecordDoseHandler{regimenRepo: regimenRepo, doseRepo: doseRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行勾選給藥的流程
+func (h *RecordDoseHandler) Handle(c context.Context, cmd RecordDoseCommand) (*model.DoseEvent, error) {
+	ctx := contextx.WithContext(c)
+
+	regimen, err := findWritableMedicationRegimen(ctx, h.regimenRepo, h.guard, cmd.RegimenID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	event := &model.DoseEvent{
+		ScheduledAt: cmd.ScheduledAt,
+		Status:      cmd.Status,
+		TakenAt:     cmd.TakenAt,
+		RecordedBy:  userID,
+		Note:        cmd.Note,
+	}
+	if err := behavior.ValidateDoseEvent(regimen, event); err != nil {
+		ctx.Warn("給藥紀錄驗證失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, err
+	}
+
+	if err := h.doseRepo.Upsert(ctx, event); err != nil {
+		ctx.Error("儲存給藥紀錄失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, fmt.Errorf("儲存給藥紀錄失敗: %w", err)
+	}
+
+	action := model.AuditActionUpdate
+	if event.CreatedAt != nil && event.UpdatedAt != nil && event.CreatedAt.Equal(*event.UpdatedAt) {
+		action = model.AuditActionCreate
+	}
+	h.audit.Record(ctx, action, model.AuditEntityDoseEvent, event.PetID, event.ID, nil, event)
+
+	ctx.Info("成功勾選給藥", "regimen_id", regimen.ID, "scheduled_at", event.ScheduledAt, "status", event.Status)
+	return event, nil
+}
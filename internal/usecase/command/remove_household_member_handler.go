@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RemoveHouseholdMemberCommand 表示移除家庭成員的命令請求
+type RemoveHouseholdMemberCommand struct {
+	HouseholdID string `json:"household_id"`
+	UserID      string `json:"user_id"`
+}
+
+// RemoveHouseholdMemberHandler 處理移除家庭成員的命令
+// owner 可移除任何成員，一般成員只能移除自己（退出家庭）
+type RemoveHouseholdMemberHandler struct {
+	householdRepo repository.HouseholdRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewRemoveHouseholdMemberHandler 建立新的 RemoveHouseholdMemberHandler
+func NewRemoveHouseholdMemberHandler(householdRepo repository.HouseholdRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *RemoveHouseholdMemberHandler {
+	if householdRepo == nil || guard == nil || audit == nil {
+		panic("householdRepo, guard and audit are required")
+	}
+	return &RemoveHouseholdMemberHandler{
+		householdRepo: householdRepo,
+		guard:         guard,
+		audit:         audit,
+	}
+}
+
+// Handle 執行移除家庭成員的命令
+func (h *RemoveHouseholdMemberHandler) Handle(c context.Context, cmd RemoveHouseholdMemberCommand) error {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	perm := model.PermissionManage
+	if cmd.UserID == userID {
+		perm = model.PermissionRead
+	}
+
+	household, err := h.guard.AuthorizeHousehold(ctx, cmd.HouseholdID, perm)
+	if err != nil {
+		return err
+	}
+
+	before := household.Clone()
+	if !household.RemoveMember(cmd.UserID) {
+		return fmt.Errorf("%w: user %s is not a member of household %s", domain.ErrNotFound, cmd.UserID, cmd.HouseholdID)
+	}
+
+	validator := &behavior.HouseholdValidator{}
+	if err := validator.ValidateOwners(household, time.Now()); err != nil {
+		return err
+	}
+
+	if err := h.householdRepo.Update(ctx, household); err != nil {
+		ctx.Error("移除家庭成員失敗", "error", err, "household_id", household.ID)
+		return fmt.Errorf("移除家庭成員失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityHousehold, "", household.ID, before, household)
+
+	ctx.Info("成功移除家庭成員", "household_id", household.ID, "member_id", cmd.UserID)
+	return nil
+}
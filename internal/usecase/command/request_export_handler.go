@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RequestExportCommand 表示匯出使用者資料的命令請求
+type RequestExportCommand struct {
+	Format model.ExportFormat
+}
+
+// RequestExportHandler 處理資料匯出請求：資料量小時直接產生匯出檔，否則建立待處理工作交由排程器於背景產生
+type RequestExportHandler struct {
+	jobRepo     repository.ExportJobRepository
+	exporter    *behavior.DataExporter
+	inlineLimit int
+}
+
+// NewRequestExportHandler 建立新的 RequestExportHandler
+func NewRequestExportHandler(cfg config.Config, jobRepo repository.ExportJobRepository, exporter *behavior.DataExporter) *RequestExportHandler {
+	if jobRepo == nil || exporter == nil {
+		panic("jobRepo and exporter are required")
+	}
+	return &RequestExportHandler{
+		jobRepo:     jobRepo,
+		exporter:    exporter,
+		inlineLimit: cfg.Export.InlineLimit,
+	}
+}
+
+// Handle 執行資料匯出請求，回傳的工作狀態為 completed 或 pending
+func (h *RequestExportHandler) Handle(c context.Context, cmd RequestExportCommand) (*model.ExportJob, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+	if err := behavior.ValidateExportFormat(cmd.Format); err != nil {
+		return nil, err
+	}
+
+	count, err := h.exporter.CountRecords(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	inline := count <= h.inlineLimit
+
+	now := time.Now()
+	job := &model.ExportJob{OwnerID: userID, Format: cmd.Format, Status: model.ExportStatusPending}
+	if inline {
+		// 以執行中狀態建立，避免排程器同時領取
+		job.Status = model.ExportStatusRunning
+		job.StartedAt = &now
+	}
+	if err := h.jobRepo.Create(ctx, job); err != nil {
+		return nil, fmt.Errorf("建立匯出工作失敗: %w", err)
+	}
+
+	if !inline {
+		ctx.Info("資料量較大，匯出交由背景處理", "export_id", job.ID, "records", count)
+		return job, nil
+	}
+
+	if err := h.exporter.Run(ctx, job, now); err != nil {
+		return nil, err
+	}
+
+	ctx.Info("成功匯出資料", "export_id", job.ID, "format", job.Format, "records", count)
+	return job, nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RestorePetCommand represents the request for restoring an archived pet.
+type RestorePetCommand struct {
+	ID string
+}
+
+// RestorePetHandler handles restoring an archived pet together with its records.
+type RestorePetHandler struct {
+	archiveRepo   repository.PetArchiveRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+	restoreWindow time.Duration
+}
+
+// NewRestorePetHandler creates a new RestorePetHandler.
+func NewRestorePetHandler(cfg config.Config, archiveRepo repository.PetArchiveRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *RestorePetHandler {
+	if archiveRepo == nil || guard == nil || audit == nil {
+		panic("archiveRepo, guard and audit are required")
+	}
+	return &RestorePetHandler{
+		archiveRepo:   archiveRepo,
+		guard:         guard,
+		audit:         audit,
+		restoreWindow: cfg.Trash.Retention,
+	}
+}
+
+// Handle executes the restore pet command.
+func (h *RestorePetHandler) Handle(c context.Context, cmd RestorePetCommand) (*model.Pet, error) {
+	ctx := contextx.WithContext(c)
+
+	pet, err := h.archiveRepo.FindArchivedByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archived pet with id %s: %w", cmd.ID, err)
+	}
+
+	if err := h.guard.AuthorizePet(ctx, pet, model.PermissionManage); err != nil {
+		return nil, fmt.Errorf("failed to restore pet with id %s: %w", cmd.ID, err)
+	}
+
+	if err := behavior.ValidatePetRestore(pet, h.restoreWindow, time.Now()); err != nil {
+		ctx.Warn("pet restore rejected", "error", err, "pet_id", cmd.ID)
+		return nil, err
+	}
+
+	if err := h.archiveRepo.Restore(ctx, pet); err != nil {
+		ctx.Error("failed to restore pet", "error", err)
+		return nil, fmt.Errorf("failed to restore pet: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionRestore, model.AuditEntityPet, pet.ID, pet.ID, nil, pet)
+
+	ctx.Info("pet restored successfully", "pet_id", pet.ID)
+
+	return pet, nil
+}
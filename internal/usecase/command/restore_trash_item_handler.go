@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RestoreTrashItemCommand 表示從垃圾桶還原項目的命令請求
+type RestoreTrashItemCommand struct {
+	Type model.TrashItemType
+	ID   string
+}
+
+// RestoreTrashItemHandler 處理從垃圾桶還原項目的命令
+// 寵物需具備管理權限並連同一同封存的紀錄還原；紀錄需具備寫入權限，且所屬寵物須未被刪除
+type RestoreTrashItemHandler struct {
+	archiveRepo repository.PetArchiveRepository
+	trashRepo   repository.TrashRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+	retention   time.Duration
+}
+
+// NewRestoreTrashItemHandler 建立新的 RestoreTrashItemHandler
+func NewRestoreTrashItemHandler(
+	cfg config.Config,
+	archiveRepo repository.PetArchiveRepository,
+	trashRepo repository.TrashRepository,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *RestoreTrashItemHandler {
+	if archiveRepo == nil || trashRepo == nil || guard == nil || audit == nil {
+		panic("archiveRepo, trashRepo, guard and audit are required")
+	}
+	return &RestoreTrashItemHandler{
+		archiveRepo: archiveRepo,
+		trashRepo:   trashRepo,
+		guard:       guard,
+		audit:       audit,
+		retention:   cfg.Trash.Retention,
+	}
+}
+
+// Handle 執行還原垃圾桶項目的命令
+func (h *RestoreTrashItemHandler) Handle(c context.Context, cmd RestoreTrashItemCommand) error {
+	ctx := contextx.WithContext(c)
+
+	if err := behavior.ValidateTrashItemType(cmd.Type, false); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if cmd.Type == model.TrashItemPet {
+		pet, err := h.archiveRepo.FindArchivedByID(ctx, cmd.ID)
+		if err != nil {
+			return fmt.Errorf("查找封存寵物失敗: %w", err)
+		}
+		if err := h.guard.AuthorizePet(ctx, pet, model.PermissionManage); err != nil {
+			return err
+		}
+		if err := behavior.ValidatePetRestore(pet, h.retention, now); err != nil {
+			ctx.Warn("拒絕還原寵物", "error", err, "pet_id", cmd.ID)
+			return err
+		}
+		if err := h.archiveRepo.Restore(ctx, pet); err != nil {
+			return fmt.Errorf("還原寵物失敗: %w", err)
+		}
+
+		h.audit.Record(ctx, model.AuditActionRestore, model.AuditEntityPet, pet.ID, pet.ID, nil, pet)
+
+		ctx.Info("成功從垃圾桶還原寵物", "pet_id", cmd.ID)
+		return nil
+	}
+
+	item, err := h.trashRepo.FindByID(ctx, cmd.Type, cmd.ID)
+	if err != nil {
+		return fmt.Errorf("查找垃圾桶紀錄失敗: %w", err)
+	}
+
+	// 所屬寵物已被刪除時查無寵物，須先還原寵物
+	if _, err := h.guard.Authorize(ctx, item.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+
+	if err := behavior.ValidateTrashRestore(item, h.retention, now); err != nil {
+		ctx.Warn("拒絕還原紀錄", "error", err, "type", cmd.Type, "id", cmd.ID)
+		return err
+	}
+
+	if err := h.trashRepo.Restore(ctx, cmd.Type, cmd.ID); err != nil {
+		return fmt.Errorf("還原紀錄失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionRestore, model.AuditEntityType(cmd.Type), item.PetID, cmd.ID, nil, nil)
+
+	ctx.Info("成功從垃圾桶還原紀錄", "type", cmd.Type, "id", cmd.ID, "pet_id", item.PetID)
+	return nil
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RetryNotificationsCommand 表示重送失敗通知的命令
+type RetryNotificationsCommand struct {
+	Now time.Time
+}
+
+// RetryNotificationsHandler 重送已到重試時間的失敗通知
+type RetryNotificationsHandler struct {
+	deliveryRepo repository.NotificationDeliveryRepository
+	notifier     *notifier
+}
+
+// NewRetryNotificationsHandler 建立新的 RetryNotificationsHandler
+func NewRetryNotificationsHandler(cfg config.Config, deliveryRepo repository.NotificationDeliveryRepository, senders []service.NotificationSender) *RetryNotificationsHandler {
+	if deliveryRepo == nil {
+		panic("deliveryRepo is required")
+	}
+	return &RetryNotificationsHandler{
+		deliveryRepo: deliveryRepo,
+		notifier:     newNotifier(cfg, senders, deliveryRepo),
+	}
+}
+
+// Handle 執行重送命令，回傳本次重送成功的數量
+func (h *RetryNotificationsHandler) Handle(c context.Context, cmd RetryNotificationsCommand) (int, error) {
+	ctx := contextx.WithContext(c)
+
+	now := cmd.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	deliveries, err := h.deliveryRepo.FindRetryable(ctx, now)
+	if err != nil {
+		ctx.Error("查找待重試的通知失敗", "error", err)
+		return 0, fmt.Errorf("查找待重試的通知失敗: %w", err)
+	}
+
+	succeeded := 0
+	for _, delivery := range deliveries {
+		ok, err := h.notifier.attempt(ctx, delivery, now)
+		if err != nil {
+			return succeeded, err
+		}
+		if ok {
+			succeeded++
+		}
+	}
+
+	return succeeded, nil
+}
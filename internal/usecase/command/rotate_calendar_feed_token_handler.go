@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// RotateCalendarFeedTokenCommand 產生或重新產生行事曆訂閱 token 的命令
+type RotateCalendarFeedTokenCommand struct{}
+
+// RotateCalendarFeedTokenResult 產生結果，明文 token 只在此回傳一次
+type RotateCalendarFeedTokenResult struct {
+	Token string
+	Feed  *model.CalendarFeed
+}
+
+// RotateCalendarFeedTokenHandler 為目前使用者產生新的行事曆訂閱 token，舊 token 的訂閱隨即失效
+type RotateCalendarFeedTokenHandler struct {
+	feedRepo repository.CalendarFeedRepository
+}
+
+// NewRotateCalendarFeedTokenHandler 建立新的 RotateCalendarFeedTokenHandler
+func NewRotateCalendarFeedTokenHandler(feedRepo repository.CalendarFeedRepository) *RotateCalendarFeedTokenHandler {
+	if feedRepo == nil {
+		panic("feedRepo is required")
+	}
+	return &RotateCalendarFeedTokenHandler{feedRepo: feedRepo}
+}
+
+// Handle 執行產生行事曆訂閱 token 的命令
+func (h *RotateCalendarFeedTokenHandler) Handle(c context.Context, _ RotateCalendarFeedTokenCommand) (*RotateCalendarFeedTokenResult, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	token, tokenHash, err := behavior.NewCalendarFeedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &model.CalendarFeed{
+		UserID:    userID,
+		TokenHash: tokenHash,
+		RotatedAt: time.Now(),
+	}
+	if err := h.feedRepo.Upsert(ctx, feed); err != nil {
+		ctx.Error("儲存行事曆訂閱失敗", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("產生行事曆訂閱 token 失敗: %w", err)
+	}
+
+	ctx.Info("成功產生行事曆訂閱 token", "user_id", userID)
+	return &RotateCalendarFeedTokenResult{Token: token, Feed: feed}, nil
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// SkipRecurringExpenseCommand 封裝略過週期性費用單次期別的請求參數
+type SkipRecurringExpenseCommand struct {
+	ID   string
+	Date time.Time
+
+	// ExpectedVersion 為客戶端最後取得的版本（If-Match），nil 表示不檢查
+	ExpectedVersion *int
+}
+
+// SkipRecurringExpenseHandler 處理略過單次期別的業務邏輯
+type SkipRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewSkipRecurringExpenseHandler 建立新的 handler 實例
+func NewSkipRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *SkipRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil || audit == nil {
+		panic("recurringRepo, guard and audit are required")
+	}
+	return &SkipRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行略過單次期別的流程，只能略過尚未產生費用的期別
+func (h *SkipRecurringExpenseHandler) Handle(c context.Context, cmd SkipRecurringExpenseCommand) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	recurring, err := findWritableRecurringExpense(ctx, h.recurringRepo, h.guard, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *recurring
+	before.SkippedDates = append([]time.Time(nil), recurring.SkippedDates...)
+	if err := behavior.SkipRecurringOccurrence(recurring, cmd.Date); err != nil {
+		ctx.Warn("略過期別驗證失敗", "error", err, "recurring_expense_id", recurring.ID)
+		return nil, err
+	}
+
+	return recurring, saveRecurringExpense(ctx, h.recurringRepo, h.audit, &before, recurring)
+}
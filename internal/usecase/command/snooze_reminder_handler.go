@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// SnoozeReminderCommand 表示延後提醒的命令請求
+// Until 與 Minutes 擇一填寫，Until 優先
+type SnoozeReminderCommand struct {
+	ID      string     `json:"id"`
+	Until   *time.Time `json:"until,omitempty"`
+	Minutes int        `json:"minutes,omitempty"`
+}
+
+// SnoozeReminderHandler 處理延後提醒的命令
+type SnoozeReminderHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewSnoozeReminderHandler 建立新的 SnoozeReminderHandler
+func NewSnoozeReminderHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *SnoozeReminderHandler {
+	if reminderRepo == nil || guard == nil || audit == nil {
+		panic("reminderRepo, guard and audit are required")
+	}
+	return &SnoozeReminderHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行延後提醒的命令
+func (h *SnoozeReminderHandler) Handle(c context.Context, cmd SnoozeReminderCommand) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理延後提醒請求", "user_id", userID, "reminder_id", cmd.ID)
+
+	reminder, err := h.reminderRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找提醒失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, reminder.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	before := *reminder
+
+	now := time.Now()
+	var until time.Time
+	switch {
+	case cmd.Until != nil:
+		until = *cmd.Until
+	case cmd.Minutes > 0:
+		until = now.Add(time.Duration(cmd.Minutes) * time.Minute)
+	default:
+		return nil, fmt.Errorf("%w: 必須指定延後時間", domain.ErrInvalidParameter)
+	}
+
+	if err := reminder.Snooze(until, now); err != nil {
+		ctx.Warn("提醒無法延後", "reminder_id", cmd.ID, "status", reminder.Status, "until", until)
+		return nil, fmt.Errorf("延後提醒失敗: %w", err)
+	}
+
+	if err := h.reminderRepo.Update(ctx, reminder); err != nil {
+		ctx.Error("在倉儲中更新提醒失敗", "error", err, "reminder_id", cmd.ID)
+		return nil, fmt.Errorf("延後提醒失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityReminder, reminder.PetID, reminder.ID, &before, reminder)
+
+	ctx.Info("成功延後提醒", "reminder_id", reminder.ID, "snoozed_until", until)
+
+	return reminder, nil
+}
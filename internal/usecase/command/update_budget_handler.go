@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateBudgetCommand 封裝更新預算的請求參數
+type UpdateBudgetCommand struct {
+	ID         string
+	PetID      string
+	Category   string
+	Period     model.BudgetPeriod
+	Limit      int
+	Thresholds []int
+
+	// ExpectedVersion 為客戶端最後取得的版本（If-Match），nil 表示不檢查
+	ExpectedVersion *int
+}
+
+// UpdateBudgetHandler 處理更新預算的業務邏輯
+type UpdateBudgetHandler struct {
+	budgetRepo repository.BudgetRepository
+	guard      *behavior.PetAccessGuard
+	audit      *behavior.AuditTrail
+}
+
+// NewUpdateBudgetHandler 建立新的 handler 實例
+func NewUpdateBudgetHandler(budgetRepo repository.BudgetRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *UpdateBudgetHandler {
+	if budgetRepo == nil || guard == nil || audit == nil {
+		panic("budgetRepo, guard and audit are required")
+	}
+	return &UpdateBudgetHandler{budgetRepo: budgetRepo, guard: guard, audit: audit}
+}
+
+// Handle 執行更新預算的流程
+func (h *UpdateBudgetHandler) Handle(c context.Context, cmd UpdateBudgetCommand) (*model.Budget, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	budget, err := h.budgetRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找預算失敗: %w", err)
+	}
+	if err := behavior.AuthorizeBudget(budget, userID); err != nil {
+		return nil, err
+	}
+	if err := behavior.ValidateExpectedVersion(cmd.ExpectedVersion, budget.Version); err != nil {
+		return nil, err
+	}
+
+	if cmd.PetID != "" && cmd.PetID != budget.PetID {
+		if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionRead); err != nil {
+			return nil, err
+		}
+	}
+
+	before := *budget
+
+	// 範圍或上限改變後重新計算警示，讓新的設定可以再次通知
+	if cmd.PetID != budget.PetID || cmd.Category != budget.Category || cmd.Period != budget.Period || cmd.Limit != budget.Limit {
+		budget.AlertedPeriodStart = nil
+		budget.AlertedPct = 0
+	}
+
+	budget.PetID = cmd.PetID
+	budget.Category = cmd.Category
+	budget.Period = cmd.Period
+	budget.Limit = cmd.Limit
+	budget.Thresholds = cmd.Thresholds
+	if err := behavior.NormalizeBudget(budget); err != nil {
+		ctx.Warn("預算驗證失敗", "error", err, "budget_id", budget.ID)
+		return nil, err
+	}
+
+	if err := h.budgetRepo.Update(ctx, budget); err != nil {
+		ctx.Error("更新預算失敗", "error", err, "budget_id", budget.ID)
+		return nil, fmt.Errorf("更新預算失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityBudget, budget.PetID, budget.ID, &before, budget)
+
+	ctx.Info("成功更新預算", "budget_id", budget.ID, "version", budget.Version)
+	return budget, nil
+}
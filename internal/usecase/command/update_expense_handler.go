@@ -18,19 +18,29 @@ type UpdateExpenseCommand struct {
 	Amount      int       `json:"amount"`
 	Description string    `json:"description,omitempty"`
 	Date        time.Time `json:"date"`
+
+	// Currency 為 ISO 4217 幣別代碼，未指定時維持原本的幣別
+	Currency string `json:"currency,omitempty"`
+
+	// ExpectedVersion 用戶端最後取得的版本（If-Match），nil 時不檢查
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdateExpenseHandler 處理更新費用的業務邏輯
 type UpdateExpenseHandler struct {
 	expenseRepo repository.ExpenseRepository
+	guard       *behavior.PetAccessGuard
+	audit       *behavior.AuditTrail
+	budgets     *behavior.BudgetTracker
+	currencies  *behavior.CurrencyConverter
 }
 
 // NewUpdateExpenseHandler 建立新的 handler 實例
-func NewUpdateExpenseHandler(expenseRepo repository.ExpenseRepository) *UpdateExpenseHandler {
-	if expenseRepo == nil {
-		panic("expenseRepo is required")
+func NewUpdateExpenseHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail, budgets *behavior.BudgetTracker, currencies *behavior.CurrencyConverter) *UpdateExpenseHandler {
+	if expenseRepo == nil || guard == nil || audit == nil || budgets == nil || currencies == nil {
+		panic("expenseRepo, guard, audit, budgets and currencies are required")
 	}
-	return &UpdateExpenseHandler{expenseRepo: expenseRepo}
+	return &UpdateExpenseHandler{expenseRepo: expenseRepo, guard: guard, audit: audit, budgets: budgets, currencies: currencies}
 }
 
 // Handle 執行更新費用的流程
@@ -50,6 +60,13 @@ func (h *UpdateExpenseHandler) Handle(c context.Context, cmd UpdateExpenseComman
 		ctx.Warn("費用驗證失敗", "error", err, "expense_id", exp.ID)
 		return nil, fmt.Errorf("費用驗證失敗: %w", err)
 	}
+	if cmd.Currency != "" {
+		currency, err := resolveCurrency(ctx, h.currencies, cmd.Currency)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Currency = currency
+	}
 
 	// 取得原始資料，僅允許更新部分欄位，category 不可變更
 	existing, err := h.expenseRepo.FindByID(ctx, cmd.ID)
@@ -58,10 +75,30 @@ func (h *UpdateExpenseHandler) Handle(c context.Context, cmd UpdateExpenseComman
 		return nil, fmt.Errorf("expense not found: %w", err)
 	}
 
+	// 原本與新指定的寵物都必須屬於目前使用者
+	pet, err := h.guard.Authorize(ctx, existing.PetID, model.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.PetID != existing.PetID {
+		if pet, err = h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	// 通過權限檢查後才比對版本，避免無權存取的使用者得知資料是否存在
+	if err := behavior.ValidateExpectedVersion(cmd.ExpectedVersion, existing.Version); err != nil {
+		return nil, err
+	}
+
+	before := *existing
 	existing.PetID = cmd.PetID
 	existing.Amount = cmd.Amount
 	existing.Description = cmd.Description
 	existing.Date = cmd.Date
+	if cmd.Currency != "" {
+		existing.Currency = cmd.Currency
+	}
 	existing.UpdatedAt = time.Now()
 	// existing.Category 不可變更
 
@@ -70,6 +107,9 @@ func (h *UpdateExpenseHandler) Handle(c context.Context, cmd UpdateExpenseComman
 		return nil, fmt.Errorf("failed to update expense: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityExpense, existing.PetID, existing.ID, &before, existing)
+	h.budgets.Check(ctx, pet, existing)
+
 	ctx.Info("expense updated successfully", "id", existing.ID)
 	return existing, nil
 }
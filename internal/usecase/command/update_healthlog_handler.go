@@ -7,6 +7,7 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -16,23 +17,31 @@ type UpdateHealthLogCommand struct {
 	PetID          string    `json:"pet_id"`
 	Date           time.Time `json:"date"`
 	WeightKg       float64   `json:"weight_kg,omitempty"`
-	FoodGram       int       `json:"food_gram,omitempty"`
+	FoodGram       *int      `json:"food_gram,omitempty"`
 	LitterNotes    string    `json:"litter_notes,omitempty"`
 	BehaviourNotes string    `json:"behaviour_notes,omitempty"`
+	model.HealthObservations
+
+	// ExpectedVersion 用戶端最後取得的版本（If-Match），nil 時不檢查
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdateHealthLogHandler 處理更新健康日誌的命令
 type UpdateHealthLogHandler struct {
 	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
 }
 
 // NewUpdateHealthLogHandler 建立新的 UpdateHealthLogHandler
-func NewUpdateHealthLogHandler(healthLogRepo repository.HealthLogRepository) *UpdateHealthLogHandler {
-	if healthLogRepo == nil {
-		panic("healthLogRepo is required")
+func NewUpdateHealthLogHandler(healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *UpdateHealthLogHandler {
+	if healthLogRepo == nil || guard == nil || audit == nil {
+		panic("healthLogRepo, guard and audit are required")
 	}
 	return &UpdateHealthLogHandler{
 		healthLogRepo: healthLogRepo,
+		guard:         guard,
+		audit:         audit,
 	}
 }
 
@@ -47,25 +56,45 @@ func (h *UpdateHealthLogHandler) Handle(c context.Context, cmd UpdateHealthLogCo
 
 	ctx.Info("處理更新健康日誌請求", "user_id", userID, "log_id", cmd.ID)
 
+	validator := &behavior.HealthLogValidator{}
+	if err := validator.ValidateObservations(cmd.HealthObservations); err != nil {
+		ctx.Warn("健康日誌觀察驗證失敗", "error", err, "log_id", cmd.ID)
+		return nil, err
+	}
+
 	// 首先檢查健康日誌是否存在
-	_, err = h.healthLogRepo.FindByID(ctx, cmd.ID)
+	existingLog, err := h.healthLogRepo.FindByID(ctx, cmd.ID)
 	if err != nil {
 		ctx.Error("查找要更新的健康日誌失敗", "error", err, "log_id", cmd.ID)
 		return nil, fmt.Errorf("查找健康日誌失敗: %w", err)
 	}
 
-	// TODO: 這裡可能需要新增驗證邏輯，確保使用者擁有該寵物的健康日誌存取權限
-	// 這需要透過 PetRepository 來驗證寵物的擁有者。目前為了簡化先跳過此驗證
+	// 確認使用者可寫入原本與新指定的寵物，避免將日誌移到無權存取的寵物下
+	if _, err := h.guard.Authorize(ctx, existingLog.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	if cmd.PetID != existingLog.PetID {
+		if _, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite); err != nil {
+			return nil, err
+		}
+	}
+
+	// 通過權限檢查後才比對版本，避免無權存取的使用者得知資料是否存在
+	if err := behavior.ValidateExpectedVersion(cmd.ExpectedVersion, existingLog.Version); err != nil {
+		return nil, err
+	}
 
 	// 更新健康日誌資料
 	updatedLog := &model.HealthLog{
-		ID:             cmd.ID,
-		PetID:          cmd.PetID,
-		Date:           cmd.Date,
-		WeightKg:       cmd.WeightKg,
-		FoodGram:       cmd.FoodGram,
-		LitterNotes:    cmd.LitterNotes,
-		BehaviourNotes: cmd.BehaviourNotes,
+		ID:                 cmd.ID,
+		PetID:              cmd.PetID,
+		Date:               cmd.Date,
+		WeightKg:           cmd.WeightKg,
+		FoodGram:           cmd.FoodGram,
+		LitterNotes:        cmd.LitterNotes,
+		BehaviourNotes:     cmd.BehaviourNotes,
+		HealthObservations: cmd.HealthObservations,
+		Version:            existingLog.Version,
 	}
 
 	if err := h.healthLogRepo.Update(ctx, updatedLog); err != nil {
@@ -73,7 +102,9 @@ func (h *UpdateHealthLogHandler) Handle(c context.Context, cmd UpdateHealthLogCo
 		return nil, fmt.Errorf("更新健康日誌失敗: %w", err)
 	}
 
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityHealthLog, updatedLog.PetID, updatedLog.ID, existingLog, updatedLog)
+
 	ctx.Info("成功更新健康日誌", "log_id", updatedLog.ID, "pet_id", updatedLog.PetID)
 
 	return updatedLog, nil
-}
\ No newline at end of file
+}
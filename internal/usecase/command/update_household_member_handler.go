@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateHouseholdMemberCommand 表示調整家庭成員角色的命令請求
+type UpdateHouseholdMemberCommand struct {
+	HouseholdID string              `json:"household_id"`
+	UserID      string              `json:"user_id"`
+	Role        model.HouseholdRole `json:"role"`
+	ExpiresAt   *time.Time          `json:"expires_at,omitempty"`
+}
+
+// UpdateHouseholdMemberHandler 處理調整家庭成員角色與到期日的命令，僅 owner 可操作
+type UpdateHouseholdMemberHandler struct {
+	householdRepo repository.HouseholdRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+}
+
+// NewUpdateHouseholdMemberHandler 建立新的 UpdateHouseholdMemberHandler
+func NewUpdateHouseholdMemberHandler(householdRepo repository.HouseholdRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *UpdateHouseholdMemberHandler {
+	if householdRepo == nil || guard == nil || audit == nil {
+		panic("householdRepo, guard and audit are required")
+	}
+	return &UpdateHouseholdMemberHandler{
+		householdRepo: householdRepo,
+		guard:         guard,
+		audit:         audit,
+	}
+}
+
+// Handle 執行調整家庭成員的命令
+func (h *UpdateHouseholdMemberHandler) Handle(c context.Context, cmd UpdateHouseholdMemberCommand) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+
+	household, err := h.guard.AuthorizeHousehold(ctx, cmd.HouseholdID, model.PermissionManage)
+	if err != nil {
+		return nil, err
+	}
+
+	member := household.Member(cmd.UserID)
+	if member == nil {
+		return nil, fmt.Errorf("%w: user %s is not a member of household %s", domain.ErrNotFound, cmd.UserID, cmd.HouseholdID)
+	}
+
+	now := time.Now()
+	validator := &behavior.HouseholdValidator{}
+	if err := validator.ValidateMembership(cmd.Role, cmd.ExpiresAt, now); err != nil {
+		ctx.Warn("成員驗證失敗", "error", err)
+		return nil, err
+	}
+
+	before := household.Clone()
+	member.Role = cmd.Role
+	member.ExpiresAt = cmd.ExpiresAt
+	if err := validator.ValidateOwners(household, now); err != nil {
+		return nil, err
+	}
+
+	if err := h.householdRepo.Update(ctx, household); err != nil {
+		ctx.Error("更新家庭成員失敗", "error", err, "household_id", household.ID)
+		return nil, fmt.Errorf("更新家庭成員失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityHousehold, "", household.ID, before, household)
+
+	ctx.Info("成功更新家庭成員", "household_id", household.ID, "member_id", cmd.UserID, "role", cmd.Role)
+	return household, nil
+}
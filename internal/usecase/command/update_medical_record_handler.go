@@ -5,19 +5,44 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // UpdateMedicalRecordHandler 負責更新醫療記錄
 type UpdateMedicalRecordHandler struct {
-	repo repository.MedicalRecordRepository
+	repo  repository.MedicalRecordRepository
+	guard *behavior.PetAccessGuard
+	audit *behavior.AuditTrail
 }
 
 // NewUpdateMedicalRecordHandler 建立 handler 實例
-func NewUpdateMedicalRecordHandler(repo repository.MedicalRecordRepository) *UpdateMedicalRecordHandler {
-	return &UpdateMedicalRecordHandler{repo: repo}
+func NewUpdateMedicalRecordHandler(repo repository.MedicalRecordRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *UpdateMedicalRecordHandler {
+	return &UpdateMedicalRecordHandler{repo: repo, guard: guard, audit: audit}
 }
 
 // Handle 執行更新醫療記錄邏輯
-func (h *UpdateMedicalRecordHandler) Handle(c context.Context, record *model.MedicalRecord) error {
-	return h.repo.Update(c, record)
+// 原本與新指定的寵物都必須屬於目前使用者；expectedVersion 為用戶端最後取得的版本（If-Match），nil 時不檢查
+func (h *UpdateMedicalRecordHandler) Handle(c context.Context, record *model.MedicalRecord, expectedVersion *int) error {
+	existing, err := h.repo.FindByID(c, record.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := h.guard.Authorize(c, existing.PetID, model.PermissionWrite); err != nil {
+		return err
+	}
+	if record.PetID != existing.PetID {
+		if _, err := h.guard.Authorize(c, record.PetID, model.PermissionWrite); err != nil {
+			return err
+		}
+	}
+	// 通過權限檢查後才比對版本，避免無權存取的使用者得知資料是否存在
+	if err := behavior.ValidateExpectedVersion(expectedVersion, existing.Version); err != nil {
+		return err
+	}
+	record.Version = existing.Version
+	if err := h.repo.Update(c, record); err != nil {
+		return err
+	}
+	h.audit.Record(c, model.AuditActionUpdate, model.AuditEntityMedicalRecord, record.PetID, record.ID, existing, record)
+	return nil
 }
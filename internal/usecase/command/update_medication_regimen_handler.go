@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateMedicationRegimenCommand 封裝編輯用藥療程的請求參數，所屬寵物不可變更
+type UpdateMedicationRegimenCommand struct {
+	ID              string
+	Drug            string
+	Dose            float64
+	Unit            string
+	Schedule        model.DoseSchedule
+	Timezone        string
+	StartDate       time.Time
+	EndDate         *time.Time
+	HospitalID      string
+	MedicalRecordID string
+	Notes           string
+
+	// ExpectedVersion 為客戶端最後取得的版本（If-Match），nil 表示不檢查
+	ExpectedVersion *int
+}
+
+// UpdateMedicationRegimenHandler 處理編輯用藥療程的業務邏輯
+// 已勾選的給藥紀錄維持原樣，不在新排程中的紀錄仍會列在給藥紀錄中
+type UpdateMedicationRegimenHandler struct {
+	regimenRepo  repository.MedicationRegimenRepository
+	hospitalRepo repository.HospitalRepository
+	recordRepo   repository.MedicalRecordRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewUpdateMedicationRegimenHandler 建立新的 handler 實例
+func NewUpdateMedicationRegimenHandler(
+	regimenRepo repository.MedicationRegimenRepository,
+	hospitalRepo repository.HospitalRepository,
+	recordRepo repository.MedicalRecordRepository,
+	guard *behavior.PetAccessGuard,
+	audit *behavior.AuditTrail,
+) *UpdateMedicationRegimenHandler {
+	if regimenRepo == nil || hospitalRepo == nil || recordRepo == nil || guard == nil || audit == nil {
+		panic("regimenRepo, hospitalRepo, recordRepo, guard and audit are required")
+	}
+	return &UpdateMedicationRegimenHandler{
+		regimenRepo:  regimenRepo,
+		hospitalRepo: hospitalRepo,
+		recordRepo:   recordRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行編輯用藥療程的流程
+func (h *UpdateMedicationRegimenHandler) Handle(c context.Context, cmd UpdateMedicationRegimenCommand) (*model.MedicationRegimen, error) {
+	ctx := contextx.WithContext(c)
+
+	regimen, err := findWritableMedicationRegimen(ctx, h.regimenRepo, h.guard, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *regimen
+	regimen.Drug = cmd.Drug
+	regimen.Dose = cmd.Dose
+	regimen.Unit = cmd.Unit
+	regimen.Schedule = cmd.Schedule
+	regimen.Timezone = cmd.Timezone
+	regimen.StartDate = cmd.StartDate
+	regimen.EndDate = cmd.EndDate
+	regimen.HospitalID = cmd.HospitalID
+	regimen.MedicalRecordID = cmd.MedicalRecordID
+	regimen.Notes = cmd.Notes
+	if err := behavior.NormalizeMedicationRegimen(regimen); err != nil {
+		ctx.Warn("用藥療程驗證失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, err
+	}
+	if err := validateRegimenReferences(ctx, h.hospitalRepo, h.recordRepo, regimen); err != nil {
+		return nil, err
+	}
+
+	if err := h.regimenRepo.Update(ctx, regimen); err != nil {
+		ctx.Error("更新用藥療程失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, fmt.Errorf("更新用藥療程失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityMedicationRegimen, regimen.PetID, regimen.ID, &before, regimen)
+
+	ctx.Info("成功更新用藥療程", "regimen_id", regimen.ID, "version", regimen.Version)
+	return regimen, nil
+}
+
+// findWritableMedicationRegimen 查找用藥療程，確認使用者可編輯其寵物並比對預期版本
+func findWritableMedicationRegimen(
+	c context.Context,
+	regimenRepo repository.MedicationRegimenRepository,
+	guard *behavior.PetAccessGuard,
+	id string,
+	expectedVersion *int,
+) (*model.MedicationRegimen, error) {
+	regimen, err := regimenRepo.FindByID(c, id)
+	if err != nil {
+		return nil, fmt.Errorf("查找用藥療程失敗: %w", err)
+	}
+	if _, err := guard.Authorize(c, regimen.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	if err := behavior.ValidateExpectedVersion(expectedVersion, regimen.Version); err != nil {
+		return nil, err
+	}
+	return regimen, nil
+}
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateNotificationPreferenceCommand 表示更新通知偏好的命令請求
+type UpdateNotificationPreferenceCommand struct {
+	Channels []model.NotificationChannelSetting `json:"channels"`
+}
+
+// UpdateNotificationPreferenceHandler 處理更新通知偏好的命令
+type UpdateNotificationPreferenceHandler struct {
+	prefRepo repository.NotificationPreferenceRepository
+}
+
+// NewUpdateNotificationPreferenceHandler 建立新的 UpdateNotificationPreferenceHandler
+func NewUpdateNotificationPreferenceHandler(prefRepo repository.NotificationPreferenceRepository) *UpdateNotificationPreferenceHandler {
+	if prefRepo == nil {
+		panic("prefRepo is required")
+	}
+	return &UpdateNotificationPreferenceHandler{prefRepo: prefRepo}
+}
+
+// Handle 執行更新通知偏好的命令
+func (h *UpdateNotificationPreferenceHandler) Handle(c context.Context, cmd UpdateNotificationPreferenceCommand) (*model.NotificationPreference, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	pref := &model.NotificationPreference{
+		UserID:   userID,
+		Channels: cmd.Channels,
+	}
+
+	validator := &behavior.NotificationPreferenceValidator{}
+	if err := validator.Validate(pref); err != nil {
+		ctx.Warn("通知偏好驗證失敗", "error", err)
+		return nil, err
+	}
+
+	if err := h.prefRepo.Upsert(ctx, pref); err != nil {
+		return nil, fmt.Errorf("更新通知偏好失敗: %w", err)
+	}
+
+	return pref, nil
+}
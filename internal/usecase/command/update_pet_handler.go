@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
 	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
@@ -18,43 +19,84 @@ type UpdatePetCommand struct {
 	DOB         time.Time `json:"dob"`
 	Breed       string    `json:"breed"`
 	MicrochipID string    `json:"microchip_id"`
+
+	// HouseholdID moves the pet to another household; nil keeps the current one and "" removes it.
+	HouseholdID *string `json:"household_id"`
+
+	// WeightAlert overrides the default weight change thresholds; nil keeps the stored thresholds
+	// and an empty object clears them so the defaults apply again.
+	WeightAlert *model.WeightAlertThreshold `json:"weight_alert"`
+
+	// ExpectedVersion is the version the client last saw (If-Match); nil skips the check.
+	ExpectedVersion *int `json:"-"`
 }
 
 // UpdatePetHandler handles the pet update command.
 type UpdatePetHandler struct {
 	petRepo repository.PetRepository
+	guard   *behavior.PetAccessGuard
+	avatars *behavior.PetAvatarStore
+	audit   *behavior.AuditTrail
 }
 
 // NewUpdatePetHandler creates a new UpdatePetHandler.
-func NewUpdatePetHandler(petRepo repository.PetRepository) *UpdatePetHandler {
-	if petRepo == nil {
-		panic("petRepo is required")
+func NewUpdatePetHandler(
+	petRepo repository.PetRepository,
+	guard *behavior.PetAccessGuard,
+	avatars *behavior.PetAvatarStore,
+	audit *behavior.AuditTrail,
+) *UpdatePetHandler {
+	if petRepo == nil || guard == nil || avatars == nil || audit == nil {
+		panic("petRepo, guard, avatars and audit are required")
 	}
 	return &UpdatePetHandler{
 		petRepo: petRepo,
+		guard:   guard,
+		avatars: avatars,
+		audit:   audit,
 	}
 }
 
 // Handle executes the update pet command.
-func (h *UpdatePetHandler) Handle(c context.Context, cmd UpdatePetCommand) error {
+func (h *UpdatePetHandler) Handle(c context.Context, cmd UpdatePetCommand) (*model.Pet, error) {
 	ctx := contextx.WithContext(c)
 
 	userID, err := contextx.GetUserID(ctx)
 	if err != nil {
-		return fmt.Errorf("user ID not found in context: %w", err)
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
 	}
 
 	ctx.Info("handling update pet request", "user_id", userID, "pet_id", cmd.ID)
 
-	// Fetch existing pet
-	pet, err := h.petRepo.FindByID(ctx, cmd.ID)
+	// Fetch existing pet and check access through ownership or household membership
+	pet, err := h.guard.Authorize(ctx, cmd.ID, model.PermissionWrite)
 	if err != nil {
-		return fmt.Errorf("failed to find pet with id %s: %w", cmd.ID, err)
+		return nil, fmt.Errorf("failed to update pet with id %s: %w", cmd.ID, err)
 	}
 
-	// Authorization check
-	if pet.OwnerID != userID {
-		return fmt.Errorf("user %s is not authorized to update pet %s", userID, cmd.ID)
+	if err := behavior.ValidateExpectedVersion(cmd.ExpectedVersion, pet.Version); err != nil {
+		return nil, err
+	}
+
+	// Moving a pet between households requires manage permission on both sides
+	if cmd.HouseholdID != nil && *cmd.HouseholdID != pet.HouseholdID {
+		if _, err := h.guard.Authorize(ctx, cmd.ID, model.PermissionManage); err != nil {
+			return nil, fmt.Errorf("failed to change household of pet %s: %w", cmd.ID, err)
+		}
+		if *cmd.HouseholdID != "" {
+			if _, err := h.guard.AuthorizeHousehold(ctx, *cmd.HouseholdID, model.PermissionWrite); err != nil {
+				return nil, fmt.Errorf("failed to change household of pet %s: %w", cmd.ID, err)
+			}
+		}
+	}
+
+	before := *pet
+
+	// Setting a different AvatarURL replaces the uploaded avatar, whose files are then removed
+	var replaced *model.PetAvatar
+	if pet.Avatar != nil && cmd.AvatarURL != pet.AvatarURL {
+		replaced = pet.Avatar
+		pet.Avatar = nil
 	}
 
 	// Update fields
@@ -63,17 +105,33 @@ func (h *UpdatePetHandler) Handle(c context.Context, cmd UpdatePetCommand) error
 	pet.DOB = cmd.DOB
 	pet.Breed = cmd.Breed
 	pet.MicrochipID = cmd.MicrochipID
+	if cmd.HouseholdID != nil {
+		pet.HouseholdID = *cmd.HouseholdID
+	}
+	if cmd.WeightAlert != nil {
+		pet.WeightAlert = cmd.WeightAlert
+		if *cmd.WeightAlert == (model.WeightAlertThreshold{}) {
+			pet.WeightAlert = nil
+		}
+	}
 
 	if err := behavior.ValidatePet(pet); err != nil {
-		return fmt.Errorf("pet validation failed: %w", err)
+		return nil, fmt.Errorf("pet validation failed: %w", err)
+	}
+	if err := behavior.ValidateWeightAlertThreshold(pet.WeightAlert); err != nil {
+		return nil, err
 	}
 
 	if err := h.petRepo.Update(ctx, pet); err != nil {
 		ctx.Error("failed to update pet in repository", "error", err)
-		return fmt.Errorf("failed to update pet: %w", err)
+		return nil, fmt.Errorf("failed to update pet: %w", err)
 	}
 
-	ctx.Info("pet updated successfully", "pet_id", pet.ID, "user_id", userID)
+	h.avatars.Remove(ctx, replaced)
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityPet, pet.ID, pet.ID, &before, pet)
+
+	ctx.Info("pet updated successfully", "pet_id", pet.ID, "user_id", userID, "version", pet.Version)
 
-	return nil
+	return pet, nil
 }
@@ -0,0 +1,136 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdatePetHandler_Household(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	householdRepo := repository.NewMockHouseholdRepository(ctrl)
+	auditRepo := repository.NewMockAuditRepository(ctrl)
+	handler := NewUpdatePetHandler(
+		petRepo,
+		behavior.NewPetAccessGuard(petRepo, householdRepo),
+		behavior.NewPetAvatarStore(config.Config{}, service.NewMockBlobStore(ctrl)),
+		behavior.NewAuditTrail(auditRepo),
+	)
+
+	dob := time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)
+	petRepo.EXPECT().FindByID(gomock.Any(), "pet-1").DoAndReturn(func(context.Context, string) (*model.Pet, error) {
+		return &model.Pet{ID: "pet-1", OwnerID: "owner", Name: "Mochi", DOB: dob, HouseholdID: "hh-1", Version: 1}, nil
+	}).AnyTimes()
+	householdRepo.EXPECT().FindByID(gomock.Any(), "hh-1").
+		Return(&model.Household{ID: "hh-1", Members: []model.HouseholdMember{
+			{UserID: "owner", Role: model.HouseholdRoleOwner},
+			{UserID: "partner", Role: model.HouseholdRoleEditor},
+		}}, nil).AnyTimes()
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	for _, userID := range []string{"owner", "partner"} {
+		t.Run(userID+" 未指定 household_id 時保留原本的家庭", func(t *testing.T) {
+			petRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, pet *model.Pet) error {
+				if pet.HouseholdID != "hh-1" {
+					t.Errorf("預期保留家庭 hh-1，實際為 %q", pet.HouseholdID)
+				}
+				return nil
+			})
+
+			c := contextx.WithUserID(context.Background(), userID)
+			pet, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi Jr.", DOB: dob})
+			if err != nil {
+				t.Fatalf("預期無錯誤，實際為 %v", err)
+			}
+			if pet.Name != "Mochi Jr." || pet.HouseholdID != "hh-1" {
+				t.Errorf("更新結果不正確: %+v", pet)
+			}
+		})
+	}
+
+	t.Run("家庭 editor 將寵物移出家庭應回傳 ErrForbidden", func(t *testing.T) {
+		none := ""
+		c := contextx.WithUserID(context.Background(), "partner")
+		_, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi", DOB: dob, HouseholdID: &none})
+		if !domain.IsForbidden(err) {
+			t.Errorf("預期 ErrForbidden，實際為 %v", err)
+		}
+	})
+
+	t.Run("飼主指定空字串時移出家庭", func(t *testing.T) {
+		petRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		none := ""
+		c := contextx.WithUserID(context.Background(), "owner")
+		pet, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi", DOB: dob, HouseholdID: &none})
+		if err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if pet.HouseholdID != "" {
+			t.Errorf("預期移出家庭，實際為 %q", pet.HouseholdID)
+		}
+	})
+}
+
+func TestUpdatePetHandler_WeightAlert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	petRepo := repository.NewMockPetRepository(ctrl)
+	auditRepo := repository.NewMockAuditRepository(ctrl)
+	handler := NewUpdatePetHandler(
+		petRepo,
+		behavior.NewPetAccessGuard(petRepo, repository.NewMockHouseholdRepository(ctrl)),
+		behavior.NewPetAvatarStore(config.Config{}, service.NewMockBlobStore(ctrl)),
+		behavior.NewAuditTrail(auditRepo),
+	)
+
+	dob := time.Date(2020, time.May, 1, 0, 0, 0, 0, time.UTC)
+	petRepo.EXPECT().FindByID(gomock.Any(), "pet-1").DoAndReturn(func(context.Context, string) (*model.Pet, error) {
+		return &model.Pet{
+			ID: "pet-1", OwnerID: "owner", Name: "Mochi", DOB: dob,
+			WeightAlert: &model.WeightAlertThreshold{LossPct: 5, GainPct: 8},
+		}, nil
+	}).AnyTimes()
+	petRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	c := contextx.WithUserID(context.Background(), "owner")
+
+	t.Run("未指定 weight_alert 時保留自訂門檻", func(t *testing.T) {
+		pet, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi", DOB: dob})
+		if err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if pet.WeightAlert == nil || pet.WeightAlert.LossPct != 5 || pet.WeightAlert.GainPct != 8 {
+			t.Errorf("預期保留自訂門檻，實際為 %+v", pet.WeightAlert)
+		}
+	})
+
+	t.Run("指定空物件時清除自訂門檻", func(t *testing.T) {
+		pet, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi", DOB: dob, WeightAlert: &model.WeightAlertThreshold{}})
+		if err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if pet.WeightAlert != nil {
+			t.Errorf("預期清除自訂門檻，實際為 %+v", pet.WeightAlert)
+		}
+	})
+
+	t.Run("指定新門檻時覆寫", func(t *testing.T) {
+		pet, err := handler.Handle(c, UpdatePetCommand{ID: "pet-1", Name: "Mochi", DOB: dob, WeightAlert: &model.WeightAlertThreshold{LossPct: 10, GainPct: 10}})
+		if err != nil {
+			t.Fatalf("預期無錯誤，實際為 %v", err)
+		}
+		if pet.WeightAlert == nil || pet.WeightAlert.LossPct != 10 {
+			t.Errorf("預期覆寫門檻，實際為 %+v", pet.WeightAlert)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateRecurringExpenseCommand 封裝編輯週期性費用的請求參數，所屬寵物不可變更
+type UpdateRecurringExpenseCommand struct {
+	ID          string
+	Category    string
+	Amount      int
+	Description string
+	Schedule    model.ExpenseSchedule
+	StartDate   time.Time
+	EndDate     *time.Time
+
+	// Currency 為 ISO 4217 幣別代碼，未指定時維持原本的幣別
+	Currency string
+
+	// ExpectedVersion 為客戶端最後取得的版本（If-Match），nil 表示不檢查
+	ExpectedVersion *int
+}
+
+// UpdateRecurringExpenseHandler 處理編輯週期性費用的業務邏輯
+// 編輯只影響尚未產生的期別，已產生的費用維持原樣
+type UpdateRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+	audit         *behavior.AuditTrail
+	currencies    *behavior.CurrencyConverter
+}
+
+// NewUpdateRecurringExpenseHandler 建立新的 handler 實例
+func NewUpdateRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail, currencies *behavior.CurrencyConverter) *UpdateRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil || audit == nil || currencies == nil {
+		panic("recurringRepo, guard, audit and currencies are required")
+	}
+	return &UpdateRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard, audit: audit, currencies: currencies}
+}
+
+// Handle 執行編輯週期性費用的流程
+func (h *UpdateRecurringExpenseHandler) Handle(c context.Context, cmd UpdateRecurringExpenseCommand) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	recurring, err := findWritableRecurringExpense(ctx, h.recurringRepo, h.guard, cmd.ID, cmd.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *recurring
+	recurring.Category = cmd.Category
+	recurring.Amount = cmd.Amount
+	recurring.Description = cmd.Description
+	recurring.Schedule = cmd.Schedule
+	recurring.StartDate = cmd.StartDate
+	recurring.EndDate = cmd.EndDate
+	if cmd.Currency != "" {
+		currency, err := resolveCurrency(ctx, h.currencies, cmd.Currency)
+		if err != nil {
+			return nil, err
+		}
+		recurring.Currency = currency
+	}
+	if err := behavior.NormalizeRecurringExpense(recurring); err != nil {
+		ctx.Warn("週期性費用驗證失敗", "error", err, "recurring_expense_id", recurring.ID)
+		return nil, err
+	}
+
+	return recurring, saveRecurringExpense(ctx, h.recurringRepo, h.audit, &before, recurring)
+}
+
+// findWritableRecurringExpense 查找週期性費用，確認使用者可編輯其寵物並比對預期版本
+func findWritableRecurringExpense(
+	c context.Context,
+	recurringRepo repository.RecurringExpenseRepository,
+	guard *behavior.PetAccessGuard,
+	id string,
+	expectedVersion *int,
+) (*model.RecurringExpense, error) {
+	recurring, err := recurringRepo.FindByID(c, id)
+	if err != nil {
+		return nil, fmt.Errorf("查找週期性費用失敗: %w", err)
+	}
+	if _, err := guard.Authorize(c, recurring.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	if err := behavior.ValidateExpectedVersion(expectedVersion, recurring.Version); err != nil {
+		return nil, err
+	}
+	return recurring, nil
+}
+
+// saveRecurringExpense 依版本更新週期性費用並寫入稽核紀錄
+func saveRecurringExpense(
+	ctx *contextx.Contextx,
+	recurringRepo repository.RecurringExpenseRepository,
+	audit *behavior.AuditTrail,
+	before, recurring *model.RecurringExpense,
+) error {
+	if err := recurringRepo.Update(ctx, recurring); err != nil {
+		ctx.Error("更新週期性費用失敗", "error", err, "recurring_expense_id", recurring.ID)
+		return fmt.Errorf("更新週期性費用失敗: %w", err)
+	}
+
+	audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityRecurringExpense, recurring.PetID, recurring.ID, before, recurring)
+
+	ctx.Info("成功更新週期性費用", "recurring_expense_id", recurring.ID, "version", recurring.Version)
+	return nil
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateReminderCommand 表示更新提醒的命令請求
+type UpdateReminderCommand struct {
+	ID       string                  `json:"id"`
+	Type     model.MedicalRecordType `json:"type"`
+	Title    string                  `json:"title"`
+	DueDate  time.Time               `json:"due_date"`
+	RemindAt time.Time               `json:"remind_at"`
+}
+
+// UpdateReminderHandler 處理更新提醒的命令
+type UpdateReminderHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+	audit        *behavior.AuditTrail
+}
+
+// NewUpdateReminderHandler 建立新的 UpdateReminderHandler
+func NewUpdateReminderHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard, audit *behavior.AuditTrail) *UpdateReminderHandler {
+	if reminderRepo == nil || guard == nil || audit == nil {
+		panic("reminderRepo, guard and audit are required")
+	}
+	return &UpdateReminderHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+		audit:        audit,
+	}
+}
+
+// Handle 執行更新提醒的命令
+func (h *UpdateReminderHandler) Handle(c context.Context, cmd UpdateReminderCommand) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理更新提醒請求", "user_id", userID, "reminder_id", cmd.ID)
+
+	reminder, err := h.reminderRepo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找提醒失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, reminder.PetID, model.PermissionWrite); err != nil {
+		return nil, err
+	}
+	before := *reminder
+
+	if cmd.Type != "" {
+		reminder.Type = cmd.Type
+	}
+	reminder.Title = cmd.Title
+	reminder.DueDate = cmd.DueDate
+	reminder.RemindAt = cmd.RemindAt
+
+	validator := &behavior.ReminderValidator{}
+	if err := validator.Validate(reminder); err != nil {
+		ctx.Warn("提醒驗證失敗", "error", err)
+		return nil, err
+	}
+
+	if err := h.reminderRepo.Update(ctx, reminder); err != nil {
+		ctx.Error("在倉儲中更新提醒失敗", "error", err, "reminder_id", cmd.ID)
+		return nil, fmt.Errorf("更新提醒失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityReminder, reminder.PetID, reminder.ID, &before, reminder)
+
+	ctx.Info("成功更新提醒", "reminder_id", reminder.ID)
+
+	return reminder, nil
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UpdateUserSettingsCommand 表示更新使用者設定的命令請求
+type UpdateUserSettingsCommand struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+// UpdateUserSettingsHandler 處理更新使用者設定的命令
+type UpdateUserSettingsHandler struct {
+	settingsRepo repository.UserSettingsRepository
+	audit        *behavior.AuditTrail
+}
+
+// NewUpdateUserSettingsHandler 建立新的 UpdateUserSettingsHandler
+func NewUpdateUserSettingsHandler(settingsRepo repository.UserSettingsRepository, audit *behavior.AuditTrail) *UpdateUserSettingsHandler {
+	if settingsRepo == nil || audit == nil {
+		panic("settingsRepo and audit are required")
+	}
+	return &UpdateUserSettingsHandler{settingsRepo: settingsRepo, audit: audit}
+}
+
+// Handle 執行更新使用者設定的命令
+func (h *UpdateUserSettingsHandler) Handle(c context.Context, cmd UpdateUserSettingsCommand) (*model.UserSettings, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	currency, err := behavior.NormalizeCurrency(cmd.BaseCurrency)
+	if err != nil {
+		ctx.Warn("使用者設定驗證失敗", "error", err)
+		return nil, err
+	}
+
+	// 尚未設定過時記錄為建立
+	before, err := h.settingsRepo.FindByUserID(ctx, userID)
+	if err != nil && !domain.IsNotFound(err) {
+		return nil, fmt.Errorf("查找使用者設定失敗: %w", err)
+	}
+
+	settings := &model.UserSettings{UserID: userID, BaseCurrency: currency}
+	if err := h.settingsRepo.Upsert(ctx, settings); err != nil {
+		return nil, fmt.Errorf("更新使用者設定失敗: %w", err)
+	}
+
+	if before == nil {
+		h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityUserSettings, "", userID, nil, settings)
+	} else {
+		h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityUserSettings, "", userID, before, settings)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UploadAttachmentCommand 表示上傳附件的命令請求
+type UploadAttachmentCommand struct {
+	OwnerType model.AttachmentOwnerType
+	OwnerID   string
+	FileName  string
+	Content   io.Reader
+}
+
+// UploadAttachmentHandler 處理上傳附件的命令：驗證類型與大小、寫入 BlobStore 並建立中繼資料
+type UploadAttachmentHandler struct {
+	attachmentRepo repository.AttachmentRepository
+	store          service.BlobStore
+	owners         *behavior.AttachmentOwnerResolver
+	audit          *behavior.AuditTrail
+	maxSize        int64
+}
+
+// NewUploadAttachmentHandler 建立新的 UploadAttachmentHandler
+func NewUploadAttachmentHandler(
+	cfg config.Config,
+	attachmentRepo repository.AttachmentRepository,
+	store service.BlobStore,
+	owners *behavior.AttachmentOwnerResolver,
+	audit *behavior.AuditTrail,
+) *UploadAttachmentHandler {
+	if attachmentRepo == nil || store == nil || owners == nil || audit == nil {
+		panic("attachmentRepo, store, owners and audit are required")
+	}
+	return &UploadAttachmentHandler{
+		attachmentRepo: attachmentRepo,
+		store:          store,
+		owners:         owners,
+		audit:          audit,
+		maxSize:        cfg.Storage.MaxUploadSize,
+	}
+}
+
+// Handle 執行上傳附件的命令
+func (h *UploadAttachmentHandler) Handle(c context.Context, cmd UploadAttachmentCommand) (*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	petID, err := h.owners.Authorize(ctx, cmd.OwnerType, cmd.OwnerID, model.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多讀一個位元組以判斷是否超過上限
+	content, err := io.ReadAll(io.LimitReader(cmd.Content, h.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("讀取附件內容失敗: %w", err)
+	}
+	contentType, err := behavior.DetectAttachmentContentType(content, h.maxSize)
+	if err != nil {
+		ctx.Warn("附件驗證失敗", "error", err, "owner_type", cmd.OwnerType, "owner_id", cmd.OwnerID)
+		return nil, err
+	}
+
+	key, err := behavior.NewAttachmentStorageKey(petID)
+	if err != nil {
+		return nil, err
+	}
+	attachment := &model.Attachment{
+		PetID:       petID,
+		OwnerType:   cmd.OwnerType,
+		OwnerID:     cmd.OwnerID,
+		FileName:    behavior.NormalizeAttachmentFileName(cmd.FileName),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		StorageKey:  key,
+		UploadedBy:  userID,
+	}
+
+	obj := service.BlobObject{Key: key, ContentType: contentType, FileName: attachment.FileName}
+	if err := h.store.Put(ctx, obj, bytes.NewReader(content), attachment.Size); err != nil {
+		ctx.Error("寫入附件檔案失敗", "error", err, "key", key)
+		return nil, fmt.Errorf("寫入附件檔案失敗: %w", err)
+	}
+
+	if err := h.attachmentRepo.Create(ctx, attachment); err != nil {
+		// 中繼資料寫入失敗時移除已上傳的檔案，避免留下無法存取的檔案
+		if delErr := h.store.Delete(ctx, key); delErr != nil {
+			ctx.Error("移除未建立中繼資料的附件檔案失敗", "error", delErr, "key", key)
+		}
+		return nil, fmt.Errorf("建立附件失敗: %w", err)
+	}
+
+	h.audit.Record(ctx, model.AuditActionCreate, model.AuditEntityAttachment, petID, attachment.ID, nil, attachment)
+
+	ctx.Info("成功上傳附件", "attachment_id", attachment.ID, "owner_type", cmd.OwnerType, "owner_id", cmd.OwnerID, "size", attachment.Size)
+	return attachment, nil
+}
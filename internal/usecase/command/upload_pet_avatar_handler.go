@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// UploadPetAvatarCommand represents the request for uploading a pet avatar.
+type UploadPetAvatarCommand struct {
+	PetID   string
+	Content io.Reader
+
+	// ExpectedVersion is the version the client last saw (If-Match); nil skips the check.
+	ExpectedVersion *int
+}
+
+// UploadPetAvatarHandler handles the pet avatar upload command.
+type UploadPetAvatarHandler struct {
+	petRepo repository.PetRepository
+	guard   *behavior.PetAccessGuard
+	avatars *behavior.PetAvatarStore
+	audit   *behavior.AuditTrail
+	maxSize int64
+}
+
+// NewUploadPetAvatarHandler creates a new UploadPetAvatarHandler.
+func NewUploadPetAvatarHandler(
+	cfg config.Config,
+	petRepo repository.PetRepository,
+	guard *behavior.PetAccessGuard,
+	avatars *behavior.PetAvatarStore,
+	audit *behavior.AuditTrail,
+) *UploadPetAvatarHandler {
+	if petRepo == nil || guard == nil || avatars == nil || audit == nil {
+		panic("petRepo, guard, avatars and audit are required")
+	}
+	return &UploadPetAvatarHandler{
+		petRepo: petRepo,
+		guard:   guard,
+		avatars: avatars,
+		audit:   audit,
+		maxSize: cfg.Storage.MaxUploadSize,
+	}
+}
+
+// Handle validates the image, stores its thumbnails and points the pet's AvatarURL at them.
+// The previous avatar's files are removed once the pet has been updated.
+func (h *UploadPetAvatarHandler) Handle(c context.Context, cmd UploadPetAvatarCommand) (*model.Pet, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("handling upload pet avatar request", "user_id", userID, "pet_id", cmd.PetID)
+
+	pet, err := h.guard.Authorize(ctx, cmd.PetID, model.PermissionWrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload avatar of pet %s: %w", cmd.PetID, err)
+	}
+
+	if err := behavior.ValidateExpectedVersion(cmd.ExpectedVersion, pet.Version); err != nil {
+		return nil, err
+	}
+
+	// Read one extra byte so oversized uploads can be told apart
+	content, err := io.ReadAll(io.LimitReader(cmd.Content, h.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read avatar: %w", err)
+	}
+	images, err := behavior.ProcessAvatar(content, h.maxSize)
+	if err != nil {
+		ctx.Warn("avatar validation failed", "error", err, "pet_id", pet.ID)
+		return nil, err
+	}
+
+	avatar, err := h.avatars.Save(ctx, pet.ID, images)
+	if err != nil {
+		ctx.Error("failed to store avatar", "error", err, "pet_id", pet.ID)
+		return nil, err
+	}
+
+	before := *pet
+	previous := pet.Avatar
+
+	pet.Avatar = avatar
+	pet.AvatarURL = behavior.LargestAvatarURL(avatar)
+
+	if err := h.petRepo.Update(ctx, pet); err != nil {
+		// Drop the new files so a failed update doesn't leave orphaned blobs behind
+		h.avatars.Remove(ctx, avatar)
+		ctx.Error("failed to update pet in repository", "error", err)
+		return nil, fmt.Errorf("failed to update pet avatar: %w", err)
+	}
+
+	h.avatars.Remove(ctx, previous)
+
+	h.audit.Record(ctx, model.AuditActionUpdate, model.AuditEntityPet, pet.ID, pet.ID, &before, pet)
+
+	ctx.Info("pet avatar uploaded successfully", "pet_id", pet.ID, "user_id", userID, "version", pet.Version)
+
+	return pet, nil
+}
@@ -0,0 +1,57 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// Handler 處理單一領域事件的訂閱者
+type Handler func(c context.Context, event model.DomainEvent) error
+
+// Bus 為行程內的同步事件匯流排，實作 service.EventPublisher
+// 事件依名稱分派給所有訂閱者；單一訂閱者失敗不影響其他訂閱者
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+var _ service.EventPublisher = (*Bus)(nil)
+
+// NewBus 建立沒有任何訂閱者的 Bus
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe 註冊指定事件名稱的訂閱者
+func (b *Bus) Subscribe(name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[name] = append(b.handlers[name], handler)
+}
+
+// Publish 依序呼叫事件的所有訂閱者，並回傳所有失敗訂閱者的錯誤
+func (b *Bus) Publish(c context.Context, event model.DomainEvent) error {
+	ctx := contextx.WithContext(c)
+
+	b.mu.RLock()
+	handlers := b.handlers[event.EventName()]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			ctx.Error("事件訂閱者處理失敗", "error", err, "event", event.EventName())
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("事件 %s 處理失敗: %w", event.EventName(), errors.Join(errs...))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/google/wire"
+)
+
+// ProviderSet 提供事件匯流排並綁定為 service.EventPublisher
+var ProviderSet = wire.NewSet(
+	ProvideBus,
+	wire.Bind(new(service.EventPublisher), new(*Bus)),
+)
+
+// ProvideBus 建立事件匯流排並註冊應用程式內所有的事件訂閱者
+func ProvideBus(budgetAlert *command.NotifyBudgetAlertHandler, exportReady *command.NotifyExportReadyHandler) *Bus {
+	bus := NewBus()
+
+	// 預算門檻突破時通知預算擁有者
+	bus.Subscribe(model.BudgetThresholdCrossedEventName, func(c context.Context, e model.DomainEvent) error {
+		evt, ok := e.(model.BudgetThresholdCrossed)
+		if !ok {
+			return fmt.Errorf("unexpected event type %T", e)
+		}
+		return budgetAlert.Handle(c, evt)
+	})
+
+	// 背景匯出完成時通知使用者下載
+	bus.Subscribe(model.ExportCompletedEventName, func(c context.Context, e model.DomainEvent) error {
+		evt, ok := e.(model.ExportCompleted)
+		if !ok {
+			return fmt.Errorf("unexpected event type %T", e)
+		}
+		return exportReady.Handle(c, evt)
+	})
+
+	return bus
+}
@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetAttachmentDownloadHandler 處理產生附件限時下載連結的業務邏輯
+type GetAttachmentDownloadHandler struct {
+	attachments *GetAttachmentHandler
+	store       service.BlobStore
+	urlExpiry   time.Duration
+}
+
+// NewGetAttachmentDownloadHandler 建立新的 handler 實例
+func NewGetAttachmentDownloadHandler(cfg config.Config, attachments *GetAttachmentHandler, store service.BlobStore) *GetAttachmentDownloadHandler {
+	if attachments == nil || store == nil {
+		panic("attachments and store are required")
+	}
+	return &GetAttachmentDownloadHandler{attachments: attachments, store: store, urlExpiry: cfg.Storage.URLExpiry}
+}
+
+// Handle 檢查存取權後產生附件的限時下載連結
+func (h *GetAttachmentDownloadHandler) Handle(c context.Context, q GetAttachmentQuery) (*model.AttachmentDownload, error) {
+	ctx := contextx.WithContext(c)
+
+	attachment, err := h.attachments.Handle(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(h.urlExpiry)
+	obj := service.BlobObject{Key: attachment.StorageKey, ContentType: attachment.ContentType, FileName: attachment.FileName}
+	url, err := h.store.SignedURL(ctx, obj, expiresAt)
+	if err != nil {
+		ctx.Error("產生附件下載連結失敗", "error", err, "attachment_id", q.ID)
+		return nil, fmt.Errorf("產生附件下載連結失敗: %w", err)
+	}
+
+	return &model.AttachmentDownload{URL: url, ExpiresAt: expiresAt}, nil
+}
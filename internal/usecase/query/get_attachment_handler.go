@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetAttachmentQuery 封裝查詢單一附件的請求參數
+type GetAttachmentQuery struct {
+	ID string
+}
+
+// GetAttachmentHandler 處理查詢附件中繼資料的業務邏輯
+type GetAttachmentHandler struct {
+	attachmentRepo repository.AttachmentRepository
+	guard          *behavior.PetAccessGuard
+}
+
+// NewGetAttachmentHandler 建立新的 handler 實例
+func NewGetAttachmentHandler(attachmentRepo repository.AttachmentRepository, guard *behavior.PetAccessGuard) *GetAttachmentHandler {
+	if attachmentRepo == nil || guard == nil {
+		panic("attachmentRepo and guard are required")
+	}
+	return &GetAttachmentHandler{attachmentRepo: attachmentRepo, guard: guard}
+}
+
+// Handle 取得附件中繼資料
+func (h *GetAttachmentHandler) Handle(c context.Context, q GetAttachmentQuery) (*model.Attachment, error) {
+	ctx := contextx.WithContext(c)
+
+	attachment, err := h.attachmentRepo.FindByID(ctx, q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找附件失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, attachment.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
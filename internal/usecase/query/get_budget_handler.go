@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetBudgetQuery 封裝查詢單一預算的請求參數
+type GetBudgetQuery struct {
+	ID string
+}
+
+// GetBudgetHandler 處理查詢單一預算的業務邏輯
+type GetBudgetHandler struct {
+	budgetRepo repository.BudgetRepository
+}
+
+// NewGetBudgetHandler 建立新的 handler 實例
+func NewGetBudgetHandler(budgetRepo repository.BudgetRepository) *GetBudgetHandler {
+	if budgetRepo == nil {
+		panic("budgetRepo is required")
+	}
+	return &GetBudgetHandler{budgetRepo: budgetRepo}
+}
+
+// Handle 執行查詢單一預算的流程
+func (h *GetBudgetHandler) Handle(c context.Context, q GetBudgetQuery) (*model.Budget, error) {
+	return findOwnedBudget(c, h.budgetRepo, q.ID)
+}
+
+// findOwnedBudget 查找預算並確認由目前使用者設定
+func findOwnedBudget(c context.Context, budgetRepo repository.BudgetRepository, id string) (*model.Budget, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	budget, err := budgetRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("查找預算失敗: %w", err)
+	}
+	if err := behavior.AuthorizeBudget(budget, userID); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
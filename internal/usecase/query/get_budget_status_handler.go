@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetBudgetStatusQuery 封裝查詢預算執行狀況的請求參數
+type GetBudgetStatusQuery struct {
+	ID string
+}
+
+// GetBudgetStatusHandler 回報預算在目前週期的花費、剩餘額度與月底推估
+type GetBudgetStatusHandler struct {
+	budgetRepo repository.BudgetRepository
+	tracker    *behavior.BudgetTracker
+}
+
+// NewGetBudgetStatusHandler 建立新的 handler 實例
+func NewGetBudgetStatusHandler(budgetRepo repository.BudgetRepository, tracker *behavior.BudgetTracker) *GetBudgetStatusHandler {
+	if budgetRepo == nil || tracker == nil {
+		panic("budgetRepo and tracker are required")
+	}
+	return &GetBudgetStatusHandler{budgetRepo: budgetRepo, tracker: tracker}
+}
+
+// Handle 執行查詢預算執行狀況的流程
+func (h *GetBudgetStatusHandler) Handle(c context.Context, q GetBudgetStatusQuery) (*model.BudgetStatus, error) {
+	ctx := contextx.WithContext(c)
+
+	budget, err := findOwnedBudget(ctx, h.budgetRepo, q.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := h.tracker.Status(ctx, budget, time.Now())
+	if err != nil {
+		ctx.Error("計算預算狀態失敗", "error", err, "budget_id", budget.ID)
+		return nil, err
+	}
+
+	return status, nil
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetCalendarFeedQuery 以訂閱 token 讀取行事曆的請求
+type GetCalendarFeedQuery struct {
+	Token string
+}
+
+// GetCalendarFeedHandler 以訂閱 token 找出使用者，將其可存取寵物的醫療到期日輸出為 iCalendar
+// 訂閱請求不帶登入憑證，token 即為存取憑證，因此查不到 token 時一律回傳不存在
+type GetCalendarFeedHandler struct {
+	feedRepo          repository.CalendarFeedRepository
+	medicalRecordRepo repository.MedicalRecordRepository
+	guard             *behavior.PetAccessGuard
+	leadDays          int
+}
+
+// NewGetCalendarFeedHandler 建立新的 GetCalendarFeedHandler
+func NewGetCalendarFeedHandler(
+	cfg config.Config,
+	feedRepo repository.CalendarFeedRepository,
+	medicalRecordRepo repository.MedicalRecordRepository,
+	guard *behavior.PetAccessGuard,
+) *GetCalendarFeedHandler {
+	if feedRepo == nil || medicalRecordRepo == nil || guard == nil {
+		panic("feedRepo, medicalRecordRepo and guard are required")
+	}
+	return &GetCalendarFeedHandler{
+		feedRepo:          feedRepo,
+		medicalRecordRepo: medicalRecordRepo,
+		guard:             guard,
+		leadDays:          cfg.Reminder.LeadDays,
+	}
+}
+
+// Handle 產生使用者的行事曆訂閱內容
+func (h *GetCalendarFeedHandler) Handle(c context.Context, q GetCalendarFeedQuery) ([]byte, error) {
+	if q.Token == "" {
+		return nil, domain.ErrNotFound
+	}
+
+	feed, err := h.feedRepo.FindByTokenHash(c, behavior.HashCalendarFeedToken(q.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	// 以 token 所屬的使用者身分檢查寵物存取權，已離開的家庭寵物不會出現在訂閱中
+	ctx := contextx.WithContext(contextx.WithUserID(c, feed.UserID))
+
+	pets, err := h.guard.AccessiblePets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*model.MedicalRecord
+	for _, pet := range pets {
+		found, err := h.medicalRecordRepo.FindByPetID(ctx, pet.ID, time.Time{}, time.Time{})
+		if err != nil {
+			ctx.Error("查詢醫療記錄失敗", "error", err, "pet_id", pet.ID)
+			return nil, fmt.Errorf("查詢醫療記錄失敗: %w", err)
+		}
+		records = append(records, found...)
+	}
+
+	return behavior.BuildCalendarFeed(pets, records, h.leadDays, time.Now()), nil
+}
@@ -5,13 +5,12 @@ import (
 	"fmt"
 
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // GetDashboardOverviewQuery 聚合查詢參數
-// 目前僅需 ownerID
-type GetDashboardOverviewQuery struct {
-	OwnerID string
-}
+// 使用者由 context 取得，統計範圍包含其所屬家庭的寵物
+type GetDashboardOverviewQuery struct{}
 
 // GetDashboardOverviewResult 聚合查詢結果
 type GetDashboardOverviewResult struct {
@@ -21,22 +20,22 @@ type GetDashboardOverviewResult struct {
 
 // GetDashboardOverviewHandler 聚合查詢 handler
 type GetDashboardOverviewHandler struct {
-	petRepo       repository.PetRepository
+	guard         *behavior.PetAccessGuard
 	healthlogRepo repository.HealthLogRepository
 }
 
 // NewGetDashboardOverviewHandler 建構函式
-func NewGetDashboardOverviewHandler(petRepo repository.PetRepository, healthlogRepo repository.HealthLogRepository) *GetDashboardOverviewHandler {
+func NewGetDashboardOverviewHandler(guard *behavior.PetAccessGuard, healthlogRepo repository.HealthLogRepository) *GetDashboardOverviewHandler {
 	return &GetDashboardOverviewHandler{
-		petRepo:       petRepo,
+		guard:         guard,
 		healthlogRepo: healthlogRepo,
 	}
 }
 
 // Handle 執行聚合查詢
 func (h *GetDashboardOverviewHandler) Handle(c context.Context, q GetDashboardOverviewQuery) (*GetDashboardOverviewResult, error) {
-	// 1. 取得該 owner 的所有 petIDs
-	petIDs, err := h.petRepo.FindIDsByOwnerID(c, q.OwnerID)
+	// 1. 取得使用者可存取的所有 petIDs
+	petIDs, err := h.guard.AccessiblePetIDs(c)
 	if err != nil {
 		return nil, fmt.Errorf("查詢寵物 ID 失敗: %w", err)
 	}
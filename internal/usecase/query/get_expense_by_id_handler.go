@@ -6,6 +6,7 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -17,12 +18,14 @@ type GetExpenseByIDQuery struct {
 // GetExpenseByIDHandler 處理根據 ID 查詢費用的業務邏輯
 type GetExpenseByIDHandler struct {
 	expenseRepo repository.ExpenseRepository
+	guard       *behavior.PetAccessGuard
 }
 
 // NewGetExpenseByIDHandler 建立新的 handler 實例
-func NewGetExpenseByIDHandler(expenseRepo repository.ExpenseRepository) *GetExpenseByIDHandler {
+func NewGetExpenseByIDHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard) *GetExpenseByIDHandler {
 	return &GetExpenseByIDHandler{
 		expenseRepo: expenseRepo,
+		guard:       guard,
 	}
 }
 
@@ -44,6 +47,11 @@ func (h *GetExpenseByIDHandler) Handle(c context.Context, query GetExpenseByIDQu
 		return nil, fmt.Errorf("根據 ID 查詢費用失敗: %w", err)
 	}
 
+	// 確認使用者可存取該費用所屬的寵物
+	if _, err := h.guard.Authorize(ctx, expense.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
 	ctx.Info("成功查詢費用", "expense_id", query.ID)
 	return expense, nil
 }
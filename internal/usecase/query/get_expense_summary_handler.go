@@ -2,75 +2,177 @@ package query
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
 // GetExpenseSummaryQuery 封裝查詢費用摘要的請求參數
+// StartDate/EndDate 為零值時不限制；GroupBy 未指定時依月份分組
 type GetExpenseSummaryQuery struct {
-	PetID string `json:"pet_id,omitempty"`
+	PetID     string               `json:"pet_id,omitempty"`
+	StartDate time.Time            `json:"start_date,omitempty"`
+	EndDate   time.Time            `json:"end_date,omitempty"`
+	GroupBy   model.ExpenseGroupBy `json:"group_by,omitempty"`
 }
 
 // ExpenseSummary 費用摘要結構
+// - Currency: 金額統計換算後的基準貨幣，為查詢者設定的基準貨幣
+// - PetStats: 各寵物的支出合計，範圍內沒有費用的寵物為 0
+// - Series: 依 GroupBy 分組的統計，時間分組會補齊沒有費用的區間
+// - Recent: 期間內依日期由新到舊的最近幾筆紀錄，保留原始金額與幣別
+// - Unconverted: 缺少匯率而未計入統計的費用，依幣別合計
 type ExpenseSummary struct {
-	TotalAmount   int              `json:"total_amount"`
-	CategoryStats map[string]int   `json:"category_stats"`
-	Recent        []*model.Expense `json:"recent,omitempty"`
+	TotalAmount   int                    `json:"total_amount"`
+	Count         int                    `json:"count"`
+	CategoryStats map[string]int         `json:"category_stats"`
+	GroupBy       model.ExpenseGroupBy   `json:"group_by"`
+	Series        []*model.ExpenseBucket `json:"series"`
+	Recent        []*model.Expense       `json:"recent,omitempty"`
+	Currency      string                 `json:"currency"`
+	PetStats      map[string]int         `json:"pet_stats"`
+
+	Unconverted []*model.UnconvertedExpense `json:"unconverted,omitempty"`
 }
 
 // GetExpenseSummaryHandler 處理查詢費用摘要的業務邏輯
 type GetExpenseSummaryHandler struct {
 	expenseRepo repository.ExpenseRepository
+	guard       *behavior.PetAccessGuard
+	currencies  *behavior.CurrencyConverter
 }
 
 // NewGetExpenseSummaryHandler 建立新的 handler 實例
-func NewGetExpenseSummaryHandler(expenseRepo repository.ExpenseRepository) *GetExpenseSummaryHandler {
-	if expenseRepo == nil {
-		panic("expenseRepo is required")
+func NewGetExpenseSummaryHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard, currencies *behavior.CurrencyConverter) *GetExpenseSummaryHandler {
+	if expenseRepo == nil || guard == nil || currencies == nil {
+		panic("expenseRepo, guard and currencies are required")
 	}
-	return &GetExpenseSummaryHandler{expenseRepo: expenseRepo}
+	return &GetExpenseSummaryHandler{expenseRepo: expenseRepo, guard: guard, currencies: currencies}
 }
 
 // Handle 執行查詢費用摘要的流程
 func (h *GetExpenseSummaryHandler) Handle(c context.Context, query GetExpenseSummaryQuery) (*ExpenseSummary, error) {
 	ctx := contextx.WithContext(c)
 
-	// 查詢所有費用紀錄（如果有指定 PetID 則篩選）
-	var opts []repository.ExpenseQueryOption
-	if query.PetID != "" {
-		opts = append(opts, repository.WithPetID(query.PetID))
+	if query.GroupBy == "" {
+		query.GroupBy = behavior.DefaultExpenseGroupBy
+	}
+	if err := behavior.ValidateExpenseReportOptions(query.GroupBy, query.StartDate, query.EndDate); err != nil {
+		return nil, err
 	}
 
-	expenses, _, err := h.expenseRepo.FindAll(ctx, opts...)
+	// 查詢使用者的費用紀錄（如果有指定 PetID 則篩選）
+	petIDs, opts, err := petScopeOptions(ctx, h.guard, query.PetID)
+	if err != nil {
+		return nil, err
+	}
+	if !query.StartDate.IsZero() || !query.EndDate.IsZero() {
+		opts = append(opts, dateRangeOption(query.StartDate, query.EndDate))
+	}
+
+	// 金額依消費日期的匯率換算為查詢者的基準貨幣
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+	base, err := h.currencies.BaseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	conversion := h.currencies.NewConversion(base)
+	convert := func(amount int, currency string, on time.Time) (int, error) {
+		return conversion.Convert(ctx, amount, currency, on)
+	}
+
+	// 分類統計與總金額同樣由資料庫彙總
+	categories, unconverted, err := h.aggregate(ctx, model.ExpenseGroupByCategory, convert, opts)
 	if err != nil {
-		ctx.Error("查詢費用紀錄失敗", "error", err)
 		return nil, err
 	}
 
-	// 計算統計資料
 	summary := &ExpenseSummary{
-		TotalAmount:   0,
-		CategoryStats: make(map[string]int),
+		CategoryStats: make(map[string]int, len(categories)),
+		GroupBy:       query.GroupBy,
 		Recent:        make([]*model.Expense, 0),
+		Currency:      base,
+		PetStats:      make(map[string]int, len(petIDs)),
+		Unconverted:   unconverted,
+	}
+	for _, bucket := range categories {
+		summary.TotalAmount += bucket.Total
+		summary.Count += bucket.Count
+		summary.CategoryStats[bucket.Key] = bucket.Total
+	}
+
+	// 各寵物的支出合計同樣由資料庫彙總，依寵物分組時沿用為圖表序列
+	pets, _, err := h.aggregate(ctx, model.ExpenseGroupByPet, convert, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, petID := range petIDs {
+		summary.PetStats[petID] = 0
+	}
+	for _, bucket := range pets {
+		summary.PetStats[bucket.Key] = bucket.Total
 	}
 
-	// 計算總金額和分類統計
-	for _, expense := range expenses {
-		summary.TotalAmount += expense.Amount
-		summary.CategoryStats[expense.Category] += expense.Amount
+	switch query.GroupBy {
+	case model.ExpenseGroupByCategory:
+		summary.Series = categories
+	case model.ExpenseGroupByPet:
+		summary.Series = pets
+	default:
+		buckets, _, err := h.aggregate(ctx, query.GroupBy, convert, opts)
+		if err != nil {
+			return nil, err
+		}
+		summary.Series = behavior.FillExpenseSeries(buckets, query.GroupBy, query.StartDate, query.EndDate)
 	}
 
-	// 取最近 5 筆紀錄
-	recentCount := 5
-	if len(expenses) < recentCount {
-		recentCount = len(expenses)
+	recent, _, err := h.expenseRepo.FindAll(ctx, append(opts, repository.WithRecent(behavior.DefaultRecentExpenses))...)
+	if err != nil {
+		ctx.Error("查詢最近費用紀錄失敗", "error", err)
+		return nil, fmt.Errorf("查詢最近費用紀錄失敗: %w", err)
 	}
-	if recentCount > 0 {
-		summary.Recent = expenses[:recentCount]
+	if len(recent) > 0 {
+		summary.Recent = recent
 	}
 
-	ctx.Info("成功取得費用摘要", "total_amount", summary.TotalAmount, "categories", len(summary.CategoryStats))
+	ctx.Info("成功取得費用摘要", "total_amount", summary.TotalAmount, "currency", summary.Currency, "categories", len(summary.CategoryStats), "group_by", summary.GroupBy)
 	return summary, nil
 }
+
+// aggregate 由資料庫彙總後換算為基準貨幣並合併，缺少匯率的費用另行回傳
+func (h *GetExpenseSummaryHandler) aggregate(ctx *contextx.Contextx, groupBy model.ExpenseGroupBy, convert behavior.ExpenseAmountConverter, opts []repository.ExpenseQueryOption) ([]*model.ExpenseBucket, []*model.UnconvertedExpense, error) {
+	parts, err := h.expenseRepo.Aggregate(ctx, groupBy, opts...)
+	if err != nil {
+		ctx.Error("彙總費用報表失敗", "error", err, "group_by", groupBy)
+		return nil, nil, fmt.Errorf("彙總費用報表失敗: %w", err)
+	}
+
+	buckets, unconverted, err := behavior.MergeExpenseBuckets(parts, groupBy, convert)
+	if err != nil {
+		ctx.Warn("換算費用金額失敗", "error", err, "group_by", groupBy)
+		return nil, nil, err
+	}
+	if len(unconverted) > 0 {
+		ctx.Warn("部分費用缺少匯率，未計入統計", "group_by", groupBy, "currencies", len(unconverted))
+	}
+	return buckets, unconverted, nil
+}
+
+// dateRangeOption 產生日期範圍查詢條件，零值的一端不限制
+func dateRangeOption(startDate, endDate time.Time) repository.ExpenseQueryOption {
+	return func(o *repository.ExpenseQueryOptions) {
+		if !startDate.IsZero() {
+			o.StartDate = &startDate
+		}
+		if !endDate.IsZero() {
+			o.EndDate = &endDate
+		}
+	}
+}
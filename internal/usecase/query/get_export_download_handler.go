@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+var ErrExportNotReady = errors.New("匯出檔尚未產生完成")
+
+// GetExportDownloadHandler 處理產生匯出檔限時下載連結的業務邏輯
+type GetExportDownloadHandler struct {
+	exports   *GetExportHandler
+	store     service.BlobStore
+	urlExpiry time.Duration
+}
+
+// NewGetExportDownloadHandler 建立新的 handler 實例
+func NewGetExportDownloadHandler(cfg config.Config, exports *GetExportHandler, store service.BlobStore) *GetExportDownloadHandler {
+	if exports == nil || store == nil {
+		panic("exports and store are required")
+	}
+	return &GetExportDownloadHandler{exports: exports, store: store, urlExpiry: cfg.Storage.URLExpiry}
+}
+
+// Handle 產生已完成匯出檔的限時下載連結
+func (h *GetExportDownloadHandler) Handle(c context.Context, q GetExportQuery) (*model.ExportDownload, error) {
+	ctx := contextx.WithContext(c)
+
+	job, err := h.exports.Handle(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != model.ExportStatusCompleted {
+		return nil, fmt.Errorf("%w: %w（狀態 %s）", domain.ErrUpdateConflict, ErrExportNotReady, job.Status)
+	}
+
+	expiresAt := time.Now().Add(h.urlExpiry)
+	obj := service.BlobObject{Key: job.StorageKey, ContentType: behavior.ExportContentType(job.Format), FileName: job.FileName}
+	url, err := h.store.SignedURL(ctx, obj, expiresAt)
+	if err != nil {
+		ctx.Error("產生匯出檔下載連結失敗", "error", err, "export_id", q.ID)
+		return nil, fmt.Errorf("產生匯出檔下載連結失敗: %w", err)
+	}
+
+	return &model.ExportDownload{URL: url, ExpiresAt: expiresAt}, nil
+}
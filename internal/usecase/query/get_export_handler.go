@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetExportQuery 封裝查詢單一匯出工作的請求參數
+type GetExportQuery struct {
+	ID string
+}
+
+// GetExportHandler 處理查詢匯出工作狀態的業務邏輯
+type GetExportHandler struct {
+	jobRepo repository.ExportJobRepository
+}
+
+// NewGetExportHandler 建立新的 handler 實例
+func NewGetExportHandler(jobRepo repository.ExportJobRepository) *GetExportHandler {
+	if jobRepo == nil {
+		panic("jobRepo is required")
+	}
+	return &GetExportHandler{jobRepo: jobRepo}
+}
+
+// Handle 取得匯出工作，只有建立者可以查詢，其他使用者視為不存在
+func (h *GetExportHandler) Handle(c context.Context, q GetExportQuery) (*model.ExportJob, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	job, err := h.jobRepo.FindByID(ctx, q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找匯出工作失敗: %w", err)
+	}
+	if job.OwnerID != userID {
+		return nil, fmt.Errorf("查找匯出工作失敗: %w", domain.ErrNotFound)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetFoodIntakeTrendQuery 表示查詢寵物進食量趨勢的請求
+// - StartDate/EndDate: 未指定時為過去 30 天到現在
+// - BaselineDays/ThresholdPct/ConsecutiveDays: 為 0 時使用系統設定值
+type GetFoodIntakeTrendQuery struct {
+	PetID           string
+	StartDate       time.Time
+	EndDate         time.Time
+	BaselineDays    int
+	ThresholdPct    float64
+	ConsecutiveDays int
+	// Filter 依結構化觀察篩選納入計算的健康日誌
+	Filter model.HealthLogFilter
+}
+
+// GetFoodIntakeTrendHandler 依每日進食量與滾動基準偵測食慾下降
+type GetFoodIntakeTrendHandler struct {
+	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
+	defaults      config.AnalyticsConfig
+}
+
+// NewGetFoodIntakeTrendHandler 建立新的 GetFoodIntakeTrendHandler
+func NewGetFoodIntakeTrendHandler(cfg config.Config, healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard) *GetFoodIntakeTrendHandler {
+	if healthLogRepo == nil || guard == nil {
+		panic("healthLogRepo and guard are required")
+	}
+	return &GetFoodIntakeTrendHandler{
+		healthLogRepo: healthLogRepo,
+		guard:         guard,
+		defaults:      cfg.Analytics,
+	}
+}
+
+// Handle 執行進食量趨勢查詢
+// 會額外取得查詢起始日前 BaselineDays 天的資料作為基準，但只回傳查詢範圍內的每日資料
+func (h *GetFoodIntakeTrendHandler) Handle(c context.Context, q GetFoodIntakeTrendQuery) (*model.FoodIntakeTrend, error) {
+	ctx := contextx.WithContext(c)
+
+	if q.BaselineDays == 0 {
+		q.BaselineDays = h.defaults.FoodBaselineDays
+	}
+	if q.ThresholdPct == 0 {
+		q.ThresholdPct = h.defaults.FoodThresholdPct
+	}
+	if q.ConsecutiveDays == 0 {
+		q.ConsecutiveDays = h.defaults.FoodAlertDays
+	}
+	if err := behavior.ValidateFoodIntakeOptions(q.BaselineDays, q.ThresholdPct, q.ConsecutiveDays); err != nil {
+		return nil, err
+	}
+	validator := &behavior.HealthLogValidator{}
+	if err := validator.ValidateFilter(q.Filter); err != nil {
+		return nil, err
+	}
+
+	if q.EndDate.IsZero() {
+		q.EndDate = time.Now()
+	}
+	if q.StartDate.IsZero() {
+		q.StartDate = q.EndDate.AddDate(0, 0, -30)
+	}
+
+	if _, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	rangeStart := behavior.TrendPeriodStart(q.StartDate, model.TrendIntervalDay)
+	daily, err := h.healthLogRepo.AggregateDailyFoodIntake(ctx, q.PetID, rangeStart.AddDate(0, 0, -q.BaselineDays), q.EndDate, q.Filter)
+	if err != nil {
+		ctx.Error("彙總每日進食量失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("彙總每日進食量失敗: %w", err)
+	}
+
+	points, alerts := behavior.BuildFoodIntakeTrend(daily, q.BaselineDays, q.ThresholdPct, q.ConsecutiveDays)
+
+	// 基準用的前置資料不列入回傳的序列與警示
+	trimmed := make([]model.FoodIntakePoint, 0, len(points))
+	for _, p := range points {
+		if !p.Date.Before(rangeStart) {
+			trimmed = append(trimmed, p)
+		}
+	}
+	inRange := make([]model.AppetiteAlert, 0, len(alerts))
+	for _, a := range alerts {
+		if !a.EndDate.Before(rangeStart) {
+			inRange = append(inRange, a)
+		}
+	}
+
+	return &model.FoodIntakeTrend{
+		PetID:           q.PetID,
+		BaselineDays:    q.BaselineDays,
+		ThresholdPct:    q.ThresholdPct,
+		ConsecutiveDays: q.ConsecutiveDays,
+		Points:          trimmed,
+		Alerts:          inRange,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -17,15 +18,17 @@ type GetHealthLogByIDQuery struct {
 // GetHealthLogByIDHandler 處理根據 ID 取得健康日誌的查詢
 type GetHealthLogByIDHandler struct {
 	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
 }
 
 // NewGetHealthLogByIDHandler 建立新的 GetHealthLogByIDHandler
-func NewGetHealthLogByIDHandler(healthLogRepo repository.HealthLogRepository) *GetHealthLogByIDHandler {
-	if healthLogRepo == nil {
-		panic("healthLogRepo is required")
+func NewGetHealthLogByIDHandler(healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard) *GetHealthLogByIDHandler {
+	if healthLogRepo == nil || guard == nil {
+		panic("healthLogRepo and guard are required")
 	}
 	return &GetHealthLogByIDHandler{
 		healthLogRepo: healthLogRepo,
+		guard:         guard,
 	}
 }
 
@@ -47,8 +50,10 @@ func (h *GetHealthLogByIDHandler) Handle(c context.Context, query GetHealthLogBy
 		return nil, fmt.Errorf("取得健康日誌失敗: %w", err)
 	}
 
-	// TODO: 這裡可能需要新增驗證邏輯，確保使用者擁有該寵物的健康日誌存取權限
-	// 這需要透過 PetRepository 來驗證寵物的擁有者。目前為了簡化先跳過此驗證
+	// 確認使用者可存取該健康日誌所屬的寵物
+	if _, err := h.guard.Authorize(ctx, log.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
 
 	ctx.Info("成功取得健康日誌", "log_id", log.ID, "pet_id", log.PetID)
 
@@ -0,0 +1,33 @@
+package query
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetHouseholdByIDQuery 表示根據 ID 取得家庭的查詢請求
+type GetHouseholdByIDQuery struct {
+	ID string `json:"id"`
+}
+
+// GetHouseholdByIDHandler 處理根據 ID 取得家庭的查詢，僅家庭成員可檢視
+type GetHouseholdByIDHandler struct {
+	guard *behavior.PetAccessGuard
+}
+
+// NewGetHouseholdByIDHandler 建立新的 GetHouseholdByIDHandler
+func NewGetHouseholdByIDHandler(guard *behavior.PetAccessGuard) *GetHouseholdByIDHandler {
+	if guard == nil {
+		panic("guard is required")
+	}
+	return &GetHouseholdByIDHandler{guard: guard}
+}
+
+// Handle 執行根據 ID 取得家庭的查詢
+func (h *GetHouseholdByIDHandler) Handle(c context.Context, query GetHouseholdByIDQuery) (*model.Household, error) {
+	ctx := contextx.WithContext(c)
+	return h.guard.AuthorizeHousehold(ctx, query.ID, model.PermissionRead)
+}
@@ -5,19 +5,28 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // GetMedicalRecordByIDHandler 負責根據 ID 取得醫療記錄
 type GetMedicalRecordByIDHandler struct {
-	repo repository.MedicalRecordRepository
+	repo  repository.MedicalRecordRepository
+	guard *behavior.PetAccessGuard
 }
 
 // NewGetMedicalRecordByIDHandler 建立 handler 實例
-func NewGetMedicalRecordByIDHandler(repo repository.MedicalRecordRepository) *GetMedicalRecordByIDHandler {
-	return &GetMedicalRecordByIDHandler{repo: repo}
+func NewGetMedicalRecordByIDHandler(repo repository.MedicalRecordRepository, guard *behavior.PetAccessGuard) *GetMedicalRecordByIDHandler {
+	return &GetMedicalRecordByIDHandler{repo: repo, guard: guard}
 }
 
 // Handle 執行查詢邏輯
 func (h *GetMedicalRecordByIDHandler) Handle(c context.Context, id string) (*model.MedicalRecord, error) {
-	return h.repo.FindByID(c, id)
+	record, err := h.repo.FindByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.guard.Authorize(c, record.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+	return record, nil
 }
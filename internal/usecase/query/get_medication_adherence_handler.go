@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetMedicationAdherenceQuery 封裝查詢療程服藥率的請求參數
+// 未指定期間時為最近 30 天，未指定分組單位時以週分組
+type GetMedicationAdherenceQuery struct {
+	RegimenID string
+	StartDate time.Time
+	EndDate   time.Time
+	Interval  model.TrendInterval
+}
+
+// GetMedicationAdherenceHandler 依區間統計療程的給藥狀態與服藥率
+type GetMedicationAdherenceHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	doseRepo    repository.DoseEventRepository
+	guard       *behavior.PetAccessGuard
+	grace       time.Duration
+}
+
+// NewGetMedicationAdherenceHandler 建立新的 handler 實例
+func NewGetMedicationAdherenceHandler(cfg config.Config, regimenRepo repository.MedicationRegimenRepository, doseRepo repository.DoseEventRepository, guard *behavior.PetAccessGuard) *GetMedicationAdherenceHandler {
+	if regimenRepo == nil || doseRepo == nil || guard == nil {
+		panic("regimenRepo, doseRepo and guard are required")
+	}
+	return &GetMedicationAdherenceHandler{regimenRepo: regimenRepo, doseRepo: doseRepo, guard: guard, grace: cfg.Reminder.MissedDoseGrace}
+}
+
+// Handle 執行查詢服藥率的流程
+func (h *GetMedicationAdherenceHandler) Handle(c context.Context, q GetMedicationAdherenceQuery) (*model.MedicationAdherence, error) {
+	ctx := contextx.WithContext(c)
+
+	interval := q.Interval
+	if interval == "" {
+		interval = model.TrendIntervalWeek
+	}
+	if err := behavior.ValidateTrendOptions(interval, behavior.DefaultTrendWindow, behavior.DefaultChangeWindow); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	start, end := q.StartDate, q.EndDate
+	if end.IsZero() {
+		end = now
+	}
+	if start.IsZero() {
+		start = end.AddDate(0, 0, -30)
+	}
+	if err := behavior.ValidateDoseRange(start, end); err != nil {
+		return nil, err
+	}
+
+	regimen, err := findReadableMedicationRegimen(ctx, h.regimenRepo, h.guard, q.RegimenID)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := h.doseRepo.FindByRegimen(ctx, regimen.ID, start, end)
+	if err != nil {
+		ctx.Error("查詢給藥紀錄失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, fmt.Errorf("查詢給藥紀錄失敗: %w", err)
+	}
+
+	events := behavior.BuildDoseEvents(regimen, recorded, start, end, h.grace, now)
+	return behavior.BuildMedicationAdherence(regimen, events, start, end, interval), nil
+}
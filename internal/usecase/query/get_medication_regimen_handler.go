@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetMedicationRegimenQuery 封裝查詢單一用藥療程的請求參數
+type GetMedicationRegimenQuery struct {
+	ID string
+}
+
+// GetMedicationRegimenHandler 處理查詢單一用藥療程的業務邏輯
+type GetMedicationRegimenHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	guard       *behavior.PetAccessGuard
+}
+
+// NewGetMedicationRegimenHandler 建立新的 handler 實例
+func NewGetMedicationRegimenHandler(regimenRepo repository.MedicationRegimenRepository, guard *behavior.PetAccessGuard) *GetMedicationRegimenHandler {
+	if regimenRepo == nil || guard == nil {
+		panic("regimenRepo and guard are required")
+	}
+	return &GetMedicationRegimenHandler{regimenRepo: regimenRepo, guard: guard}
+}
+
+// Handle 執行查詢單一用藥療程的流程
+func (h *GetMedicationRegimenHandler) Handle(c context.Context, q GetMedicationRegimenQuery) (*model.MedicationRegimen, error) {
+	return findReadableMedicationRegimen(contextx.WithContext(c), h.regimenRepo, h.guard, q.ID)
+}
+
+// findReadableMedicationRegimen 查找用藥療程並確認使用者可檢視其寵物
+func findReadableMedicationRegimen(
+	c context.Context,
+	regimenRepo repository.MedicationRegimenRepository,
+	guard *behavior.PetAccessGuard,
+	id string,
+) (*model.MedicationRegimen, error) {
+	regimen, err := regimenRepo.FindByID(c, id)
+	if err != nil {
+		return nil, fmt.Errorf("查找用藥療程失敗: %w", err)
+	}
+	if _, err := guard.Authorize(c, regimen.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+	return regimen, nil
+}
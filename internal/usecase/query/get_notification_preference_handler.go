@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetNotificationPreferenceHandler 處理取得目前使用者通知偏好的查詢
+type GetNotificationPreferenceHandler struct {
+	prefRepo repository.NotificationPreferenceRepository
+}
+
+// NewGetNotificationPreferenceHandler 建立新的 GetNotificationPreferenceHandler
+func NewGetNotificationPreferenceHandler(prefRepo repository.NotificationPreferenceRepository) *GetNotificationPreferenceHandler {
+	if prefRepo == nil {
+		panic("prefRepo is required")
+	}
+	return &GetNotificationPreferenceHandler{prefRepo: prefRepo}
+}
+
+// Handle 取得通知偏好，尚未設定時回傳空的設定
+func (h *GetNotificationPreferenceHandler) Handle(c context.Context) (*model.NotificationPreference, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	pref, err := h.prefRepo.FindByUserID(ctx, userID)
+	if domain.IsNotFound(err) {
+		return &model.NotificationPreference{
+			UserID:   userID,
+			Channels: []model.NotificationChannelSetting{},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查找通知偏好失敗: %w", err)
+	}
+
+	return pref, nil
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetPetAvatarQuery represents the request for resolving a public avatar URL.
+type GetPetAvatarQuery struct {
+	// Path is the part of the avatar URL after /api/v1/avatars/.
+	Path string
+}
+
+// GetPetAvatarHandler resolves public avatar URLs into short-lived download links.
+type GetPetAvatarHandler struct {
+	avatars *behavior.PetAvatarStore
+}
+
+// NewGetPetAvatarHandler creates a new GetPetAvatarHandler.
+func NewGetPetAvatarHandler(avatars *behavior.PetAvatarStore) *GetPetAvatarHandler {
+	if avatars == nil {
+		panic("avatars is required")
+	}
+	return &GetPetAvatarHandler{avatars: avatars}
+}
+
+// Handle returns a signed download URL for the requested avatar thumbnail.
+func (h *GetPetAvatarHandler) Handle(c context.Context, q GetPetAvatarQuery) (string, error) {
+	ctx := contextx.WithContext(c)
+
+	url, err := h.avatars.SignedURL(ctx, q.Path)
+	if err != nil {
+		ctx.Warn("failed to resolve pet avatar", "error", err, "path", q.Path)
+		return "", err
+	}
+	return url, nil
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
-	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -16,16 +16,16 @@ type GetPetByIDQuery struct {
 
 // GetPetByIDHandler handles the get pet by ID query.
 type GetPetByIDHandler struct {
-	petRepo repository.PetRepository
+	guard *behavior.PetAccessGuard
 }
 
 // NewGetPetByIDHandler creates a new GetPetByIDHandler.
-func NewGetPetByIDHandler(petRepo repository.PetRepository) *GetPetByIDHandler {
-	if petRepo == nil {
-		panic("petRepo is required")
+func NewGetPetByIDHandler(guard *behavior.PetAccessGuard) *GetPetByIDHandler {
+	if guard == nil {
+		panic("guard is required")
 	}
 	return &GetPetByIDHandler{
-		petRepo: petRepo,
+		guard: guard,
 	}
 }
 
@@ -40,14 +40,10 @@ func (h *GetPetByIDHandler) Handle(c context.Context, qry GetPetByIDQuery) (*mod
 
 	ctx.Info("handling get pet by id request", "user_id", userID, "pet_id", qry.ID)
 
-	pet, err := h.petRepo.FindByID(ctx, qry.ID)
+	// Authorization check through ownership or household membership
+	pet, err := h.guard.Authorize(ctx, qry.ID, model.PermissionRead)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find pet with id %s: %w", qry.ID, err)
-	}
-
-	// Authorization check
-	if pet.OwnerID != userID {
-		return nil, fmt.Errorf("user %s is not authorized to view pet %s", userID, qry.ID)
+		return nil, fmt.Errorf("failed to get pet with id %s: %w", qry.ID, err)
 	}
 
 	return pet, nil
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/domain/service"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetPetReportQuery 表示產生寵物就診用健康報告的請求
+// - StartDate/EndDate: 未指定時為過去一年到現在
+type GetPetReportQuery struct {
+	PetID     string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// GetPetReportHandler 彙整寵物資料、體重趨勢、醫療記錄與健康日誌觀察，輸出為 PDF 報告
+type GetPetReportHandler struct {
+	healthLogRepo     repository.HealthLogRepository
+	medicalRecordRepo repository.MedicalRecordRepository
+	guard             *behavior.PetAccessGuard
+	renderer          service.ReportRenderer
+	defaultThreshold  model.WeightAlertThreshold
+}
+
+// NewGetPetReportHandler 建立新的 GetPetReportHandler
+func NewGetPetReportHandler(
+	cfg config.Config,
+	healthLogRepo repository.HealthLogRepository,
+	medicalRecordRepo repository.MedicalRecordRepository,
+	guard *behavior.PetAccessGuard,
+	renderer service.ReportRenderer,
+) *GetPetReportHandler {
+	if healthLogRepo == nil || medicalRecordRepo == nil || guard == nil || renderer == nil {
+		panic("healthLogRepo, medicalRecordRepo, guard and renderer are required")
+	}
+	return &GetPetReportHandler{
+		healthLogRepo:     healthLogRepo,
+		medicalRecordRepo: medicalRecordRepo,
+		guard:             guard,
+		renderer:          renderer,
+		defaultThreshold: model.WeightAlertThreshold{
+			LossPct: cfg.Analytics.WeightLossAlertPct,
+			GainPct: cfg.Analytics.WeightGainAlertPct,
+		},
+	}
+}
+
+// Handle 產生寵物健康報告，寵物有自訂體重警示門檻時優先使用
+func (h *GetPetReportHandler) Handle(c context.Context, q GetPetReportQuery) (*model.RenderedReport, error) {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	if q.StartDate.IsZero() {
+		q.StartDate = now.AddDate(-1, 0, 0)
+	}
+	if q.EndDate.IsZero() {
+		q.EndDate = now
+	}
+	if err := behavior.ValidateReportRange(q.StartDate, q.EndDate); err != nil {
+		return nil, err
+	}
+
+	pet, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := h.healthLogRepo.FindByPetID(ctx, q.PetID, q.StartDate, q.EndDate, model.HealthLogFilter{})
+	if err != nil {
+		ctx.Error("查詢健康日誌失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢健康日誌失敗: %w", err)
+	}
+
+	// 疫苗需要完整的接種史，因此醫療記錄從最早查到報告結束日
+	records, err := h.medicalRecordRepo.FindByPetID(ctx, q.PetID, time.Time{}, q.EndDate)
+	if err != nil {
+		ctx.Error("查詢醫療記錄失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢醫療記錄失敗: %w", err)
+	}
+
+	threshold := h.defaultThreshold
+	if pet.WeightAlert != nil {
+		threshold = *pet.WeightAlert
+	}
+	report := behavior.BuildPetHealthReport(pet, logs, records, q.StartDate, q.EndDate, threshold, now)
+
+	var buf bytes.Buffer
+	if err := h.renderer.RenderPetHealthReport(ctx, &buf, report); err != nil {
+		ctx.Error("產生寵物健康報告失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("產生寵物健康報告失敗: %w", err)
+	}
+
+	return &model.RenderedReport{
+		FileName:    behavior.PetReportFileName(q.PetID, q.EndDate),
+		ContentType: behavior.PetReportContentType,
+		Content:     buf.Bytes(),
+	}, nil
+}
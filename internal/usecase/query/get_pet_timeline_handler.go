@@ -0,0 +1,193 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetPetTimelineQuery 表示查詢寵物時間軸的請求
+// - PetID: 空值代表使用者可存取的所有寵物
+// - StartDate/EndDate: 未指定時為前後一個月，期間不可超過 1 年
+// - Types: 只列出指定種類，空值代表所有種類
+// - Page: 分頁與排序條件，預設依日期由舊到新
+type GetPetTimelineQuery struct {
+	PetID     string
+	StartDate time.Time
+	EndDate   time.Time
+	Types     []model.TimelineItemType
+	Page      repository.PageRequest
+}
+
+// timelineSort 時間軸可排序的欄位
+var timelineSort = repository.SortSpec{Fields: []string{"date", "type"}, Field: "date", Order: repository.SortAsc}
+
+// timelineComparators 依各排序欄位比較兩個時間軸項目，相同時再依種類與 ID 排序讓分頁結果穩定
+var timelineComparators = map[string]func(a, b *model.TimelineItem) int{
+	"date": func(a, b *model.TimelineItem) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	},
+	"type": func(a, b *model.TimelineItem) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), a.Date.Compare(b.Date), cmp.Compare(a.ID, b.ID))
+	},
+}
+
+// GetPetTimelineHandler 合併健康日誌、醫療記錄、費用與醫療到期事件為依時間排序的時間軸
+type GetPetTimelineHandler struct {
+	healthLogRepo     repository.HealthLogRepository
+	medicalRecordRepo repository.MedicalRecordRepository
+	expenseRepo       repository.ExpenseRepository
+	guard             *behavior.PetAccessGuard
+}
+
+// NewGetPetTimelineHandler 建立新的 GetPetTimelineHandler
+func NewGetPetTimelineHandler(
+	healthLogRepo repository.HealthLogRepository,
+	medicalRecordRepo repository.MedicalRecordRepository,
+	expenseRepo repository.ExpenseRepository,
+	guard *behavior.PetAccessGuard,
+) *GetPetTimelineHandler {
+	if healthLogRepo == nil || medicalRecordRepo == nil || expenseRepo == nil || guard == nil {
+		panic("healthLogRepo, medicalRecordRepo, expenseRepo and guard are required")
+	}
+	return &GetPetTimelineHandler{
+		healthLogRepo:     healthLogRepo,
+		medicalRecordRepo: medicalRecordRepo,
+		expenseRepo:       expenseRepo,
+		guard:             guard,
+	}
+}
+
+// Handle 查詢期間內的時間軸項目，合併後分頁；Total 為各來源符合條件的總筆數
+func (h *GetPetTimelineHandler) Handle(c context.Context, q GetPetTimelineQuery) (*repository.Page[*model.TimelineItem], error) {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	if q.StartDate.IsZero() {
+		q.StartDate = now.AddDate(0, -1, 0)
+	}
+	if q.EndDate.IsZero() {
+		q.EndDate = now.AddDate(0, 1, 0)
+	}
+	if err := behavior.ValidateTimelineRange(q.StartDate, q.EndDate); err != nil {
+		return nil, err
+	}
+	if err := behavior.ValidateTimelineTypes(q.Types); err != nil {
+		return nil, err
+	}
+	page, err := q.Page.Resolve(timelineSort)
+	if err != nil {
+		return nil, err
+	}
+
+	petIDs, scope, err := petScopeOptions(ctx, h.guard, q.PetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 各來源依日期在資料庫排序並只取到本頁結尾，合併後再切出本頁
+	sourcePage := timelineSourcePage(page)
+
+	var (
+		logs     []*model.HealthLog
+		records  []*model.MedicalRecord
+		due      []*model.MedicalRecord
+		later    []*model.MedicalRecord
+		expenses []*model.Expense
+		total    int
+	)
+
+	for _, petID := range petIDs {
+		if behavior.IncludesTimelineType(q.Types, model.TimelineItemHealthLog) {
+			found, count, err := h.healthLogRepo.ListByPetID(ctx, petID, q.StartDate, q.EndDate, model.HealthLogFilter{}, sourcePage)
+			if err != nil {
+				ctx.Error("查詢健康日誌失敗", "error", err, "pet_id", petID)
+				return nil, fmt.Errorf("查詢健康日誌失敗: %w", err)
+			}
+			logs = append(logs, found...)
+			total += count
+		}
+
+		if behavior.IncludesTimelineType(q.Types, model.TimelineItemMedicalRecord) {
+			found, count, err := h.medicalRecordRepo.ListByPetID(ctx, petID, q.StartDate, q.EndDate, sourcePage)
+			if err != nil {
+				ctx.Error("查詢醫療記錄失敗", "error", err, "pet_id", petID)
+				return nil, fmt.Errorf("查詢醫療記錄失敗: %w", err)
+			}
+			records = append(records, found...)
+			total += count
+		}
+
+		if behavior.IncludesTimelineType(q.Types, model.TimelineItemDue) {
+			found, newer, err := h.findDueRecords(ctx, petID, q.StartDate, q.EndDate)
+			if err != nil {
+				return nil, err
+			}
+			due = append(due, found...)
+			later = append(later, newer...)
+		}
+	}
+
+	if behavior.IncludesTimelineType(q.Types, model.TimelineItemExpense) && len(petIDs) > 0 {
+		opts := append([]repository.ExpenseQueryOption{repository.WithDateRange(q.StartDate, q.EndDate), repository.WithPage(sourcePage)}, scope...)
+		var count int
+		expenses, count, err = h.expenseRepo.FindAll(ctx, opts...)
+		if err != nil {
+			ctx.Error("查詢費用失敗", "error", err, "pet_id", q.PetID)
+			return nil, fmt.Errorf("查詢費用失敗: %w", err)
+		}
+		total += count
+	}
+
+	items := behavior.BuildTimeline(logs, records, behavior.TimelineDueRecords(due, later), expenses, q.StartDate, q.EndDate, q.Types)
+	for _, item := range items {
+		if item.Type == model.TimelineItemDue {
+			total++
+		}
+	}
+
+	merged := repository.Paginate(items, page, timelineComparators)
+	return repository.NewPage(merged.Items, total, page), nil
+}
+
+// findDueRecords 查詢到期日落在期間內的醫療記錄，以及用來判斷是否已被取代的其後紀錄
+// 其後紀錄只需從最早一筆到期紀錄的日期查起，不必掃描整個醫療史
+func (h *GetPetTimelineHandler) findDueRecords(ctx *contextx.Contextx, petID string, start, end time.Time) ([]*model.MedicalRecord, []*model.MedicalRecord, error) {
+	due, err := h.medicalRecordRepo.FindDueByPetID(ctx, petID, start, end)
+	if err != nil {
+		ctx.Error("查詢到期醫療記錄失敗", "error", err, "pet_id", petID)
+		return nil, nil, fmt.Errorf("查詢到期醫療記錄失敗: %w", err)
+	}
+	if len(due) == 0 {
+		return nil, nil, nil
+	}
+
+	since := due[0].Date
+	for _, record := range due[1:] {
+		if record.Date.Before(since) {
+			since = record.Date
+		}
+	}
+	later, err := h.medicalRecordRepo.FindByPetID(ctx, petID, since, time.Time{})
+	if err != nil {
+		ctx.Error("查詢醫療記錄失敗", "error", err, "pet_id", petID)
+		return nil, nil, fmt.Errorf("查詢醫療記錄失敗: %w", err)
+	}
+	return due, later, nil
+}
+
+// timelineSourcePage 各來源的查詢條件：依日期以相同方向排序，取到本頁結尾為止；未分頁時取全部
+// 單一來源的項目種類相同，依日期排序即與時間軸的任一排序欄位一致
+func timelineSourcePage(page repository.PageRequest) repository.PageRequest {
+	source := repository.PageRequest{Sort: "date", Order: page.Order}
+	if page.Limit > 0 {
+		source.Limit = page.Offset + page.Limit
+	}
+	return source
+}
@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetRecurringExpenseQuery 封裝查詢單一週期性費用的請求參數
+type GetRecurringExpenseQuery struct {
+	ID string
+}
+
+// GetRecurringExpenseHandler 處理查詢單一週期性費用的業務邏輯
+type GetRecurringExpenseHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+}
+
+// NewGetRecurringExpenseHandler 建立新的 handler 實例
+func NewGetRecurringExpenseHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard) *GetRecurringExpenseHandler {
+	if recurringRepo == nil || guard == nil {
+		panic("recurringRepo and guard are required")
+	}
+	return &GetRecurringExpenseHandler{recurringRepo: recurringRepo, guard: guard}
+}
+
+// Handle 執行查詢單一週期性費用的流程
+func (h *GetRecurringExpenseHandler) Handle(c context.Context, q GetRecurringExpenseQuery) (*model.RecurringExpense, error) {
+	ctx := contextx.WithContext(c)
+
+	recurring, err := h.recurringRepo.FindByID(ctx, q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查找週期性費用失敗: %w", err)
+	}
+	if _, err := h.guard.Authorize(ctx, recurring.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return recurring, nil
+}
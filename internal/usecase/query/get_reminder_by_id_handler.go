@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetReminderByIDQuery 表示根據 ID 取得提醒的查詢請求
+type GetReminderByIDQuery struct {
+	ID string `json:"id"`
+}
+
+// GetReminderByIDHandler 處理根據 ID 取得提醒的查詢
+type GetReminderByIDHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+}
+
+// NewGetReminderByIDHandler 建立新的 GetReminderByIDHandler
+func NewGetReminderByIDHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard) *GetReminderByIDHandler {
+	if reminderRepo == nil || guard == nil {
+		panic("reminderRepo and guard are required")
+	}
+	return &GetReminderByIDHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+	}
+}
+
+// Handle 執行根據 ID 取得提醒的查詢
+func (h *GetReminderByIDHandler) Handle(c context.Context, query GetReminderByIDQuery) (*model.Reminder, error) {
+	ctx := contextx.WithContext(c)
+
+	reminder, err := h.reminderRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		ctx.Error("從倉儲取得提醒失敗", "error", err, "reminder_id", query.ID)
+		return nil, fmt.Errorf("取得提醒失敗: %w", err)
+	}
+
+	if _, err := h.guard.Authorize(ctx, reminder.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
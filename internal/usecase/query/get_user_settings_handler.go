@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetUserSettingsHandler 處理取得目前使用者設定的查詢
+type GetUserSettingsHandler struct {
+	settingsRepo repository.UserSettingsRepository
+	currencies   *behavior.CurrencyConverter
+}
+
+// NewGetUserSettingsHandler 建立新的 GetUserSettingsHandler
+func NewGetUserSettingsHandler(settingsRepo repository.UserSettingsRepository, currencies *behavior.CurrencyConverter) *GetUserSettingsHandler {
+	if settingsRepo == nil || currencies == nil {
+		panic("settingsRepo and currencies are required")
+	}
+	return &GetUserSettingsHandler{settingsRepo: settingsRepo, currencies: currencies}
+}
+
+// Handle 取得使用者設定，尚未設定時回傳系統預設值
+func (h *GetUserSettingsHandler) Handle(c context.Context) (*model.UserSettings, error) {
+	ctx := contextx.WithContext(c)
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	settings, err := h.settingsRepo.FindByUserID(ctx, userID)
+	if domain.IsNotFound(err) {
+		return &model.UserSettings{
+			UserID:       userID,
+			BaseCurrency: h.currencies.DefaultCurrency(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查找使用者設定失敗: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,105 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// GetWeightTrendQuery 表示查詢寵物體重趨勢的請求
+// - Interval: 分組單位，空值時以週分組
+// - StartDate/EndDate: 未指定時為過去一年到現在
+// - Window: 移動平均涵蓋的區間數，0 時使用預設值
+// - ChangeWindow: 變化百分比比較的區間數，0 時使用預設值
+type GetWeightTrendQuery struct {
+	PetID        string
+	Interval     model.TrendInterval
+	StartDate    time.Time
+	EndDate      time.Time
+	Window       int
+	ChangeWindow int
+	// Filter 依結構化觀察篩選納入計算的健康日誌
+	Filter model.HealthLogFilter
+}
+
+// GetWeightTrendHandler 依健康日誌計算寵物的體重趨勢與異常變化
+type GetWeightTrendHandler struct {
+	healthLogRepo    repository.HealthLogRepository
+	guard            *behavior.PetAccessGuard
+	defaultThreshold model.WeightAlertThreshold
+}
+
+// NewGetWeightTrendHandler 建立新的 GetWeightTrendHandler
+func NewGetWeightTrendHandler(cfg config.Config, healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard) *GetWeightTrendHandler {
+	if healthLogRepo == nil || guard == nil {
+		panic("healthLogRepo and guard are required")
+	}
+	return &GetWeightTrendHandler{
+		healthLogRepo: healthLogRepo,
+		guard:         guard,
+		defaultThreshold: model.WeightAlertThreshold{
+			LossPct: cfg.Analytics.WeightLossAlertPct,
+			GainPct: cfg.Analytics.WeightGainAlertPct,
+		},
+	}
+}
+
+// Handle 執行體重趨勢查詢，寵物有自訂警示門檻時優先使用
+func (h *GetWeightTrendHandler) Handle(c context.Context, q GetWeightTrendQuery) (*model.WeightTrend, error) {
+	ctx := contextx.WithContext(c)
+
+	if q.Interval == "" {
+		q.Interval = model.TrendIntervalWeek
+	}
+	if q.Window == 0 {
+		q.Window = behavior.DefaultTrendWindow
+	}
+	if q.ChangeWindow == 0 {
+		q.ChangeWindow = behavior.DefaultChangeWindow
+	}
+	if err := behavior.ValidateTrendOptions(q.Interval, q.Window, q.ChangeWindow); err != nil {
+		return nil, err
+	}
+	validator := &behavior.HealthLogValidator{}
+	if err := validator.ValidateFilter(q.Filter); err != nil {
+		return nil, err
+	}
+
+	if q.StartDate.IsZero() {
+		q.StartDate = time.Now().AddDate(-1, 0, 0)
+	}
+	if q.EndDate.IsZero() {
+		q.EndDate = time.Now()
+	}
+
+	pet, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := h.healthLogRepo.FindByPetID(ctx, q.PetID, q.StartDate, q.EndDate, q.Filter)
+	if err != nil {
+		ctx.Error("查詢健康日誌失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢健康日誌失敗: %w", err)
+	}
+
+	threshold := h.defaultThreshold
+	if pet.WeightAlert != nil {
+		threshold = *pet.WeightAlert
+	}
+
+	return &model.WeightTrend{
+		PetID:        q.PetID,
+		Interval:     q.Interval,
+		Window:       q.Window,
+		ChangeWindow: q.ChangeWindow,
+		Threshold:    threshold,
+		Points:       behavior.BuildWeightTrend(logs, q.Interval, q.Window, q.ChangeWindow, threshold),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListAttachmentsQuery 封裝查詢附件的請求參數
+// Page 為分頁與排序條件，預設依上傳時間由早到晚
+type ListAttachmentsQuery struct {
+	OwnerType model.AttachmentOwnerType
+	OwnerID   string
+	Page      repository.PageRequest
+}
+
+// attachmentSort 附件列表可排序的欄位
+var attachmentSort = repository.SortSpec{Fields: []string{"created_at", "file_name", "size"}, Field: "created_at", Order: repository.SortAsc}
+
+// attachmentComparators 依各排序欄位比較兩筆附件
+var attachmentComparators = map[string]func(a, b *model.Attachment) int{
+	"created_at": func(a, b *model.Attachment) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"file_name":  func(a, b *model.Attachment) int { return cmp.Compare(a.FileName, b.FileName) },
+	"size":       func(a, b *model.Attachment) int { return cmp.Compare(a.Size, b.Size) },
+}
+
+// ListAttachmentsHandler 處理查詢費用或醫療記錄附件的業務邏輯
+type ListAttachmentsHandler struct {
+	attachmentRepo repository.AttachmentRepository
+	owners         *behavior.AttachmentOwnerResolver
+}
+
+// NewListAttachmentsHandler 建立新的 handler 實例
+func NewListAttachmentsHandler(attachmentRepo repository.AttachmentRepository, owners *behavior.AttachmentOwnerResolver) *ListAttachmentsHandler {
+	if attachmentRepo == nil || owners == nil {
+		panic("attachmentRepo and owners are required")
+	}
+	return &ListAttachmentsHandler{attachmentRepo: attachmentRepo, owners: owners}
+}
+
+// Handle 執行查詢附件的流程並分頁
+func (h *ListAttachmentsHandler) Handle(c context.Context, q ListAttachmentsQuery) (*repository.Page[*model.Attachment], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(attachmentSort)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.owners.Authorize(ctx, q.OwnerType, q.OwnerID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	attachments, err := h.attachmentRepo.FindByOwner(ctx, q.OwnerType, q.OwnerID)
+	if err != nil {
+		ctx.Error("查詢附件失敗", "error", err, "owner_type", q.OwnerType, "owner_id", q.OwnerID)
+		return nil, fmt.Errorf("查詢附件失敗: %w", err)
+	}
+
+	return repository.Paginate(attachments, page, attachmentComparators), nil
+}
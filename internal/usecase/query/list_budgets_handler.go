@@ -0,0 +1,62 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListBudgetsQuery 封裝查詢預算清單的請求參數
+type ListBudgetsQuery struct {
+	// Page 分頁與排序條件，預設依建立時間由舊到新
+	Page repository.PageRequest
+}
+
+// budgetSort 預算列表可排序的欄位
+var budgetSort = repository.SortSpec{Fields: []string{"created_at", "limit"}, Field: "created_at", Order: repository.SortAsc}
+
+// budgetComparators 依各排序欄位比較兩筆預算
+var budgetComparators = map[string]func(a, b *model.Budget) int{
+	"created_at": func(a, b *model.Budget) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	"limit":      func(a, b *model.Budget) int { return cmp.Compare(a.Limit, b.Limit) },
+}
+
+// ListBudgetsHandler 列出目前使用者設定的預算
+type ListBudgetsHandler struct {
+	budgetRepo repository.BudgetRepository
+}
+
+// NewListBudgetsHandler 建立新的 handler 實例
+func NewListBudgetsHandler(budgetRepo repository.BudgetRepository) *ListBudgetsHandler {
+	if budgetRepo == nil {
+		panic("budgetRepo is required")
+	}
+	return &ListBudgetsHandler{budgetRepo: budgetRepo}
+}
+
+// Handle 執行查詢預算清單的流程
+func (h *ListBudgetsHandler) Handle(c context.Context, q ListBudgetsQuery) (*repository.Page[*model.Budget], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(budgetSort)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	budgets, err := h.budgetRepo.FindByOwnerID(ctx, userID)
+	if err != nil {
+		ctx.Error("查詢預算失敗", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("查詢預算失敗: %w", err)
+	}
+
+	return repository.Paginate(budgets, page, budgetComparators), nil
+}
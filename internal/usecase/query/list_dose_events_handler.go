@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListDoseEventsQuery 封裝查詢療程給藥紀錄的請求參數，未指定期間時為前 7 天至後 1 天
+type ListDoseEventsQuery struct {
+	RegimenID string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// ListDoseEventsHandler 列出療程在期間內排定的每次給藥，含照護者勾選的狀態與推算的 pending/missed
+type ListDoseEventsHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	doseRepo    repository.DoseEventRepository
+	guard       *behavior.PetAccessGuard
+	grace       time.Duration
+}
+
+// NewListDoseEventsHandler 建立新的 handler 實例
+func NewListDoseEventsHandler(cfg config.Config, regimenRepo repository.MedicationRegimenRepository, doseRepo repository.DoseEventRepository, guard *behavior.PetAccessGuard) *ListDoseEventsHandler {
+	if regimenRepo == nil || doseRepo == nil || guard == nil {
+		panic("regimenRepo, doseRepo and guard are required")
+	}
+	return &ListDoseEventsHandler{regimenRepo: regimenRepo, doseRepo: doseRepo, guard: guard, grace: cfg.Reminder.MissedDoseGrace}
+}
+
+// Handle 執行查詢給藥紀錄的流程
+func (h *ListDoseEventsHandler) Handle(c context.Context, q ListDoseEventsQuery) ([]*model.DoseEvent, error) {
+	ctx := contextx.WithContext(c)
+
+	now := time.Now()
+	start, end := q.StartDate, q.EndDate
+	if start.IsZero() {
+		start = now.AddDate(0, 0, -7)
+	}
+	if end.IsZero() {
+		end = now.AddDate(0, 0, 1)
+	}
+	if err := behavior.ValidateDoseRange(start, end); err != nil {
+		return nil, err
+	}
+
+	regimen, err := findReadableMedicationRegimen(ctx, h.regimenRepo, h.guard, q.RegimenID)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded, err := h.doseRepo.FindByRegimen(ctx, regimen.ID, start, end)
+	if err != nil {
+		ctx.Error("查詢給藥紀錄失敗", "error", err, "regimen_id", regimen.ID)
+		return nil, fmt.Errorf("查詢給藥紀錄失敗: %w", err)
+	}
+
+	return behavior.BuildDoseEvents(regimen, recorded, start, end, h.grace, now), nil
+}
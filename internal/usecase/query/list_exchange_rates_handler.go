@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListExchangeRatesQuery 封裝查詢匯率的請求參數
+type ListExchangeRatesQuery struct {
+	Currency string
+}
+
+// ListExchangeRatesResult 匯率查詢結果，匯率為 1 單位參考貨幣可兌換的 Currency 數量
+type ListExchangeRatesResult struct {
+	Reference string                `json:"reference"`
+	Rates     []*model.ExchangeRate `json:"rates"`
+}
+
+// ListExchangeRatesHandler 處理查詢指定幣別匯率表的業務邏輯
+type ListExchangeRatesHandler struct {
+	rateRepo   repository.ExchangeRateRepository
+	currencies *behavior.CurrencyConverter
+}
+
+// NewListExchangeRatesHandler 建立新的 handler 實例
+func NewListExchangeRatesHandler(rateRepo repository.ExchangeRateRepository, currencies *behavior.CurrencyConverter) *ListExchangeRatesHandler {
+	if rateRepo == nil || currencies == nil {
+		panic("rateRepo and currencies are required")
+	}
+	return &ListExchangeRatesHandler{rateRepo: rateRepo, currencies: currencies}
+}
+
+// Handle 執行查詢匯率的流程，依日期由早到晚排列
+func (h *ListExchangeRatesHandler) Handle(c context.Context, q ListExchangeRatesQuery) (*ListExchangeRatesResult, error) {
+	ctx := contextx.WithContext(c)
+
+	currency, err := behavior.NormalizeCurrency(q.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := h.rateRepo.FindByCurrency(ctx, currency)
+	if err != nil {
+		ctx.Error("查詢匯率失敗", "error", err, "currency", currency)
+		return nil, fmt.Errorf("查詢匯率失敗: %w", err)
+	}
+
+	return &ListExchangeRatesResult{Reference: h.currencies.ReferenceCurrency(), Rates: rates}, nil
+}
@@ -3,48 +3,92 @@ package query
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
 // ListExpensesByPetQuery 封裝根據寵物 ID 查詢費用列表的請求參數
+// PetID 為空時查詢使用者可存取的所有寵物；Category 為空、StartDate/EndDate 為零值時不限制
 type ListExpensesByPetQuery struct {
-	PetID   string                          `json:"pet_id"`
-	Options []repository.ExpenseQueryOption `json:"-"`
+	PetID     string                          `json:"pet_id"`
+	Category  string                          `json:"category,omitempty"`
+	StartDate time.Time                       `json:"start_date,omitempty"`
+	EndDate   time.Time                       `json:"end_date,omitempty"`
+	Options   []repository.ExpenseQueryOption `json:"-"`
+	// Page 分頁與排序條件，預設依日期由新到舊
+	Page repository.PageRequest `json:"-"`
 }
 
 // ListExpensesByPetHandler 處理查詢費用列表的業務邏輯
 type ListExpensesByPetHandler struct {
 	expenseRepo repository.ExpenseRepository
+	guard       *behavior.PetAccessGuard
 }
 
 // NewListExpensesByPetHandler 建立新的 handler 實例
-func NewListExpensesByPetHandler(expenseRepo repository.ExpenseRepository) *ListExpensesByPetHandler {
+func NewListExpensesByPetHandler(expenseRepo repository.ExpenseRepository, guard *behavior.PetAccessGuard) *ListExpensesByPetHandler {
 	return &ListExpensesByPetHandler{
 		expenseRepo: expenseRepo,
+		guard:       guard,
 	}
 }
 
 // Handle 執行根據寵物 ID 查詢費用列表的業務邏輯
-func (h *ListExpensesByPetHandler) Handle(c context.Context, query ListExpensesByPetQuery) ([]*model.Expense, int, error) {
+func (h *ListExpensesByPetHandler) Handle(c context.Context, query ListExpensesByPetQuery) (*repository.Page[*model.Expense], error) {
 	ctx := contextx.WithContext(c)
 
 	ctx.Info("開始根據寵物 ID 查詢費用列表", "pet_id", query.PetID)
 
-	// 組合查詢選項，加上 PetID 篩選
-	var opts []repository.ExpenseQueryOption
-	if query.PetID != "" {
-		opts = append(opts, repository.WithPetID(query.PetID))
+	page, err := query.Page.Resolve(repository.ExpenseSort)
+	if err != nil {
+		return nil, err
+	}
+
+	// 組合查詢選項：指定寵物時檢查存取權，未指定時限定為使用者可存取的寵物
+	_, scope, err := petScopeOptions(ctx, h.guard, query.PetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 範圍條件放在最後，避免被呼叫端的選項覆寫
+	opts := slices.Clone(query.Options)
+	if query.Category != "" {
+		opts = append(opts, repository.WithCategory(query.Category))
+	}
+	if !query.StartDate.IsZero() || !query.EndDate.IsZero() {
+		opts = append(opts, dateRangeOption(query.StartDate, query.EndDate))
 	}
+	opts = append(opts, repository.WithPage(page))
+	opts = append(opts, scope...)
 
 	expenses, total, err := h.expenseRepo.FindAll(ctx, opts...)
 	if err != nil {
 		ctx.Error("查詢費用列表失敗", "pet_id", query.PetID, "error", err)
-		return nil, 0, fmt.Errorf("根據寵物 ID 查詢費用列表失敗: %w", err)
+		return nil, fmt.Errorf("根據寵物 ID 查詢費用列表失敗: %w", err)
 	}
 
 	ctx.Info("成功查詢費用列表", "pet_id", query.PetID, "total", total, "count", len(expenses))
-	return expenses, total, nil
+	return repository.NewPage(expenses, total, page), nil
+}
+
+// petScopeOptions 依是否指定寵物產生費用查詢的範圍條件，並回傳範圍內的寵物 ID
+// 未指定寵物時限定為使用者自己的與所屬家庭的寵物，不會查到其他使用者的費用
+func petScopeOptions(c context.Context, guard *behavior.PetAccessGuard, petID string) ([]string, []repository.ExpenseQueryOption, error) {
+	if petID != "" {
+		if _, err := guard.Authorize(c, petID, model.PermissionRead); err != nil {
+			return nil, nil, err
+		}
+		return []string{petID}, []repository.ExpenseQueryOption{repository.WithPetID(petID)}, nil
+	}
+
+	petIDs, err := guard.AccessiblePetIDs(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return petIDs, []repository.ExpenseQueryOption{repository.WithPetIDs(petIDs)}, nil
 }
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListExportsQuery 封裝查詢匯出工作列表的請求參數
+type ListExportsQuery struct {
+	// Page 分頁與排序條件，預設依建立時間由新到舊
+	Page repository.PageRequest
+}
+
+// exportSort 匯出工作列表可排序的欄位
+var exportSort = repository.SortSpec{Fields: []string{"created_at"}, Field: "created_at", Order: repository.SortDesc}
+
+// exportComparators 依各排序欄位比較兩筆匯出工作
+var exportComparators = map[string]func(a, b *model.ExportJob) int{
+	"created_at": func(a, b *model.ExportJob) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListExportsHandler 處理查詢使用者匯出工作的業務邏輯
+type ListExportsHandler struct {
+	jobRepo repository.ExportJobRepository
+}
+
+// NewListExportsHandler 建立新的 handler 實例
+func NewListExportsHandler(jobRepo repository.ExportJobRepository) *ListExportsHandler {
+	if jobRepo == nil {
+		panic("jobRepo is required")
+	}
+	return &ListExportsHandler{jobRepo: jobRepo}
+}
+
+// Handle 列出使用者尚未逾期的匯出工作，預設由新到舊排列
+func (h *ListExportsHandler) Handle(c context.Context, q ListExportsQuery) (*repository.Page[*model.ExportJob], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(exportSort)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	jobs, err := h.jobRepo.FindByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查詢匯出工作失敗: %w", err)
+	}
+	return repository.Paginate(jobs, page, exportComparators), nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
@@ -15,25 +16,33 @@ type ListHealthLogsByPetQuery struct {
 	PetID     string    `json:"pet_id"`
 	StartDate time.Time `json:"start_date,omitempty"`
 	EndDate   time.Time `json:"end_date,omitempty"`
+
+	// Filter 依結構化觀察篩選，例如只列出有嘔吐的日子
+	Filter model.HealthLogFilter `json:"-"`
+
+	// Page 分頁與排序條件，預設依日期由新到舊
+	Page repository.PageRequest `json:"-"`
 }
 
 // ListHealthLogsByPetHandler 處理列出寵物健康日誌的查詢
 type ListHealthLogsByPetHandler struct {
 	healthLogRepo repository.HealthLogRepository
+	guard         *behavior.PetAccessGuard
 }
 
 // NewListHealthLogsByPetHandler 建立新的 ListHealthLogsByPetHandler
-func NewListHealthLogsByPetHandler(healthLogRepo repository.HealthLogRepository) *ListHealthLogsByPetHandler {
-	if healthLogRepo == nil {
-		panic("healthLogRepo is required")
+func NewListHealthLogsByPetHandler(healthLogRepo repository.HealthLogRepository, guard *behavior.PetAccessGuard) *ListHealthLogsByPetHandler {
+	if healthLogRepo == nil || guard == nil {
+		panic("healthLogRepo and guard are required")
 	}
 	return &ListHealthLogsByPetHandler{
 		healthLogRepo: healthLogRepo,
+		guard:         guard,
 	}
 }
 
 // Handle 執行列出寵物健康日誌的查詢
-func (h *ListHealthLogsByPetHandler) Handle(c context.Context, query ListHealthLogsByPetQuery) ([]*model.HealthLog, error) {
+func (h *ListHealthLogsByPetHandler) Handle(c context.Context, query ListHealthLogsByPetQuery) (*repository.Page[*model.HealthLog], error) {
 	ctx := contextx.WithContext(c)
 
 	userID, err := contextx.GetUserID(ctx)
@@ -41,6 +50,11 @@ func (h *ListHealthLogsByPetHandler) Handle(c context.Context, query ListHealthL
 		return nil, fmt.Errorf("user ID not found in context: %w", err)
 	}
 
+	page, err := query.Page.Resolve(repository.HealthLogSort)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx.Info("處理列出健康日誌查詢請求", "user_id", userID, "pet_id", query.PetID)
 
 	// 如果沒有指定日期範圍，設定預設值（過去一年到現在）
@@ -55,17 +69,24 @@ func (h *ListHealthLogsByPetHandler) Handle(c context.Context, query ListHealthL
 		endDate = time.Now() // 現在
 	}
 
-	// TODO: 這裡可能需要新增驗證邏輯，確保使用者擁有該寵物的存取權限
-	// 這需要透過 PetRepository 來驗證寵物的擁有者。目前為了簡化先跳過此驗證
+	validator := &behavior.HealthLogValidator{}
+	if err := validator.ValidateFilter(query.Filter); err != nil {
+		return nil, err
+	}
+
+	// 確認使用者可檢視該寵物
+	if _, err := h.guard.Authorize(ctx, query.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
 
 	// 從倉儲取得健康日誌列表
-	logs, err := h.healthLogRepo.FindByPetID(ctx, query.PetID, startDate, endDate)
+	logs, total, err := h.healthLogRepo.ListByPetID(ctx, query.PetID, startDate, endDate, query.Filter, page)
 	if err != nil {
 		ctx.Error("從倉儲取得健康日誌列表失敗", "error", err, "pet_id", query.PetID)
 		return nil, fmt.Errorf("取得健康日誌列表失敗: %w", err)
 	}
 
-	ctx.Info("成功取得健康日誌列表", "pet_id", query.PetID, "count", len(logs))
+	ctx.Info("成功取得健康日誌列表", "pet_id", query.PetID, "count", len(logs), "total", total)
 
-	return logs, nil
+	return repository.NewPage(logs, total, page), nil
 }
\ No newline at end of file
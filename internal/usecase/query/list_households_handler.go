@@ -0,0 +1,71 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListHouseholdsQuery 封裝列出使用者所屬家庭的請求參數
+type ListHouseholdsQuery struct {
+	// Page 分頁與排序條件，預設依名稱排序
+	Page repository.PageRequest
+}
+
+// householdSort 家庭列表可排序的欄位
+var householdSort = repository.SortSpec{Fields: []string{"name", "created_at"}, Field: "name", Order: repository.SortAsc}
+
+// householdComparators 依各排序欄位比較兩個家庭
+var householdComparators = map[string]func(a, b *model.Household) int{
+	"name":       func(a, b *model.Household) int { return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)) },
+	"created_at": func(a, b *model.Household) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListHouseholdsHandler 處理列出使用者所屬家庭的查詢，已到期的成員資格不列出
+type ListHouseholdsHandler struct {
+	householdRepo repository.HouseholdRepository
+}
+
+// NewListHouseholdsHandler 建立新的 ListHouseholdsHandler
+func NewListHouseholdsHandler(householdRepo repository.HouseholdRepository) *ListHouseholdsHandler {
+	if householdRepo == nil {
+		panic("householdRepo is required")
+	}
+	return &ListHouseholdsHandler{householdRepo: householdRepo}
+}
+
+// Handle 執行列出使用者所屬家庭的查詢
+func (h *ListHouseholdsHandler) Handle(c context.Context, q ListHouseholdsQuery) (*repository.Page[*model.Household], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(householdSort)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	households, err := h.householdRepo.FindByMemberUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("查找使用者家庭失敗: %w", err)
+	}
+
+	now := time.Now()
+	active := make([]*model.Household, 0, len(households))
+	for _, household := range households {
+		if household.Allows(userID, model.PermissionRead, now) {
+			active = append(active, household)
+		}
+	}
+
+	return repository.Paginate(active, page, householdComparators), nil
+}
@@ -6,19 +6,33 @@ import (
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 )
 
 // ListMedicalRecordsByPetHandler 負責依寵物 ID 及日期範圍查詢醫療記錄
 type ListMedicalRecordsByPetHandler struct {
-	repo repository.MedicalRecordRepository
+	repo  repository.MedicalRecordRepository
+	guard *behavior.PetAccessGuard
 }
 
 // NewListMedicalRecordsByPetHandler 建立 handler 實例
-func NewListMedicalRecordsByPetHandler(repo repository.MedicalRecordRepository) *ListMedicalRecordsByPetHandler {
-	return &ListMedicalRecordsByPetHandler{repo: repo}
+func NewListMedicalRecordsByPetHandler(repo repository.MedicalRecordRepository, guard *behavior.PetAccessGuard) *ListMedicalRecordsByPetHandler {
+	return &ListMedicalRecordsByPetHandler{repo: repo, guard: guard}
 }
 
-// Handle 執行查詢邏輯
-func (h *ListMedicalRecordsByPetHandler) Handle(c context.Context, petID string, startDate, endDate time.Time) ([]*model.MedicalRecord, error) {
-	return h.repo.FindByPetID(c, petID, startDate, endDate)
+// Handle 執行查詢邏輯，預設依日期由新到舊分頁
+func (h *ListMedicalRecordsByPetHandler) Handle(c context.Context, petID string, startDate, endDate time.Time, page repository.PageRequest) (*repository.Page[*model.MedicalRecord], error) {
+	page, err := page.Resolve(repository.MedicalRecordSort)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.guard.Authorize(c, petID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	records, total, err := h.repo.ListByPetID(c, petID, startDate, endDate, page)
+	if err != nil {
+		return nil, err
+	}
+	return repository.NewPage(records, total, page), nil
 }
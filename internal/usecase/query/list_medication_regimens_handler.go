@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListMedicationRegimensQuery 封裝查詢用藥療程清單的請求參數，PetID 為空表示所有可存取的寵物
+type ListMedicationRegimensQuery struct {
+	PetID string
+	// Page 分頁與排序條件，預設依開始日期由舊到新
+	Page repository.PageRequest
+}
+
+// medicationRegimenSort 用藥療程列表可排序的欄位
+var medicationRegimenSort = repository.SortSpec{Fields: []string{"start_date", "drug", "created_at"}, Field: "start_date", Order: repository.SortAsc}
+
+// medicationRegimenComparators 依各排序欄位比較兩筆用藥療程
+var medicationRegimenComparators = map[string]func(a, b *model.MedicationRegimen) int{
+	"start_date": func(a, b *model.MedicationRegimen) int { return a.StartDate.Compare(b.StartDate) },
+	"drug": func(a, b *model.MedicationRegimen) int {
+		return strings.Compare(strings.ToLower(a.Drug), strings.ToLower(b.Drug))
+	},
+	"created_at": func(a, b *model.MedicationRegimen) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListMedicationRegimensHandler 列出使用者可存取寵物的用藥療程
+type ListMedicationRegimensHandler struct {
+	regimenRepo repository.MedicationRegimenRepository
+	guard       *behavior.PetAccessGuard
+}
+
+// NewListMedicationRegimensHandler 建立新的 handler 實例
+func NewListMedicationRegimensHandler(regimenRepo repository.MedicationRegimenRepository, guard *behavior.PetAccessGuard) *ListMedicationRegimensHandler {
+	if regimenRepo == nil || guard == nil {
+		panic("regimenRepo and guard are required")
+	}
+	return &ListMedicationRegimensHandler{regimenRepo: regimenRepo, guard: guard}
+}
+
+// Handle 執行查詢用藥療程清單的流程
+func (h *ListMedicationRegimensHandler) Handle(c context.Context, q ListMedicationRegimensQuery) (*repository.Page[*model.MedicationRegimen], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(medicationRegimenSort)
+	if err != nil {
+		return nil, err
+	}
+
+	petIDs := []string{q.PetID}
+	if q.PetID != "" {
+		if _, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if petIDs, err = h.guard.AccessiblePetIDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	regimens, err := h.regimenRepo.FindByPetIDs(ctx, petIDs)
+	if err != nil {
+		ctx.Error("查詢用藥療程失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢用藥療程失敗: %w", err)
+	}
+
+	return repository.Paginate(regimens, page, medicationRegimenComparators), nil
+}
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListPetHistoryQuery 表示查詢寵物異動歷程的請求
+// - EntityType: 只列出指定種類的資料異動，空值代表所有種類
+// - StartDate/EndDate: 異動時間範圍，零值代表不限
+// - Page: 分頁條件，異動歷程固定依時間排序，預設由新到舊
+type ListPetHistoryQuery struct {
+	PetID      string
+	EntityType model.AuditEntityType
+	StartDate  time.Time
+	EndDate    time.Time
+	Page       repository.PageRequest
+}
+
+// petHistorySort 異動歷程列表可排序的欄位
+var petHistorySort = repository.SortSpec{Fields: []string{"created_at"}, Field: "created_at", Order: repository.SortDesc}
+
+// petHistoryComparators 依各排序欄位比較兩筆異動紀錄
+var petHistoryComparators = map[string]func(a, b *model.AuditEntry) int{
+	"created_at": func(a, b *model.AuditEntry) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListPetHistoryHandler 列出寵物與其相關紀錄的異動歷程
+type ListPetHistoryHandler struct {
+	auditRepo repository.AuditRepository
+	guard     *behavior.PetAccessGuard
+}
+
+// NewListPetHistoryHandler 建立新的 ListPetHistoryHandler
+func NewListPetHistoryHandler(auditRepo repository.AuditRepository, guard *behavior.PetAccessGuard) *ListPetHistoryHandler {
+	if auditRepo == nil || guard == nil {
+		panic("auditRepo and guard are required")
+	}
+	return &ListPetHistoryHandler{auditRepo: auditRepo, guard: guard}
+}
+
+// Handle 執行查詢寵物異動歷程並分頁
+func (h *ListPetHistoryHandler) Handle(c context.Context, q ListPetHistoryQuery) (*repository.Page[*model.AuditEntry], error) {
+	ctx := contextx.WithContext(c)
+
+	if err := behavior.ValidateAuditEntityType(q.EntityType); err != nil {
+		return nil, err
+	}
+	page, err := q.Page.Resolve(petHistorySort)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	entries, err := h.auditRepo.FindByPetID(ctx, q.PetID, q.EntityType, q.StartDate, q.EndDate)
+	if err != nil {
+		ctx.Error("查詢寵物異動歷程失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢寵物異動歷程失敗: %w", err)
+	}
+
+	return repository.Paginate(entries, page, petHistoryComparators), nil
+}
@@ -1,38 +1,59 @@
 package query
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/blackhorseya/petlog/internal/domain/model"
 	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
 	"github.com/blackhorseya/petlog/pkg/contextx"
 )
 
 // ListPetsByOwnerQuery represents the request for listing pets by owner.
 type ListPetsByOwnerQuery struct {
-	// Pagination fields can be added here, e.g., Page, PageSize
+	// Page holds the pagination and sort options; pets are sorted by name by default.
+	Page repository.PageRequest
+}
+
+// petSort lists the sortable fields of the pet list.
+var petSort = repository.SortSpec{Fields: []string{"name", "dob", "created_at"}, Field: "name", Order: repository.SortAsc}
+
+// petComparators compares pets by each sortable field.
+var petComparators = map[string]func(a, b *model.Pet) int{
+	"name":       func(a, b *model.Pet) int { return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)) },
+	"dob":        func(a, b *model.Pet) int { return a.DOB.Compare(b.DOB) },
+	"created_at": func(a, b *model.Pet) int { return a.CreatedAt.Compare(b.CreatedAt) },
 }
 
 // ListPetsByOwnerHandler handles the list pets by owner query.
+// Pets shared through the user's households are included.
 type ListPetsByOwnerHandler struct {
-	petRepo repository.PetRepository
+	guard *behavior.PetAccessGuard
 }
 
 // NewListPetsByOwnerHandler creates a new ListPetsByOwnerHandler.
-func NewListPetsByOwnerHandler(petRepo repository.PetRepository) *ListPetsByOwnerHandler {
-	if petRepo == nil {
-		panic("petRepo is required")
+func NewListPetsByOwnerHandler(guard *behavior.PetAccessGuard) *ListPetsByOwnerHandler {
+	if guard == nil {
+		panic("guard is required")
 	}
 	return &ListPetsByOwnerHandler{
-		petRepo: petRepo,
+		guard: guard,
 	}
 }
 
 // Handle executes the list pets by owner query.
-func (h *ListPetsByOwnerHandler) Handle(c context.Context, qry ListPetsByOwnerQuery) ([]*model.Pet, error) {
+// Owned and shared pets are merged before paging, so the page is sorted in memory.
+func (h *ListPetsByOwnerHandler) Handle(c context.Context, qry ListPetsByOwnerQuery) (*repository.Page[*model.Pet], error) {
 	ctx := contextx.WithContext(c)
 
+	page, err := qry.Page.Resolve(petSort)
+	if err != nil {
+		return nil, err
+	}
+
 	userID, err := contextx.GetUserID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("user ID not found in context: %w", err)
@@ -40,10 +61,10 @@ func (h *ListPetsByOwnerHandler) Handle(c context.Context, qry ListPetsByOwnerQu
 
 	ctx.Info("handling list pets by owner request", "user_id", userID)
 
-	pets, err := h.petRepo.FindByOwnerID(ctx, userID)
+	pets, err := h.guard.AccessiblePets(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find pets for owner %s: %w", userID, err)
 	}
 
-	return pets, nil
+	return repository.Paginate(pets, page, petComparators), nil
 }
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListRecurringExpensesQuery 封裝查詢週期性費用清單的請求參數，PetID 為空表示所有可存取的寵物
+type ListRecurringExpensesQuery struct {
+	PetID string
+	// Page 分頁與排序條件，預設依開始日期由舊到新
+	Page repository.PageRequest
+}
+
+// recurringExpenseSort 週期性費用列表可排序的欄位
+var recurringExpenseSort = repository.SortSpec{Fields: []string{"start_date", "amount", "created_at"}, Field: "start_date", Order: repository.SortAsc}
+
+// recurringExpenseComparators 依各排序欄位比較兩筆週期性費用
+var recurringExpenseComparators = map[string]func(a, b *model.RecurringExpense) int{
+	"start_date": func(a, b *model.RecurringExpense) int { return a.StartDate.Compare(b.StartDate) },
+	"amount":     func(a, b *model.RecurringExpense) int { return cmp.Compare(a.Amount, b.Amount) },
+	"created_at": func(a, b *model.RecurringExpense) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListRecurringExpensesHandler 列出使用者可存取寵物的週期性費用
+type ListRecurringExpensesHandler struct {
+	recurringRepo repository.RecurringExpenseRepository
+	guard         *behavior.PetAccessGuard
+}
+
+// NewListRecurringExpensesHandler 建立新的 handler 實例
+func NewListRecurringExpensesHandler(recurringRepo repository.RecurringExpenseRepository, guard *behavior.PetAccessGuard) *ListRecurringExpensesHandler {
+	if recurringRepo == nil || guard == nil {
+		panic("recurringRepo and guard are required")
+	}
+	return &ListRecurringExpensesHandler{recurringRepo: recurringRepo, guard: guard}
+}
+
+// Handle 執行查詢週期性費用清單的流程
+func (h *ListRecurringExpensesHandler) Handle(c context.Context, q ListRecurringExpensesQuery) (*repository.Page[*model.RecurringExpense], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := q.Page.Resolve(recurringExpenseSort)
+	if err != nil {
+		return nil, err
+	}
+
+	petIDs := []string{q.PetID}
+	if q.PetID != "" {
+		if _, err := h.guard.Authorize(ctx, q.PetID, model.PermissionRead); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if petIDs, err = h.guard.AccessiblePetIDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	recurrings, err := h.recurringRepo.FindByPetIDs(ctx, petIDs)
+	if err != nil {
+		ctx.Error("查詢週期性費用失敗", "error", err, "pet_id", q.PetID)
+		return nil, fmt.Errorf("查詢週期性費用失敗: %w", err)
+	}
+
+	return repository.Paginate(recurrings, page, recurringExpenseComparators), nil
+}
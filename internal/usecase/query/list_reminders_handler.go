@@ -0,0 +1,89 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListRemindersQuery 表示列出提醒的查詢請求
+// PetID 為空時列出使用者所有寵物的提醒
+type ListRemindersQuery struct {
+	PetID    string                 `json:"pet_id,omitempty"`
+	Statuses []model.ReminderStatus `json:"statuses,omitempty"`
+	// Page 分頁與排序條件，預設依到期日由近到遠
+	Page repository.PageRequest `json:"-"`
+}
+
+// reminderSort 提醒列表可排序的欄位
+var reminderSort = repository.SortSpec{Fields: []string{"due_date", "remind_at", "title", "created_at"}, Field: "due_date", Order: repository.SortAsc}
+
+// reminderComparators 依各排序欄位比較兩筆提醒
+var reminderComparators = map[string]func(a, b *model.Reminder) int{
+	"due_date":   func(a, b *model.Reminder) int { return a.DueDate.Compare(b.DueDate) },
+	"remind_at":  func(a, b *model.Reminder) int { return a.RemindAt.Compare(b.RemindAt) },
+	"title":      func(a, b *model.Reminder) int { return cmp.Compare(a.Title, b.Title) },
+	"created_at": func(a, b *model.Reminder) int { return a.CreatedAt.Compare(b.CreatedAt) },
+}
+
+// ListRemindersHandler 處理列出提醒的查詢
+type ListRemindersHandler struct {
+	reminderRepo repository.ReminderRepository
+	guard        *behavior.PetAccessGuard
+}
+
+// NewListRemindersHandler 建立新的 ListRemindersHandler
+func NewListRemindersHandler(reminderRepo repository.ReminderRepository, guard *behavior.PetAccessGuard) *ListRemindersHandler {
+	if reminderRepo == nil || guard == nil {
+		panic("reminderRepo and guard are required")
+	}
+	return &ListRemindersHandler{
+		reminderRepo: reminderRepo,
+		guard:        guard,
+	}
+}
+
+// Handle 執行列出提醒的查詢
+func (h *ListRemindersHandler) Handle(c context.Context, query ListRemindersQuery) (*repository.Page[*model.Reminder], error) {
+	ctx := contextx.WithContext(c)
+
+	page, err := query.Page.Resolve(reminderSort)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := contextx.GetUserID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("user ID not found in context: %w", err)
+	}
+
+	ctx.Info("處理列出提醒查詢請求", "user_id", userID, "pet_id", query.PetID)
+
+	var petIDs []string
+	if query.PetID != "" {
+		if _, err := h.guard.Authorize(ctx, query.PetID, model.PermissionRead); err != nil {
+			return nil, err
+		}
+		petIDs = []string{query.PetID}
+	} else {
+		petIDs, err = h.guard.AccessiblePetIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reminders, err := h.reminderRepo.FindByPetIDs(ctx, petIDs, query.Statuses...)
+	if err != nil {
+		ctx.Error("從倉儲取得提醒列表失敗", "error", err)
+		return nil, fmt.Errorf("取得提醒列表失敗: %w", err)
+	}
+
+	ctx.Info("成功取得提醒列表", "count", len(reminders))
+
+	return repository.Paginate(reminders, page, reminderComparators), nil
+}
@@ -0,0 +1,120 @@
+package query
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/domain/repository"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+)
+
+// ListTrashQuery 表示列出垃圾桶項目的查詢請求
+// - Type: 只列出指定種類，空值代表所有種類
+// - PetID: 只列出指定寵物的項目
+// - Page: 分頁與排序條件，預設依刪除時間由新到舊
+type ListTrashQuery struct {
+	Type  model.TrashItemType
+	PetID string
+	Page  repository.PageRequest
+}
+
+// trashSort 垃圾桶列表可排序的欄位
+var trashSort = repository.SortSpec{Fields: []string{"deleted_at", "type"}, Field: "deleted_at", Order: repository.SortDesc}
+
+// trashComparators 依各排序欄位比較兩筆垃圾桶項目
+var trashComparators = map[string]func(a, b *model.TrashItem) int{
+	"deleted_at": func(a, b *model.TrashItem) int { return a.DeletedAt.Compare(b.DeletedAt) },
+	"type":       func(a, b *model.TrashItem) int { return cmp.Compare(a.Type, b.Type) },
+}
+
+// ListTrashHandler 列出目前使用者可檢視的封存寵物與已刪除紀錄
+// 隨寵物一同封存的紀錄不會個別列出，還原寵物時會一併還原
+type ListTrashHandler struct {
+	archiveRepo repository.PetArchiveRepository
+	trashRepo   repository.TrashRepository
+	guard       *behavior.PetAccessGuard
+}
+
+// NewListTrashHandler 建立新的 ListTrashHandler
+func NewListTrashHandler(
+	archiveRepo repository.PetArchiveRepository,
+	trashRepo repository.TrashRepository,
+	guard *behavior.PetAccessGuard,
+) *ListTrashHandler {
+	if archiveRepo == nil || trashRepo == nil || guard == nil {
+		panic("archiveRepo, trashRepo and guard are required")
+	}
+	return &ListTrashHandler{
+		archiveRepo: archiveRepo,
+		trashRepo:   trashRepo,
+		guard:       guard,
+	}
+}
+
+// Handle 執行列出垃圾桶項目的查詢，封存寵物與已刪除紀錄合併後分頁
+func (h *ListTrashHandler) Handle(c context.Context, q ListTrashQuery) (*repository.Page[*model.TrashItem], error) {
+	ctx := contextx.WithContext(c)
+
+	if err := behavior.ValidateTrashItemType(q.Type, true); err != nil {
+		return nil, err
+	}
+	page, err := q.Page.Resolve(trashSort)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.TrashItem, 0)
+
+	if q.Type == "" || q.Type == model.TrashItemPet {
+		userID, householdIDs, err := h.guard.AccessScope(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pets, err := h.archiveRepo.FindArchivedByScope(ctx, userID, householdIDs)
+		if err != nil {
+			return nil, fmt.Errorf("查找封存寵物失敗: %w", err)
+		}
+
+		for _, pet := range pets {
+			if q.PetID != "" && pet.ID != q.PetID {
+				continue
+			}
+			items = append(items, &model.TrashItem{
+				Type:      model.TrashItemPet,
+				ID:        pet.ID,
+				PetID:     pet.ID,
+				Summary:   pet.Name,
+				DeletedAt: *pet.DeletedAt,
+			})
+		}
+	}
+
+	if q.Type != model.TrashItemPet {
+		petIDs, err := h.guard.AccessiblePetIDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if q.PetID != "" {
+			filtered := make([]string, 0, 1)
+			for _, id := range petIDs {
+				if id == q.PetID {
+					filtered = append(filtered, id)
+				}
+			}
+			petIDs = filtered
+		}
+
+		records, err := h.trashRepo.FindByPetIDs(ctx, petIDs, q.Type)
+		if err != nil {
+			return nil, fmt.Errorf("查找已刪除紀錄失敗: %w", err)
+		}
+		items = append(items, records...)
+	}
+
+	return repository.Paginate(items, page, trashComparators), nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/domain/model"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/blackhorseya/petlog/pkg/contextx"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// 命令列參數
+	inputFile  string
+	entity     string
+	format     string
+	userID     string
+	petID      string
+	mapping    map[string]string
+	dryRun     bool
+	reportFile string
+)
+
+// rootCmd 根命令
+var rootCmd = &cobra.Command{
+	Use:   "import-records",
+	Short: "批次匯入健康日誌或費用",
+	Long: `從 CSV 或 XLSX 檔案讀取健康日誌或費用，以指定使用者的身分驗證後寫入 MongoDB
+欄位名稱與資料匯出的 CSV 相同，其他名稱可用 --map 對應；每列以去重鍵寫入，重複執行不會產生重複資料`,
+	RunE: runImport,
+}
+
+func init() {
+	// 設定命令列參數
+	rootCmd.Flags().StringVarP(&inputFile, "input", "i", "", "輸入的 CSV 或 XLSX 檔案路徑")
+	rootCmd.Flags().StringVarP(&entity, "entity", "e", string(model.ImportEntityHealthLogs), "資料種類：health_logs 或 expenses")
+	rootCmd.Flags().StringVarP(&format, "format", "f", "", "檔案格式：csv 或 xlsx，未指定時依副檔名判斷")
+	rootCmd.Flags().StringVarP(&userID, "user", "u", "", "以此使用者 ID 的身分匯入，需有寵物的寫入權限")
+	rootCmd.Flags().StringVarP(&petID, "pet", "p", "", "預設寵物 ID，檔案沒有 pet_id 欄位或欄位空白時使用")
+	rootCmd.Flags().StringToStringVarP(&mapping, "map", "m", nil, "欄位對應，例如 date=日期,weight_kg=體重")
+	rootCmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "預覽模式，只驗證不實際寫入資料庫")
+	rootCmd.Flags().StringVarP(&reportFile, "report", "r", "", "將完整的逐列報告以 JSON 寫入此檔案")
+	_ = rootCmd.MarkFlagRequired("input")
+	_ = rootCmd.MarkFlagRequired("user")
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runImport 執行匯入邏輯
+func runImport(cmd *cobra.Command, args []string) error {
+	ctx := contextx.WithUserID(context.Background(), userID)
+
+	// 載入配置
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("配置載入失敗: %v", err)
+	}
+
+	importFormat := model.ImportFormat(format)
+	if importFormat == "" {
+		importFormat = behavior.DetectImportFormat(inputFile)
+	}
+
+	// 1. 開啟檔案
+	fmt.Printf("正在讀取檔案：%s\n", inputFile)
+	file, err := os.Open(inputFile)
+	if err != nil {
+		return fmt.Errorf("讀取檔案失敗：%w", err)
+	}
+	defer file.Close()
+
+	// 2. 初始化依賴注入
+	inject, cleanup, err := newInjector(*cfg)
+	if err != nil {
+		return fmt.Errorf("初始化失敗：%w", err)
+	}
+	defer cleanup()
+
+	// 3. 驗證並匯入
+	if dryRun {
+		fmt.Println("\n=== 預覽模式 ===")
+	}
+	report, err := inject.importHandler.Handle(ctx, command.ImportRecordsCommand{
+		Entity:  model.ImportEntity(entity),
+		Format:  importFormat,
+		PetID:   petID,
+		Mapping: mapping,
+		DryRun:  dryRun,
+		Content: file,
+	})
+	if err != nil {
+		return fmt.Errorf("匯入失敗：%w", err)
+	}
+
+	printReport(report)
+
+	if reportFile != "" {
+		if err := writeReport(reportFile, report); err != nil {
+			return fmt.Errorf("寫入報告失敗：%w", err)
+		}
+		fmt.Printf("報告已寫入：%s\n", reportFile)
+	}
+	return nil
+}
+
+// printReport 輸出有問題的資料列與統計結果
+func printReport(report *model.ImportReport) {
+	for _, row := range report.Rows {
+		switch row.Status {
+		case model.ImportRowInvalid, model.ImportRowFailed:
+			fmt.Printf("第 %d 列 %s：%s\n", row.Row, row.Status, strings.Join(row.Errors, "；"))
+		case model.ImportRowDuplicate:
+			fmt.Printf("第 %d 列已匯入過，略過\n", row.Row)
+		}
+	}
+
+	fmt.Printf("\n處理結果：共 %d 列，", report.Total)
+	if report.DryRun {
+		fmt.Printf("可匯入 %d 列，", report.Valid)
+	} else {
+		fmt.Printf("成功 %d 列，", report.Created)
+	}
+	fmt.Printf("重複 %d 列，驗證失敗 %d 列，寫入失敗 %d 列\n", report.Duplicates, report.Invalid, report.Failed)
+}
+
+// writeReport 將完整報告寫入 JSON 檔案
+func writeReport(filename string, report *model.ImportReport) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
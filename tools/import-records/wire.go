@@ -0,0 +1,36 @@
+//go:build wireinject
+// +build wireinject
+
+package main
+
+import (
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/infra/mongodb"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+	"github.com/google/wire"
+)
+
+// injector 依賴注入容器
+type injector struct {
+	config        config.Config
+	importHandler *command.ImportRecordsHandler
+}
+
+func newInjector(config config.Config) (*injector, func(), error) {
+	panic(wire.Build(
+		wire.Struct(new(injector), "*"),
+		mongodb.ProviderSet,
+		mongodb.NewPetMongoRepo,
+		mongodb.NewHouseholdRepository,
+		mongodb.NewHealthLogRepository,
+		mongodb.NewExpenseRepository,
+		mongodb.NewAuditRepository,
+		mongodb.NewExchangeRateRepository,
+		mongodb.NewUserSettingsRepository,
+		behavior.NewPetAccessGuard,
+		behavior.NewAuditTrail,
+		behavior.NewCurrencyConverter,
+		command.NewImportRecordsHandler,
+	))
+}
@@ -0,0 +1,53 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/blackhorseya/petlog/internal/config"
+	"github.com/blackhorseya/petlog/internal/infra/mongodb"
+	"github.com/blackhorseya/petlog/internal/usecase/behavior"
+	"github.com/blackhorseya/petlog/internal/usecase/command"
+)
+
+// Injectors from wire.go:
+
+func newInjector(config2 config.Config) (*injector, func(), error) {
+	database, cleanup, err := mongodb.NewDatabase(config2)
+	if err != nil {
+		return nil, nil, err
+	}
+	healthLogRepository, err := mongodb.NewHealthLogRepository(database)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	expenseRepository := mongodb.NewExpenseRepository(database)
+	petRepository := mongodb.NewPetMongoRepo(database)
+	householdRepository := mongodb.NewHouseholdRepository(database)
+	petAccessGuard := behavior.NewPetAccessGuard(petRepository, householdRepository)
+	auditRepository := mongodb.NewAuditRepository(database)
+	auditTrail := behavior.NewAuditTrail(auditRepository)
+	exchangeRateRepository := mongodb.NewExchangeRateRepository(database)
+	userSettingsRepository := mongodb.NewUserSettingsRepository(database)
+	currencyConverter := behavior.NewCurrencyConverter(config2, exchangeRateRepository, userSettingsRepository)
+	importRecordsHandler := command.NewImportRecordsHandler(config2, healthLogRepository, expenseRepository, petAccessGuard, auditTrail, currencyConverter)
+	mainInjector := &injector{
+		config:        config2,
+		importHandler: importRecordsHandler,
+	}
+	return mainInjector, func() {
+		cleanup()
+	}, nil
+}
+
+// wire.go:
+
+// injector 依賴注入容器
+type injector struct {
+	config        config.Config
+	importHandler *command.ImportRecordsHandler
+}